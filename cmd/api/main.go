@@ -2,25 +2,41 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	handlerHttp "github.com/mikiasgoitom/Articulate/internal/handler/http"
 	redisclient "github.com/mikiasgoitom/Articulate/internal/infrastructure/cache"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/config"
 	database "github.com/mikiasgoitom/Articulate/internal/infrastructure/database"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/experiments"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/external_services"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/featureflags"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/jwt"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/logger"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/outbox"
 	passwordservice "github.com/mikiasgoitom/Articulate/internal/infrastructure/password_service"
 	randomgenerator "github.com/mikiasgoitom/Articulate/internal/infrastructure/random_generator"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/repository/mongodb"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/resilience"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/storage"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/store"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/tasks"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/uuidgen"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/validator"
 	"github.com/mikiasgoitom/Articulate/internal/usecase"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
 func main() {
@@ -66,60 +82,524 @@ func main() {
 	blogRepo := mongodb.NewBlogRepository(mongoClient.Client.Database(dbName), userCollection)
 	likeRepo := mongodb.NewLikeRepository(mongoClient.Client.Database(dbName))
 	commentRepo := mongodb.NewCommentRepository(mongoClient.Client.Database(dbName))
+	securityEventRepo := mongodb.NewSecurityEventRepository(mongoClient.Client.Database(dbName))
+	tagRepo := mongodb.NewTagRepository(mongoClient.Client.Database(dbName))
+	orgRepo := mongodb.NewOrganizationRepository(mongoClient.Client.Database(dbName))
+	blockedDomainRepo := mongodb.NewBlockedDomainRepository(mongoClient.Client.Database(dbName))
+	moderationActionRepo := mongodb.NewModerationActionRepository(mongoClient.Client.Database(dbName))
+	profanityWordRepo := mongodb.NewProfanityWordRepository(mongoClient.Client.Database(dbName))
+	featureFlagRepo := mongodb.NewFeatureFlagRepository(mongoClient.Client.Database(dbName))
+	experimentRepo := mongodb.NewExperimentRepository(mongoClient.Client.Database(dbName))
+	apiKeyRepo := mongodb.NewAPIKeyRepository(mongoClient.Client.Database(dbName))
+	serviceClientRepo := mongodb.NewServiceClientRepository(mongoClient.Client.Database(dbName))
+	outboxRepo := mongodb.NewOutboxRepository(mongoClient.Client.Database(dbName))
 
 	// Dependency Injection: Services
-	hasher := passwordservice.NewHasher()
+	appConfig := config.NewConfig()
+	hasher := passwordservice.NewHasher(appConfig.GetPasswordHashAlgorithm(), appConfig.GetBcryptCost())
 	jwtSecret := os.Getenv("JWT_SECRET")
 	if jwtSecret == "" {
 		log.Fatal("JWT_SECRET environment variable not set")
 	}
 	jwtManager := jwt.NewJWTManager(jwtSecret)
-	jwtService := jwt.NewJWTService(jwtManager)
+	jwtService := jwt.NewJWTService(jwtManager, appConfig)
 	appLogger := logger.NewStdLogger()
-	mailService := external_services.NewEmailService(smtpHost, smtpPort, smtpUsername, smtpPassword, smtpFrom)
+	mailService := resilience.WrapEmailService(
+		external_services.NewEmailService(smtpHost, smtpPort, smtpUsername, smtpPassword, smtpFrom),
+		resilience.NewPolicy("smtp", 15*time.Second, 2, 5, time.Minute),
+	)
 	randomGenerator := randomgenerator.NewRandomGenerator()
 	appValidator := validator.NewValidator()
 	uuidGenerator := uuidgen.NewGenerator()
-	appConfig := config.NewConfig()
-	aiService := external_services.NewGeminiAIService(appConfig.GetAIServiceAPIKey())
+	aiService := resilience.WrapAIService(
+		external_services.NewGeminiAIService(appConfig.GetAIServiceAPIKey()),
+		resilience.NewPolicy("gemini_ai", 30*time.Second, 2, 5, 30*time.Second),
+	)
 	// config
 	baseURL := appConfig.GetAppBaseURL()
 	// Dependency Injection: Usecases
 	aiUsecase := usecase.NewAIUseCase(aiService)
 	emailUsecase := usecase.NewEmailVerificationUseCase(tokenRepo, userRepo, mailService, randomGenerator, uuidGenerator, baseURL)
 	userUsecase := usecase.NewUserUsecase(userRepo, tokenRepo, emailUsecase, hasher, jwtService, mailService, appLogger, appConfig, appValidator, uuidGenerator, randomGenerator)
+	geoResolver := external_services.NewGeoIPService(os.Getenv("GEOIP_API_URL"))
+	userUsecase.SetSecurityEventTracking(securityEventRepo, geoResolver)
+
+	// Disposable email domain blocking on registration
+	emailBlocklist := external_services.NewDisposableEmailService(blockedDomainRepo, os.Getenv("DISPOSABLE_EMAIL_BLOCKLIST_URL"))
+	userUsecase.SetEmailBlocklist(emailBlocklist)
+
+	// Deactivating or deleting a user cascades to their content's visibility
+	userUsecase.SetContentCascade(blogRepo, commentRepo)
 
 	blogUsecase := usecase.NewBlogUseCase(blogRepo, uuidGenerator, appLogger, aiUsecase)
+	blogUsecase.SetGeoIPResolver(geoResolver)
+	blogUsecase.SetOrganizationRepo(orgRepo)
+	blogUsecase.SetUserRepository(userRepo)
+
+	// New-account probation restrictions, consulted by the comment and blog
+	// usecases
+	probationPolicy := usecase.NewProbationPolicy(userRepo, appConfig)
+	blogUsecase.SetProbationPolicy(probationPolicy)
+
+	// Per-role content limits, configured by admins and enforced centrally
+	// in the blog and comment usecases
+	limitsPolicy := usecase.NewLimitsPolicy(mongodb.NewRoleLimitsRepository(mongoClient.Client.Database(dbName)), userRepo, blogRepo, appLogger)
+	blogUsecase.SetLimitsPolicy(limitsPolicy)
+
+	// Runtime feature flags, managed via the admin API
+	featureFlags := featureflags.NewFeatureFlagService(featureFlagRepo)
+	blogUsecase.SetFeatureFlags(featureFlags)
 
 	// Pass Prometheus metrics to handlers or usecases as needed (import from metrics package)
 
 	// Optional Dependency Injection: Redis cache
+	var tagCache contract.ITagCache
+	var rateCounter contract.IRateLimitCounter
+	var blogCache contract.IBlogCache
+	var feedProjection contract.IFeedProjection
+	var presenceTracker contract.IPresenceTracker
+	var invalidationBus contract.ICacheInvalidationBus
 	if redisURL := os.Getenv("REDIS_URL"); redisURL != "" {
 		rdb := redisclient.NewRedisFromURL(context.Background(), redisURL)
 		defer redisclient.Close(rdb)
-		blogCache := store.NewBlogCacheStore(rdb)
+		blogCache = store.NewBlogCacheStore(rdb)
 		blogUsecase.SetBlogCache(blogCache)
+		tagCache = store.NewTagCacheStore(rdb)
+		rateCounter = store.NewRateLimitCounterStore(rdb)
+		feedProjection = store.NewFeedProjectionStore(rdb)
+		presenceTracker = store.NewPresenceStore(rdb)
+
+		// Cross-instance cache coherency: writes on any instance broadcast
+		// an invalidation so the others drop their in-memory snapshots
+		// instead of serving stale state until the next periodic refresh
+		invalidationBus = store.NewInvalidationBus(rdb)
+		featureFlags.SetInvalidationBus(invalidationBus)
+		emailBlocklist.SetInvalidationBus(invalidationBus)
+
+		// AI response caching: unchanged content reuses its last moderation
+		// verdict instead of being re-sent to the backend
+		aiCacheTTL := 24 * time.Hour
+		if v := os.Getenv("AI_CACHE_TTL_MINUTES"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				aiCacheTTL = time.Duration(n) * time.Minute
+			} else {
+				appLogger.Warningf("invalid AI_CACHE_TTL_MINUTES %q, using default of %s", v, aiCacheTTL)
+			}
+		}
+		aiCache := store.NewAIResponseCacheStore(rdb, aiCacheTTL)
+		aiUsecase.SetResponseCache(aiCache)
+		// Pick up hot-reloaded TTL changes without a restart
+		if reloadableConfig, ok := appConfig.(usecasecontract.IReloadableConfig); ok {
+			reloadableConfig.OnReload(func(changes []usecasecontract.ConfigChange) {
+				for _, change := range changes {
+					if change.Key == "AI_CACHE_TTL_MINUTES" {
+						if n, err := strconv.Atoi(change.New); err == nil && n > 0 {
+							aiCache.SetTTL(time.Duration(n) * time.Minute)
+						}
+					}
+				}
+			})
+		}
 	}
 
 	// Create like usecase
+	// Debounce popularity recomputes on hot posts; the refresher catches up
+	// the deferred ones once per window
+	if rateCounter != nil {
+		blogUsecase.SetPopularityDebounce(rateCounter, 30*time.Second)
+		blogUsecase.StartPopularityRefresher(context.Background())
+	}
+
+	// Per-user AI consumption tracking for quotas and billing metrics;
+	// quotas default to 50 requests per day and 500 per month, zero disables
+	aiQuota := func(envVar string, fallback int) int {
+		v := os.Getenv(envVar)
+		if v == "" {
+			return fallback
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			appLogger.Warningf("invalid %s %q, using default of %d", envVar, v, fallback)
+			return fallback
+		}
+		return n
+	}
+	aiUsecase.SetUsageTracking(
+		mongodb.NewAIUsageRepository(mongoClient.Client.Database(dbName)),
+		aiQuota("AI_DAILY_QUOTA", 50),
+		aiQuota("AI_MONTHLY_QUOTA", 500),
+	)
+
 	likeUsecase := usecase.NewLikeUsecase(likeRepo, blogRepo)
+	likeUsecase.SetPopularityRefresher(blogUsecase)
 
 	// Setup API routes
 	appRouter := handlerHttp.NewRouter(
 		userUsecase, blogUsecase, likeUsecase, emailUsecase,
 		userRepo, tokenRepo, hasher, jwtService, mailService,
 		appLogger, appConfig, appValidator, uuidGenerator, randomGenerator,
-		commentRepo, blogRepo, aiUsecase,
+		commentRepo, blogRepo, tagRepo, orgRepo, aiUsecase,
 	)
+	if tagCache != nil {
+		appRouter.SetTagCache(tagCache)
+	}
+	if rateCounter != nil {
+		appRouter.SetCommentRateLimiter(rateCounter)
+	}
+	appRouter.SetProbationPolicy(probationPolicy)
+	appRouter.SetLimitsPolicy(limitsPolicy)
+
+	// Per-country content restrictions (legal takedowns), enforced on blog
+	// reads via the GeoIP resolver
+	geoRestrictionUC := usecase.NewGeoRestrictionUseCase(mongodb.NewGeoRestrictionRepository(mongoClient.Client.Database(dbName)), blogRepo, uuidGenerator, appLogger)
+	appRouter.SetGeoRestrictions(geoRestrictionUC, geoResolver)
+
+	// DMCA/takedown workflow: public submissions, admin review queue,
+	// tombstones and author notification
+	takedownUsecase := usecase.NewTakedownUseCase(mongodb.NewTakedownRepository(mongoClient.Client.Database(dbName)), blogRepo, userRepo, uuidGenerator, appLogger)
+	takedownUsecase.SetEmailService(mailService)
+	if blogCache != nil {
+		takedownUsecase.SetBlogCache(blogCache)
+	}
+	appRouter.SetTakedowns(takedownUsecase)
+	// Reader highlights with optional notes, plus popular passages per post
+	appRouter.SetHighlights(usecase.NewHighlightUseCase(mongodb.NewHighlightRepository(mongoClient.Client.Database(dbName)), blogRepo, uuidGenerator, appLogger))
+	// Inline editorial comments on drafts for the review workflow
+	appRouter.SetDraftComments(usecase.NewDraftCommentUseCase(mongodb.NewDraftCommentRepository(mongoClient.Client.Database(dbName)), blogRepo, userRepo, uuidGenerator, appLogger))
+	// Bulk user import for platform migrations
+	appRouter.SetUserImport(usecase.NewUserImportUseCase(userRepo, hasher, appValidator, uuidGenerator, randomGenerator, appLogger))
+	// Event posts: RSVPs with capacity enforcement and iCal export
+	eventUsecase := usecase.NewEventUseCase(mongodb.NewEventRSVPRepository(mongoClient.Client.Database(dbName)), blogRepo, userRepo, uuidGenerator, appLogger)
+	if blogCache != nil {
+		eventUsecase.SetBlogCache(blogCache)
+	}
+	appRouter.SetEvents(eventUsecase)
+	appRouter.SetEmailBlocklist(emailBlocklist)
+	appRouter.SetModerationLog(moderationActionRepo)
+	// Audit-grade request/response capture (redacted) on the configured
+	// route prefixes, for debugging production incidents
+	if auditRoutes := os.Getenv("AUDIT_LOG_ROUTES"); auditRoutes != "" {
+		auditSize := 256
+		if v := os.Getenv("AUDIT_LOG_SIZE"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				auditSize = n
+			}
+		}
+		appRouter.SetAuditCapture(auditSize, strings.Split(auditRoutes, ","))
+	}
+	// Panic recovery with error reporting to an external tracker, with
+	// sampled 5xx capture and request correlation IDs
+	if reportURL := os.Getenv("ERROR_REPORT_URL"); reportURL != "" {
+		sampleRate := 1.0
+		if v := os.Getenv("ERROR_REPORT_SAMPLE_RATE"); v != "" {
+			if f, err := strconv.ParseFloat(v, 64); err == nil && f > 0 && f <= 1 {
+				sampleRate = f
+			}
+		}
+		errorReporter := external_services.NewErrorReportingService(reportURL, os.Getenv("ERROR_REPORT_TOKEN"))
+		appRouter.SetErrorReporting(errorReporter, sampleRate)
+	}
+	// Hot configuration reload: admins can override tunable values at
+	// runtime, and SIGHUP resets them back to the environment
+	if reloadableConfig, ok := appConfig.(usecasecontract.IReloadableConfig); ok {
+		appRouter.SetConfigReload(reloadableConfig)
+		sighup := make(chan os.Signal, 1)
+		signal.Notify(sighup, syscall.SIGHUP)
+		go func() {
+			for range sighup {
+				changes := reloadableConfig.Reload("sighup")
+				if len(changes) == 0 {
+					appLogger.Infof("config reload: no changes")
+					continue
+				}
+				for _, change := range changes {
+					appLogger.Infof("config reload: %s changed from %q to %q", change.Key, change.Old, change.New)
+				}
+			}
+		}()
+	}
+	appRouter.SetFeatureFlags(featureFlags)
+	// A/B experiment assignment with exposure tracking
+	experimentService := experiments.NewExperimentService(experimentRepo)
+	appRouter.SetExperiments(experimentService)
+	// Profanity screening of comments, with admin-managed word lists
+	profanityFilter := external_services.NewProfanityFilterService(profanityWordRepo, appConfig.GetProfanityWords())
+	appRouter.SetProfanityFilter(profanityFilter)
+	if invalidationBus != nil {
+		experimentService.SetInvalidationBus(invalidationBus)
+		profanityFilter.SetInvalidationBus(invalidationBus)
+	}
+
+	apiKeyUsecase := usecase.NewAPIKeyUseCase(apiKeyRepo, userRepo, hasher, uuidGenerator, randomGenerator)
+	appRouter.SetAPIKeyService(apiKeyUsecase)
+
+	serviceAuthUsecase := usecase.NewServiceAuthUseCase(serviceClientRepo, jwtManager, hasher, uuidGenerator, randomGenerator)
+	appRouter.SetServiceAuth(serviceAuthUsecase)
+
+	// Newsletter subscriptions (double opt-in) and author broadcasts
+	newsletterRepo := mongodb.NewNewsletterRepository(mongoClient.Client.Database(dbName))
+	newsletterUsecase := usecase.NewNewsletterUseCase(newsletterRepo, blogRepo, userRepo, mailService, uuidGenerator, randomGenerator, appLogger, baseURL)
+	appRouter.SetNewsletter(newsletterUsecase)
+
+	// Reply-by-email for comment notifications (optional): enabled when a
+	// reply domain is configured for the inbound email webhook
+	if replyDomain := os.Getenv("REPLY_EMAIL_DOMAIN"); replyDomain != "" {
+		replyTokenRepo := mongodb.NewEmailReplyTokenRepository(mongoClient.Client.Database(dbName))
+		appRouter.SetReplyByEmail(mailService, replyTokenRepo, randomGenerator, replyDomain, os.Getenv("INBOUND_EMAIL_SECRET"))
+	}
+
+	// Saved searches: users store search/filter combinations and get an
+	// email when a freshly published post matches
+	savedSearchRepo := mongodb.NewSavedSearchRepository(mongoClient.Client.Database(dbName))
+	savedSearchUsecase := usecase.NewSavedSearchUseCase(savedSearchRepo, userRepo, mailService, uuidGenerator, appLogger, baseURL)
+	blogUsecase.SetSavedSearchNotifier(savedSearchUsecase)
+	appRouter.SetSavedSearches(savedSearchUsecase)
+
+	// Search analytics: executed queries, result counts and result clicks
+	// are recorded for the admin analytics endpoint
+	searchEventRepo := mongodb.NewSearchEventRepository(mongoClient.Client.Database(dbName))
+	blogUsecase.SetSearchEventRepository(searchEventRepo)
+
+	// Revision history: every title/content edit snapshots the previous
+	// state for the revision list and diff endpoints
+	blogUsecase.SetRevisionHistory(mongodb.NewBlogRevisionRepository(mongoClient.Client.Database(dbName)))
+
+	// Accepted answers on question posts need the comment repository to
+	// validate the chosen comment
+	blogUsecase.SetCommentRepository(commentRepo)
+
+	// RSS import: authors register external feeds whose new items are
+	// imported as draft posts with canonical-URL attribution
+	rssFeedRepo := mongodb.NewRSSFeedRepository(mongoClient.Client.Database(dbName))
+	rssImportUsecase := usecase.NewRSSImportUseCase(rssFeedRepo, blogRepo, external_services.NewRSSFetcher(), uuidGenerator, appLogger)
+	rssImportUsecase.StartImportScheduler(context.Background())
+	appRouter.SetRSSImport(rssImportUsecase)
+
+	// Open Graph share images: rendered when a post is published, recorded
+	// in the media layer and served from the blog's og-image endpoint
+	mediaRepo := mongodb.NewMediaRepository(mongoClient.Client.Database(dbName))
+	blogUsecase.SetOGImageService(external_services.NewOGImageService(), mediaRepo, baseURL)
+
+	// Media uploads: every file passes magic-byte validation, EXIF/metadata
+	// stripping and (when clamd is configured) a virus scan before it is
+	// persisted to local storage
+	uploadDir := os.Getenv("MEDIA_UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = "./uploads"
+	}
+	fileStorage := storage.NewLocalFileStorage(uploadDir, baseURL)
+	mediaUsecase := usecase.NewMediaUseCase(mediaRepo, fileStorage, external_services.NewMediaSanitizerService(), uuidGenerator, appLogger)
+	if clamdAddr := os.Getenv("CLAMD_ADDR"); clamdAddr != "" {
+		mediaUsecase.SetVirusScanner(external_services.NewClamAVScanner(clamdAddr))
+	}
+	// Responsive image variants, generated asynchronously after upload
+	mediaUsecase.SetImageResizer(external_services.NewImageResizerService())
+	// Per-user storage quota in megabytes; unset or zero means unlimited
+	if quotaMB := os.Getenv("MEDIA_STORAGE_QUOTA_MB"); quotaMB != "" {
+		if mb, err := strconv.ParseInt(quotaMB, 10, 64); err == nil && mb > 0 {
+			mediaUsecase.SetStorageQuota(mb << 20)
+		} else {
+			appLogger.Warningf("invalid MEDIA_STORAGE_QUOTA_MB %q, storage quota disabled", quotaMB)
+		}
+	}
+	// Media library: "where is this used" lookups against blogs
+	mediaUsecase.SetBlogRepository(blogRepo)
+	// Video transcoding (optional): enabled when a transcoder backend is
+	// configured
+	if transcoderURL := os.Getenv("VIDEO_TRANSCODER_URL"); transcoderURL != "" {
+		mediaUsecase.SetVideoTranscoder(external_services.NewVideoTranscoderService(transcoderURL, os.Getenv("VIDEO_TRANSCODER_API_KEY")))
+		mediaUsecase.StartTranscodePoller(context.Background())
+	}
+	// Chunked/resumable uploads for large files, with expiry of abandoned
+	// sessions
+	mediaUsecase.SetUploadSessionRepository(mongodb.NewUploadSessionRepository(mongoClient.Client.Database(dbName)))
+	mediaUsecase.StartSessionCleanup(context.Background())
+	appRouter.SetMedia(mediaUsecase)
+	router.Static("/uploads", uploadDir)
+
+	// Audio renditions (optional): published posts are synthesized to speech
+	// when a TTS backend is configured
+	if ttsURL := os.Getenv("TTS_API_URL"); ttsURL != "" {
+		blogUsecase.SetTTSService(external_services.NewTTSService(ttsURL, os.Getenv("TTS_API_KEY")), mediaRepo, fileStorage)
+	}
+
+	// Comment digest emails: authors who opted in get their comment
+	// notifications batched hourly or daily
+	commentDigestUsecase := usecase.NewCommentDigestUseCase(commentRepo, blogRepo, userRepo, mailService, appLogger)
+	commentDigestUsecase.StartDigestScheduler(context.Background())
+
+	// Author performance reports: authors who opted in get a weekly or
+	// monthly summary of views, top posts, new subscribers and comment
+	// highlights
+	performanceReportUsecase := usecase.NewPerformanceReportUseCase(blogRepo, commentRepo, userRepo, newsletterRepo, mailService, appLogger)
+	performanceReportUsecase.StartReportScheduler(context.Background())
+
+	// Live engagement counters: counter changes stream to open post pages
+	// over SSE
+	engagementBus := store.NewEngagementBus()
+	blogUsecase.SetEngagementBus(engagementBus)
+	likeUsecase.SetEngagementBus(engagementBus)
+	appRouter.SetLiveEngagement(engagementBus, blogUsecase)
+	// Reader presence ("N people reading this post") needs Redis for the
+	// decaying heartbeat counts
+	if presenceTracker != nil {
+		engagementBus.SetPresenceTracker(presenceTracker)
+		blogUsecase.SetPresenceTracker(presenceTracker)
+		appRouter.SetPresence(blogUsecase)
+	}
+
+	// Push notifications (optional): enabled when the FCM credentials are
+	// configured
+	if fcmKey := os.Getenv("FCM_SERVER_KEY"); fcmKey != "" {
+		deviceRepo := mongodb.NewDeviceRepository(mongoClient.Client.Database(dbName))
+		pushProvider := external_services.NewFCMProvider(fcmKey)
+		pushUsecase := usecase.NewPushUseCase(deviceRepo, userRepo, pushProvider, appLogger)
+		likeUsecase.SetPushNotifier(pushUsecase)
+		appRouter.SetPushNotifications(pushUsecase)
+	}
+
+	// Reader memberships behind the paywall; gated posts are truncated to a
+	// teaser for viewers without the required tier
+	membershipUsecase := usecase.NewMembershipUseCase(mongodb.NewMembershipRepository(mongoClient.Client.Database(dbName)), userRepo, uuidGenerator, appLogger, baseURL)
+	blogUsecase.SetAccessPolicy(membershipUsecase)
+	appRouter.SetMemberships(membershipUsecase)
+
+	// Gamification: publishing, received likes and accepted answers earn
+	// reputation points and badges.
+	gamificationUsecase := usecase.NewGamificationUseCase(mongodb.NewGamificationRepository(mongoClient.Client.Database(dbName)), userRepo, uuidGenerator, appLogger)
+	blogUsecase.SetReputationRecorder(gamificationUsecase)
+	likeUsecase.SetReputationRecorder(gamificationUsecase)
+	appRouter.SetGamification(gamificationUsecase)
+
+	// Tipping (optional): enabled when the Stripe credentials are configured
+	if stripeKey := os.Getenv("STRIPE_SECRET_KEY"); stripeKey != "" {
+		tipRepo := mongodb.NewTipRepository(mongoClient.Client.Database(dbName))
+		stripeProvider := external_services.NewStripeProvider(stripeKey, os.Getenv("STRIPE_WEBHOOK_SECRET"))
+		tipUsecase := usecase.NewTipUseCase(tipRepo, blogRepo, stripeProvider, uuidGenerator, appLogger, baseURL)
+		appRouter.SetTipping(tipUsecase)
+		// Membership checkouts reuse the Stripe integration; each tier needs
+		// its recurring price configured
+		if priceID := os.Getenv("STRIPE_PRICE_MEMBER"); priceID != "" {
+			stripeProvider.SetSubscriptionPrice(entity.MembershipTierMember, priceID)
+		}
+		if priceID := os.Getenv("STRIPE_PRICE_PAID"); priceID != "" {
+			stripeProvider.SetSubscriptionPrice(entity.MembershipTierPaid, priceID)
+		}
+		membershipUsecase.SetSubscriptionProvider(stripeProvider)
+	}
+	// Link previews (Open Graph unfurling) for URLs in blogs and comments
+	linkPreviewService := external_services.NewLinkPreviewService()
+	blogUsecase.SetLinkPreviewService(linkPreviewService)
+	appRouter.SetLinkPreviewService(linkPreviewService)
+	// Whitelisted media embeds (oEmbed) in blog content
+	blogUsecase.SetOEmbedService(external_services.NewOEmbedService())
+	// Signed image proxy for external images in blog content
+	imageProxySecret := os.Getenv("IMAGE_PROXY_SECRET")
+	if imageProxySecret == "" {
+		imageProxySecret = jwtSecret
+	}
+	imageProxy := external_services.NewImageProxyService(imageProxySecret, "/api/v1/images/proxy")
+	blogUsecase.SetImageProxy(imageProxy)
+	appRouter.SetImageProxy(imageProxy)
+	// Optional CAPTCHA verification on abuse-prone endpoints
+	if appConfig.GetCaptchaEnabled() {
+		captchaVerifier := external_services.NewCaptchaService(appConfig.GetCaptchaProvider(), appConfig.GetCaptchaSecret())
+		appRouter.SetCaptchaVerifier(captchaVerifier)
+	}
 	appRouter.SetupRoutes(router)
 
-	// Start the server
+	// Transactional outbox relay: blog writes enqueue events in the same
+	// Mongo session, and the relay performs cache invalidation and webhook
+	// delivery (search indexing rides on the webhook to the indexer service)
+	// with retries. The outbox is only enabled when a consumer is configured,
+	// so events never pile up unprocessed.
+	blogEvents := []string{entity.OutboxEventBlogCreated, entity.OutboxEventBlogUpdated, entity.OutboxEventBlogDeleted}
+	outboxRelay := outbox.NewRelay(outboxRepo, 10*time.Second, appLogger)
+	hasOutboxConsumers := false
+	if blogCache != nil {
+		hasOutboxConsumers = true
+		cache := blogCache
+		for _, eventType := range blogEvents {
+			outboxRelay.RegisterHandler(eventType, func(ctx context.Context, event *entity.OutboxEvent) error {
+				if err := cache.InvalidateBlogLists(ctx); err != nil {
+					return err
+				}
+				if slug := event.Payload["slug"]; slug != "" {
+					return cache.InvalidateBlogBySlug(ctx, slug)
+				}
+				return nil
+			})
+		}
+	}
+	if feedProjection != nil {
+		hasOutboxConsumers = true
+		appRouter.SetFeedProjection(feedProjection)
+		projection := feedProjection
+		projectBlog := func(ctx context.Context, event *entity.OutboxEvent) error {
+			blog, err := blogRepo.GetBlogByID(ctx, event.Payload["blog_id"])
+			if err != nil || blog.Status != entity.BlogStatusPublished || blog.ContentRating == entity.ContentRatingMature {
+				// Unpublished, deleted or mature-rated blogs leave the feed;
+				// the home feed is general-audience like the public listing.
+				return projection.RemoveBlog(ctx, event.Payload["blog_id"])
+			}
+			publishedAt := blog.CreatedAt
+			if blog.PublishedAt != nil {
+				publishedAt = *blog.PublishedAt
+			}
+			return projection.UpsertBlog(ctx, blog.ID, blog.Tags, publishedAt, blog.Popularity)
+		}
+		outboxRelay.RegisterHandler(entity.OutboxEventBlogCreated, projectBlog)
+		outboxRelay.RegisterHandler(entity.OutboxEventBlogUpdated, projectBlog)
+		outboxRelay.RegisterHandler(entity.OutboxEventBlogDeleted, func(ctx context.Context, event *entity.OutboxEvent) error {
+			return projection.RemoveBlog(ctx, event.Payload["blog_id"])
+		})
+	}
+	if webhookURL := os.Getenv("OUTBOX_WEBHOOK_URL"); webhookURL != "" {
+		hasOutboxConsumers = true
+		webhookService := external_services.NewWebhookService(webhookURL, os.Getenv("OUTBOX_WEBHOOK_SECRET"))
+		for _, eventType := range blogEvents {
+			outboxRelay.RegisterHandler(eventType, webhookService.Deliver)
+		}
+		// Referral reward fulfillment rides on the same webhook consumer, so
+		// referral events are only enqueued when it is configured.
+		referralEvents := []string{entity.OutboxEventReferralSignup, entity.OutboxEventReferralConverted}
+		for _, eventType := range referralEvents {
+			outboxRelay.RegisterHandler(eventType, webhookService.Deliver)
+		}
+		userUsecase.SetOutbox(outboxRepo)
+		emailUsecase.SetOutbox(outboxRepo)
+	}
+	if hasOutboxConsumers {
+		blogRepo.SetOutbox(outboxRepo)
+		outboxRelay.Start(context.Background())
+	}
+
+	// Start the server and shut down gracefully on SIGINT/SIGTERM: stop
+	// accepting requests, then drain the background task pool so queued
+	// side effects (metrics, cache invalidations, notifications) finish.
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
-	log.Printf("Server running on port %s", port)
-	if err := router.Run(":" + port); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	srv := &http.Server{Addr: ":" + port, Handler: router}
+	go func() {
+		log.Printf("Server running on port %s", port)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	log.Println("Shutting down server...")
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancelShutdown()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Server shutdown: %v", err)
+	}
+	if !tasks.Drain(10 * time.Second) {
+		log.Println("Background tasks did not drain in time")
 	}
 }
@@ -0,0 +1,13 @@
+package contract
+
+import "context"
+
+// IAIResponseCache caches AI responses keyed by prompt type and content
+// hash, so unchanged content is not re-sent to the AI backend.
+type IAIResponseCache interface {
+	// GetResponse returns the cached response for the prompt type and
+	// content hash, with found=false on a miss.
+	GetResponse(ctx context.Context, promptType, contentHash string) (string, bool, error)
+	// SetResponse stores a response until the cache's TTL expires it.
+	SetResponse(ctx context.Context, promptType, contentHash, response string) error
+}
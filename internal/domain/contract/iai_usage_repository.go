@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IAIUsageRepository persists per-user AI consumption for quota enforcement
+// and billing.
+type IAIUsageRepository interface {
+	RecordUsage(ctx context.Context, record *entity.AIUsageRecord) error
+	// SummarizeUsage totals a user's requests and estimated tokens since the
+	// given time.
+	SummarizeUsage(ctx context.Context, userID string, since time.Time) (*entity.AIUsageSummary, error)
+}
@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IAPIKeyRepository persists personal access tokens. Keys are stored hashed,
+// so lookups go through the deterministic hash of the presented key.
+type IAPIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *entity.APIKey) error
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+	ListAPIKeysByUserID(ctx context.Context, userID string) ([]*entity.APIKey, error)
+	// RevokeAPIKey revokes a key owned by the given user; revoking someone
+	// else's key fails the same way as a missing one.
+	RevokeAPIKey(ctx context.Context, keyID, userID string) error
+	// TouchAPIKey advances the key's last-used timestamp.
+	TouchAPIKey(ctx context.Context, keyID string) error
+}
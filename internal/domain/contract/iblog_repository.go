@@ -12,10 +12,21 @@ type IBlogRepository interface {
 	CreateBlog(ctx context.Context, blog *entity.Blog) error
 	GetBlogByID(ctx context.Context, blogID string) (*entity.Blog, error)
 	GetBlogBySlug(ctx context.Context, slug string) (*entity.Blog, error)
+	GetBlogsByFeaturedImageID(ctx context.Context, mediaID string) ([]*entity.Blog, error)
+	CountBlogsByAuthorSince(ctx context.Context, authorID string, since time.Time) (int64, error)
 	GetBlogs(ctx context.Context, filterOptions *BlogFilterOptions) ([]*entity.Blog, int64, error)
 	UpdateBlog(ctx context.Context, blogID string, updates map[string]interface{}) error
 	DeleteBlog(ctx context.Context, blogID string) error
 	SearchBlogs(ctx context.Context, query string, filterOptions *BlogFilterOptions) ([]*entity.Blog, int64, error)
+	// SuggestBlogTitles returns published blogs whose title starts with the
+	// given prefix, most popular first
+	SuggestBlogTitles(ctx context.Context, prefix string, limit int) ([]*entity.BlogSuggestion, error)
+	// GetArchiveCounts returns published-post counts grouped by publication
+	// year and month, newest first
+	GetArchiveCounts(ctx context.Context, orgID *string) ([]entity.ArchivePeriod, error)
+	// GetBlogsByArchivePeriod pages over the posts published in the given
+	// year and month, newest first
+	GetBlogsByArchivePeriod(ctx context.Context, year, month int, orgID *string, page, pageSize int) ([]*entity.Blog, int64, error)
 	IncrementViewCount(ctx context.Context, blogID string) error
 	IncrementLikeCount(ctx context.Context, blogID string) error
 	DecrementLikeCount(ctx context.Context, blogID string) error
@@ -30,12 +41,49 @@ type IBlogRepository interface {
 	GetBlogsByTagID(ctx context.Context, tagID string, opts *BlogFilterOptions) ([]*entity.Blog, int64, error)
 	// GetBlogsByTagIDs retrieves blogs for multiple tag IDs with pagination
 	GetBlogsByTagIDs(ctx context.Context, tagIDs []string, page int, pageSize int) ([]*entity.Blog, int64, error)
+	// GetBlogsByIDs retrieves blogs by ID, preserving the input order
+	GetBlogsByIDs(ctx context.Context, blogIDs []string) ([]*entity.Blog, error)
+	// ListBlogsByAuthorID returns the author's non-deleted blogs
+	ListBlogsByAuthorID(ctx context.Context, authorID string) ([]*entity.Blog, error)
+	// SetAuthorHidden hides or unhides all of the author's blogs in list
+	// queries, used when the author is deactivated or reactivated.
+	SetAuthorHidden(ctx context.Context, authorID string, hidden bool) error
+	// ListBlogIDs pages over the IDs of all non-deleted blogs
+	ListBlogIDs(ctx context.Context, offset, limit int) ([]string, error)
+	// GetTopAuthors aggregates published-blog engagement per author since
+	// the given time; a zero time means all time
+	GetTopAuthors(ctx context.Context, since time.Time, limit int) ([]entity.AuthorStat, error)
+	// ReassignTag replaces one tag with another on every blog that carries it
+	// and returns the number of blogs touched
+	ReassignTag(ctx context.Context, fromTagID, toTagID string) (int64, error)
+	// Review notes (editorial workflow)
+	AddReviewNote(ctx context.Context, note *entity.ReviewNote) error
+	GetReviewNotes(ctx context.Context, blogID string) ([]*entity.ReviewNote, error)
 	HasViewedRecently(ctx context.Context, blogID, userID, ipAddress string) (bool, error)
-	RecordView(ctx context.Context, blogID, userID, ipAddress, userAgent string) error
+	RecordView(ctx context.Context, view *entity.BlogView) error
+	// GetViewGeoBreakdown aggregates recorded views for a blog by country.
+	GetViewGeoBreakdown(ctx context.Context, blogID string) ([]entity.ViewGeoStat, error)
+	// CountViewsSince aggregates the recorded views per blog since the given
+	// time.
+	CountViewsSince(ctx context.Context, blogIDs []string, since time.Time) (map[string]int, error)
 	// IncrementLikeCount(ctx context.Context, blogID string) error
 	// DecrementLikeCount(ctx context.Context, blogID string) error
 	GetRecentViewsByIP(ctx context.Context, ipAddress string, since time.Time) ([]entity.BlogView, error)
 	GetRecentViewsByUser(ctx context.Context, userID string, since time.Time) ([]entity.BlogView, error)
+	// Dwell-time gating: a view is stored as pending on page load and only
+	// counts once the client heartbeat confirms the reader stuck around.
+	CreatePendingView(ctx context.Context, view *entity.BlogView) error
+	// ConsumePendingView removes and returns the viewer's pending view on
+	// the blog; it errors with "no pending view" when none exists.
+	ConsumePendingView(ctx context.Context, blogID, userID, ipAddress string) (*entity.BlogView, error)
+	// RecordViewAnomaly bumps the blog's persisted anomaly score by the
+	// given weight and tallies the signal that fired.
+	RecordViewAnomaly(ctx context.Context, blogID, signal string, weight int) error
+	// ListViewAnomalies returns the blogs with the highest anomaly scores.
+	ListViewAnomalies(ctx context.Context, limit int) ([]entity.ViewAnomaly, error)
+	// RollbackViews subtracts count suspicious views from the blog's view
+	// counter (clamped at zero) and clears its anomaly record.
+	RollbackViews(ctx context.Context, blogID string, count int) error
 }
 
 // BlogFilterOptions encapsulates filtering, pagination, and sorting parameters for blog retrieval.
@@ -51,5 +99,22 @@ type BlogFilterOptions struct {
 	MinLikes  *int
 	MaxLikes  *int
 	AuthorID  *string
-	TagIDs    []string
+	// OrgID filters blogs owned by an organization
+	OrgID *string
+	// AuthorUsername filters by the author's username via a users lookup.
+	AuthorUsername *string
+	TagIDs         []string
+	// TagsAll requires every listed tag to be present (AND), unlike TagIDs
+	// which matches any of them (OR).
+	TagsAll []string
+	// Language filters by the post's language tag.
+	Language *string
+	// Status filters by publication status (e.g. "published", "draft").
+	Status *string
+	// MinReadingTime / MaxReadingTime bound the estimated reading time in
+	// minutes.
+	MinReadingTime *int
+	MaxReadingTime *int
+	// ExcludeMature drops mature-rated posts, for public feeds.
+	ExcludeMature bool
 }
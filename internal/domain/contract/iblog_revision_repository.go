@@ -0,0 +1,19 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IBlogRevisionRepository persists per-blog revision snapshots.
+type IBlogRevisionRepository interface {
+	// CreateRevision stores a snapshot, assigning the next revision number
+	// for the blog.
+	CreateRevision(ctx context.Context, revision *entity.BlogRevision) error
+	// ListRevisions returns a blog's revision metadata, newest first, with
+	// Content omitted to keep the listing light.
+	ListRevisions(ctx context.Context, blogID string) ([]*entity.BlogRevision, error)
+	// GetRevision retrieves one revision of a blog by its number.
+	GetRevision(ctx context.Context, blogID string, number int) (*entity.BlogRevision, error)
+}
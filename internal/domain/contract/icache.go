@@ -12,6 +12,13 @@ type CachedBlogsPage struct {
 	Total int           `json:"total"`
 }
 
+// ITagCache defines caching operations for tag auto-complete suggestions.
+type ITagCache interface {
+	// Suggestion lists (key built by usecase)
+	GetTagSuggestions(ctx context.Context, key string) ([]*entity.TagSuggestion, bool, error)
+	SetTagSuggestions(ctx context.Context, key string, suggestions []*entity.TagSuggestion) error
+}
+
 // IBlogCache defines caching operations for blogs.
 type IBlogCache interface {
 	// Detail (by slug)
@@ -29,4 +36,11 @@ type IBlogCache interface {
 	GetRecentViewCountByIP(ctx context.Context, ip string) (int64, error)
 	AddRecentViewByUser(ctx context.Context, userID, ip string, ttlSeconds int64) error
 	GetRecentIPCountByUser(ctx context.Context, userID string) (int64, error)
+
+	// Search type-ahead: cached title suggestions (key built by usecase) and
+	// a running tally of executed search queries.
+	GetBlogSuggestions(ctx context.Context, key string) ([]*entity.BlogSuggestion, bool, error)
+	SetBlogSuggestions(ctx context.Context, key string, suggestions []*entity.BlogSuggestion) error
+	RecordSearchQuery(ctx context.Context, query string) error
+	GetPopularSearchQueries(ctx context.Context, limit int) ([]string, error)
 }
@@ -0,0 +1,27 @@
+package contract
+
+import "context"
+
+// Invalidation topics. Each names one in-memory snapshot that instances keep
+// of repository-backed state; a write under a topic tells every instance to
+// drop that snapshot and re-read on the next check.
+const (
+	InvalidationTopicFeatureFlags   = "feature_flags"
+	InvalidationTopicEmailBlocklist = "email_blocklist"
+	InvalidationTopicProfanityWords = "profanity_words"
+	InvalidationTopicExperiments    = "experiments"
+)
+
+// ICacheInvalidationBus fans cache invalidations out to every running API
+// instance, so a write on one instance does not leave the others serving a
+// stale in-memory snapshot until their refresh interval elapses. Delivery is
+// best-effort: a missed broadcast only delays coherency until the next
+// periodic refresh.
+type ICacheInvalidationBus interface {
+	// PublishInvalidation broadcasts that cached state under topic is stale.
+	PublishInvalidation(ctx context.Context, topic string)
+	// SubscribeInvalidations registers a handler invoked for every broadcast
+	// topic, including this instance's own. Handlers must be fast and must
+	// not block.
+	SubscribeInvalidations(handler func(topic string))
+}
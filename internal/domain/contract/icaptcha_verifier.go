@@ -0,0 +1,9 @@
+package contract
+
+import "context"
+
+// ICaptchaVerifier validates CAPTCHA response tokens (reCAPTCHA/hCaptcha)
+// server-side against the provider's verification endpoint.
+type ICaptchaVerifier interface {
+	VerifyToken(ctx context.Context, token, remoteIP string) (bool, error)
+}
@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 )
@@ -30,13 +31,32 @@ type ICommentRepository interface {
 	Delete(ctx context.Context, id string) error
 
 	// Listing operations
-	GetTopLevelComments(ctx context.Context, blogID string, pagination Pagination) ([]*entity.Comment, int64, error)
-	GetCommentThread(ctx context.Context, parentID string) (*entity.CommentThread, error)
+	// GetTopLevelComments returns approved comments for a blog; when viewerID is
+	// provided the viewer's own hidden comments are included (shadow ban support).
+	GetTopLevelComments(ctx context.Context, blogID string, viewerID *string, pagination Pagination) ([]*entity.Comment, int64, error)
+	// GetCommentThread returns a top-level comment with its nested replies;
+	// viewerID has the same shadow-ban semantics as GetTopLevelComments.
+	GetCommentThread(ctx context.Context, parentID string, viewerID *string) (*entity.CommentThread, error)
 	GetCommentsByUser(ctx context.Context, userID string, pagination Pagination) ([]*entity.Comment, int64, error)
+	// ListApprovedByBlogIDsSince returns the approved comments created on any
+	// of the blogs after the cutoff, oldest first (digest building).
+	ListApprovedByBlogIDsSince(ctx context.Context, blogIDs []string, since time.Time) ([]*entity.Comment, error)
 
 	// Status and moderation
 	UpdateStatus(ctx context.Context, id, status string) error
 	GetCommentCount(ctx context.Context, blogID string) (int64, error)
+	// Bulk moderation; each applies a single batched update and returns the
+	// number of comments affected.
+	BulkUpdateStatus(ctx context.Context, commentIDs []string, status string) (int64, error)
+	BulkDelete(ctx context.Context, commentIDs []string) (int64, error)
+
+	// User visibility cascade
+	// SetAuthorHidden hides or unhides all of the author's comments in
+	// listings, used when the author is deactivated or reactivated.
+	SetAuthorHidden(ctx context.Context, authorID string, hidden bool) error
+	// AnonymizeByAuthor blanks the denormalized author name on all of the
+	// author's comments after their account is deleted.
+	AnonymizeByAuthor(ctx context.Context, authorID, placeholder string) error
 
 	// Like system
 	LikeComment(ctx context.Context, commentID, userID string) error
@@ -44,8 +64,15 @@ type ICommentRepository interface {
 	IsCommentLikedByUser(ctx context.Context, commentID, userID string) (bool, error)
 	GetCommentLikeCount(ctx context.Context, commentID string) (int64, error)
 
+	// Reaction system (stored alongside likes in comment_likes)
+	ReactToComment(ctx context.Context, commentID, userID, reaction string) error
+	RemoveCommentReaction(ctx context.Context, commentID, userID, reaction string) error
+	GetCommentReactionCounts(ctx context.Context, commentID string) (map[string]int64, error)
+	GetUserCommentReactions(ctx context.Context, commentID, userID string) ([]string, error)
+
 	// Reporting system
 	ReportComment(ctx context.Context, report *entity.CommentReport) error
+	GetReportByID(ctx context.Context, reportID string) (*entity.CommentReport, error)
 	GetCommentReports(ctx context.Context, pagination Pagination) ([]*entity.CommentReport, int64, error)
 	UpdateReportStatus(ctx context.Context, reportID string, status string, reviewerID string) error
 }
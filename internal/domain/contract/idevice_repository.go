@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IDeviceRepository provides methods for managing push notification device
+// registrations in the database.
+type IDeviceRepository interface {
+	// RegisterDevice stores the device token, re-assigning it to the user
+	// when the token is already registered.
+	RegisterDevice(ctx context.Context, device *entity.Device) error
+	ListDevicesByUserID(ctx context.Context, userID string) ([]*entity.Device, error)
+	DeleteDeviceByToken(ctx context.Context, userID, token string) error
+}
@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IDraftCommentRepository persists editorial comments on drafts.
+type IDraftCommentRepository interface {
+	// CreateComment inserts a new draft comment.
+	CreateComment(ctx context.Context, comment *entity.DraftComment) error
+	// ListComments returns a blog's draft comments, oldest first.
+	ListComments(ctx context.Context, blogID string) ([]*entity.DraftComment, error)
+	// GetCommentByID retrieves a draft comment by its ID.
+	GetCommentByID(ctx context.Context, commentID string) (*entity.DraftComment, error)
+	// SetResolved updates a comment's resolve state, recording who resolved
+	// it; unresolving clears the resolver.
+	SetResolved(ctx context.Context, commentID string, resolved bool, resolverID string) error
+}
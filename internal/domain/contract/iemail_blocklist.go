@@ -0,0 +1,24 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IBlockedDomainRepository persists admin-added email domain blocklist
+// entries.
+type IBlockedDomainRepository interface {
+	AddDomain(ctx context.Context, domain *entity.BlockedDomain) error
+	RemoveDomain(ctx context.Context, domain string) error
+	ListDomains(ctx context.Context) ([]string, error)
+}
+
+// IEmailBlocklist answers whether an email address uses a blocked
+// (disposable) domain and lets admins manage extra blocklist entries.
+type IEmailBlocklist interface {
+	IsBlocked(ctx context.Context, email string) bool
+	AddDomain(ctx context.Context, domain string) error
+	RemoveDomain(ctx context.Context, domain string) error
+	ListDomains(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,14 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IEmailReplyTokenRepository provides methods for managing reply-by-email
+// tokens in the database.
+type IEmailReplyTokenRepository interface {
+	CreateReplyToken(ctx context.Context, token *entity.EmailReplyToken) error
+	GetReplyToken(ctx context.Context, token string) (*entity.EmailReplyToken, error)
+}
@@ -4,4 +4,8 @@ import "context"
 
 type IEmailService interface {
 	SendEmail(ctx context.Context, to, subject, body string) error
+	// SendEmailWithReplyTo sends an email whose Reply-To header points at
+	// the given address, so answering it reaches that address instead of
+	// the sender.
+	SendEmailWithReplyTo(ctx context.Context, to, subject, body, replyTo string) error
 }
@@ -0,0 +1,15 @@
+package contract
+
+import "github.com/mikiasgoitom/Articulate/internal/domain/entity"
+
+// IEngagementBus fans live engagement counter updates out to subscribers
+// (the SSE connections of open post pages).
+type IEngagementBus interface {
+	// Publish delivers the update to every subscriber of its blog. Slow
+	// subscribers may miss intermediate updates; each update is a full
+	// snapshot so the next one catches them up.
+	Publish(update entity.EngagementUpdate)
+	// Subscribe returns a channel of updates for the blog and a cancel
+	// function that must be called when the subscriber disconnects.
+	Subscribe(blogID string) (<-chan entity.EngagementUpdate, func())
+}
@@ -0,0 +1,30 @@
+package contract
+
+import "context"
+
+// ErrorReport describes one captured server failure: a recovered panic or a
+// 5xx response. Fields identify the failing request without carrying request
+// bodies, so reports stay free of user content.
+type ErrorReport struct {
+	// Kind is "panic" or "http_5xx".
+	Kind string `json:"kind"`
+	// Message is the panic value or error summary, scrubbed of sensitive
+	// data before it reaches the reporter.
+	Message string `json:"message"`
+	// Stack is the goroutine stack trace; empty for plain 5xx reports.
+	Stack string `json:"stack,omitempty"`
+	// Route is the matched route template (e.g. /api/v1/blogs/:blogID), not
+	// the raw URL, so report grouping works and no path parameters leak.
+	Route     string `json:"route"`
+	Method    string `json:"method"`
+	Status    int    `json:"status"`
+	RequestID string `json:"request_id,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+}
+
+// IErrorReporter forwards captured panics and server errors to an external
+// error tracking service (Sentry, Rollbar, ...). Implementations must not
+// block request handling and must swallow their own delivery failures.
+type IErrorReporter interface {
+	Report(ctx context.Context, report *ErrorReport)
+}
@@ -0,0 +1,22 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IEventRSVPRepository manages RSVPs to event posts.
+type IEventRSVPRepository interface {
+	CreateRSVP(ctx context.Context, rsvp *entity.EventRSVP) error
+	// GetRSVP returns a user's RSVP to an event, if any.
+	GetRSVP(ctx context.Context, blogID, userID string) (*entity.EventRSVP, error)
+	DeleteRSVP(ctx context.Context, blogID, userID string) error
+	// CountRSVPs returns the number of RSVPs on an event, for capacity
+	// enforcement.
+	CountRSVPs(ctx context.Context, blogID string) (int64, error)
+	// ListRSVPsByBlog returns an event's RSVPs, oldest first.
+	ListRSVPsByBlog(ctx context.Context, blogID string) ([]*entity.EventRSVP, error)
+	// ListRSVPsByUser returns a user's RSVPs, newest first.
+	ListRSVPsByUser(ctx context.Context, userID string) ([]*entity.EventRSVP, error)
+}
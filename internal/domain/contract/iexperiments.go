@@ -0,0 +1,31 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IExperimentRepository persists experiment definitions and exposure events.
+type IExperimentRepository interface {
+	UpsertExperiment(ctx context.Context, experiment *entity.Experiment) error
+	GetExperiment(ctx context.Context, name string) (*entity.Experiment, error)
+	ListExperiments(ctx context.Context) ([]*entity.Experiment, error)
+	DeleteExperiment(ctx context.Context, name string) error
+	// RecordExposure upserts the subject's exposure record for an
+	// experiment, so repeated assignments do not flood the collection.
+	RecordExposure(ctx context.Context, exposure *entity.ExperimentExposure) error
+}
+
+// IExperiments assigns subjects (users or sessions) to experiment variants.
+// Assignments are deterministic: the same subject always lands in the same
+// variant for a given experiment.
+type IExperiments interface {
+	// Assign returns the subject's variant for an active experiment and
+	// records the exposure; ok is false when the experiment does not exist
+	// or is inactive.
+	Assign(ctx context.Context, experiment, subjectID string) (variant string, ok bool)
+	SetExperiment(ctx context.Context, name string, variants []entity.ExperimentVariant, active bool) error
+	ListExperiments(ctx context.Context) ([]*entity.Experiment, error)
+	DeleteExperiment(ctx context.Context, name string) error
+}
@@ -0,0 +1,31 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IFeatureFlagRepository persists feature flags.
+type IFeatureFlagRepository interface {
+	UpsertFlag(ctx context.Context, flag *entity.FeatureFlag) error
+	GetFlag(ctx context.Context, name string) (*entity.FeatureFlag, error)
+	ListFlags(ctx context.Context) ([]*entity.FeatureFlag, error)
+	DeleteFlag(ctx context.Context, name string) error
+}
+
+// IFeatureFlags answers runtime feature toggles. Flags that have never been
+// defined fall back to the caller-provided default, so wiring a feature
+// through the flag service does not change its behavior until an admin sets
+// the flag.
+type IFeatureFlags interface {
+	// IsEnabled reports whether the flag is fully enabled.
+	IsEnabled(ctx context.Context, name string, fallback bool) bool
+	// IsEnabledFor reports whether the flag is enabled for a specific
+	// subject, honoring percentage rollouts (the same subject always gets
+	// the same answer for a given percentage).
+	IsEnabledFor(ctx context.Context, name, subjectID string, fallback bool) bool
+	SetFlag(ctx context.Context, name string, enabled bool, rolloutPercent int) error
+	ListFlags(ctx context.Context) ([]*entity.FeatureFlag, error)
+	DeleteFlag(ctx context.Context, name string) error
+}
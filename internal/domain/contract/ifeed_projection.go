@@ -0,0 +1,24 @@
+package contract
+
+import (
+	"context"
+	"time"
+)
+
+// IFeedProjection maintains precomputed feed lists so the home feed reads a
+// ready-made projection instead of running the "latest + popular + followed"
+// query per request. The projection is updated from domain events and may
+// briefly lag the source of truth.
+type IFeedProjection interface {
+	// UpsertBlog records a published blog in the latest, popular and per-tag
+	// feed lists.
+	UpsertBlog(ctx context.Context, blogID string, tagIDs []string, publishedAt time.Time, popularity float64) error
+	// RemoveBlog drops a blog from every feed list it appears in.
+	RemoveBlog(ctx context.Context, blogID string) error
+	// LatestBlogIDs pages through the site-wide latest list, newest first.
+	LatestBlogIDs(ctx context.Context, offset, limit int) ([]string, int64, error)
+	// PopularBlogIDs pages through the site-wide popular list.
+	PopularBlogIDs(ctx context.Context, offset, limit int) ([]string, int64, error)
+	// BlogIDsForTags pages through the merged per-tag lists, newest first.
+	BlogIDsForTags(ctx context.Context, tagIDs []string, offset, limit int) ([]string, int64, error)
+}
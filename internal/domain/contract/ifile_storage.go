@@ -0,0 +1,17 @@
+package contract
+
+import "context"
+
+// IFileStorage persists upload bytes and serves them back by URL.
+type IFileStorage interface {
+	// Save stores the bytes under the given name and returns the public URL
+	// the file is served from.
+	Save(ctx context.Context, fileName string, data []byte) (string, error)
+	// Append adds bytes to the end of a stored file, creating it when
+	// missing. Used to assemble chunked uploads.
+	Append(ctx context.Context, fileName string, data []byte) error
+	// Load reads a stored file back into memory.
+	Load(ctx context.Context, fileName string) ([]byte, error)
+	// Delete removes a stored file by the name it was saved under.
+	Delete(ctx context.Context, fileName string) error
+}
@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IGamificationRepository persists per-user reputation action tallies and
+// the badges awarded for reaching their milestones.
+type IGamificationRepository interface {
+	// IncrementActionCount bumps the user's tally for an action and returns
+	// the new count.
+	IncrementActionCount(ctx context.Context, userID string, action entity.ReputationAction) (int, error)
+	// AwardBadge stores a badge unless the user already holds it and reports
+	// whether it was newly awarded.
+	AwardBadge(ctx context.Context, badge *entity.Badge) (bool, error)
+	// ListBadgesByUser retrieves a user's badges, oldest first.
+	ListBadgesByUser(ctx context.Context, userID string) ([]*entity.Badge, error)
+}
@@ -0,0 +1,25 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IGeoRestrictionRepository persists per-country content restrictions and
+// their audit trail.
+type IGeoRestrictionRepository interface {
+	// UpsertRestriction stores (or replaces) a blog's restriction.
+	UpsertRestriction(ctx context.Context, restriction *entity.GeoRestriction) error
+	// GetRestrictionByBlogID returns a blog's restriction, or an error when
+	// none exists.
+	GetRestrictionByBlogID(ctx context.Context, blogID string) (*entity.GeoRestriction, error)
+	// DeleteRestriction removes a blog's restriction.
+	DeleteRestriction(ctx context.Context, blogID string) error
+	// ListRestrictions returns every restricted blog.
+	ListRestrictions(ctx context.Context) ([]*entity.GeoRestriction, error)
+	// AddAuditEntry appends to the restriction audit trail.
+	AddAuditEntry(ctx context.Context, entry *entity.GeoRestrictionAudit) error
+	// ListAuditEntries returns a blog's audit trail, newest first.
+	ListAuditEntries(ctx context.Context, blogID string) ([]*entity.GeoRestrictionAudit, error)
+}
@@ -0,0 +1,16 @@
+package contract
+
+import "context"
+
+// GeoLocation holds the geographic information resolved for an IP address.
+type GeoLocation struct {
+	Country string
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country.
+	CountryCode string
+	Region      string
+}
+
+// IGeoIPResolver resolves an IP address to a geographic location.
+type IGeoIPResolver interface {
+	ResolveIP(ctx context.Context, ipAddress string) (*GeoLocation, error)
+}
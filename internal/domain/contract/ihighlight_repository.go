@@ -0,0 +1,21 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IHighlightRepository persists reader highlights on posts.
+type IHighlightRepository interface {
+	// CreateHighlight inserts a new highlight.
+	CreateHighlight(ctx context.Context, highlight *entity.Highlight) error
+	// GetHighlightByID retrieves a highlight by its ID.
+	GetHighlightByID(ctx context.Context, highlightID string) (*entity.Highlight, error)
+	// DeleteHighlight removes a highlight.
+	DeleteHighlight(ctx context.Context, highlightID string) error
+	// ListHighlightsByUser returns a user's highlights, newest first.
+	ListHighlightsByUser(ctx context.Context, userID string) ([]*entity.Highlight, error)
+	// TopHighlights aggregates a post's most-highlighted passages.
+	TopHighlights(ctx context.Context, blogID string, limit int) ([]entity.HighlightStat, error)
+}
@@ -0,0 +1,19 @@
+package contract
+
+import "context"
+
+// IImageProxyService fetches, caches and resizes external images on behalf
+// of clients, so blog pages avoid mixed-content and hotlinking issues. Proxy
+// URLs are HMAC-signed so the endpoint cannot be abused as an open proxy.
+type IImageProxyService interface {
+	// SignURL returns the signed proxy path for an external image URL; width
+	// is an optional resize target (0 keeps the original size).
+	SignURL(rawURL string, width int) string
+	// VerifySignature reports whether sig is valid for the URL and width.
+	VerifySignature(rawURL string, width int, sig string) bool
+	// Fetch returns the (possibly resized) image bytes and content type.
+	Fetch(ctx context.Context, rawURL string, width int) ([]byte, string, error)
+	// RewriteContent replaces external image references in HTML content with
+	// signed proxy URLs.
+	RewriteContent(content string) string
+}
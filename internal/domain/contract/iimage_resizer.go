@@ -0,0 +1,12 @@
+package contract
+
+// IImageResizer scales uploaded images down for responsive variants.
+// Implementations may also transcode to better-compressed formats (WebP,
+// AVIF) when an encoder is available; the returned MIME type says what was
+// produced.
+type IImageResizer interface {
+	// ResizeImage scales the image to the given width, keeping the aspect
+	// ratio. It returns the encoded bytes, the produced MIME type and the
+	// resulting height.
+	ResizeImage(data []byte, mimeType string, width int) ([]byte, string, int, error)
+}
@@ -1,19 +1,41 @@
 package contract
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
 
 type IJWTManager interface {
-	GenerateAccessToken(userID, userRole string) (string, error)
-	GenerateRefreshToken(tokenID, userID string) (string, error)
+	// A non-positive ttl falls back to the manager's default lifetime.
+	GenerateAccessToken(userID, userRole, clientType string, ttl time.Duration) (string, error)
+	GenerateRefreshToken(tokenID, userID, clientType string, rememberMe bool, ttl time.Duration) (string, error)
 	VerifyToken(token string) (*CustomClaims, error)
 	VerifyRefreshToken(token string) (*RefreshClaims, error)
+	// GenerateServiceToken issues a client-credentials token for an internal
+	// service. A non-positive ttl falls back to the manager's default lifetime.
+	GenerateServiceToken(clientID string, scopes []string, ttl time.Duration) (string, error)
+	VerifyServiceToken(token string) (*ServiceClaims, error)
 }
 
 type CustomClaims struct {
-	Role string `json:"role"`
+	Role       string `json:"role"`
+	ClientType string `json:"client_type,omitempty"`
 	jwt.RegisteredClaims
 }
 
 type RefreshClaims struct {
+	ClientType string `json:"client_type,omitempty"`
+	RememberMe bool   `json:"remember_me,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// ServiceClaims are the claims of a machine token issued through the
+// client-credentials flow. The subject is the service client ID; TokenUse
+// keeps service tokens from being accepted where user tokens are expected
+// and vice versa.
+type ServiceClaims struct {
+	Scopes   []string `json:"scopes"`
+	TokenUse string   `json:"token_use"`
 	jwt.RegisteredClaims
 }
@@ -14,4 +14,11 @@ type ILikeRepository interface {
 	GetReactionByUserIDTargetIDAndType(ctx context.Context, userID, targetID string, reactionType entity.LikeType) (*entity.Like, error) // Changed from uuid.UUID to string
 	CountLikesByTargetID(ctx context.Context, targetID string) (int64, error)                                                            // Changed from uuid.UUID to string
 	CountDislikesByTargetID(ctx context.Context, targetID string) (int64, error)                                                         // Changed from uuid.UUID to string
+	// AddClaps adds up to count claps from the user, capped at
+	// entity.MaxClapsPerUser per blog, and returns the user's new tally
+	AddClaps(ctx context.Context, userID, blogID string, count int) (int, error)
+	// GetClapCountByUserIDAndBlogID returns the user's clap tally for a blog
+	GetClapCountByUserIDAndBlogID(ctx context.Context, userID, blogID string) (int, error)
+	// CountClapsByBlogID sums the claps a blog received across all users
+	CountClapsByBlogID(ctx context.Context, blogID string) (int64, error)
 }
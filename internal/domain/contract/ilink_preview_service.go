@@ -0,0 +1,16 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ILinkPreviewService resolves URLs found in user-submitted content into
+// Open Graph link previews. Implementations are expected to guard against
+// SSRF and to cache fetched metadata.
+type ILinkPreviewService interface {
+	// ExtractPreviews returns previews for the URLs in content; URLs that
+	// cannot be fetched safely are skipped.
+	ExtractPreviews(ctx context.Context, content string) []entity.LinkPreview
+}
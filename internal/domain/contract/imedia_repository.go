@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 )
@@ -10,10 +11,48 @@ import (
 type MediaFilterOptions struct {
 	UploadedByUserID *string
 	MimeType         *string
-	Page             int64
-	Limit            int64
-	SortBy           string // e.g., "created_at", "file_name"
-	SortOrder        string // "asc" or "desc"
+	DateFrom         *time.Time
+	DateTo           *time.Time
+	// Used filters on whether the media is referenced by a blog.
+	Used      *bool
+	Page      int64
+	Limit     int64
+	SortBy    string // e.g., "created_at", "file_name"
+	SortOrder string // "asc" or "desc"
+}
+
+// StorageUsageByType is one slice of a user's storage usage, grouped by
+// MIME type.
+type StorageUsageByType struct {
+	MimeType string
+	Bytes    int64
+	Count    int64
+}
+
+// IMediaStore is the subset of the media layer that components storing
+// generated assets (e.g. share images) depend on.
+type IMediaStore interface {
+	CreateMedia(ctx context.Context, media *entity.Media) error
+	AssociateMediaWithBlog(ctx context.Context, mediaID, blogID string) error
+	SetMediaVariants(ctx context.Context, mediaID string, variants []entity.MediaVariant) error
+	// MarkTranscodeSubmitted records the transcoder job a video upload was
+	// handed to.
+	MarkTranscodeSubmitted(ctx context.Context, mediaID, jobID string) error
+	// SetTranscodeResult stores a finished (or failed) transcode's outcome.
+	SetTranscodeResult(ctx context.Context, mediaID string, status *entity.TranscodeJobStatus) error
+	// ListProcessingTranscodes returns video media whose transcode job is
+	// still in flight, for status polling.
+	ListProcessingTranscodes(ctx context.Context, limit int) ([]*entity.Media, error)
+	GetMediaByID(ctx context.Context, mediaID string) (*entity.Media, error)
+	// ListUserMedia returns a page of a user's media matching the filters,
+	// with the total match count.
+	ListUserMedia(ctx context.Context, opts *MediaFilterOptions) ([]*entity.Media, int64, error)
+	// BulkSoftDeleteByUser soft-deletes the given media IDs that belong to
+	// the user and returns how many were deleted.
+	BulkSoftDeleteByUser(ctx context.Context, userID string, mediaIDs []string) (int64, error)
+	// GetStorageUsage sums the bytes a user's live uploads occupy, grouped
+	// by MIME type.
+	GetStorageUsage(ctx context.Context, userID string) ([]StorageUsageByType, error)
 }
 
 // IMediaRepository defines the interface for media data persistence.
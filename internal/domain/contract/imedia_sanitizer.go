@@ -0,0 +1,19 @@
+package contract
+
+import "context"
+
+// IMediaSanitizer validates and cleans upload bytes before they are
+// persisted: the file's magic bytes must match the declared MIME type, and
+// image metadata (EXIF, GPS coordinates, embedded comments) is stripped.
+type IMediaSanitizer interface {
+	// SanitizeUpload returns the cleaned bytes, or an error when the content
+	// does not match the declared MIME type or the type is not allowed.
+	SanitizeUpload(fileName, declaredMIME string, data []byte) ([]byte, error)
+}
+
+// IVirusScanner scans upload bytes for malware before they are persisted.
+type IVirusScanner interface {
+	// Scan returns an error when the content is infected or the scan could
+	// not be completed.
+	Scan(ctx context.Context, fileName string, data []byte) error
+}
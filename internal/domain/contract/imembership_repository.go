@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IMembershipRepository manages reader membership subscriptions.
+type IMembershipRepository interface {
+	// UpsertMembership creates or replaces a user's membership record.
+	UpsertMembership(ctx context.Context, membership *entity.Membership) error
+	GetMembershipByUserID(ctx context.Context, userID string) (*entity.Membership, error)
+	// GetMembershipBySubscriptionID resolves a membership from the payments
+	// provider's subscription identifier, for webhook handling.
+	GetMembershipBySubscriptionID(ctx context.Context, subscriptionID string) (*entity.Membership, error)
+}
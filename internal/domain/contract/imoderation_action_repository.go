@@ -0,0 +1,15 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IModerationActionRepository defines the interface for moderation audit
+// record persistence.
+type IModerationActionRepository interface {
+	RecordAction(ctx context.Context, action *entity.ModerationAction) error
+	GetActionsByComment(ctx context.Context, commentID string) ([]*entity.ModerationAction, error)
+	GetActionsByUser(ctx context.Context, userID string) ([]*entity.ModerationAction, error)
+}
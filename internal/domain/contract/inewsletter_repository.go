@@ -0,0 +1,31 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// INewsletterRepository provides methods for managing newsletter
+// subscriptions and broadcasts in the database.
+type INewsletterRepository interface {
+	CreateSubscription(ctx context.Context, subscription *entity.NewsletterSubscription) error
+	// GetSubscriptionByEmailAndAuthor looks up a subscription on one list;
+	// an empty authorID addresses the site newsletter
+	GetSubscriptionByEmailAndAuthor(ctx context.Context, email, authorID string) (*entity.NewsletterSubscription, error)
+	// ConfirmSubscription completes the double opt-in for the confirm token
+	ConfirmSubscription(ctx context.Context, confirmToken string) (*entity.NewsletterSubscription, error)
+	// DeleteSubscriptionByToken removes the subscription behind an
+	// unsubscribe link
+	DeleteSubscriptionByToken(ctx context.Context, unsubscribeToken string) error
+	// ListConfirmedSubscriptions returns the confirmed subscribers of the
+	// author's list plus the site-wide list
+	ListConfirmedSubscriptions(ctx context.Context, authorID string) ([]*entity.NewsletterSubscription, error)
+
+	CreateBroadcast(ctx context.Context, broadcast *entity.NewsletterBroadcast) error
+	// UpdateBroadcastStats records delivery progress; completed marks the
+	// broadcast sent
+	UpdateBroadcastStats(ctx context.Context, broadcastID string, delivered, failed int, completed bool) error
+	// ListBroadcastsByAuthorID lists the author's broadcasts, newest first
+	ListBroadcastsByAuthorID(ctx context.Context, authorID string) ([]*entity.NewsletterBroadcast, error)
+}
@@ -0,0 +1,19 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IOEmbedService resolves media URLs from whitelisted providers (YouTube,
+// Twitter, ...) into oEmbed metadata, and strips embed markup from
+// unapproved sources.
+type IOEmbedService interface {
+	// ResolveEmbeds returns embed metadata for the whitelisted media URLs in
+	// content; URLs from unknown providers are ignored.
+	ResolveEmbeds(ctx context.Context, content string) []entity.EmbeddedMedia
+	// SanitizeContent removes iframe tags whose source is not a whitelisted
+	// provider.
+	SanitizeContent(content string) string
+}
@@ -0,0 +1,8 @@
+package contract
+
+// IOGImageRenderer renders the social share (Open Graph) image for a post:
+// its title and author drawn over the site template.
+type IOGImageRenderer interface {
+	// RenderShareImage returns the rendered image as PNG bytes.
+	RenderShareImage(title, author string) ([]byte, error)
+}
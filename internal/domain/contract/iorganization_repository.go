@@ -0,0 +1,26 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IOrganizationRepository defines the interface for organization data persistence.
+type IOrganizationRepository interface {
+	CreateOrganization(ctx context.Context, org *entity.Organization) error
+	GetOrganizationByID(ctx context.Context, orgID string) (*entity.Organization, error)
+	GetOrganizationBySlug(ctx context.Context, slug string) (*entity.Organization, error)
+	// GetOrganizationByDomain resolves a custom hostname to its organization
+	GetOrganizationByDomain(ctx context.Context, domain string) (*entity.Organization, error)
+	UpdateOrganization(ctx context.Context, orgID string, updates map[string]interface{}) error
+
+	// Membership
+	AddMember(ctx context.Context, member *entity.OrgMember) error
+	RemoveMember(ctx context.Context, orgID, userID string) error
+	UpdateMemberRole(ctx context.Context, orgID, userID string, role entity.OrgRole) error
+	// GetMember returns the user's membership in the organization, or an
+	// error when the user is not a member
+	GetMember(ctx context.Context, orgID, userID string) (*entity.OrgMember, error)
+	GetMembers(ctx context.Context, orgID string) ([]*entity.OrgMember, error)
+}
@@ -0,0 +1,22 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IOutboxRepository persists the transactional outbox. EnqueueOutboxEvent
+// joins an ambient Mongo session when called with a session context, so the
+// event is written together with the domain document.
+type IOutboxRepository interface {
+	EnqueueOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error
+	// FetchPendingOutboxEvents returns due pending events, oldest first.
+	FetchPendingOutboxEvents(ctx context.Context, limit int) ([]*entity.OutboxEvent, error)
+	MarkOutboxEventDelivered(ctx context.Context, eventID string) error
+	// RescheduleOutboxEvent records a failed attempt and when to retry.
+	RescheduleOutboxEvent(ctx context.Context, eventID string, nextAttemptAt time.Time, lastError string) error
+	// MarkOutboxEventFailed parks an event that exhausted its retries.
+	MarkOutboxEventFailed(ctx context.Context, eventID, lastError string) error
+}
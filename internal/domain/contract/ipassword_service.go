@@ -3,6 +3,9 @@ package contract
 type IHasher interface {
 	HashPassword(password string) (string, error)
 	ComparePasswordHash(password, hash string) error
+	// NeedsRehash reports whether a stored hash should be regenerated because
+	// the hashing algorithm or parameters have changed.
+	NeedsRehash(hash string) bool
 	HashString(s string) string
 	CheckHash(s, hash string) bool
 }
@@ -0,0 +1,35 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// CheckoutSession is a provider-hosted payment page for a single tip.
+type CheckoutSession struct {
+	ID  string
+	URL string
+}
+
+// IPaymentProvider abstracts the payment processor behind tipping so the
+// provider can be swapped without touching the use case.
+type IPaymentProvider interface {
+	// CreateCheckoutSession opens a hosted checkout for the tip and returns
+	// the session the reader is redirected to
+	CreateCheckoutSession(ctx context.Context, tipID string, amountCents int64, currency, successURL, cancelURL string) (*CheckoutSession, error)
+	// ParseCompletedTip verifies a webhook payload and returns the tip ID
+	// when it reports a completed checkout; ok is false for other events
+	ParseCompletedTip(payload []byte, signature string) (tipID string, ok bool, err error)
+}
+
+// ISubscriptionProvider abstracts the payment processor behind membership
+// subscriptions, the recurring counterpart of tipping.
+type ISubscriptionProvider interface {
+	// CreateSubscriptionCheckout opens a hosted checkout for a recurring
+	// membership and returns the session the reader is redirected to
+	CreateSubscriptionCheckout(ctx context.Context, userID, email string, tier entity.MembershipTier, successURL, cancelURL string) (*CheckoutSession, error)
+	// ParseSubscriptionEvent verifies a webhook payload and maps it onto a
+	// membership event; ok is false for unrelated events
+	ParseSubscriptionEvent(payload []byte, signature string) (event *entity.PaymentEvent, ok bool, err error)
+}
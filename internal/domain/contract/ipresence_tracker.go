@@ -0,0 +1,12 @@
+package contract
+
+import "context"
+
+// IPresenceTracker tracks which readers currently have a blog open, based on
+// periodic heartbeats. Readers whose heartbeats stop decay out of the count.
+type IPresenceTracker interface {
+	// Heartbeat marks the reader as currently viewing the blog.
+	Heartbeat(ctx context.Context, blogID, readerID string) error
+	// CountActiveReaders returns how many readers heartbeated recently.
+	CountActiveReaders(ctx context.Context, blogID string) (int64, error)
+}
@@ -0,0 +1,27 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IProfanityWordRepository persists the admin-managed profanity wordlist.
+type IProfanityWordRepository interface {
+	AddWord(ctx context.Context, word *entity.ProfanityWord) error
+	RemoveWord(ctx context.Context, word, locale string) error
+	// ListWords returns the entries for a locale; an empty locale returns all.
+	ListWords(ctx context.Context, locale string) ([]*entity.ProfanityWord, error)
+}
+
+// IProfanityFilter screens user-submitted text against locale-specific word
+// lists and exposes admin management of those lists.
+type IProfanityFilter interface {
+	// Check returns the severity of the worst match in content
+	// (entity.ProfanitySeveritySevere or entity.ProfanitySeverityMild), or an
+	// empty string when the content is clean.
+	Check(ctx context.Context, content, locale string) string
+	AddWord(ctx context.Context, word, locale, severity string) error
+	RemoveWord(ctx context.Context, word, locale string) error
+	ListWords(ctx context.Context, locale string) ([]*entity.ProfanityWord, error)
+}
@@ -0,0 +1,9 @@
+package contract
+
+import "context"
+
+// IPushProvider abstracts the push delivery service (FCM, Web Push/VAPID)
+// used to reach a registered device token.
+type IPushProvider interface {
+	SendPush(ctx context.Context, token, title, body string) error
+}
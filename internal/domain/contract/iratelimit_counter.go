@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+	"time"
+)
+
+// IRateLimitCounter tracks per-key request counts inside a fixed window,
+// backed by Redis. It powers application-level rate limits (e.g. per-user
+// comment throttling) as opposed to the IP-based middleware limiter.
+type IRateLimitCounter interface {
+	// Increment bumps the counter for key and returns the new count. The key
+	// expires window after its first increment.
+	Increment(ctx context.Context, key string, window time.Duration) (int64, error)
+	// TTL returns the remaining lifetime of the key's window.
+	TTL(ctx context.Context, key string) (time.Duration, error)
+}
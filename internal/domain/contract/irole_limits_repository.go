@@ -0,0 +1,19 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IRoleLimitsRepository persists the admin-configured per-role content
+// limits. Roles without a stored record fall back to the entity defaults.
+type IRoleLimitsRepository interface {
+	// UpsertLimits stores (or replaces) the limits for a role.
+	UpsertLimits(ctx context.Context, limits *entity.RoleLimits) error
+	// GetLimits returns the configured limits for a role, or an error when
+	// none have been configured.
+	GetLimits(ctx context.Context, role entity.UserRole) (*entity.RoleLimits, error)
+	// ListLimits returns every configured role's limits.
+	ListLimits(ctx context.Context) ([]*entity.RoleLimits, error)
+}
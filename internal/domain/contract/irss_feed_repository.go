@@ -0,0 +1,33 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IRSSFeedRepository manages registered RSS feeds and the import ledger that
+// keeps items from being imported twice.
+type IRSSFeedRepository interface {
+	CreateFeed(ctx context.Context, feed *entity.RSSFeed) error
+	ListFeedsByUserID(ctx context.Context, userID string) ([]*entity.RSSFeed, error)
+	CountFeedsByUserID(ctx context.Context, userID string) (int64, error)
+	// DeleteFeed removes one of the user's feeds; deleting another user's
+	// feed fails.
+	DeleteFeed(ctx context.Context, userID, feedID string) error
+	// ListFeeds pages over all registered feeds for the import job.
+	ListFeeds(ctx context.Context, offset, limit int) ([]*entity.RSSFeed, error)
+	// UpdateFeedStatus records the outcome of a poll: fetch time, the error
+	// (empty on success) and how many items were imported.
+	UpdateFeedStatus(ctx context.Context, feedID string, fetchedAt time.Time, lastError string, importedDelta int) error
+	// MarkItemImported records that a feed item was imported as the given
+	// blog; importing the same item twice fails.
+	MarkItemImported(ctx context.Context, feedID, guid, blogID string) error
+	IsItemImported(ctx context.Context, feedID, guid string) (bool, error)
+}
+
+// IRSSFetcher fetches and parses an external RSS or Atom feed.
+type IRSSFetcher interface {
+	FetchFeed(ctx context.Context, url string) ([]entity.RSSItem, error)
+}
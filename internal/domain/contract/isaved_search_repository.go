@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ISavedSearchRepository provides methods for managing saved searches in the
+// database.
+type ISavedSearchRepository interface {
+	CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) error
+	ListSavedSearchesByUserID(ctx context.Context, userID string) ([]*entity.SavedSearch, error)
+	CountSavedSearchesByUserID(ctx context.Context, userID string) (int64, error)
+	// DeleteSavedSearch removes the user's saved search by ID.
+	DeleteSavedSearch(ctx context.Context, userID, id string) error
+	// ListSavedSearches pages over all saved searches (publish-time alert
+	// evaluation).
+	ListSavedSearches(ctx context.Context, offset, limit int) ([]*entity.SavedSearch, error)
+}
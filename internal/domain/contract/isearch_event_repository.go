@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ISearchEventRepository persists search analytics events and serves the
+// aggregations behind the admin search analytics endpoint.
+type ISearchEventRepository interface {
+	RecordSearchEvent(ctx context.Context, event *entity.SearchEvent) error
+	// GetTopQueries returns the most-executed queries since the given time,
+	// with their execution and click counts.
+	GetTopQueries(ctx context.Context, since time.Time, limit int) ([]entity.SearchQueryStat, error)
+	// GetZeroResultQueries returns the most-executed queries that returned
+	// no results since the given time.
+	GetZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]entity.SearchQueryStat, error)
+}
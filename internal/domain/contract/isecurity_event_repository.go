@@ -0,0 +1,15 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ISecurityEventRepository defines the interface for security event persistence.
+type ISecurityEventRepository interface {
+	CreateEvent(ctx context.Context, event *entity.SecurityEvent) error
+	GetEventsByUserID(ctx context.Context, userID string, pagination Pagination) ([]*entity.SecurityEvent, int64, error)
+	// GetKnownCountriesForUser returns the distinct countries this user has signed in from.
+	GetKnownCountriesForUser(ctx context.Context, userID string) ([]string, error)
+}
@@ -0,0 +1,16 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IServiceClientRepository persists the registry of internal service clients
+// used by the client-credentials flow.
+type IServiceClientRepository interface {
+	CreateServiceClient(ctx context.Context, client *entity.ServiceClient) error
+	GetServiceClientByID(ctx context.Context, clientID string) (*entity.ServiceClient, error)
+	ListServiceClients(ctx context.Context) ([]*entity.ServiceClient, error)
+	RevokeServiceClient(ctx context.Context, clientID string) error
+}
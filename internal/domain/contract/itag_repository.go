@@ -11,7 +11,24 @@ type ITagRepository interface {
 	CreateTag(ctx context.Context, tag *entity.Tag) error
 	GetTagByID(ctx context.Context, tagID string) (*entity.Tag, error)
 	GetTagByName(ctx context.Context, name string) (*entity.Tag, error)
+	GetTagBySlug(ctx context.Context, slug string) (*entity.Tag, error)
+	// SuggestTags returns tags whose name or slug starts with the given
+	// prefix, ordered by how many blogs use them
+	SuggestTags(ctx context.Context, prefix string, limit int) ([]*entity.TagSuggestion, error)
 	GetAllTags(ctx context.Context) ([]*entity.Tag, error)
 	UpdateTag(ctx context.Context, tagID string, updates map[string]interface{}) error
 	DeleteTag(ctx context.Context, tagID string) error
+	// AddTagAliases records old slugs on a tag so they keep resolving after a merge
+	AddTagAliases(ctx context.Context, tagID string, aliases []string) error
+	// ReassignTagFollowers moves followers of one tag to another, dropping
+	// duplicates for users who already follow the target
+	ReassignTagFollowers(ctx context.Context, fromTagID, toTagID string) error
+
+	// Tag follows
+	FollowTag(ctx context.Context, follow *entity.TagFollow) error
+	UnfollowTag(ctx context.Context, userID, tagID string) error
+	IsFollowingTag(ctx context.Context, userID, tagID string) (bool, error)
+	CountTagFollowers(ctx context.Context, tagID string) (int64, error)
+	// GetFollowedTagIDs returns the IDs of every tag the user follows
+	GetFollowedTagIDs(ctx context.Context, userID string) ([]string, error)
 }
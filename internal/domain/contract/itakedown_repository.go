@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ITakedownRepository persists DMCA/legal takedown requests.
+type ITakedownRepository interface {
+	CreateRequest(ctx context.Context, request *entity.TakedownRequest) error
+	GetRequestByID(ctx context.Context, requestID string) (*entity.TakedownRequest, error)
+	// ListRequests returns requests newest first, optionally filtered by
+	// status.
+	ListRequests(ctx context.Context, status *entity.TakedownStatus) ([]*entity.TakedownRequest, error)
+	UpdateRequest(ctx context.Context, request *entity.TakedownRequest) error
+}
@@ -0,0 +1,19 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ITipRepository provides methods for managing tip data in the database.
+type ITipRepository interface {
+	CreateTip(ctx context.Context, tip *entity.Tip) error
+	// MarkTipCompleted records the provider's payment confirmation; it is
+	// idempotent so duplicate webhook deliveries are harmless
+	MarkTipCompleted(ctx context.Context, tipID string) error
+	// GetEarningsByAuthorID sums the completed tips an author received
+	GetEarningsByAuthorID(ctx context.Context, authorID string) (*entity.AuthorEarnings, error)
+	// ListTipsByAuthorID lists the author's most recent completed tips
+	ListTipsByAuthorID(ctx context.Context, authorID string, limit int) ([]*entity.Tip, error)
+}
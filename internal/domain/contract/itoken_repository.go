@@ -11,8 +11,16 @@ type ITokenRepository interface {
 	CreateToken(ctx context.Context, token *entity.Token) error
 	GetTokenByID(ctx context.Context, id string) (*entity.Token, error)
 	GetTokenByUserID(ctx context.Context, userID string) (*entity.Token, error)
+	// GetActiveTokenByUserIDAndType returns the newest unrevoked, unexpired
+	// token of the given type, so refresh lookups are not confused by
+	// verification or reset tokens the user also holds.
+	GetActiveTokenByUserIDAndType(ctx context.Context, userID string, tokenType entity.TokenType) (*entity.Token, error)
+	// ListActiveTokensByUserIDAndType returns every unrevoked, unexpired
+	// token of the given type for a user, newest first.
+	ListActiveTokensByUserIDAndType(ctx context.Context, userID string, tokenType entity.TokenType) ([]*entity.Token, error)
 	UpdateToken(ctx context.Context, tokenID string, tokenHash string, expiry time.Time) error
 	GetTokenByVerifier(ctx context.Context, verifier string) (*entity.Token, error)
 	RevokeToken(ctx context.Context, id string) error
+	ConsumeToken(ctx context.Context, id string) error
 	RevokeAllTokensForUser(ctx context.Context, userID string, tokenType entity.TokenType) error
 }
@@ -0,0 +1,12 @@
+package contract
+
+import "context"
+
+// ITTSSynthesizer renders text into spoken audio, used to generate audio
+// renditions of published posts.
+type ITTSSynthesizer interface {
+	// Synthesize returns the spoken audio of the text as MP3 bytes. The
+	// language tag (BCP 47) selects the voice; an empty tag lets the
+	// provider pick a default.
+	Synthesize(ctx context.Context, text, language string) ([]byte, error)
+}
@@ -0,0 +1,21 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IUploadSessionRepository persists chunked-upload sessions.
+type IUploadSessionRepository interface {
+	CreateSession(ctx context.Context, session *entity.UploadSession) error
+	GetSessionByID(ctx context.Context, sessionID string) (*entity.UploadSession, error)
+	// UpdateReceived records the new received byte count after a chunk was
+	// appended and bumps the chunk counter.
+	UpdateReceived(ctx context.Context, sessionID string, receivedSize int64) error
+	DeleteSession(ctx context.Context, sessionID string) error
+	// ListExpiredSessions returns up to limit sessions whose expiry passed
+	// before now, for cleanup.
+	ListExpiredSessions(ctx context.Context, now time.Time, limit int) ([]*entity.UploadSession, error)
+}
@@ -8,6 +8,8 @@ import (
 
 type IUserRepository interface {
 	CreateUser(ctx context.Context, user *entity.User) error
+	// CreateUsers inserts a batch of users in one call (bulk import).
+	CreateUsers(ctx context.Context, users []*entity.User) error
 	GetUserByID(ctx context.Context, id string) (*entity.User, error)
 	// GetUserByUsername retrieves a user by username.
 	GetUserByUsername(ctx context.Context, username string) (*entity.User, error)
@@ -17,6 +19,21 @@ type IUserRepository interface {
 	UpdateUser(ctx context.Context, user *entity.User) (*entity.User, error)
 	// UpdateUserPassword updates user's password by ID with the provided hashed password.
 	UpdateUserPassword(ctx context.Context, id string, hashedPassword string) error
-	// DeleteUser removes a user by ID.
+	// DeleteUser soft-deletes a user by ID and scrubs their PII.
 	DeleteUser(ctx context.Context, id string) error
+	// GetShadowBannedUsers retrieves all users that are currently shadow banned.
+	GetShadowBannedUsers(ctx context.Context) ([]*entity.User, error)
+	// ListUsersByCommentDigest retrieves the users who opted into a comment
+	// digest at the given frequency ("hourly" or "daily").
+	ListUsersByCommentDigest(ctx context.Context, frequency string) ([]*entity.User, error)
+	// ListUsersByPerformanceReport retrieves the authors who opted into a
+	// performance report at the given frequency ("weekly" or "monthly").
+	ListUsersByPerformanceReport(ctx context.Context, frequency string) ([]*entity.User, error)
+	// IncrementReputation adds delta to the user's reputation points.
+	IncrementReputation(ctx context.Context, id string, delta int) error
+	// GetUserByReferralCode retrieves the user owning a referral code.
+	GetUserByReferralCode(ctx context.Context, code string) (*entity.User, error)
+	// ListUsersByReferrer retrieves the users who signed up with the given
+	// user's referral code.
+	ListUsersByReferrer(ctx context.Context, referrerID string) ([]*entity.User, error)
 }
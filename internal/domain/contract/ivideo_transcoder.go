@@ -0,0 +1,18 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IVideoTranscoder hands uploaded videos to a transcoding backend (an ffmpeg
+// worker or an external service) that produces HLS/DASH renditions.
+type IVideoTranscoder interface {
+	// SubmitJob queues a transcode of the source file and returns the job ID
+	// used to poll for its result.
+	SubmitJob(ctx context.Context, mediaID, sourceURL string) (string, error)
+	// GetJobStatus reports a job's current state and, once ready, its
+	// playback URLs.
+	GetJobStatus(ctx context.Context, jobID string) (*entity.TranscodeJobStatus, error)
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// AIUsageRecord is one AI request charged to a user, with a rough token
+// estimate for cost monitoring.
+type AIUsageRecord struct {
+	ID     string `json:"id" bson:"_id"`
+	UserID string `json:"user_id" bson:"user_id"`
+	// Operation names the endpoint: generate, modify, outline, expand or
+	// rewrite.
+	Operation       string    `json:"operation" bson:"operation"`
+	EstimatedTokens int64     `json:"estimated_tokens" bson:"estimated_tokens"`
+	CreatedAt       time.Time `json:"created_at" bson:"created_at"`
+}
+
+// AIUsageSummary aggregates a user's AI consumption over a period.
+type AIUsageSummary struct {
+	Requests        int64 `json:"requests" bson:"requests"`
+	EstimatedTokens int64 `json:"estimated_tokens" bson:"estimated_tokens"`
+}
@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// API key scopes. Read-only keys may only call safe (GET/HEAD) endpoints.
+const (
+	APIKeyScopeRead  = "read"
+	APIKeyScopeWrite = "write"
+)
+
+// IsValidAPIKeyScope reports whether the scope is one of the known values.
+func IsValidAPIKeyScope(scope string) bool {
+	return scope == APIKeyScopeRead || scope == APIKeyScopeWrite
+}
+
+// APIKey is a personal access token for programmatic API access. Only the
+// hash of the key is stored; the plaintext is shown once at creation.
+type APIKey struct {
+	ID         string    `json:"id" bson:"_id"`
+	UserID     string    `json:"user_id" bson:"user_id"`
+	Name       string    `json:"name" bson:"name"`
+	KeyHash    string    `json:"-" bson:"key_hash"`
+	Prefix     string    `json:"prefix" bson:"prefix"`
+	Scopes     []string  `json:"scopes" bson:"scopes"`
+	CreatedAt  time.Time `json:"created_at" bson:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at,omitempty" bson:"last_used_at,omitempty"`
+	Revoked    bool      `json:"revoked" bson:"revoked"`
+}
+
+// HasScope reports whether the key carries the given scope.
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
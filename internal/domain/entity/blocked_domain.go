@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// BlockedDomain is an admin-added email domain that may not be used for
+// registration, on top of the built-in disposable-domain blocklist.
+type BlockedDomain struct {
+	ID        string    `json:"id" bson:"_id,omitempty"`
+	Domain    string    `json:"domain" bson:"domain"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
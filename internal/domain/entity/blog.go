@@ -6,25 +6,127 @@ import (
 
 // Blog represents a blog post in the system
 type Blog struct {
-	ID              string     `json:"id" bson:"_id"`
-	Title           string     `json:"title" bson:"title"`
-	Content         string     `json:"content" bson:"content"`
-	AuthorID        string     `json:"author_id" bson:"author_id"`
-	Slug            string     `json:"slug" bson:"slug"`
-	Status          BlogStatus `json:"status" bson:"status"`
-	Tags            []string   `json:"tags" bson:"tags"`
-	CreatedAt       time.Time  `json:"created_at" bson:"created_at"`
-	UpdatedAt       time.Time  `json:"updated_at" bson:"updated_at"`
-	PublishedAt     *time.Time `json:"published_at" bson:"published_at"`
-	ViewCount       int        `json:"view_count" bson:"view_count"`
-	LikeCount       int        `json:"like_count" bson:"like_count"`
-	DislikeCount    int        `json:"dislike_count" bson:"dislike_count"`
-	CommentCount    int        `json:"comment_count" bson:"comment_count"`
-	Popularity      float64    `json:"popularity" bson:"popularity"`
-	FeaturedImageID *string    `json:"featured_image_id" bson:"featured_image_id"`
-	IsDeleted       bool       `json:"is_deleted" bson:"is_deleted"`
+	ID       string     `json:"id" bson:"_id"`
+	Title    string     `json:"title" bson:"title"`
+	Content  string     `json:"content" bson:"content"`
+	AuthorID string     `json:"author_id" bson:"author_id"`
+	OrgID    *string    `json:"org_id,omitempty" bson:"org_id,omitempty"`
+	Slug     string     `json:"slug" bson:"slug"`
+	Status   BlogStatus `json:"status" bson:"status"`
+	Tags     []string   `json:"tags" bson:"tags"`
+	// Language is the post's BCP 47 language tag (e.g. "en", "am").
+	Language string `json:"language,omitempty" bson:"language,omitempty"`
+	// ReadingTimeMinutes is the estimated reading time, recomputed from the
+	// content on every edit.
+	ReadingTimeMinutes int        `json:"reading_time_minutes" bson:"reading_time_minutes"`
+	CreatedAt          time.Time  `json:"created_at" bson:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at" bson:"updated_at"`
+	PublishedAt        *time.Time `json:"published_at" bson:"published_at"`
+	ViewCount          int        `json:"view_count" bson:"view_count"`
+	LikeCount          int        `json:"like_count" bson:"like_count"`
+	DislikeCount       int        `json:"dislike_count" bson:"dislike_count"`
+	CommentCount       int        `json:"comment_count" bson:"comment_count"`
+	ClapCount          int        `json:"clap_count" bson:"clap_count"`
+	Popularity         float64    `json:"popularity" bson:"popularity"`
+	FeaturedImageID    *string    `json:"featured_image_id" bson:"featured_image_id"`
+	// CanonicalURL points at the original post when this one was syndicated
+	// from an external feed.
+	CanonicalURL string `json:"canonical_url,omitempty" bson:"canonical_url,omitempty"`
+	// OriginalSource names the publication the post was syndicated from.
+	OriginalSource string `json:"original_source,omitempty" bson:"original_source,omitempty"`
+	// OGImageURL is the generated social share image, set when the post is
+	// published.
+	OGImageURL string `json:"og_image_url,omitempty" bson:"og_image_url,omitempty"`
+	// ContentRating marks posts as general-audience or mature. Mature posts
+	// are age-gated and excluded from public feeds by default.
+	ContentRating ContentRating `json:"content_rating,omitempty" bson:"content_rating,omitempty"`
+	// Type distinguishes regular articles from question and discussion
+	// posts. An empty type means article (pre-existing posts).
+	Type BlogType `json:"type,omitempty" bson:"type,omitempty"`
+	// AcceptedAnswerID is the comment the author accepted as the answer on
+	// a question post, surfaced at the top of the comment list.
+	AcceptedAnswerID string `json:"accepted_answer_id,omitempty" bson:"accepted_answer_id,omitempty"`
+	// Event is the optional event metadata block; posts carrying it accept
+	// RSVPs.
+	Event *EventInfo `json:"event,omitempty" bson:"event,omitempty"`
+	// AccessLevel gates who can read the full post: everyone (public, the
+	// default), members, or paid subscribers.
+	AccessLevel BlogAccessLevel `json:"access_level,omitempty" bson:"access_level,omitempty"`
+	// IsLocked is set at read time when the viewer lacks access and the
+	// content was truncated to a teaser; it is never persisted.
+	IsLocked bool `json:"is_locked,omitempty" bson:"-"`
+	// AuthorHidden hides the post from list queries while its author is
+	// deactivated. Maintained by the user visibility cascade.
+	AuthorHidden bool `json:"-" bson:"author_hidden,omitempty"`
+	// TakedownReason is shown on the tombstone page after the post was
+	// unpublished through the takedown workflow.
+	TakedownReason string `json:"takedown_reason,omitempty" bson:"takedown_reason,omitempty"`
+	// AudioURL and AudioStatus track the post's spoken audio rendition,
+	// generated asynchronously after publication.
+	AudioURL    string      `json:"audio_url,omitempty" bson:"audio_url,omitempty"`
+	AudioStatus AudioStatus `json:"audio_status,omitempty" bson:"audio_status,omitempty"`
+	// LinkPreviews holds Open Graph metadata for URLs in the content.
+	LinkPreviews []LinkPreview `json:"link_previews,omitempty" bson:"link_previews,omitempty"`
+	// EmbeddedMedia holds oEmbed metadata for whitelisted media URLs in the
+	// content.
+	EmbeddedMedia []EmbeddedMedia `json:"embedded_media,omitempty" bson:"embedded_media,omitempty"`
+	IsDeleted     bool            `json:"is_deleted" bson:"is_deleted"`
 }
 
+// BlogSuggestion is one entry of the search type-ahead: a published post
+// whose title matches the typed prefix.
+type BlogSuggestion struct {
+	ID    string `json:"id" bson:"_id"`
+	Title string `json:"title" bson:"title"`
+	Slug  string `json:"slug" bson:"slug"`
+}
+
+// ArchivePeriod is one month of the blog archive with its published-post
+// count.
+type ArchivePeriod struct {
+	Year  int   `json:"year" bson:"year"`
+	Month int   `json:"month" bson:"month"`
+	Count int64 `json:"count" bson:"count"`
+}
+
+// BlogAccessLevel gates who can read a post's full content. An empty level
+// means public (pre-existing posts).
+type BlogAccessLevel string
+
+const (
+	BlogAccessPublic  BlogAccessLevel = "public"
+	BlogAccessMembers BlogAccessLevel = "members"
+	BlogAccessPaid    BlogAccessLevel = "paid"
+)
+
+// BlogType distinguishes the kinds of posts: regular articles, questions
+// (which can have an accepted answer) and open discussions.
+type BlogType string
+
+const (
+	BlogTypeArticle    BlogType = "article"
+	BlogTypeQuestion   BlogType = "question"
+	BlogTypeDiscussion BlogType = "discussion"
+)
+
+// AudioStatus tracks the generation of a post's audio rendition. An empty
+// status means generation has not been attempted.
+type AudioStatus string
+
+const (
+	AudioStatusPending AudioStatus = "pending"
+	AudioStatusReady   AudioStatus = "ready"
+	AudioStatusFailed  AudioStatus = "failed"
+)
+
+// ContentRating classifies a post's audience suitability.
+type ContentRating string
+
+const (
+	ContentRatingGeneral ContentRating = "general"
+	ContentRatingMature  ContentRating = "mature"
+)
+
 // BlogStatus represents the status of a blog post
 type BlogStatus string
 
@@ -32,4 +134,14 @@ const (
 	BlogStatusDraft     BlogStatus = "draft"
 	BlogStatusPublished BlogStatus = "published"
 	BlogStatusArchived  BlogStatus = "archived"
+
+	// Editorial workflow statuses: drafts are submitted for review, then
+	// approved (publishable) or sent back with change requests.
+	BlogStatusInReview         BlogStatus = "in_review"
+	BlogStatusApproved         BlogStatus = "approved"
+	BlogStatusChangesRequested BlogStatus = "changes_requested"
+
+	// BlogStatusTakenDown marks posts unpublished through the takedown
+	// workflow; readers get a tombstone page with the reason.
+	BlogStatusTakenDown BlogStatus = "taken_down"
 )
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// BlogRevision is a snapshot of a blog's title and content taken before an
+// edit, so authors and editors can review and compare what changed.
+type BlogRevision struct {
+	ID     string `bson:"_id" json:"id"`
+	BlogID string `bson:"blog_id" json:"blog_id"`
+	// Number orders revisions per blog, starting at 1 for the oldest.
+	Number    int       `bson:"number" json:"number"`
+	Title     string    `bson:"title" json:"title"`
+	Content   string    `bson:"content" json:"content"`
+	EditorID  string    `bson:"editor_id" json:"editor_id"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// MaxClapsPerUser caps how many claps a single user can give one blog.
+const MaxClapsPerUser = 50
+
+// Clap tracks how many times a user clapped for a blog. Claps accumulate
+// per user up to MaxClapsPerUser, Medium-style.
+type Clap struct {
+	ID        string    `json:"id" bson:"_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	BlogID    string    `json:"blog_id" bson:"blog_id"`
+	Count     int       `json:"count" bson:"count"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
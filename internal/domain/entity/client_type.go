@@ -0,0 +1,24 @@
+package entity
+
+// ClientType identifies the kind of client a session belongs to, so token
+// lifetimes can differ between browsers, mobile apps and API integrations.
+type ClientType string
+
+const (
+	ClientTypeWeb    ClientType = "web"
+	ClientTypeMobile ClientType = "mobile"
+	ClientTypeAPI    ClientType = "api"
+)
+
+// NormalizeClientType maps a raw client type to a known value, defaulting to
+// web for empty or unrecognized input.
+func NormalizeClientType(clientType string) ClientType {
+	switch ClientType(clientType) {
+	case ClientTypeMobile:
+		return ClientTypeMobile
+	case ClientTypeAPI:
+		return ClientTypeAPI
+	default:
+		return ClientTypeWeb
+	}
+}
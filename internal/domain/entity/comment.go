@@ -6,21 +6,30 @@ import (
 
 // Comment represents a comment on a blog post with advanced reply-to-reply support
 type Comment struct {
-	ID             string    `json:"id" bson:"_id,omitempty"`
-	BlogID         string    `json:"blog_id" bson:"blog_id"`
-	Type           string    `json:"type" bson:"type"` // "comment" or "reply"
-	ParentID       *string   `json:"parent_id" bson:"parent_id"`
-	TargetID       *string   `json:"target_id" bson:"target_id"`
-	AuthorID       string    `json:"author_id" bson:"author_id"`
-	AuthorName     string    `json:"author_name" bson:"author_name"`
-	TargetUserName string    `json:"target_user_name" bson:"target_user_name"`
-	Content        string    `json:"content" bson:"content"`
-	Status         string    `json:"status" bson:"status"`
-	LikeCount      int       `json:"like_count" bson:"like_count"`
-	ReplyCount     int       `json:"reply_count" bson:"reply_count"`
-	CreatedAt      time.Time `json:"created_at" bson:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at" bson:"updated_at"`
-	IsDeleted      bool      `json:"is_deleted" bson:"is_deleted"`
+	ID       string  `json:"id" bson:"_id,omitempty"`
+	BlogID   string  `json:"blog_id" bson:"blog_id"`
+	Type     string  `json:"type" bson:"type"` // "comment" or "reply"
+	ParentID *string `json:"parent_id" bson:"parent_id"`
+	TargetID *string `json:"target_id" bson:"target_id"`
+	// AuthorID is empty for guest comments; GuestName/GuestEmail identify the
+	// visitor instead. GuestEmail is never exposed in API responses.
+	AuthorID       string `json:"author_id" bson:"author_id"`
+	AuthorName     string `json:"author_name" bson:"author_name"`
+	GuestName      string `json:"guest_name,omitempty" bson:"guest_name,omitempty"`
+	GuestEmail     string `json:"-" bson:"guest_email,omitempty"`
+	TargetUserName string `json:"target_user_name" bson:"target_user_name"`
+	Content        string `json:"content" bson:"content"`
+	Status         string `json:"status" bson:"status"`
+	LikeCount      int    `json:"like_count" bson:"like_count"`
+	ReplyCount     int    `json:"reply_count" bson:"reply_count"`
+	// LinkPreviews holds Open Graph metadata for URLs in the content.
+	LinkPreviews []LinkPreview `json:"link_previews,omitempty" bson:"link_previews,omitempty"`
+	CreatedAt    time.Time     `json:"created_at" bson:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at" bson:"updated_at"`
+	IsDeleted    bool          `json:"is_deleted" bson:"is_deleted"`
+	// AuthorHidden hides the comment from listings while its author is
+	// deactivated. Maintained by the user visibility cascade.
+	AuthorHidden bool `json:"-" bson:"author_hidden,omitempty"`
 }
 
 // CommentThread represents a comment with its nested replies
@@ -30,14 +39,31 @@ type CommentThread struct {
 	Depth   int              `json:"depth"`
 }
 
-// CommentLike represents a user's like on a comment
+// CommentLike represents a user's like or emoji reaction on a comment.
+// Plain likes leave Reaction empty; reaction records set it to one of
+// CommentReactions.
 type CommentLike struct {
 	ID        string    `json:"id" bson:"_id,omitempty"`
 	CommentID string    `json:"comment_id" bson:"comment_id"`
 	UserID    string    `json:"user_id" bson:"user_id"`
+	Reaction  string    `json:"reaction,omitempty" bson:"reaction,omitempty"`
 	CreatedAt time.Time `json:"created_at" bson:"created_at"`
 }
 
+// CommentReactions is the emoji set users can react with on a comment.
+var CommentReactions = []string{"👍", "❤️", "😂", "😮"}
+
+// IsValidCommentReaction reports whether the emoji belongs to the allowed
+// reaction set.
+func IsValidCommentReaction(reaction string) bool {
+	for _, r := range CommentReactions {
+		if r == reaction {
+			return true
+		}
+	}
+	return false
+}
+
 // CommentReport represents a report against a comment
 type CommentReport struct {
 	ID         string     `json:"id" bson:"_id,omitempty"`
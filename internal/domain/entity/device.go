@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// Device platforms accepted for push notification registration.
+const (
+	DevicePlatformWeb     = "web"
+	DevicePlatformAndroid = "android"
+	DevicePlatformIOS     = "ios"
+)
+
+// Device is a push notification registration for one of a user's clients.
+// The token is the provider-issued device token (FCM registration token or
+// Web Push subscription endpoint).
+type Device struct {
+	ID        string    `json:"id" bson:"_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	Token     string    `json:"token" bson:"token"`
+	Platform  string    `json:"platform" bson:"platform"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// DraftComment is an editorial comment a reviewer leaves on a draft,
+// anchored to a range of the content. Draft comments are only visible to
+// the author and reviewers, never to readers.
+type DraftComment struct {
+	ID       string `bson:"_id" json:"id"`
+	BlogID   string `bson:"blog_id" json:"blog_id"`
+	AuthorID string `bson:"author_id" json:"author_id"`
+	Body     string `bson:"body" json:"body"`
+	// StartOffset and EndOffset anchor the comment to a rune range of the
+	// draft content at the time the comment was made.
+	StartOffset int `bson:"start_offset" json:"start_offset"`
+	EndOffset   int `bson:"end_offset" json:"end_offset"`
+	// Quote is the anchored text excerpt, kept so the comment still reads
+	// sensibly after the draft is edited and the offsets drift.
+	Quote      string     `bson:"quote,omitempty" json:"quote,omitempty"`
+	Resolved   bool       `bson:"resolved" json:"resolved"`
+	ResolvedBy string     `bson:"resolved_by,omitempty" json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time `bson:"resolved_at,omitempty" json:"resolved_at,omitempty"`
+	CreatedAt  time.Time  `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// EmailReplyToken lets the recipient of a comment reply notification post
+// their response by replying to the email. The token is embedded in the
+// notification's reply address and maps the inbound email back to the
+// thread.
+type EmailReplyToken struct {
+	Token           string    `json:"-" bson:"_id"`
+	UserID          string    `json:"user_id" bson:"user_id"`
+	BlogID          string    `json:"blog_id" bson:"blog_id"`
+	ParentCommentID string    `json:"parent_comment_id" bson:"parent_comment_id"`
+	CreatedAt       time.Time `json:"created_at" bson:"created_at"`
+	ExpiresAt       time.Time `json:"expires_at" bson:"expires_at"`
+}
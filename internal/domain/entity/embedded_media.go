@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// EmbeddedMedia holds oEmbed metadata resolved for a media URL (video,
+// tweet, ...) found in blog content, so clients can render the provider's
+// sanctioned embed markup.
+type EmbeddedMedia struct {
+	URL          string    `json:"url" bson:"url"`
+	Provider     string    `json:"provider" bson:"provider"`
+	Type         string    `json:"type" bson:"type"`
+	Title        string    `json:"title,omitempty" bson:"title,omitempty"`
+	AuthorName   string    `json:"author_name,omitempty" bson:"author_name,omitempty"`
+	HTML         string    `json:"html,omitempty" bson:"html,omitempty"`
+	ThumbnailURL string    `json:"thumbnail_url,omitempty" bson:"thumbnail_url,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at" bson:"fetched_at"`
+}
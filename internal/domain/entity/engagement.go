@@ -0,0 +1,15 @@
+package entity
+
+// EngagementUpdate is a snapshot of a blog's live engagement counters,
+// published whenever one of them changes.
+type EngagementUpdate struct {
+	BlogID   string `json:"blog_id"`
+	Views    int    `json:"views"`
+	Likes    int    `json:"likes"`
+	Dislikes int    `json:"dislikes"`
+	Comments int    `json:"comments"`
+	Claps    int    `json:"claps"`
+	// Readers is the number of people currently reading the post, decaying
+	// as presence heartbeats stop. Zero when presence tracking is disabled.
+	Readers int64 `json:"readers"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+)
+
+// EventInfo is the optional event metadata block on a post. Posts carrying
+// it show up on the events calendar and accept RSVPs.
+type EventInfo struct {
+	StartTime time.Time `json:"start_time" bson:"start_time"`
+	// EndTime is optional; open-ended events leave it unset.
+	EndTime  *time.Time `json:"end_time,omitempty" bson:"end_time,omitempty"`
+	Location string     `json:"location,omitempty" bson:"location,omitempty"`
+	// Capacity caps the number of RSVPs; zero means unlimited.
+	Capacity int `json:"capacity,omitempty" bson:"capacity,omitempty"`
+}
+
+// EventRSVP records one user's RSVP to an event post.
+type EventRSVP struct {
+	ID        string    `json:"id" bson:"_id"`
+	BlogID    string    `json:"blog_id" bson:"blog_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// EventAttendee is one entry of an event's attendee list, an RSVP joined
+// with the attendee's username.
+type EventAttendee struct {
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	RSVPedAt time.Time `json:"rsvped_at"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// ExperimentVariant is one arm of an experiment; weights control how
+// traffic splits between the arms.
+type ExperimentVariant struct {
+	Name   string `json:"name" bson:"name"`
+	Weight int    `json:"weight" bson:"weight"`
+}
+
+// Experiment is an A/B test definition. Subjects are assigned to variants
+// deterministically so the same user or session always sees the same arm.
+type Experiment struct {
+	ID        string              `json:"id" bson:"_id,omitempty"`
+	Name      string              `json:"name" bson:"name"`
+	Variants  []ExperimentVariant `json:"variants" bson:"variants"`
+	Active    bool                `json:"active" bson:"active"`
+	CreatedAt time.Time           `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at" bson:"updated_at"`
+}
+
+// ExperimentExposure records that a subject was assigned a variant, for
+// later analysis of the experiment's results.
+type ExperimentExposure struct {
+	ID         string    `json:"id" bson:"_id,omitempty"`
+	Experiment string    `json:"experiment" bson:"experiment"`
+	SubjectID  string    `json:"subject_id" bson:"subject_id"`
+	Variant    string    `json:"variant" bson:"variant"`
+	FirstSeen  time.Time `json:"first_seen" bson:"first_seen"`
+	LastSeen   time.Time `json:"last_seen" bson:"last_seen"`
+}
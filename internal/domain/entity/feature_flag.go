@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// FeatureFlag toggles a feature at runtime, optionally rolled out to only a
+// percentage of subjects, so behavior can change per environment without a
+// redeploy.
+type FeatureFlag struct {
+	ID      string `json:"id" bson:"_id,omitempty"`
+	Name    string `json:"name" bson:"name"`
+	Enabled bool   `json:"enabled" bson:"enabled"`
+	// RolloutPercent limits an enabled flag to a percentage of subjects
+	// (0-100); 100 means fully rolled out.
+	RolloutPercent int       `json:"rollout_percent" bson:"rollout_percent"`
+	CreatedAt      time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" bson:"updated_at"`
+}
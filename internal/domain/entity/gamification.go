@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// ReputationAction is a point-earning action in the gamification system.
+type ReputationAction string
+
+const (
+	// ReputationActionPublish credits an author when their post goes live.
+	ReputationActionPublish ReputationAction = "publish"
+	// ReputationActionLikeReceived credits an author when their post is
+	// liked.
+	ReputationActionLikeReceived ReputationAction = "like_received"
+	// ReputationActionHelpfulComment credits a commenter when the question's
+	// author accepts their answer.
+	ReputationActionHelpfulComment ReputationAction = "helpful_comment"
+)
+
+// Badge is a milestone award a user earned by repeating a reputation action
+// enough times. Badges are never revoked.
+type Badge struct {
+	ID        string    `json:"id" bson:"_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	Slug      string    `json:"slug" bson:"slug"`
+	Name      string    `json:"name" bson:"name"`
+	AwardedAt time.Time `json:"awarded_at" bson:"awarded_at"`
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// GeoRestriction blocks a post from being served to requests geolocated in
+// the listed countries, typically for legal takedowns. One record exists per
+// restricted blog.
+type GeoRestriction struct {
+	BlogID string `json:"blog_id" bson:"_id"`
+	// CountryCodes are the ISO 3166-1 alpha-2 codes the post is blocked in.
+	CountryCodes []string  `json:"country_codes" bson:"country_codes"`
+	Reason       string    `json:"reason,omitempty" bson:"reason,omitempty"`
+	CreatedBy    string    `json:"created_by" bson:"created_by"`
+	CreatedAt    time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// Geo restriction audit actions.
+const (
+	GeoRestrictionActionRestricted   = "restricted"
+	GeoRestrictionActionUnrestricted = "unrestricted"
+)
+
+// GeoRestrictionAudit is one entry of the append-only audit trail of
+// restriction changes on a blog.
+type GeoRestrictionAudit struct {
+	ID           string    `json:"id" bson:"_id"`
+	BlogID       string    `json:"blog_id" bson:"blog_id"`
+	Action       string    `json:"action" bson:"action"`
+	CountryCodes []string  `json:"country_codes,omitempty" bson:"country_codes,omitempty"`
+	Reason       string    `json:"reason,omitempty" bson:"reason,omitempty"`
+	ActorID      string    `json:"actor_id" bson:"actor_id"`
+	CreatedAt    time.Time `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Highlight is a passage of a post a reader highlighted, optionally with a
+// private note. Highlights are anchored to a rune range of the content.
+type Highlight struct {
+	ID          string    `bson:"_id" json:"id"`
+	BlogID      string    `bson:"blog_id" json:"blog_id"`
+	UserID      string    `bson:"user_id" json:"user_id"`
+	StartOffset int       `bson:"start_offset" json:"start_offset"`
+	EndOffset   int       `bson:"end_offset" json:"end_offset"`
+	Text        string    `bson:"text" json:"text"`
+	Note        string    `bson:"note,omitempty" json:"note,omitempty"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
+}
+
+// HighlightStat is one entry of a post's most-highlighted passages.
+type HighlightStat struct {
+	Text  string `bson:"_id" json:"text"`
+	Count int64  `bson:"count" json:"count"`
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// LinkPreview holds Open Graph metadata fetched for a URL found in
+// user-submitted content, so clients can render rich link cards without
+// fetching the target themselves.
+type LinkPreview struct {
+	URL         string    `json:"url" bson:"url"`
+	Title       string    `json:"title,omitempty" bson:"title,omitempty"`
+	Description string    `json:"description,omitempty" bson:"description,omitempty"`
+	ImageURL    string    `json:"image_url,omitempty" bson:"image_url,omitempty"`
+	SiteName    string    `json:"site_name,omitempty" bson:"site_name,omitempty"`
+	FetchedAt   time.Time `json:"fetched_at" bson:"fetched_at"`
+}
@@ -15,4 +15,38 @@ type Media struct {
 	BlogID           string    `json:"blog_id,omitempty" bson:"blog_id"`
 	CreatedAt        time.Time `json:"created_at" bson:"created_at"`
 	IsDeleted        bool      `json:"is_deleted,omitempty" bson:"is_deleted"`
+	// Variants are the responsive size renditions generated asynchronously
+	// after an image upload.
+	Variants []MediaVariant `json:"variants,omitempty" bson:"variants,omitempty"`
+	// Transcode tracking for video uploads: the job submitted to the
+	// transcoder and the playback URLs once it is ready.
+	TranscodeJobID   string `json:"transcode_job_id,omitempty" bson:"transcode_job_id,omitempty"`
+	TranscodeStatus  string `json:"transcode_status,omitempty" bson:"transcode_status,omitempty"`
+	TranscodeMessage string `json:"transcode_message,omitempty" bson:"transcode_message,omitempty"`
+	HLSPlaybackURL   string `json:"hls_playback_url,omitempty" bson:"hls_playback_url,omitempty"`
+	DASHPlaybackURL  string `json:"dash_playback_url,omitempty" bson:"dash_playback_url,omitempty"`
+}
+
+// Transcode job states tracked on video media.
+const (
+	TranscodeStateProcessing = "processing"
+	TranscodeStateReady      = "ready"
+	TranscodeStateFailed     = "failed"
+)
+
+// TranscodeJobStatus is a transcoder's report on one job.
+type TranscodeJobStatus struct {
+	State           string
+	HLSPlaybackURL  string
+	DASHPlaybackURL string
+	Message         string
+}
+
+// MediaVariant is one generated rendition of an uploaded image.
+type MediaVariant struct {
+	Width    int    `json:"width" bson:"width"`
+	Height   int    `json:"height" bson:"height"`
+	URL      string `json:"url" bson:"url"`
+	MimeType string `json:"mime_type" bson:"mime_type"`
+	FileSize int64  `json:"file_size" bson:"file_size"`
 }
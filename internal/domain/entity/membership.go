@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+)
+
+// MembershipTier is a reader's paid membership level. Free readers have no
+// membership record.
+type MembershipTier string
+
+const (
+	// MembershipTierMember unlocks member-only posts.
+	MembershipTierMember MembershipTier = "member"
+	// MembershipTierPaid additionally unlocks paid posts.
+	MembershipTierPaid MembershipTier = "paid"
+)
+
+// MembershipStatus is the lifecycle state of a membership subscription.
+type MembershipStatus string
+
+const (
+	MembershipStatusActive    MembershipStatus = "active"
+	MembershipStatusCancelled MembershipStatus = "cancelled"
+	MembershipStatusExpired   MembershipStatus = "expired"
+)
+
+// Membership records a reader's subscription tier, kept in sync with the
+// payments provider through its webhooks.
+type Membership struct {
+	ID     string           `json:"id" bson:"_id"`
+	UserID string           `json:"user_id" bson:"user_id"`
+	Tier   MembershipTier   `json:"tier" bson:"tier"`
+	Status MembershipStatus `json:"status" bson:"status"`
+	// SubscriptionID is the payments provider's identifier for the
+	// subscription.
+	SubscriptionID string `json:"subscription_id,omitempty" bson:"subscription_id,omitempty"`
+	// ExpiresAt is the end of the current billing period; access lapses
+	// after it unless the provider renews the subscription.
+	ExpiresAt *time.Time `json:"expires_at,omitempty" bson:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" bson:"updated_at"`
+}
+
+// PaymentEvent is a webhook notification from the payments provider about
+// a subscription.
+type PaymentEvent struct {
+	Type           PaymentEventType `json:"type"`
+	UserID         string           `json:"user_id"`
+	Tier           MembershipTier   `json:"tier"`
+	SubscriptionID string           `json:"subscription_id"`
+	ExpiresAt      *time.Time       `json:"expires_at,omitempty"`
+}
+
+// PaymentEventType enumerates the subscription webhook events.
+type PaymentEventType string
+
+const (
+	PaymentEventSubscriptionActivated PaymentEventType = "subscription.activated"
+	PaymentEventSubscriptionRenewed   PaymentEventType = "subscription.renewed"
+	PaymentEventSubscriptionCancelled PaymentEventType = "subscription.cancelled"
+)
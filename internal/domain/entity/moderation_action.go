@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// ModerationActionType labels what kind of moderation action was taken.
+type ModerationActionType string
+
+const (
+	ModerationActionStatusChange     ModerationActionType = "status_change"
+	ModerationActionReportResolution ModerationActionType = "report_resolution"
+	ModerationActionDeletion         ModerationActionType = "deletion"
+)
+
+// ModerationAction is an audit record of a moderation step taken on a
+// comment, kept for accountability.
+type ModerationAction struct {
+	ID        string `json:"id" bson:"_id,omitempty"`
+	CommentID string `json:"comment_id" bson:"comment_id"`
+	// TargetUserID is the author of the moderated comment; empty for guests.
+	TargetUserID string               `json:"target_user_id" bson:"target_user_id"`
+	ModeratorID  string               `json:"moderator_id" bson:"moderator_id"`
+	Action       ModerationActionType `json:"action" bson:"action"`
+	// Detail carries the action specifics, e.g. the new status or the report
+	// resolution.
+	Detail    string    `json:"detail" bson:"detail"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,43 @@
+package entity
+
+import "time"
+
+// NewsletterSubscription represents a reader's subscription to an author's
+// newsletter, or to the site newsletter when AuthorID is empty. The
+// subscription only receives broadcasts after the double opt-in email is
+// confirmed.
+type NewsletterSubscription struct {
+	ID               string     `json:"id" bson:"_id"`
+	Email            string     `json:"email" bson:"email"`
+	AuthorID         string     `json:"author_id,omitempty" bson:"author_id,omitempty"`
+	Confirmed        bool       `json:"confirmed" bson:"confirmed"`
+	ConfirmToken     string     `json:"-" bson:"confirm_token"`
+	UnsubscribeToken string     `json:"-" bson:"unsubscribe_token"`
+	CreatedAt        time.Time  `json:"created_at" bson:"created_at"`
+	ConfirmedAt      *time.Time `json:"confirmed_at,omitempty" bson:"confirmed_at,omitempty"`
+}
+
+// BroadcastStatus represents the delivery state of a newsletter broadcast.
+type BroadcastStatus string
+
+const (
+	// BroadcastStatusSending marks a broadcast still being delivered.
+	BroadcastStatusSending BroadcastStatus = "sending"
+	// BroadcastStatusSent marks a broadcast delivered to every recipient.
+	BroadcastStatusSent BroadcastStatus = "sent"
+)
+
+// NewsletterBroadcast records one published post sent as a newsletter,
+// with its delivery stats.
+type NewsletterBroadcast struct {
+	ID          string          `json:"id" bson:"_id"`
+	BlogID      string          `json:"blog_id" bson:"blog_id"`
+	AuthorID    string          `json:"author_id" bson:"author_id"`
+	Subject     string          `json:"subject" bson:"subject"`
+	Status      BroadcastStatus `json:"status" bson:"status"`
+	Recipients  int             `json:"recipients" bson:"recipients"`
+	Delivered   int             `json:"delivered" bson:"delivered"`
+	Failed      int             `json:"failed" bson:"failed"`
+	CreatedAt   time.Time       `json:"created_at" bson:"created_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+}
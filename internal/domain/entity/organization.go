@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"time"
+)
+
+// Organization represents a multi-author publication (team) that can own blogs
+type Organization struct {
+	ID          string `json:"id" bson:"_id"`
+	Name        string `json:"name" bson:"name"`
+	Slug        string `json:"slug" bson:"slug"`
+	Description string `json:"description" bson:"description"`
+	// CustomDomain maps a hostname to this organization so one deployment can
+	// serve multiple branded publications
+	CustomDomain string `json:"custom_domain,omitempty" bson:"custom_domain,omitempty"`
+	// Theme holds per-tenant theming metadata (colors, logo URL, etc.)
+	Theme     map[string]string `json:"theme,omitempty" bson:"theme,omitempty"`
+	CreatedAt time.Time         `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at" bson:"updated_at"`
+}
+
+// OrgRole represents a member's role inside an organization
+type OrgRole string
+
+const (
+	// OrgRoleOwner manages the organization itself: members and settings
+	OrgRoleOwner OrgRole = "owner"
+	// OrgRoleEditor can manage any post owned by the organization
+	OrgRoleEditor OrgRole = "editor"
+	// OrgRoleWriter can publish posts under the organization
+	OrgRoleWriter OrgRole = "writer"
+)
+
+// OrgMember represents a user's membership in an organization
+type OrgMember struct {
+	ID        string    `json:"id" bson:"_id"`
+	OrgID     string    `json:"org_id" bson:"org_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	Role      OrgRole   `json:"role" bson:"role"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// OrganizationProfile aggregates an organization with its members and recent
+// posts for the public profile page.
+type OrganizationProfile struct {
+	Organization Organization
+	Members      []*OrgMember
+	RecentBlogs  []*Blog
+	BlogCount    int64
+}
+
+// CanManageOrgPosts reports whether the member's role allows managing posts
+// owned by the organization regardless of who authored them.
+func (m *OrgMember) CanManageOrgPosts() bool {
+	return m.Role == OrgRoleOwner || m.Role == OrgRoleEditor
+}
@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// Outbox event lifecycle statuses.
+const (
+	OutboxStatusPending   = "pending"
+	OutboxStatusDelivered = "delivered"
+	OutboxStatusFailed    = "failed"
+)
+
+// Outbox event types emitted by domain writes.
+const (
+	OutboxEventBlogCreated = "blog.created"
+	OutboxEventBlogUpdated = "blog.updated"
+	OutboxEventBlogDeleted = "blog.deleted"
+)
+
+// Outbox event types emitted by the referral program. Reward fulfillment
+// consumes them: a signup event when a referred account is created and a
+// conversion event when that account verifies its email.
+const (
+	OutboxEventReferralSignup    = "referral.signup"
+	OutboxEventReferralConverted = "referral.converted"
+)
+
+// OutboxEvent is a pending side effect recorded alongside the domain write
+// that caused it. A relay worker performs the follow-up work (cache
+// invalidation, webhook delivery, search indexing) and retries with backoff,
+// so side effects are not lost when the process dies between the write and
+// the follow-up.
+type OutboxEvent struct {
+	ID            string            `json:"id" bson:"_id"`
+	EventType     string            `json:"event_type" bson:"event_type"`
+	Payload       map[string]string `json:"payload" bson:"payload"`
+	Status        string            `json:"status" bson:"status"`
+	Attempts      int               `json:"attempts" bson:"attempts"`
+	NextAttemptAt time.Time         `json:"next_attempt_at" bson:"next_attempt_at"`
+	CreatedAt     time.Time         `json:"created_at" bson:"created_at"`
+	DeliveredAt   time.Time         `json:"delivered_at,omitempty" bson:"delivered_at,omitempty"`
+	LastError     string            `json:"last_error,omitempty" bson:"last_error,omitempty"`
+}
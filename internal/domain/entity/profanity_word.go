@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// Profanity severity levels. Severe words block the content outright while
+// mild words only hold it for moderation.
+const (
+	ProfanitySeverityMild   = "mild"
+	ProfanitySeveritySevere = "severe"
+)
+
+// IsValidProfanitySeverity reports whether severity is a known level.
+func IsValidProfanitySeverity(severity string) bool {
+	return severity == ProfanitySeverityMild || severity == ProfanitySeveritySevere
+}
+
+// ProfanityWord is an admin-managed wordlist entry used by the profanity
+// filter. Words are scoped to a locale so lists can differ per language.
+type ProfanityWord struct {
+	ID        string    `json:"id" bson:"_id,omitempty"`
+	Word      string    `json:"word" bson:"word"`
+	Locale    string    `json:"locale" bson:"locale"`
+	Severity  string    `json:"severity" bson:"severity"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"time"
+)
+
+// ReviewNote is an inline note left by an editor while reviewing a submitted
+// blog post.
+type ReviewNote struct {
+	ID         string    `json:"id" bson:"_id"`
+	BlogID     string    `json:"blog_id" bson:"blog_id"`
+	ReviewerID string    `json:"reviewer_id" bson:"reviewer_id"`
+	Note       string    `json:"note" bson:"note"`
+	CreatedAt  time.Time `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// RoleLimits are the per-role content quotas admins can configure: how many
+// posts a user may publish per day, how long comments may be, and how many
+// tags and images a post may carry. A zero value for any limit means that
+// limit is not enforced for the role.
+type RoleLimits struct {
+	Role             UserRole  `json:"role" bson:"_id"`
+	MaxPostsPerDay   int       `json:"max_posts_per_day" bson:"max_posts_per_day"`
+	MaxCommentLength int       `json:"max_comment_length" bson:"max_comment_length"`
+	MaxTagsPerPost   int       `json:"max_tags_per_post" bson:"max_tags_per_post"`
+	MaxImagesPerPost int       `json:"max_images_per_post" bson:"max_images_per_post"`
+	UpdatedAt        time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// DefaultRoleLimits returns the limits in effect for a role before an admin
+// has configured it. Admins are unrestricted by default.
+func DefaultRoleLimits(role UserRole) *RoleLimits {
+	if role == UserRoleAdmin {
+		return &RoleLimits{Role: role}
+	}
+	return &RoleLimits{
+		Role:             role,
+		MaxPostsPerDay:   10,
+		MaxCommentLength: 1000,
+		MaxTagsPerPost:   10,
+		MaxImagesPerPost: 20,
+	}
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// RSSFeed is an external feed an author registered for syndicated
+// cross-posting: new items are periodically imported as draft posts.
+type RSSFeed struct {
+	ID        string    `json:"id" bson:"_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	URL       string    `json:"url" bson:"url"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	// LastFetchedAt is when the import job last polled the feed.
+	LastFetchedAt *time.Time `json:"last_fetched_at,omitempty" bson:"last_fetched_at,omitempty"`
+	// LastError is the failure of the most recent poll, empty on success.
+	LastError string `json:"last_error,omitempty" bson:"last_error,omitempty"`
+	// ImportedCount is how many items have been imported as drafts so far.
+	ImportedCount int `json:"imported_count" bson:"imported_count"`
+}
+
+// RSSItem is one entry of a fetched feed.
+type RSSItem struct {
+	// GUID identifies the item within its feed; the item link is used when
+	// the feed does not provide one.
+	GUID    string
+	Title   string
+	Content string
+	// Link is the canonical URL of the original post.
+	Link        string
+	PublishedAt time.Time
+}
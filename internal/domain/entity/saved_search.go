@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// SavedSearch is a stored search/filter combination a user wants to be
+// alerted about: newly published posts matching it trigger a notification.
+type SavedSearch struct {
+	ID        string    `json:"id" bson:"_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	Name      string    `json:"name" bson:"name"`
+	Query     string    `json:"query" bson:"query,omitempty"`
+	Tags      []string  `json:"tags" bson:"tags,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// Matches reports whether a published blog satisfies the saved search: the
+// query must appear in the title or content (case-insensitive) and at least
+// one tag must overlap. Empty criteria match anything.
+func (s *SavedSearch) Matches(blog *Blog) bool {
+	if s.Query != "" {
+		query := strings.ToLower(s.Query)
+		if !strings.Contains(strings.ToLower(blog.Title), query) &&
+			!strings.Contains(strings.ToLower(blog.Content), query) {
+			return false
+		}
+	}
+	if len(s.Tags) > 0 {
+		tagged := make(map[string]struct{}, len(blog.Tags))
+		for _, tag := range blog.Tags {
+			tagged[strings.ToLower(tag)] = struct{}{}
+		}
+		found := false
+		for _, tag := range s.Tags {
+			if _, ok := tagged[strings.ToLower(tag)]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// SearchEvent is one recorded search interaction: a query execution with its
+// result count, or a click on one of the results (ClickedBlogID set).
+type SearchEvent struct {
+	ID          string `json:"id" bson:"_id"`
+	Query       string `json:"query" bson:"query"`
+	ResultCount int    `json:"result_count" bson:"result_count"`
+	// ClickedBlogID is set when the event records a result click rather than
+	// a search execution.
+	ClickedBlogID string `json:"clicked_blog_id,omitempty" bson:"clicked_blog_id,omitempty"`
+	// UserID is empty for anonymous searches.
+	UserID    string    `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// SearchQueryStat aggregates recorded search events for one query.
+type SearchQueryStat struct {
+	Query string `json:"query" bson:"_id"`
+	// Count is how many times the query was executed.
+	Count int64 `json:"count" bson:"count"`
+	// ClickCount is how many result clicks the query produced.
+	ClickCount int64 `json:"click_count" bson:"click_count"`
+}
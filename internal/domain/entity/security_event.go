@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+)
+
+// SecurityEvent records an authentication-related action together with the
+// client's IP address and user-agent fingerprint for abuse investigation.
+type SecurityEvent struct {
+	ID        string            `json:"id" bson:"_id"`
+	UserID    string            `json:"user_id" bson:"user_id"`
+	EventType SecurityEventType `json:"event_type" bson:"event_type"`
+	IPAddress string            `json:"ip_address" bson:"ip_address"`
+	UserAgent string            `json:"user_agent" bson:"user_agent"`
+	Country   string            `json:"country,omitempty" bson:"country,omitempty"`
+	Region    string            `json:"region,omitempty" bson:"region,omitempty"`
+	CreatedAt time.Time         `json:"created_at" bson:"created_at"`
+}
+
+// SecurityEventType represents the kind of security-relevant action recorded.
+type SecurityEventType string
+
+const (
+	SecurityEventRegister   SecurityEventType = "register"
+	SecurityEventLogin      SecurityEventType = "login"
+	SecurityEventOAuthLogin SecurityEventType = "oauth_login"
+	SecurityEventRoleChange SecurityEventType = "role_change"
+)
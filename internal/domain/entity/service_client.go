@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// Scopes grantable to service clients. Each scope unlocks one internal
+// endpoint family.
+const (
+	ServiceScopeSearchIndex   = "search:index"
+	ServiceScopeAnalyticsRead = "analytics:read"
+)
+
+// IsValidServiceScope reports whether the scope is one of the known values.
+func IsValidServiceScope(scope string) bool {
+	return scope == ServiceScopeSearchIndex || scope == ServiceScopeAnalyticsRead
+}
+
+// ServiceClient is a registered internal service (search indexer, analytics)
+// that authenticates with client credentials. Only the hash of the secret is
+// stored; the plaintext is shown once at registration.
+type ServiceClient struct {
+	ID         string    `json:"id" bson:"_id"`
+	Name       string    `json:"name" bson:"name"`
+	SecretHash string    `json:"-" bson:"secret_hash"`
+	Scopes     []string  `json:"scopes" bson:"scopes"`
+	CreatedAt  time.Time `json:"created_at" bson:"created_at"`
+	Revoked    bool      `json:"revoked" bson:"revoked"`
+}
+
+// HasScope reports whether the client was granted the given scope.
+func (c *ServiceClient) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
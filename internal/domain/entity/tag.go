@@ -6,8 +6,29 @@ import (
 
 // Tag represents a tag for categorizing blog posts
 type Tag struct {
-	ID        string    `json:"id" bson:"_id"`
-	Name      string    `json:"name" bson:"name"`
-	Slug      string    `json:"slug" bson:"slug"`
+	ID          string `json:"id" bson:"_id"`
+	Name        string `json:"name" bson:"name"`
+	Slug        string `json:"slug" bson:"slug"`
+	Description string `json:"description" bson:"description"`
+	// Aliases holds slugs of tags merged into this one so old links keep working
+	Aliases   []string  `json:"aliases,omitempty" bson:"aliases,omitempty"`
 	CreatedAt time.Time `json:"created_at" bson:"created_at"`
 }
+
+// TagSuggestion is a lightweight tag projection for the auto-complete endpoint.
+type TagSuggestion struct {
+	ID         string `json:"id" bson:"_id"`
+	Name       string `json:"name" bson:"name"`
+	Slug       string `json:"slug" bson:"slug"`
+	UsageCount int64  `json:"usage_count" bson:"usage_count"`
+}
+
+// TagDetail aggregates a tag's metadata with its usage and follow statistics
+// for the public tag page.
+type TagDetail struct {
+	Tag           Tag
+	BlogCount     int64
+	FollowerCount int64
+	IsFollowing   bool
+	TrendingBlogs []*Blog
+}
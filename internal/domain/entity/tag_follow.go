@@ -0,0 +1,13 @@
+package entity
+
+import (
+	"time"
+)
+
+// TagFollow represents a user following a tag, feeding the personalized feed
+type TagFollow struct {
+	ID        string    `json:"id" bson:"_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	TagID     string    `json:"tag_id" bson:"tag_id"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
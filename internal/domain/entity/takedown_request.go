@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// TakedownRequest is a DMCA/legal takedown complaint filed against a post.
+// Requests start pending and are accepted (unpublishing the post) or
+// rejected by an admin.
+type TakedownRequest struct {
+	ID            string         `json:"id" bson:"_id"`
+	BlogID        string         `json:"blog_id" bson:"blog_id"`
+	ReporterName  string         `json:"reporter_name" bson:"reporter_name"`
+	ReporterEmail string         `json:"reporter_email" bson:"reporter_email"`
+	Reason        string         `json:"reason" bson:"reason"`
+	Status        TakedownStatus `json:"status" bson:"status"`
+	// ReviewedBy and ReviewNote record the admin verdict.
+	ReviewedBy string     `json:"reviewed_by,omitempty" bson:"reviewed_by,omitempty"`
+	ReviewNote string     `json:"review_note,omitempty" bson:"review_note,omitempty"`
+	CreatedAt  time.Time  `json:"created_at" bson:"created_at"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty" bson:"reviewed_at,omitempty"`
+}
+
+// TakedownStatus represents the review state of a takedown request.
+type TakedownStatus string
+
+const (
+	TakedownStatusPending  TakedownStatus = "pending"
+	TakedownStatusAccepted TakedownStatus = "accepted"
+	TakedownStatusRejected TakedownStatus = "rejected"
+)
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// TipStatus represents the lifecycle state of a tip.
+type TipStatus string
+
+const (
+	// TipStatusPending marks a tip whose checkout has not completed yet.
+	TipStatusPending TipStatus = "pending"
+	// TipStatusCompleted marks a tip confirmed paid by the provider webhook.
+	TipStatusCompleted TipStatus = "completed"
+)
+
+// Tip represents a reader's payment to the author of a blog post. Amounts
+// are stored in the currency's smallest unit (cents).
+type Tip struct {
+	ID          string     `json:"id" bson:"_id"`
+	BlogID      string     `json:"blog_id" bson:"blog_id"`
+	AuthorID    string     `json:"author_id" bson:"author_id"`
+	SupporterID string     `json:"supporter_id,omitempty" bson:"supporter_id,omitempty"`
+	AmountCents int64      `json:"amount_cents" bson:"amount_cents"`
+	Currency    string     `json:"currency" bson:"currency"`
+	Status      TipStatus  `json:"status" bson:"status"`
+	SessionID   string     `json:"-" bson:"session_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at" bson:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+}
+
+// AuthorEarnings summarizes the completed tips an author received.
+type AuthorEarnings struct {
+	TotalCents int64 `json:"total_cents" bson:"total_cents"`
+	TipCount   int64 `json:"tip_count" bson:"tip_count"`
+}
@@ -17,6 +17,12 @@ type Token struct {
 	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
 	CreatedAt time.Time `bson:"created_at" json:"created_at"`
 	Revoke    bool      `bson:"revoke" json:"revoked"`
+	// Session metadata, recorded for refresh tokens so active sessions can
+	// be listed per device.
+	DeviceName string    `bson:"device_name,omitempty" json:"device_name,omitempty"`
+	UserAgent  string    `bson:"user_agent,omitempty" json:"user_agent,omitempty"`
+	IPAddress  string    `bson:"ip_address,omitempty" json:"ip_address,omitempty"`
+	LastUsedAt time.Time `bson:"last_used_at,omitempty" json:"last_used_at,omitempty"`
 }
 
 // TokenType represents the type of token
@@ -51,5 +57,9 @@ func SetTokenType(tokType string) (TokenType, error) {
 type Claims struct {
 	UserID string   `json:"user_id"`
 	Role   UserRole `json:"role"`
+	// ClientType records which kind of client the token was issued to;
+	// RememberMe marks refresh tokens issued with an extended lifetime.
+	ClientType ClientType `json:"client_type,omitempty"`
+	RememberMe bool       `json:"remember_me,omitempty"`
 	jwt.RegisteredClaims
 }
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// UploadSession tracks a chunked/resumable media upload. Chunks are appended
+// to a temporary file until the declared size is reached and the client
+// completes the session, which runs the assembled file through the regular
+// upload pipeline. Abandoned sessions expire and are cleaned up.
+type UploadSession struct {
+	ID           string    `json:"id" bson:"_id"`
+	UserID       string    `json:"user_id" bson:"user_id"`
+	FileName     string    `json:"file_name" bson:"file_name"`
+	MimeType     string    `json:"mime_type" bson:"mime_type"`
+	TotalSize    int64     `json:"total_size" bson:"total_size"`
+	ReceivedSize int64     `json:"received_size" bson:"received_size"`
+	ChunkCount   int       `json:"chunk_count" bson:"chunk_count"`
+	CreatedAt    time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" bson:"updated_at"`
+	ExpiresAt    time.Time `json:"expires_at" bson:"expires_at"`
+}
@@ -6,18 +6,50 @@ import (
 
 // User represents a registered user in the system
 type User struct {
-	ID           string    `bson:"_id,omitempty" json:"id"`
-	Username     string    `bson:"username" json:"username"`
-	Email        string    `bson:"email" json:"email"`
-	PasswordHash string    `bson:"password_hash" json:"-"`
-	Role         UserRole  `bson:"role" json:"role"`
-	IsActive     bool      `bson:"is_active" json:"is_active"`
-	IsVerified   bool      `bson:"is_verified" json:"is_verified"`
-	CreatedAt    time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
-	FirstName    *string   `bson:"firstname,omitempty" json:"firstname,omitempty"`
-	LastName     *string   `bson:"lastname,omitempty" json:"lastname,omitempty"`
-	AvatarURL    *string   `bson:"avatar_url,omitempty" json:"avatar_url,omitempty"`
+	ID           string   `bson:"_id,omitempty" json:"id"`
+	Username     string   `bson:"username" json:"username"`
+	Email        string   `bson:"email" json:"email"`
+	PasswordHash string   `bson:"password_hash" json:"-"`
+	Role         UserRole `bson:"role" json:"role"`
+	IsActive     bool     `bson:"is_active" json:"is_active"`
+	IsVerified   bool     `bson:"is_verified" json:"is_verified"`
+	// IsDeleted marks a soft-deleted account: the record is kept so content
+	// attribution survives, but the profile is gone and the PII scrubbed.
+	IsDeleted bool       `bson:"is_deleted,omitempty" json:"-"`
+	DeletedAt *time.Time `bson:"deleted_at,omitempty" json:"-"`
+	CreatedAt time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time  `bson:"updated_at" json:"updated_at"`
+	FirstName *string    `bson:"firstname,omitempty" json:"firstname,omitempty"`
+	LastName  *string    `bson:"lastname,omitempty" json:"lastname,omitempty"`
+	AvatarURL *string    `bson:"avatar_url,omitempty" json:"avatar_url,omitempty"`
+	// IsShadowBanned hides the user's comments from everyone except themselves.
+	IsShadowBanned bool `bson:"is_shadow_banned" json:"is_shadow_banned"`
+	// SecurityEmailsOptOut disables security notification emails (new sign-in
+	// alerts, password change confirmations). Defaults to receiving them.
+	SecurityEmailsOptOut bool `bson:"security_emails_opt_out" json:"security_emails_opt_out"`
+	// LeaderboardOptOut hides the user from the public top-authors
+	// leaderboard. Defaults to being listed.
+	LeaderboardOptOut bool `bson:"leaderboard_opt_out" json:"leaderboard_opt_out"`
+	// PushNotificationsOptOut disables push notifications to the user's
+	// registered devices. Defaults to receiving them.
+	PushNotificationsOptOut bool `bson:"push_notifications_opt_out" json:"push_notifications_opt_out"`
+	// CommentDigest batches comment notifications on the user's posts into a
+	// periodic email ("hourly" or "daily"). Empty means no digest.
+	CommentDigest string `bson:"comment_digest,omitempty" json:"comment_digest,omitempty"`
+	// MatureContentOptIn records that the user confirmed their age and wants
+	// to see mature-rated posts. Defaults to the age-gated experience.
+	MatureContentOptIn bool `bson:"mature_content_opt_in" json:"mature_content_opt_in"`
+	// PerformanceReport subscribes the author to a periodic performance
+	// summary email ("weekly" or "monthly"). Empty means no report.
+	PerformanceReport string `bson:"performance_report,omitempty" json:"performance_report,omitempty"`
+	// Reputation is the user's gamification point total, earned by
+	// publishing, receiving likes and having answers accepted.
+	Reputation int `bson:"reputation" json:"reputation"`
+	// ReferralCode is the user's shareable signup code (the ?ref= link
+	// parameter); ReferredBy records the ID of the user whose code this
+	// account signed up with.
+	ReferralCode string `bson:"referral_code,omitempty" json:"referral_code,omitempty"`
+	ReferredBy   string `bson:"referred_by,omitempty" json:"-"`
 }
 
 // UserRole represents the role of a user in the system
@@ -4,9 +4,58 @@ import "time"
 
 // BlogView represents a record of a user viewing a blog, used for tracking and analysis.
 type BlogView struct {
-	BlogID    string    `bson:"blog_id"`
-	UserID    string    `bson:"user_id,omitempty"`
-	IPAddress string    `bson:"ip_address"`
-	UserAgent string    `bson:"user_agent"`
-	ViewedAt  time.Time `bson:"viewed_at"`
+	BlogID    string `bson:"blog_id"`
+	UserID    string `bson:"user_id,omitempty"`
+	IPAddress string `bson:"ip_address"`
+	UserAgent string `bson:"user_agent"`
+	// Fingerprint is the client-computed device fingerprint hash, used to
+	// spot accounts rotating through many devices to inflate view counts.
+	Fingerprint string    `bson:"fingerprint,omitempty"`
+	Country     string    `bson:"country,omitempty"`
+	Region      string    `bson:"region,omitempty"`
+	ViewedAt    time.Time `bson:"viewed_at"`
+}
+
+// View fraud signals, recorded against the targeted blog's anomaly score.
+const (
+	// ViewSignalIPVelocity: too many views from one IP in a short window.
+	ViewSignalIPVelocity = "ip_velocity"
+	// ViewSignalIPRotation: one account viewing from too many IPs.
+	ViewSignalIPRotation = "ip_rotation"
+	// ViewSignalFingerprintRotation: one account viewing from too many
+	// distinct device fingerprints.
+	ViewSignalFingerprintRotation = "fingerprint_rotation"
+	// ViewSignalShortDwell: the client heartbeat arrived before the minimum
+	// dwell time, so the reader cannot actually have read anything.
+	ViewSignalShortDwell = "short_dwell"
+)
+
+// ViewAnomaly is a blog's accumulated view-fraud record: every suspicious
+// signal observed against the blog bumps its score, weighted by how strong
+// an indicator the signal is. Admins review high scores and may roll the
+// blog's view count back.
+type ViewAnomaly struct {
+	BlogID       string         `json:"blog_id" bson:"_id"`
+	Score        int64          `json:"score" bson:"score"`
+	Signals      map[string]int `json:"signals" bson:"signals"`
+	LastSignalAt time.Time      `json:"last_signal_at" bson:"last_signal_at"`
+}
+
+// ViewGeoStat holds the aggregated view count for a single country,
+// used for geographic breakdowns in author analytics.
+type ViewGeoStat struct {
+	Country string `json:"country" bson:"_id"`
+	Views   int64  `json:"views" bson:"views"`
+}
+
+// AuthorStat holds the aggregated engagement totals for a single author,
+// used by the top-authors leaderboard. Username and AvatarURL are filled in
+// by the use case.
+type AuthorStat struct {
+	AuthorID  string  `json:"author_id" bson:"_id"`
+	Views     int64   `json:"views" bson:"views"`
+	Likes     int64   `json:"likes" bson:"likes"`
+	BlogCount int64   `json:"blog_count" bson:"blog_count"`
+	Username  string  `json:"username" bson:"-"`
+	AvatarURL *string `json:"avatar_url,omitempty" bson:"-"`
 }
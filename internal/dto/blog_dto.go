@@ -0,0 +1,288 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// Request DTOs for Blog Handlers
+
+// CreateBlogRequest defines the structure for creating a new blog
+type CreateBlogRequest struct {
+	Title           string   `json:"title" binding:"required"`
+	Content         string   `json:"content" binding:"required"`
+	Slug            string   `json:"slug" binding:"required"`
+	Status          string   `json:"status" binding:"required,oneof=draft published archived"`
+	FeaturedImageID *string  `json:"featured_image_id"`
+	Tags            []string `json:"tags"`
+	OrgID           *string  `json:"org_id"`
+	// Language is the post's BCP 47 language tag (e.g. "en", "am").
+	Language string `json:"language" binding:"omitempty,max=35"`
+	// CanonicalURL and OriginalSource attribute syndicated cross-posts to
+	// their original publication.
+	CanonicalURL   string `json:"canonical_url" binding:"omitempty,url"`
+	OriginalSource string `json:"original_source" binding:"omitempty,max=100"`
+	// ContentRating marks the post as general-audience or mature; empty
+	// defaults to general.
+	ContentRating string `json:"content_rating" binding:"omitempty,oneof=general mature"`
+	// Type distinguishes articles from question and discussion posts;
+	// empty defaults to article.
+	Type string `json:"type" binding:"omitempty,oneof=article question discussion"`
+	// AccessLevel gates who can read the full post; empty defaults to
+	// public.
+	AccessLevel string `json:"access_level" binding:"omitempty,oneof=public members paid"`
+}
+
+// UpdateBlogRequest defines the structure for updating an existing blog
+type UpdateBlogRequest struct {
+	Title           *string  `json:"title"`
+	Content         *string  `json:"content"`
+	Slug            *string  `json:"slug"`
+	Status          *string  `json:"status" binding:"omitempty,oneof=draft published archived"`
+	FeaturedImageID *string  `json:"featured_image_id"`
+	Tags            []string `json:"tags"`
+	Language        *string  `json:"language" binding:"omitempty,max=35"`
+	CanonicalURL    *string  `json:"canonical_url" binding:"omitempty,url"`
+	OriginalSource  *string  `json:"original_source" binding:"omitempty,max=100"`
+	ContentRating   *string  `json:"content_rating" binding:"omitempty,oneof=general mature"`
+	AccessLevel     *string  `json:"access_level" binding:"omitempty,oneof=public members paid"`
+}
+
+// SearchClickRequest defines the structure for recording a click on a search
+// result.
+type SearchClickRequest struct {
+	Query  string `json:"query" binding:"required,max=200"`
+	BlogID string `json:"blog_id" binding:"required"`
+}
+
+// ReviewBlogRequest defines the structure for an editorial review verdict.
+// A note is required when requesting changes and optional on approval.
+type ReviewBlogRequest struct {
+	Action string `json:"action" binding:"required,oneof=approve request_changes"`
+	Note   string `json:"note"`
+}
+
+// Response DTOs
+
+// BlogResponse defines the standard JSON response for a single blog
+type BlogResponse struct {
+	ID              string  `json:"id"`
+	Title           string  `json:"title"`
+	Content         string  `json:"content"`
+	AuthorID        string  `json:"author_id"`
+	OrgID           *string `json:"org_id,omitempty"`
+	Slug            string  `json:"slug"`
+	Status          string  `json:"status"`
+	Language        string  `json:"language,omitempty"`
+	ReadingTime     int     `json:"reading_time_minutes"`
+	ViewCount       int     `json:"view_count"`
+	LikeCount       int     `json:"like_count"`
+	CommentCount    int     `json:"comment_count"`
+	ClapCount       int     `json:"clap_count"`
+	Popularity      float64 `json:"popularity"`
+	FeaturedImageID *string `json:"featured_image_id,omitempty"`
+	CanonicalURL    string  `json:"canonical_url,omitempty"`
+	OriginalSource  string  `json:"original_source,omitempty"`
+	OGImageURL      string  `json:"og_image_url,omitempty"`
+	ContentRating   string  `json:"content_rating,omitempty"`
+	AudioURL        string  `json:"audio_url,omitempty"`
+	AudioStatus     string  `json:"audio_status,omitempty"`
+	// Type distinguishes articles from question and discussion posts;
+	// question posts may carry the author's accepted answer.
+	Type             string `json:"type,omitempty"`
+	AcceptedAnswerID string `json:"accepted_answer_id,omitempty"`
+	// Event carries the event metadata block on event posts.
+	Event *EventInfoResponse `json:"event,omitempty"`
+	// AccessLevel gates who reads the full post; IsLocked is set when the
+	// content was truncated to a teaser for this viewer.
+	AccessLevel string     `json:"access_level,omitempty"`
+	IsLocked    bool       `json:"is_locked,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+}
+
+// EventInfoResponse defines the event metadata block of an event post.
+type EventInfoResponse struct {
+	StartTime time.Time  `json:"start_time"`
+	EndTime   *time.Time `json:"end_time,omitempty"`
+	Location  string     `json:"location,omitempty"`
+	Capacity  int        `json:"capacity,omitempty"`
+}
+
+// toEventInfoResponse converts the optional event block of a blog.
+func toEventInfoResponse(info *entity.EventInfo) *EventInfoResponse {
+	if info == nil {
+		return nil
+	}
+	return &EventInfoResponse{
+		StartTime: info.StartTime,
+		EndTime:   info.EndTime,
+		Location:  info.Location,
+		Capacity:  info.Capacity,
+	}
+}
+
+// BlogSuggestionResponse defines one title match of the search type-ahead.
+type BlogSuggestionResponse struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
+
+// BlogSuggestResponse defines the search type-ahead payload: title prefix
+// matches plus the most popular search queries.
+type BlogSuggestResponse struct {
+	Suggestions    []BlogSuggestionResponse `json:"suggestions"`
+	PopularQueries []string                 `json:"popular_queries"`
+}
+
+// ToBlogSuggestResponse converts type-ahead results for the API.
+func ToBlogSuggestResponse(suggestions []*entity.BlogSuggestion, popularQueries []string) BlogSuggestResponse {
+	resp := BlogSuggestResponse{
+		Suggestions:    make([]BlogSuggestionResponse, 0, len(suggestions)),
+		PopularQueries: popularQueries,
+	}
+	for _, s := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, BlogSuggestionResponse{
+			ID:    s.ID,
+			Title: s.Title,
+			Slug:  s.Slug,
+		})
+	}
+	return resp
+}
+
+// metaDescriptionLength is how much of the content the meta description
+// keeps.
+const metaDescriptionLength = 160
+
+// BlogMetaResponse defines the meta/OpenGraph data for a blog page. The
+// canonical URL points at the original post for syndicated content so
+// cross-posts don't compete with their source in search rankings.
+type BlogMetaResponse struct {
+	Title          string            `json:"title"`
+	Description    string            `json:"description"`
+	Author         string            `json:"author,omitempty"`
+	PublishedAt    *time.Time        `json:"published_at,omitempty"`
+	CanonicalURL   string            `json:"canonical_url"`
+	OriginalSource string            `json:"original_source,omitempty"`
+	OGImageURL     string            `json:"og_image_url,omitempty"`
+	OpenGraph      map[string]string `json:"open_graph"`
+}
+
+// ToBlogMetaResponse builds the meta/OpenGraph data for a blog page.
+func ToBlogMetaResponse(blog *entity.Blog, authorName string, baseURL string) BlogMetaResponse {
+	pageURL := fmt.Sprintf("%s/blogs/slug/%s", baseURL, blog.Slug)
+	canonical := blog.CanonicalURL
+	if canonical == "" {
+		canonical = pageURL
+	}
+
+	description := strings.Join(strings.Fields(blog.Content), " ")
+	if len(description) > metaDescriptionLength {
+		description = description[:metaDescriptionLength]
+	}
+
+	openGraph := map[string]string{
+		"og:type":        "article",
+		"og:title":       blog.Title,
+		"og:description": description,
+		"og:url":         canonical,
+	}
+	if blog.OriginalSource != "" {
+		openGraph["og:site_name"] = blog.OriginalSource
+	}
+	if blog.OGImageURL != "" {
+		openGraph["og:image"] = blog.OGImageURL
+	}
+	if authorName != "" {
+		openGraph["article:author"] = authorName
+	}
+	if blog.PublishedAt != nil {
+		openGraph["article:published_time"] = blog.PublishedAt.UTC().Format(time.RFC3339)
+	}
+
+	return BlogMetaResponse{
+		Title:          blog.Title,
+		Description:    description,
+		Author:         authorName,
+		PublishedAt:    blog.PublishedAt,
+		CanonicalURL:   canonical,
+		OriginalSource: blog.OriginalSource,
+		OGImageURL:     blog.OGImageURL,
+		OpenGraph:      openGraph,
+	}
+}
+
+// PaginatedBlogResponse defines the structure for a paginated list of blogs.
+type PaginatedBlogResponse struct {
+	Blogs       []BlogResponse `json:"blogs"`
+	TotalCount  int            `json:"total_count"`
+	CurrentPage int            `json:"current_page"`
+	TotalPages  int            `json:"total_pages"`
+}
+
+// ReviewNoteResponse defines the JSON response for an editorial review note.
+type ReviewNoteResponse struct {
+	ID         string    `json:"id"`
+	BlogID     string    `json:"blog_id"`
+	ReviewerID string    `json:"reviewer_id"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ToReviewNoteResponses converts entity.ReviewNotes for the API.
+func ToReviewNoteResponses(notes []*entity.ReviewNote) []ReviewNoteResponse {
+	resp := make([]ReviewNoteResponse, 0, len(notes))
+	for _, note := range notes {
+		resp = append(resp, ReviewNoteResponse{
+			ID:         note.ID,
+			BlogID:     note.BlogID,
+			ReviewerID: note.ReviewerID,
+			Note:       note.Note,
+			CreatedAt:  note.CreatedAt,
+		})
+	}
+	return resp
+}
+
+// DTO Mapper
+// a mapper function to convert *entity.Blog to a BlogResponse
+
+func ToBlogResponse(blog *entity.Blog) BlogResponse {
+	return BlogResponse{
+		ID:               blog.ID,
+		Title:            blog.Title,
+		Content:          blog.Content,
+		AuthorID:         blog.AuthorID,
+		OrgID:            blog.OrgID,
+		Slug:             blog.Slug,
+		Status:           string(blog.Status),
+		Language:         blog.Language,
+		ReadingTime:      blog.ReadingTimeMinutes,
+		ViewCount:        blog.ViewCount,
+		LikeCount:        blog.LikeCount,
+		CommentCount:     blog.CommentCount,
+		ClapCount:        blog.ClapCount,
+		Popularity:       blog.Popularity,
+		FeaturedImageID:  blog.FeaturedImageID,
+		CanonicalURL:     blog.CanonicalURL,
+		OriginalSource:   blog.OriginalSource,
+		OGImageURL:       blog.OGImageURL,
+		ContentRating:    string(blog.ContentRating),
+		AudioURL:         blog.AudioURL,
+		AudioStatus:      string(blog.AudioStatus),
+		Type:             string(blog.Type),
+		AcceptedAnswerID: blog.AcceptedAnswerID,
+		Event:            toEventInfoResponse(blog.Event),
+		AccessLevel:      string(blog.AccessLevel),
+		IsLocked:         blog.IsLocked,
+		CreatedAt:        blog.CreatedAt,
+		UpdatedAt:        blog.UpdatedAt,
+		PublishedAt:      blog.PublishedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"time"
+)
+
+// Request DTOs
+type CreateCommentRequest struct {
+	Content        string  `json:"content" validate:"required,min=1,max=1000"`
+	Type           string  `json:"type"`
+	ParentID       *string `json:"parent_id"`
+	TargetID       *string `json:"target_id"`
+	TargetUserName string  `json:"target_user_name"`
+	// CaptchaToken is required only when CAPTCHA verification is enabled.
+	CaptchaToken string `json:"captcha_token"`
+}
+
+// CreateGuestCommentRequest is the payload for anonymous/guest commenting,
+// available only when the deployment enables it.
+type CreateGuestCommentRequest struct {
+	DisplayName string  `json:"display_name" validate:"required,min=2,max=50"`
+	Email       string  `json:"email" validate:"required,email"`
+	Content     string  `json:"content" validate:"required,min=1,max=1000"`
+	ParentID    *string `json:"parent_id"`
+	// CaptchaToken is required only when CAPTCHA verification is enabled.
+	CaptchaToken string `json:"captcha_token"`
+}
+
+type UpdateCommentRequest struct {
+	Content string `json:"content" validate:"required,min=1,max=1000"`
+}
+
+type UpdateCommentStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=approved pending hidden flagged"`
+}
+
+type CommentReactionRequest struct {
+	Reaction string `json:"reaction" validate:"required"`
+}
+
+// ResolveReportRequest is the DTO for resolving a comment report. The
+// optional comment action links the resolution to a moderation action on the
+// reported comment; it is mandatory when the status is "actioned".
+type ResolveReportRequest struct {
+	Status        string `json:"status" validate:"required,oneof=reviewed dismissed actioned"`
+	CommentAction string `json:"comment_action" validate:"omitempty,oneof=hide delete"`
+}
+
+type ReportCommentRequest struct {
+	Reason  string `json:"reason" validate:"required,oneof=spam harassment inappropriate offensive"`
+	Details string `json:"details" validate:"max=500"`
+}
+
+// Response DTOs
+type CommentResponse struct {
+	ID             string  `json:"id"`
+	BlogID         string  `json:"blog_id"`
+	Type           string  `json:"type"`
+	ParentID       *string `json:"parent_id"`
+	TargetID       *string `json:"target_id"`
+	AuthorID       string  `json:"author_id"`
+	AuthorName     string  `json:"author_name"`
+	IsGuest        bool    `json:"is_guest"`
+	TargetUserName string  `json:"target_user_name"`
+	Content        string  `json:"content"`
+	Status         string  `json:"status"`
+	LikeCount      int     `json:"like_count"`
+	IsLiked        bool    `json:"is_liked"`
+	// Reactions maps each emoji to its count; UserReactions lists the emojis
+	// the current viewer has used on this comment.
+	Reactions     map[string]int64 `json:"reactions"`
+	UserReactions []string         `json:"user_reactions,omitempty"`
+	// LinkPreviews carries rich link cards for URLs found in the content.
+	LinkPreviews []LinkPreviewResponse `json:"link_previews,omitempty"`
+	// IsAcceptedAnswer marks the comment the author accepted as the answer
+	// on a question post; it is listed first.
+	IsAcceptedAnswer bool      `json:"is_accepted_answer,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	ReplyCount       int       `json:"reply_count"`
+}
+
+// LinkPreviewResponse is the Open Graph metadata returned for a URL found in
+// user-submitted content.
+type LinkPreviewResponse struct {
+	URL         string `json:"url"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
+type CommentThreadResponse struct {
+	Comment *CommentResponse         `json:"comment"`
+	Replies []*CommentThreadResponse `json:"replies"`
+	Depth   int                      `json:"depth"`
+}
+
+type CommentsResponse struct {
+	Comments   []*CommentResponse `json:"comments"`
+	Pagination PaginationMeta     `json:"pagination"`
+}
+
+type PaginationMeta struct {
+	CurrentPage int   `json:"current_page"`
+	PageSize    int   `json:"page_size"`
+	TotalItems  int64 `json:"total_items"`
+	TotalPages  int   `json:"total_pages"`
+	HasNext     bool  `json:"has_next"`
+	HasPrevious bool  `json:"has_previous"`
+}
+
+type CommentReportResponse struct {
+	ID         string     `json:"id"`
+	CommentID  string     `json:"comment_id"`
+	ReporterID string     `json:"reporter_id"`
+	Reason     string     `json:"reason"`
+	Details    string     `json:"details"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	ReviewedBy *string    `json:"reviewed_by"`
+}
+
+type ReportsResponse struct {
+	Reports    []*CommentReportResponse `json:"reports"`
+	Pagination PaginationMeta           `json:"pagination"`
+}
@@ -0,0 +1,183 @@
+package dto_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertNoZeroFields fails if any exported field of the mapped response is
+// still at its zero value. Combined with fully-populated source entities this
+// catches mapper omissions: a field added to an entity but not to its mapper
+// shows up here as a zero response field.
+func assertNoZeroFields(t *testing.T, resp interface{}) {
+	t.Helper()
+	v := reflect.ValueOf(resp)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Time{}) {
+			assertNoZeroFields(t, v.Field(i).Interface())
+			continue
+		}
+		assert.False(t, v.Field(i).IsZero(), fmt.Sprintf("field %s.%s was not mapped", v.Type().Name(), field.Name))
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func sampleBlog() *entity.Blog {
+	publishedAt := time.Now().Add(-time.Hour)
+	return &entity.Blog{
+		ID:                 "blog-1",
+		Title:              "Title",
+		Content:            "Content",
+		AuthorID:           "user-1",
+		OrgID:              strPtr("org-1"),
+		Slug:               "title",
+		Status:             entity.BlogStatusPublished,
+		Tags:               []string{"go"},
+		Language:           "en",
+		ReadingTimeMinutes: 4,
+		CreatedAt:          time.Now().Add(-2 * time.Hour),
+		UpdatedAt:          time.Now(),
+		PublishedAt:        &publishedAt,
+		ViewCount:          10,
+		LikeCount:          5,
+		DislikeCount:       1,
+		CommentCount:       3,
+		ClapCount:          7,
+		Popularity:         4.2,
+		FeaturedImageID:    strPtr("img-1"),
+		CanonicalURL:       "https://origin.example.com/post",
+		OriginalSource:     "Origin Blog",
+		OGImageURL:         "https://example.com/og-title-1.png",
+		ContentRating:      entity.ContentRatingGeneral,
+		AudioURL:           "https://example.com/audio-blog-1.mp3",
+		AudioStatus:        entity.AudioStatusReady,
+		Type:               entity.BlogTypeQuestion,
+		AcceptedAnswerID:   "comment-1",
+		Event:              &entity.EventInfo{StartTime: time.Now().Add(24 * time.Hour), Location: "Addis Ababa", Capacity: 50},
+		AccessLevel:        entity.BlogAccessMembers,
+		IsLocked:           true,
+	}
+}
+
+func TestToBlogResponse(t *testing.T) {
+	blog := sampleBlog()
+	resp := dto.ToBlogResponse(blog)
+
+	assert.Equal(t, blog.ID, resp.ID)
+	assert.Equal(t, blog.AuthorID, resp.AuthorID)
+	assert.Equal(t, string(blog.Status), resp.Status)
+	assert.Equal(t, blog.OrgID, resp.OrgID)
+	assert.Equal(t, blog.PublishedAt, resp.PublishedAt)
+	assertNoZeroFields(t, resp)
+}
+
+func TestToUserResponse(t *testing.T) {
+	user := entity.User{
+		ID:         "user-1",
+		Username:   "writer",
+		Email:      "writer@example.com",
+		Role:       entity.UserRoleAdmin,
+		FirstName:  strPtr("First"),
+		LastName:   strPtr("Last"),
+		AvatarURL:  strPtr("https://example.com/a.png"),
+		Reputation: 42,
+		CreatedAt:  time.Now(),
+	}
+	resp := dto.ToUserResponse(user)
+
+	assert.Equal(t, user.ID, resp.ID)
+	assert.Equal(t, string(user.Role), resp.Role)
+	assert.Equal(t, user.CreatedAt.Format(time.RFC3339), resp.CreatedAt)
+	assertNoZeroFields(t, resp)
+}
+
+func TestToTagResponse(t *testing.T) {
+	tag := &entity.Tag{
+		ID:          "tag-1",
+		Name:        "Go",
+		Slug:        "go",
+		Description: "Posts about Go",
+		Aliases:     []string{"golang"},
+	}
+	resp := dto.ToTagResponse(tag)
+
+	assert.Equal(t, tag.ID, resp.ID)
+	assert.Equal(t, tag.Aliases, resp.Aliases)
+	assertNoZeroFields(t, resp)
+}
+
+func TestToTagDetailResponse(t *testing.T) {
+	detail := &entity.TagDetail{
+		Tag: entity.Tag{
+			ID:          "tag-1",
+			Name:        "Go",
+			Slug:        "go",
+			Description: "Posts about Go",
+		},
+		BlogCount:     7,
+		FollowerCount: 4,
+		IsFollowing:   true,
+		TrendingBlogs: []*entity.Blog{sampleBlog()},
+	}
+	resp := dto.ToTagDetailResponse(detail)
+
+	assert.Equal(t, detail.Tag.ID, resp.ID)
+	assert.Equal(t, detail.BlogCount, resp.BlogCount)
+	assert.Len(t, resp.TrendingBlogs, 1)
+	assertNoZeroFields(t, resp)
+}
+
+func TestToOrganizationProfileResponse(t *testing.T) {
+	profile := &entity.OrganizationProfile{
+		Organization: entity.Organization{
+			ID:           "org-1",
+			Name:         "Acme",
+			Slug:         "acme",
+			Description:  "Acme publication",
+			CustomDomain: "blog.acme.test",
+			Theme:        map[string]string{"color": "blue"},
+			CreatedAt:    time.Now(),
+		},
+		Members: []*entity.OrgMember{
+			{ID: "m-1", OrgID: "org-1", UserID: "user-1", Role: entity.OrgRoleOwner, CreatedAt: time.Now()},
+		},
+		RecentBlogs: []*entity.Blog{sampleBlog()},
+		BlogCount:   1,
+	}
+	resp := dto.ToOrganizationProfileResponse(profile)
+
+	assert.Equal(t, profile.Organization.ID, resp.ID)
+	assert.Equal(t, profile.BlogCount, resp.BlogCount)
+	assert.Len(t, resp.Members, 1)
+	assert.Equal(t, string(entity.OrgRoleOwner), resp.Members[0].Role)
+	assert.Len(t, resp.RecentBlogs, 1)
+	assertNoZeroFields(t, resp.OrganizationResponse)
+}
+
+func TestToReviewNoteResponses(t *testing.T) {
+	notes := []*entity.ReviewNote{
+		{ID: "note-1", BlogID: "blog-1", ReviewerID: "user-2", Note: "Looks good", CreatedAt: time.Now()},
+	}
+	resp := dto.ToReviewNoteResponses(notes)
+
+	assert.Len(t, resp, 1)
+	assertNoZeroFields(t, resp[0])
+}
+
+func TestToTagSuggestionResponses(t *testing.T) {
+	suggestions := []*entity.TagSuggestion{
+		{ID: "tag-1", Name: "Go", Slug: "go", UsageCount: 12},
+	}
+	resp := dto.ToTagSuggestionResponses(suggestions)
+
+	assert.Len(t, resp, 1)
+	assertNoZeroFields(t, resp[0])
+}
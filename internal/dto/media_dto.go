@@ -0,0 +1,189 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// BulkDeleteMediaRequest defines the structure for deleting several media
+// records at once.
+type BulkDeleteMediaRequest struct {
+	MediaIDs []string `json:"media_ids" binding:"required,min=1,max=100"`
+}
+
+// MediaUsageRef names one blog that references a media record.
+type MediaUsageRef struct {
+	BlogID string `json:"blog_id"`
+	Title  string `json:"title"`
+	Slug   string `json:"slug"`
+}
+
+// MediaUsageResponse answers "where is this media used".
+type MediaUsageResponse struct {
+	MediaID string          `json:"media_id"`
+	Used    bool            `json:"used"`
+	Blogs   []MediaUsageRef `json:"blogs"`
+}
+
+// ToMediaUsageResponse builds the usage lookup payload.
+func ToMediaUsageResponse(media *entity.Media, blogs []*entity.Blog) MediaUsageResponse {
+	resp := MediaUsageResponse{
+		MediaID: media.ID,
+		Blogs:   make([]MediaUsageRef, 0, len(blogs)),
+	}
+	for _, blog := range blogs {
+		resp.Blogs = append(resp.Blogs, MediaUsageRef{
+			BlogID: blog.ID,
+			Title:  blog.Title,
+			Slug:   blog.Slug,
+		})
+	}
+	resp.Used = len(resp.Blogs) > 0
+	return resp
+}
+
+// StorageUsageByTypeResponse is one slice of a user's storage usage.
+type StorageUsageByTypeResponse struct {
+	MimeType string `json:"mime_type"`
+	Bytes    int64  `json:"bytes"`
+	Count    int64  `json:"count"`
+}
+
+// StorageUsageResponse reports how much of the storage quota a user's
+// uploads occupy. Quota and remaining bytes are zero when no quota is
+// configured.
+type StorageUsageResponse struct {
+	TotalBytes     int64                        `json:"total_bytes"`
+	QuotaBytes     int64                        `json:"quota_bytes,omitempty"`
+	RemainingBytes int64                        `json:"remaining_bytes,omitempty"`
+	Breakdown      []StorageUsageByTypeResponse `json:"breakdown"`
+}
+
+// ToStorageUsageResponse builds the storage usage payload.
+func ToStorageUsageResponse(total, quota int64, usage []contract.StorageUsageByType) StorageUsageResponse {
+	resp := StorageUsageResponse{
+		TotalBytes: total,
+		QuotaBytes: quota,
+		Breakdown:  make([]StorageUsageByTypeResponse, 0, len(usage)),
+	}
+	if quota > 0 {
+		resp.RemainingBytes = quota - total
+		if resp.RemainingBytes < 0 {
+			resp.RemainingBytes = 0
+		}
+	}
+	for _, u := range usage {
+		resp.Breakdown = append(resp.Breakdown, StorageUsageByTypeResponse{
+			MimeType: u.MimeType,
+			Bytes:    u.Bytes,
+			Count:    u.Count,
+		})
+	}
+	return resp
+}
+
+// PaginatedMediaResponse defines the structure for a page of the media
+// library.
+type PaginatedMediaResponse struct {
+	Media       []MediaResponse `json:"media"`
+	TotalCount  int             `json:"total_count"`
+	CurrentPage int             `json:"current_page"`
+	TotalPages  int             `json:"total_pages"`
+}
+
+// BeginUploadRequest defines the structure for opening a chunked upload
+// session.
+type BeginUploadRequest struct {
+	FileName  string `json:"file_name" binding:"required,max=255"`
+	MimeType  string `json:"mime_type" binding:"required"`
+	TotalSize int64  `json:"total_size" binding:"required,min=1"`
+}
+
+// UploadSessionResponse defines the JSON response for a chunked upload
+// session, including the progress a resuming client needs.
+type UploadSessionResponse struct {
+	ID           string    `json:"id"`
+	FileName     string    `json:"file_name"`
+	MimeType     string    `json:"mime_type"`
+	TotalSize    int64     `json:"total_size"`
+	ReceivedSize int64     `json:"received_size"`
+	ChunkCount   int       `json:"chunk_count"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// ToUploadSessionResponse converts an entity.UploadSession to an
+// UploadSessionResponse.
+func ToUploadSessionResponse(session *entity.UploadSession) UploadSessionResponse {
+	return UploadSessionResponse{
+		ID:           session.ID,
+		FileName:     session.FileName,
+		MimeType:     session.MimeType,
+		TotalSize:    session.TotalSize,
+		ReceivedSize: session.ReceivedSize,
+		ChunkCount:   session.ChunkCount,
+		ExpiresAt:    session.ExpiresAt,
+	}
+}
+
+// MediaVariantResponse defines one responsive rendition of an image upload.
+type MediaVariantResponse struct {
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	FileSize int64  `json:"file_size"`
+}
+
+// MediaResponse defines the standard JSON response for an uploaded media
+// file. SrcSet is ready for an <img srcset> attribute once variants have
+// been generated.
+type MediaResponse struct {
+	ID        string                 `json:"id"`
+	FileName  string                 `json:"file_name"`
+	URL       string                 `json:"url"`
+	MimeType  string                 `json:"mime_type"`
+	FileSize  int64                  `json:"file_size"`
+	BlogID    string                 `json:"blog_id,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	Variants  []MediaVariantResponse `json:"variants,omitempty"`
+	SrcSet    string                 `json:"srcset,omitempty"`
+	// Video transcode state and playback URLs, present once the transcoder
+	// has picked up the upload.
+	TranscodeStatus string `json:"transcode_status,omitempty"`
+	HLSPlaybackURL  string `json:"hls_playback_url,omitempty"`
+	DASHPlaybackURL string `json:"dash_playback_url,omitempty"`
+}
+
+// ToMediaResponse converts an entity.Media to a MediaResponse.
+func ToMediaResponse(media *entity.Media) MediaResponse {
+	resp := MediaResponse{
+		ID:        media.ID,
+		FileName:  media.FileName,
+		URL:       media.URL,
+		MimeType:  media.MimeType,
+		FileSize:  media.FileSize,
+		BlogID:    media.BlogID,
+		CreatedAt: media.CreatedAt,
+
+		TranscodeStatus: media.TranscodeStatus,
+		HLSPlaybackURL:  media.HLSPlaybackURL,
+		DASHPlaybackURL: media.DASHPlaybackURL,
+	}
+	srcset := make([]string, 0, len(media.Variants))
+	for _, v := range media.Variants {
+		resp.Variants = append(resp.Variants, MediaVariantResponse{
+			Width:    v.Width,
+			Height:   v.Height,
+			URL:      v.URL,
+			MimeType: v.MimeType,
+			FileSize: v.FileSize,
+		})
+		srcset = append(srcset, fmt.Sprintf("%s %dw", v.URL, v.Width))
+	}
+	resp.SrcSet = strings.Join(srcset, ", ")
+	return resp
+}
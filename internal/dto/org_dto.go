@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// CreateOrganizationRequest defines the structure for creating an organization.
+type CreateOrganizationRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+}
+
+// OrgMemberRequest is the DTO for adding a member or changing their role.
+type OrgMemberRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+	Role   string `json:"role" binding:"required,oneof=owner editor writer"`
+}
+
+// UpdateOrganizationRequest is the DTO for updating org profile and tenant
+// settings (owners only).
+type UpdateOrganizationRequest struct {
+	Description  *string           `json:"description"`
+	CustomDomain *string           `json:"custom_domain"`
+	Theme        map[string]string `json:"theme"`
+}
+
+// OrganizationResponse defines the JSON response for a single organization.
+type OrganizationResponse struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Slug         string            `json:"slug"`
+	Description  string            `json:"description"`
+	CustomDomain string            `json:"custom_domain,omitempty"`
+	Theme        map[string]string `json:"theme,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+// OrgMemberResponse defines one membership entry of an organization.
+type OrgMemberResponse struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrganizationProfileResponse defines the public org profile page.
+type OrganizationProfileResponse struct {
+	OrganizationResponse
+	Members     []OrgMemberResponse `json:"members"`
+	BlogCount   int64               `json:"blog_count"`
+	RecentBlogs []BlogResponse      `json:"recent_blogs"`
+}
+
+// ToOrganizationResponse converts an entity.Organization to an OrganizationResponse.
+func ToOrganizationResponse(org *entity.Organization) OrganizationResponse {
+	return OrganizationResponse{
+		ID:           org.ID,
+		Name:         org.Name,
+		Slug:         org.Slug,
+		Description:  org.Description,
+		CustomDomain: org.CustomDomain,
+		Theme:        org.Theme,
+		CreatedAt:    org.CreatedAt,
+	}
+}
+
+// ToOrganizationProfileResponse converts an entity.OrganizationProfile for the API.
+func ToOrganizationProfileResponse(profile *entity.OrganizationProfile) OrganizationProfileResponse {
+	members := make([]OrgMemberResponse, 0, len(profile.Members))
+	for _, m := range profile.Members {
+		members = append(members, OrgMemberResponse{
+			UserID:    m.UserID,
+			Role:      string(m.Role),
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	blogs := make([]BlogResponse, 0, len(profile.RecentBlogs))
+	for _, b := range profile.RecentBlogs {
+		blogs = append(blogs, ToBlogResponse(b))
+	}
+
+	return OrganizationProfileResponse{
+		OrganizationResponse: ToOrganizationResponse(&profile.Organization),
+		Members:              members,
+		BlogCount:            profile.BlogCount,
+		RecentBlogs:          blogs,
+	}
+}
@@ -0,0 +1,313 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateUserRequest is the DTO for creating a new user.
+type CreateUserRequest struct {
+	Username  string `json:"username" binding:"required,min=3,max=32"`
+	Email     string `json:"email" binding:"required,email"`
+	Password  string `json:"password" binding:"required,min=8,max=32,containsuppercase,containslowercase,containsdigit,containssymbol"`
+	FirstName string `json:"firstname" binding:"required,min=3,max=50"`
+	LastName  string `json:"lastname" binding:"required,min=3,max=50"`
+	// CaptchaToken is required only when CAPTCHA verification is enabled.
+	CaptchaToken string `json:"captcha_token"`
+	// Website is a honeypot: the field is hidden in the UI, so any value here
+	// marks the signup as automated.
+	Website string `json:"website"`
+	// ReferralCode attributes the signup to the referrer whose ?ref= link
+	// the user arrived with.
+	ReferralCode string `json:"referral_code" binding:"omitempty,max=32"`
+}
+
+// BlockDomainRequest is the DTO for adding an email domain to the
+// registration blocklist.
+type BlockDomainRequest struct {
+	Domain string `json:"domain" binding:"required"`
+}
+
+// SetMaintenanceRequest is the DTO for switching maintenance mode.
+// AllEndpoints extends the block from write endpoints to every endpoint.
+type SetMaintenanceRequest struct {
+	Enabled      bool `json:"enabled"`
+	AllEndpoints bool `json:"all_endpoints"`
+}
+
+// SetFeatureFlagRequest is the DTO for creating or updating a feature flag.
+// RolloutPercent defaults to 100 (fully rolled out) when omitted.
+type SetFeatureFlagRequest struct {
+	Enabled        bool `json:"enabled"`
+	RolloutPercent *int `json:"rollout_percent" binding:"omitempty,min=0,max=100"`
+}
+
+// SetExperimentRequest is the DTO for creating or updating an A/B
+// experiment.
+type SetExperimentRequest struct {
+	Active   bool                       `json:"active"`
+	Variants []ExperimentVariantRequest `json:"variants" binding:"required,min=2,dive"`
+}
+
+// ExperimentVariantRequest is one arm of an experiment definition.
+type ExperimentVariantRequest struct {
+	Name   string `json:"name" binding:"required"`
+	Weight int    `json:"weight" binding:"required,min=1"`
+}
+
+// AddProfanityWordRequest is the DTO for adding a word to the profanity
+// wordlist. Locale defaults to the filter's default locale when empty.
+type AddProfanityWordRequest struct {
+	Word     string `json:"word" binding:"required"`
+	Locale   string `json:"locale"`
+	Severity string `json:"severity" binding:"required,oneof=mild severe"`
+}
+
+// LoginRequest is the DTO for user login. ClientType selects the token
+// lifetimes for the session (defaults to web); RememberMe extends the
+// refresh token lifetime.
+type LoginRequest struct {
+	Email      string `json:"email" binding:"required,email"`
+	Password   string `json:"password" binding:"required"`
+	ClientType string `json:"client_type" binding:"omitempty,oneof=web mobile api"`
+	RememberMe bool   `json:"remember_me"`
+}
+
+// RegisterRequest is the DTO for user registration.
+type RegisterRequest struct {
+	Username string `json:"username" binding:"required,min=3,max=32"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8,max=32"`
+}
+
+// UpdateUserRequest is the DTO for updating user profile.
+type UpdateUserRequest struct {
+	Username  *string `json:"username,omitempty" binding:"omitempty,min=3,max=32"`
+	FirstName *string `json:"firstname,omitempty" binding:"omitempty,max=50"`
+	LastName  *string `json:"lastname,omitempty" binding:"omitempty,max=50"`
+	AvatarURL *string `json:"avatar_url,omitempty" binding:"omitempty,url"`
+	// SecurityEmailsOptOut disables security notification emails when true.
+	SecurityEmailsOptOut *bool `json:"security_emails_opt_out,omitempty"`
+	// LeaderboardOptOut hides the user from the top-authors leaderboard when true.
+	LeaderboardOptOut *bool `json:"leaderboard_opt_out,omitempty"`
+	// PushNotificationsOptOut disables push notifications when true.
+	PushNotificationsOptOut *bool `json:"push_notifications_opt_out,omitempty"`
+	// CommentDigest batches comment notifications into a periodic email;
+	// "none" turns the digest off.
+	CommentDigest *string `json:"comment_digest,omitempty" binding:"omitempty,oneof=none hourly daily"`
+	// MatureContentOptIn confirms the user's age preference for mature posts
+	// when true.
+	MatureContentOptIn *bool `json:"mature_content_opt_in,omitempty"`
+	// PerformanceReport subscribes the author to a periodic performance
+	// summary email; "none" turns the report off.
+	PerformanceReport *string `json:"performance_report,omitempty" binding:"omitempty,oneof=none weekly monthly"`
+}
+
+// UpdateUserRoleRequest is the DTO for changing a user's role (admin only).
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin user"`
+}
+
+// ForgotPasswordRequest is the DTO for requesting password reset.
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	// CaptchaToken is required only when CAPTCHA verification is enabled.
+	CaptchaToken string `json:"captcha_token"`
+}
+
+// ResetPasswordRequest is the DTO for resetting password.
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Verifier string `json:"verifier" binding:"required"`
+	Password string `json:"password" binding:"required,min=8,max=32"`
+}
+
+// VerifyEmailRequest is the DTO for verifying email.
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ResendVerificationRequest is the DTO for resending verification email.
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// RefreshTokenRequest is the DTO for refreshing access tokens.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// CreateAPIKeyRequest is the DTO for issuing a personal API key. Scopes
+// default to read-only when omitted.
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	Scopes []string `json:"scopes" binding:"omitempty,dive,oneof=read write"`
+}
+
+// ServiceTokenRequest is the DTO for the client-credentials token exchange.
+type ServiceTokenRequest struct {
+	GrantType    string `json:"grant_type" binding:"required,oneof=client_credentials"`
+	ClientID     string `json:"client_id" binding:"required"`
+	ClientSecret string `json:"client_secret" binding:"required"`
+	// Scope is a space-separated list; when empty all of the client's
+	// granted scopes are issued.
+	Scope string `json:"scope"`
+}
+
+// RegisterServiceClientRequest is the DTO for registering an internal
+// service client (admin only).
+type RegisterServiceClientRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	Scopes []string `json:"scopes" binding:"required,min=1,dive,oneof=search:index analytics:read"`
+}
+
+// SetPopularityWeightsRequest is the DTO for tuning the popularity formula
+// (admin only). A RecencyHalfLifeHours of zero disables the recency decay.
+type SetPopularityWeightsRequest struct {
+	View                 float64 `json:"view"`
+	Like                 float64 `json:"like"`
+	Dislike              float64 `json:"dislike"`
+	Comment              float64 `json:"comment"`
+	RecencyHalfLifeHours float64 `json:"recency_half_life_hours" binding:"omitempty,min=0"`
+}
+
+// RestrictBlogRequest is the DTO for geo-restricting a blog (admin only).
+// Country codes are ISO 3166-1 alpha-2.
+type RestrictBlogRequest struct {
+	CountryCodes []string `json:"country_codes" binding:"required,min=1,max=100,dive,len=2"`
+	Reason       string   `json:"reason" binding:"omitempty,max=500"`
+}
+
+// SubmitTakedownRequest is the DTO for filing a takedown complaint against a
+// post. The endpoint is public, so the complainant identifies themselves by
+// name and email.
+type SubmitTakedownRequest struct {
+	BlogID        string `json:"blog_id" binding:"required"`
+	ReporterName  string `json:"reporter_name" binding:"required,max=200"`
+	ReporterEmail string `json:"reporter_email" binding:"required,email"`
+	Reason        string `json:"reason" binding:"required,max=2000"`
+}
+
+// ReviewTakedownRequest is the DTO for an admin verdict on a takedown
+// request. When accepting, Note becomes the public tombstone reason.
+type ReviewTakedownRequest struct {
+	Accept *bool  `json:"accept" binding:"required"`
+	Note   string `json:"note" binding:"omitempty,max=500"`
+}
+
+// SetRoleLimitsRequest is the DTO for configuring a role's content limits
+// (admin only). A zero value disables that limit for the role.
+type SetRoleLimitsRequest struct {
+	MaxPostsPerDay   int `json:"max_posts_per_day" binding:"min=0"`
+	MaxCommentLength int `json:"max_comment_length" binding:"min=0"`
+	MaxTagsPerPost   int `json:"max_tags_per_post" binding:"min=0"`
+	MaxImagesPerPost int `json:"max_images_per_post" binding:"min=0"`
+}
+
+// ClapRequest is the DTO for clapping on a blog. Count is how many claps to
+// add in this call; a user's total is capped server-side.
+type ClapRequest struct {
+	Count int `json:"count" binding:"required,min=1,max=50"`
+}
+
+// TipRequest is the DTO for tipping the author of a blog. AmountCents is in
+// the currency's smallest unit; Currency defaults to usd.
+type TipRequest struct {
+	AmountCents int64  `json:"amount_cents" binding:"required,min=100"`
+	Currency    string `json:"currency" binding:"omitempty,oneof=usd eur gbp"`
+}
+
+// SubscribeNewsletterRequest is the DTO for subscribing to a newsletter.
+// AuthorID selects an author's newsletter; when empty the subscription is
+// to the site newsletter.
+type SubscribeNewsletterRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	AuthorID string `json:"author_id"`
+}
+
+// CreateSavedSearchRequest is the DTO for saving a search/filter
+// combination to be alerted about. At least one of query or tags must be
+// provided.
+type CreateSavedSearchRequest struct {
+	Name  string   `json:"name" binding:"omitempty,max=100"`
+	Query string   `json:"query" binding:"omitempty,max=200"`
+	Tags  []string `json:"tags" binding:"omitempty,max=10,dive,max=50"`
+}
+
+// RegisterRSSFeedRequest is the DTO for registering an external RSS feed
+// whose new items are imported as draft posts.
+type RegisterRSSFeedRequest struct {
+	URL string `json:"url" binding:"required,url"`
+}
+
+// RegisterDeviceRequest is the DTO for registering a push notification
+// device token.
+type RegisterDeviceRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Platform string `json:"platform" binding:"required,oneof=web android ios"`
+}
+
+// ImportUserRow is one user in a JSON bulk import. PasswordHash carries the
+// pre-hashed password from the source platform, if available.
+type ImportUserRow struct {
+	Email        string `json:"email" binding:"required"`
+	Username     string `json:"username" binding:"omitempty,max=100"`
+	Role         string `json:"role" binding:"omitempty,max=50"`
+	PasswordHash string `json:"password_hash" binding:"omitempty,max=500"`
+}
+
+// ImportUsersRequest is the DTO for the JSON form of the admin bulk user
+// import endpoint.
+type ImportUsersRequest struct {
+	Users []ImportUserRow `json:"users" binding:"required,min=1,dive"`
+}
+
+// CreateDraftCommentRequest is the DTO for attaching an editorial comment
+// to a content range of a draft.
+type CreateDraftCommentRequest struct {
+	Body        string `json:"body" binding:"required,max=2000"`
+	StartOffset *int   `json:"start_offset" binding:"required,min=0"`
+	EndOffset   *int   `json:"end_offset" binding:"required,min=0"`
+	Quote       string `json:"quote" binding:"omitempty,max=500"`
+}
+
+// ResolveDraftCommentRequest is the DTO for resolving or reopening a draft
+// comment.
+type ResolveDraftCommentRequest struct {
+	Resolved *bool `json:"resolved" binding:"required"`
+}
+
+// CreateHighlightRequest is the DTO for a reader highlighting a passage of
+// a post, optionally with a private note.
+type CreateHighlightRequest struct {
+	StartOffset *int   `json:"start_offset" binding:"required,min=0"`
+	EndOffset   *int   `json:"end_offset" binding:"required,min=0"`
+	Text        string `json:"text" binding:"required,max=1000"`
+	Note        string `json:"note" binding:"omitempty,max=2000"`
+}
+
+// AcceptAnswerRequest is the DTO for the author accepting a comment as the
+// answer on a question post.
+type AcceptAnswerRequest struct {
+	CommentID string `json:"comment_id" binding:"required"`
+}
+
+// SetEventInfoRequest is the DTO for attaching or updating the event block
+// on a post.
+type SetEventInfoRequest struct {
+	StartTime time.Time  `json:"start_time" binding:"required"`
+	EndTime   *time.Time `json:"end_time"`
+	Location  string     `json:"location" binding:"omitempty,max=200"`
+	Capacity  int        `json:"capacity" binding:"omitempty,min=0"`
+}
+
+// SubscribeRequest is the DTO for starting a membership subscription
+// checkout.
+type SubscribeRequest struct {
+	Tier string `json:"tier" binding:"required,oneof=member paid"`
+}
+
+// RollbackViewsRequest is the DTO for removing suspicious views from a blog
+// after an anomaly review.
+type RollbackViewsRequest struct {
+	Count int `json:"count" binding:"required,min=1"`
+}
@@ -0,0 +1,303 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// UserResponse is the DTO for a user.
+type UserResponse struct {
+	ID        string  `json:"id"`
+	Username  string  `json:"username"`
+	Email     string  `json:"email"`
+	Role      string  `json:"role"`
+	FirstName *string `json:"first_name"`
+	LastName  *string `json:"last_name"`
+	AvatarURL *string `json:"avatar_url"`
+	// Reputation is the user's gamification point total.
+	Reputation int    `json:"reputation"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// LoginResponse is the DTO for a successful login.
+type LoginResponse struct {
+	User         UserResponse `json:"user"`
+	AccessToken  string       `json:"access_token"`
+	RefreshToken string       `json:"refresh_token"`
+}
+
+// converts an entity.User to a UserResponse DTO.
+func ToUserResponse(user entity.User) UserResponse {
+	return UserResponse{
+		ID:         user.ID,
+		Username:   user.Username,
+		Email:      user.Email,
+		Role:       string(user.Role),
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		AvatarURL:  user.AvatarURL,
+		Reputation: user.Reputation,
+		CreatedAt:  user.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// BadgeResponse is the DTO for one badge a user holds.
+type BadgeResponse struct {
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	AwardedAt time.Time `json:"awarded_at"`
+}
+
+// ToBadgeResponses converts entity.Badges for the API.
+func ToBadgeResponses(badges []*entity.Badge) []BadgeResponse {
+	resp := make([]BadgeResponse, 0, len(badges))
+	for _, badge := range badges {
+		resp = append(resp, BadgeResponse{
+			Slug:      badge.Slug,
+			Name:      badge.Name,
+			AwardedAt: badge.AwardedAt,
+		})
+	}
+	return resp
+}
+
+// ReferralEntryResponse describes one account that signed up with the
+// user's referral code. Converted reports whether the signup verified its
+// email, which is what the referral program rewards.
+type ReferralEntryResponse struct {
+	Username   string    `json:"username"`
+	SignedUpAt time.Time `json:"signed_up_at"`
+	Converted  bool      `json:"converted"`
+}
+
+// ReferralsResponse is the DTO for the user's referral program status.
+type ReferralsResponse struct {
+	ReferralCode string                  `json:"referral_code"`
+	TotalSignups int                     `json:"total_signups"`
+	Conversions  int                     `json:"conversions"`
+	Referrals    []ReferralEntryResponse `json:"referrals"`
+}
+
+// ToReferralsResponse builds the referral program status from the accounts
+// that signed up with the user's code.
+func ToReferralsResponse(referralCode string, referred []*entity.User) ReferralsResponse {
+	resp := ReferralsResponse{
+		ReferralCode: referralCode,
+		TotalSignups: len(referred),
+		Referrals:    make([]ReferralEntryResponse, 0, len(referred)),
+	}
+	for _, user := range referred {
+		if user.IsVerified {
+			resp.Conversions++
+		}
+		resp.Referrals = append(resp.Referrals, ReferralEntryResponse{
+			Username:   user.Username,
+			SignedUpAt: user.CreatedAt,
+			Converted:  user.IsVerified,
+		})
+	}
+	return resp
+}
+
+// SessionResponse describes one active refresh-token session.
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	DeviceName string    `json:"device_name"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// ToSessionResponses converts refresh tokens into session entries, keeping
+// the token hashes out of the response.
+func ToSessionResponses(tokens []*entity.Token) []SessionResponse {
+	sessions := make([]SessionResponse, 0, len(tokens))
+	for _, t := range tokens {
+		sessions = append(sessions, SessionResponse{
+			ID:         t.ID,
+			DeviceName: t.DeviceName,
+			UserAgent:  t.UserAgent,
+			IPAddress:  t.IPAddress,
+			CreatedAt:  t.CreatedAt,
+			LastUsedAt: t.LastUsedAt,
+			ExpiresAt:  t.ExpiresAt,
+		})
+	}
+	return sessions
+}
+
+// APIKeyResponse describes an API key without its secret; only the prefix is
+// kept for display.
+type APIKeyResponse struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Prefix     string    `json:"prefix"`
+	Scopes     []string  `json:"scopes"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at,omitempty"`
+	Revoked    bool      `json:"revoked"`
+}
+
+// CreateAPIKeyResponse carries the plaintext key alongside the record. It is
+// returned once at creation and cannot be retrieved again.
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+func ToAPIKeyResponse(key *entity.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		CreatedAt:  key.CreatedAt,
+		LastUsedAt: key.LastUsedAt,
+		Revoked:    key.Revoked,
+	}
+}
+
+func ToAPIKeyResponses(keys []*entity.APIKey) []APIKeyResponse {
+	responses := make([]APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		responses = append(responses, ToAPIKeyResponse(key))
+	}
+	return responses
+}
+
+// ServiceTokenResponse follows the OAuth2 token response shape.
+type ServiceTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// ServiceClientResponse describes a registered service client without its
+// secret.
+type ServiceClientResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+	Revoked   bool      `json:"revoked"`
+}
+
+// RegisterServiceClientResponse carries the plaintext secret alongside the
+// client. It is returned once at registration and cannot be retrieved again.
+type RegisterServiceClientResponse struct {
+	ServiceClientResponse
+	ClientSecret string `json:"client_secret"`
+}
+
+func ToServiceClientResponse(client *entity.ServiceClient) ServiceClientResponse {
+	return ServiceClientResponse{
+		ID:        client.ID,
+		Name:      client.Name,
+		Scopes:    client.Scopes,
+		CreatedAt: client.CreatedAt,
+		Revoked:   client.Revoked,
+	}
+}
+
+func ToServiceClientResponses(clients []*entity.ServiceClient) []ServiceClientResponse {
+	responses := make([]ServiceClientResponse, 0, len(clients))
+	for _, client := range clients {
+		responses = append(responses, ToServiceClientResponse(client))
+	}
+	return responses
+}
+
+// MessageResponse is a generic response for success/error messages.
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// ErrorResponse is a response for errors.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// FieldError describes a single failed validation rule on a request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// ValidationErrorResponse is the error envelope for request validation
+// failures, carrying one entry per failed field.
+type ValidationErrorResponse struct {
+	Error   string       `json:"error"`
+	Details []FieldError `json:"details"`
+}
+
+// TipResponse is the API representation of a completed tip.
+type TipResponse struct {
+	ID          string     `json:"id"`
+	BlogID      string     `json:"blog_id"`
+	AmountCents int64      `json:"amount_cents"`
+	Currency    string     `json:"currency"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+func ToTipResponse(tip *entity.Tip) TipResponse {
+	return TipResponse{
+		ID:          tip.ID,
+		BlogID:      tip.BlogID,
+		AmountCents: tip.AmountCents,
+		Currency:    tip.Currency,
+		Status:      string(tip.Status),
+		CreatedAt:   tip.CreatedAt,
+		CompletedAt: tip.CompletedAt,
+	}
+}
+
+func ToTipResponses(tips []*entity.Tip) []TipResponse {
+	responses := make([]TipResponse, 0, len(tips))
+	for _, tip := range tips {
+		responses = append(responses, ToTipResponse(tip))
+	}
+	return responses
+}
+
+// NewsletterBroadcastResponse is the API representation of a newsletter
+// broadcast with its delivery stats.
+type NewsletterBroadcastResponse struct {
+	ID          string     `json:"id"`
+	BlogID      string     `json:"blog_id"`
+	Subject     string     `json:"subject"`
+	Status      string     `json:"status"`
+	Recipients  int        `json:"recipients"`
+	Delivered   int        `json:"delivered"`
+	Failed      int        `json:"failed"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+func ToNewsletterBroadcastResponse(broadcast *entity.NewsletterBroadcast) NewsletterBroadcastResponse {
+	return NewsletterBroadcastResponse{
+		ID:          broadcast.ID,
+		BlogID:      broadcast.BlogID,
+		Subject:     broadcast.Subject,
+		Status:      string(broadcast.Status),
+		Recipients:  broadcast.Recipients,
+		Delivered:   broadcast.Delivered,
+		Failed:      broadcast.Failed,
+		CreatedAt:   broadcast.CreatedAt,
+		CompletedAt: broadcast.CompletedAt,
+	}
+}
+
+func ToNewsletterBroadcastResponses(broadcasts []*entity.NewsletterBroadcast) []NewsletterBroadcastResponse {
+	responses := make([]NewsletterBroadcastResponse, 0, len(broadcasts))
+	for _, broadcast := range broadcasts {
+		responses = append(responses, ToNewsletterBroadcastResponse(broadcast))
+	}
+	return responses
+}
@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// MergeTagsRequest is the DTO for merging one tag into another (admin only).
+type MergeTagsRequest struct {
+	Into string `json:"into" binding:"required"`
+}
+
+// TagResponse defines the JSON response for a single tag.
+type TagResponse struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Slug        string   `json:"slug"`
+	Description string   `json:"description"`
+	Aliases     []string `json:"aliases,omitempty"`
+}
+
+// ToTagResponse converts an entity.Tag to a TagResponse.
+func ToTagResponse(tag *entity.Tag) TagResponse {
+	return TagResponse{
+		ID:          tag.ID,
+		Name:        tag.Name,
+		Slug:        tag.Slug,
+		Description: tag.Description,
+		Aliases:     tag.Aliases,
+	}
+}
+
+// TagSuggestionResponse defines one entry of the auto-complete response.
+type TagSuggestionResponse struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	UsageCount int64  `json:"usage_count"`
+}
+
+// ToTagSuggestionResponses converts entity.TagSuggestions for the API.
+func ToTagSuggestionResponses(suggestions []*entity.TagSuggestion) []TagSuggestionResponse {
+	resp := make([]TagSuggestionResponse, 0, len(suggestions))
+	for _, s := range suggestions {
+		resp = append(resp, TagSuggestionResponse{
+			ID:         s.ID,
+			Name:       s.Name,
+			Slug:       s.Slug,
+			UsageCount: s.UsageCount,
+		})
+	}
+	return resp
+}
+
+// TagDetailResponse defines the JSON response for the public tag page.
+type TagDetailResponse struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Slug          string         `json:"slug"`
+	Description   string         `json:"description"`
+	BlogCount     int64          `json:"blog_count"`
+	FollowerCount int64          `json:"follower_count"`
+	IsFollowing   bool           `json:"is_following"`
+	TrendingBlogs []BlogResponse `json:"trending_blogs"`
+}
+
+// ToTagDetailResponse converts an entity.TagDetail to a TagDetailResponse.
+func ToTagDetailResponse(detail *entity.TagDetail) TagDetailResponse {
+	trending := make([]BlogResponse, 0, len(detail.TrendingBlogs))
+	for _, blog := range detail.TrendingBlogs {
+		trending = append(trending, ToBlogResponse(blog))
+	}
+
+	return TagDetailResponse{
+		ID:            detail.Tag.ID,
+		Name:          detail.Tag.Name,
+		Slug:          detail.Tag.Slug,
+		Description:   detail.Tag.Description,
+		BlogCount:     detail.BlogCount,
+		FollowerCount: detail.FollowerCount,
+		IsFollowing:   detail.IsFollowing,
+		TrendingBlogs: trending,
+	}
+}
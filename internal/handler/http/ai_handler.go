@@ -3,6 +3,7 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
@@ -27,6 +28,40 @@ type SuggestAndModifyRequest struct {
 	Keywords string `json:"keywords" binding:"required"`
 	Blog     string `json:"blog" binding:"required"`
 }
+type OutlineRequest struct {
+	Topic string `json:"topic" binding:"required"`
+}
+type ExpandSectionRequest struct {
+	Section string `json:"section" binding:"required"`
+	Draft   string `json:"draft"`
+}
+type RewriteToneRequest struct {
+	Content string `json:"content" binding:"required"`
+	Tone    string `json:"tone" binding:"required"`
+}
+
+// assistantUserID extracts the requesting user's ID for quota accounting.
+func assistantUserID(ctx *gin.Context) string {
+	if v, exists := ctx.Get("userID"); exists {
+		if userID, ok := v.(string); ok {
+			return userID
+		}
+	}
+	return ""
+}
+
+// assistantError maps assistant usecase failures onto status codes: quota
+// exhaustion and bad input are the caller's problem, the rest is ours.
+func assistantError(ctx *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "quota exceeded"):
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+	case strings.Contains(err.Error(), "unsupported tone"):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to generate content: %v", err)})
+	}
+}
 
 // implement the handlebloggeneration
 func (h *AIHandler) HandleBlogContentGeneration(ctx *gin.Context) {
@@ -36,9 +71,9 @@ func (h *AIHandler) HandleBlogContentGeneration(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to read the generate request: %v", err)})
 		return
 	}
-	generatedBlog, err := h.AIUseCase.GenerateBlogContent(requestCtx, req.Keywords)
+	generatedBlog, err := h.AIUseCase.GenerateBlogContent(requestCtx, assistantUserID(ctx), req.Keywords)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to generate blog content: %v", err)})
+		assistantError(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, gin.H{"message": "successfully generated blog\n" + generatedBlog})
@@ -53,10 +88,79 @@ func (h *AIHandler) HandleSuggestAndModifyContent(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to read the generate request: %v", err)})
 		return
 	}
-	generatedBlog, err := h.AIUseCase.SuggestAndModifyContent(requestCtx, req.Keywords, req.Blog)
+	generatedBlog, err := h.AIUseCase.SuggestAndModifyContent(requestCtx, assistantUserID(ctx), req.Keywords, req.Blog)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to generate blog content: %v", err)})
+		assistantError(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, gin.H{"message": "successfully generated blog\n" + generatedBlog})
 }
+
+// HandleOutline drafts a post outline from a topic.
+func (h *AIHandler) HandleOutline(ctx *gin.Context) {
+	var req OutlineRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to read the outline request: %v", err)})
+		return
+	}
+	outline, err := h.AIUseCase.OutlineBlog(ctx.Request.Context(), assistantUserID(ctx), req.Topic)
+	if err != nil {
+		assistantError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"outline": outline})
+}
+
+// HandleExpandSection writes out one section of a draft.
+func (h *AIHandler) HandleExpandSection(ctx *gin.Context) {
+	var req ExpandSectionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to read the expand request: %v", err)})
+		return
+	}
+	expanded, err := h.AIUseCase.ExpandSection(ctx.Request.Context(), assistantUserID(ctx), req.Section, req.Draft)
+	if err != nil {
+		assistantError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"section": expanded})
+}
+
+// HandleMyAIUsage reports the caller's AI consumption and quotas.
+func (h *AIHandler) HandleMyAIUsage(ctx *gin.Context) {
+	userID := assistantUserID(ctx)
+	if userID == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	daily, monthly, dailyQuota, monthlyQuota, err := h.AIUseCase.GetMyUsage(ctx.Request.Context(), userID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not enabled") {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "AI usage tracking is not enabled"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve AI usage"})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"daily":         daily,
+		"monthly":       monthly,
+		"daily_quota":   dailyQuota,
+		"monthly_quota": monthlyQuota,
+	})
+}
+
+// HandleRewriteTone rewrites content in the requested tone.
+func (h *AIHandler) HandleRewriteTone(ctx *gin.Context) {
+	var req RewriteToneRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to read the rewrite request: %v", err)})
+		return
+	}
+	rewritten, err := h.AIUseCase.RewriteTone(ctx.Request.Context(), assistantUserID(ctx), req.Content, req.Tone)
+	if err != nil {
+		assistantError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"content": rewritten})
+}
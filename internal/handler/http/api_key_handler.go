@@ -0,0 +1,87 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// APIKeyHandler manages personal API keys for programmatic access.
+type APIKeyHandler struct {
+	apiKeyUseCase usecasecontract.IAPIKeyUseCase
+}
+
+func NewAPIKeyHandler(apiKeyUseCase usecasecontract.IAPIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUseCase: apiKeyUseCase,
+	}
+}
+
+// CreateAPIKeyHandler issues a new key and returns its plaintext once.
+func (h *APIKeyHandler) CreateAPIKeyHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	key, rawKey, err := h.apiKeyUseCase.CreateAPIKey(c.Request.Context(), userID.(string), req.Name, req.Scopes)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to create API key")
+		return
+	}
+
+	SuccessHandler(c, http.StatusCreated, dto.CreateAPIKeyResponse{
+		APIKeyResponse: dto.ToAPIKeyResponse(key),
+		Key:            rawKey,
+	})
+}
+
+// ListAPIKeysHandler lists the user's keys, newest first. Secrets are never
+// returned; only the display prefix is included.
+func (h *APIKeyHandler) ListAPIKeysHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	keys, err := h.apiKeyUseCase.ListAPIKeys(c.Request.Context(), userID.(string))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve API keys")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"api_keys": dto.ToAPIKeyResponses(keys)})
+}
+
+// RevokeAPIKeyHandler revokes one of the user's keys.
+func (h *APIKeyHandler) RevokeAPIKeyHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	keyID := c.Param("id")
+	if err := h.apiKeyUseCase.RevokeAPIKey(c.Request.Context(), userID.(string), keyID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "API key not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to revoke API key")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "API key revoked successfully")
+}
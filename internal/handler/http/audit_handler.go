@@ -0,0 +1,25 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
+)
+
+// AuditLogHandler serves the captured request/response ring buffer for
+// debugging production incidents.
+type AuditLogHandler struct {
+	log *middleware.AuditLog
+}
+
+func NewAuditLogHandler(log *middleware.AuditLog) *AuditLogHandler {
+	return &AuditLogHandler{log: log}
+}
+
+// ListEntriesHandler returns the captured exchanges, newest first (admin
+// only). Bodies were redacted before storage, so nothing sensitive leaves
+// this endpoint.
+func (h *AuditLogHandler) ListEntriesHandler(c *gin.Context) {
+	SuccessHandler(c, http.StatusOK, gin.H{"entries": h.log.Entries()})
+}
@@ -1,15 +1,19 @@
 package http
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/resilience"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
@@ -18,12 +22,16 @@ import (
 type AuthHandler struct {
 	UserUseCase usecasecontract.IUserUseCase
 	BaseURL     string
+	// userInfoPolicy shields the login flow from a slow or failing Google
+	// user-info endpoint.
+	userInfoPolicy *resilience.Policy
 }
 
 func NewAuthHandler(uc usecasecontract.IUserUseCase, baseURL string) *AuthHandler {
 	return &AuthHandler{
-		UserUseCase: uc,
-		BaseURL:     baseURL,
+		UserUseCase:    uc,
+		BaseURL:        baseURL,
+		userInfoPolicy: resilience.NewPolicy("oauth_userinfo", 10*time.Second, 2, 5, 30*time.Second),
 	}
 }
 
@@ -76,21 +84,28 @@ func (h *AuthHandler) HandleGoogleCallback(ctx *gin.Context) {
 		return
 	}
 
-	client := h.googleOauthConfig().Client(requestCtx, token)
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	var userInfo UserInfo
+	err = h.userInfoPolicy.Execute(requestCtx, func(callCtx context.Context) error {
+		req, err := http.NewRequestWithContext(callCtx, http.MethodGet, "https://www.googleapis.com/oauth2/v2/userinfo", nil)
+		if err != nil {
+			return err
+		}
+		resp, err := h.googleOauthConfig().Client(callCtx, token).Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		return json.NewDecoder(resp.Body).Decode(&userInfo)
+	})
 	if err != nil {
+		if errors.Is(err, resilience.ErrCircuitOpen) {
+			ctx.String(http.StatusServiceUnavailable, "Google sign-in is temporarily unavailable, please try again shortly\n")
+			return
+		}
 		ctx.String(http.StatusInternalServerError, fmt.Sprintf("Failed to get user info: %v", err))
 		return
 	}
 
-	defer resp.Body.Close()
-
-	var userInfo UserInfo
-
-	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		ctx.String(http.StatusInternalServerError, fmt.Sprintf("Failed to decode user info: %v\n", err))
-	}
-
 	nameParts := strings.Split(userInfo.Name, " ")
 	var fName, lName string
 	if len(nameParts) >= 1 {
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+)
+
+// BlocklistHandler exposes the admin API for the email domain blocklist.
+type BlocklistHandler struct {
+	blocklist contract.IEmailBlocklist
+}
+
+func NewBlocklistHandler(blocklist contract.IEmailBlocklist) *BlocklistHandler {
+	return &BlocklistHandler{
+		blocklist: blocklist,
+	}
+}
+
+// ListBlockedDomainsHandler returns the admin-added blocklist entries.
+func (h *BlocklistHandler) ListBlockedDomainsHandler(c *gin.Context) {
+	domains, err := h.blocklist.ListDomains(c.Request.Context())
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list blocked domains")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, gin.H{"domains": domains})
+}
+
+// AddBlockedDomainHandler adds an email domain to the registration blocklist.
+func (h *BlocklistHandler) AddBlockedDomainHandler(c *gin.Context) {
+	var req dto.BlockDomainRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	if err := h.blocklist.AddDomain(c.Request.Context(), req.Domain); err != nil {
+		switch {
+		case strings.Contains(err.Error(), "invalid domain"):
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		case strings.Contains(err.Error(), "already blocked"):
+			ErrorHandler(c, http.StatusConflict, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to block domain")
+		}
+		return
+	}
+
+	MessageHandler(c, http.StatusCreated, "Domain blocked successfully")
+}
+
+// RemoveBlockedDomainHandler removes an admin-added blocklist entry.
+func (h *BlocklistHandler) RemoveBlockedDomainHandler(c *gin.Context) {
+	err := h.blocklist.RemoveDomain(c.Request.Context(), c.Param("domain"))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to unblock domain")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Domain unblocked successfully")
+}
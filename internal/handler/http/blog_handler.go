@@ -8,7 +8,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
-	"github.com/mikiasgoitom/Articulate/internal/handler/http/dto"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
 	"github.com/mikiasgoitom/Articulate/internal/usecase"
 )
 
@@ -20,8 +21,13 @@ type BlogHandlerInterface interface {
 	UpdateBlogHandler(*gin.Context)
 	DeleteBlogHandler(*gin.Context)
 	TrackBlogViewHandler(*gin.Context)
+	ConfirmBlogViewHandler(*gin.Context)
+	GetBlogViewGeoHandler(*gin.Context)
 	SearchAndFilterBlogsHandler(*gin.Context)
 	GetPopularBlogsHandler(*gin.Context)
+	SubmitBlogForReviewHandler(*gin.Context)
+	ReviewBlogHandler(*gin.Context)
+	GetReviewNotesHandler(*gin.Context)
 }
 
 // Ensure BlogHandler implements BlogHandlerInterface
@@ -29,14 +35,27 @@ var _ BlogHandlerInterface = (*BlogHandler)(nil)
 
 type BlogHandler struct {
 	blogUsecase usecase.IBlogUseCase
+	// baseURL is the public site URL, used to build canonical page URLs in
+	// the meta endpoint.
+	baseURL string
 }
 
-func NewBlogHandler(blogUsecase usecase.IBlogUseCase) *BlogHandler {
+func NewBlogHandler(blogUsecase usecase.IBlogUseCase, baseURL string) *BlogHandler {
 	return &BlogHandler{
 		blogUsecase: blogUsecase,
+		baseURL:     baseURL,
 	}
 }
 
+// tenantOrgID returns the owning org ID to scope queries by when the request
+// came in on a tenant's custom domain, or nil for the main site.
+func tenantOrgID(c *gin.Context) *string {
+	if org, ok := middleware.TenantFromContext(c); ok {
+		return &org.ID
+	}
+	return nil
+}
+
 // CreateBlogHandler
 func (h *BlogHandler) CreateBlogHandler(cxt *gin.Context) {
 	var req dto.CreateBlogRequest
@@ -60,7 +79,7 @@ func (h *BlogHandler) CreateBlogHandler(cxt *gin.Context) {
 		return
 	}
 
-	_, err := h.blogUsecase.CreateBlog(cxt.Request.Context(), req.Title, req.Content, authorID, req.Slug, entity.BlogStatus(req.Status), req.FeaturedImageID, req.Tags)
+	_, err := h.blogUsecase.CreateBlog(cxt.Request.Context(), req.Title, req.Content, authorID, req.Slug, entity.BlogStatus(req.Status), req.FeaturedImageID, req.Tags, req.OrgID, req.Language, req.CanonicalURL, req.OriginalSource, req.ContentRating, req.Type, req.AccessLevel)
 
 	if err != nil {
 		// Map known validation/moderation errors to 400
@@ -68,6 +87,10 @@ func (h *BlogHandler) CreateBlogHandler(cxt *gin.Context) {
 			ErrorHandler(cxt, http.StatusBadRequest, "Content contains inappropriate material")
 			return
 		}
+		if strings.Contains(err.Error(), "canonical URL") {
+			ErrorHandler(cxt, http.StatusBadRequest, err.Error())
+			return
+		}
 		ErrorHandler(cxt, http.StatusInternalServerError, "Failed to create blog")
 		return
 	}
@@ -123,8 +146,25 @@ func (h *BlogHandler) GetBlogsHandler(cxt *gin.Context) {
 		dateTo = &parsedTime
 	}
 
+	// 4. get the language filter
+	var language *string
+	if v := cxt.Query("lang"); v != "" {
+		language = &v
+	}
+
+	// 5. scope the listing to the tenant when serving a custom domain
+	orgID := tenantOrgID(cxt)
+
+	// 6. get the viewer if authenticated, for paywall checks
+	var viewerID *string
+	if v, exists := cxt.Get("userID"); exists {
+		if uid, ok := v.(string); ok {
+			viewerID = &uid
+		}
+	}
+
 	// call the usecase
-	blogs, totalCount, currentPage, totalPages, err := h.blogUsecase.GetBlogs(cxt.Request.Context(), page, pageSize, sortBy, sortOrder, dateFrom, dateTo)
+	blogs, totalCount, currentPage, totalPages, err := h.blogUsecase.GetBlogs(cxt.Request.Context(), page, pageSize, sortBy, sortOrder, dateFrom, dateTo, orgID, language, viewerID)
 	if err != nil {
 		ErrorHandler(cxt, http.StatusInternalServerError, "Failed to get blog posts")
 		return
@@ -148,15 +188,82 @@ func (h *BlogHandler) GetBlogsHandler(cxt *gin.Context) {
 // GetBlogDetailHandler
 func (h *BlogHandler) GetBlogDetailHandler(cxt *gin.Context) {
 	slug := cxt.Param("slug")
-	blog, err := h.blogUsecase.GetBlogDetail(cxt.Request.Context(), slug)
+
+	// Get the viewer if authenticated, for paywall checks
+	var viewerID *string
+	if v, exists := cxt.Get("userID"); exists {
+		if uid, ok := v.(string); ok {
+			viewerID = &uid
+		}
+	}
+
+	blog, err := h.blogUsecase.GetBlogDetail(cxt.Request.Context(), slug, viewerID)
 	if err != nil {
+		// Taken-down posts get a tombstone page with the takedown reason.
+		if strings.Contains(err.Error(), "taken down") {
+			cxt.JSON(http.StatusGone, gin.H{
+				"error":  "This post has been removed.",
+				"reason": strings.TrimPrefix(err.Error(), "blog taken down: "),
+			})
+			return
+		}
 		ErrorHandler(cxt, http.StatusNotFound, "Blog not found")
 		return
 	}
 
+	// Mature posts are age-gated: only the author and users who confirmed
+	// their age preference may view them.
+	if blog.ContentRating == entity.ContentRatingMature {
+		viewerID := ""
+		if v, exists := cxt.Get("userID"); exists {
+			viewerID, _ = v.(string)
+		}
+		if viewerID != blog.AuthorID && !h.blogUsecase.CanViewMatureContent(cxt.Request.Context(), viewerID) {
+			ErrorHandler(cxt, http.StatusForbidden, "This post is age-restricted. Confirm your age preference in your account settings to view mature content")
+			return
+		}
+	}
+
+	if blog.Language != "" {
+		cxt.Header("Content-Language", blog.Language)
+	}
 	SuccessHandler(cxt, http.StatusOK, dto.ToBlogResponse(&blog))
 }
 
+// GetBlogMetaHandler returns the meta/OpenGraph data for a blog page, with
+// canonical-URL attribution for syndicated content.
+func (h *BlogHandler) GetBlogMetaHandler(cxt *gin.Context) {
+	slug := cxt.Param("slug")
+	blog, authorName, err := h.blogUsecase.GetBlogMeta(cxt.Request.Context(), slug)
+	if err != nil {
+		ErrorHandler(cxt, http.StatusNotFound, "Blog not found")
+		return
+	}
+
+	// Meta data only changes on edit, so let SSR clients and unfurlers cache
+	// it aggressively.
+	cxt.Header("Cache-Control", "public, max-age=3600")
+	SuccessHandler(cxt, http.StatusOK, dto.ToBlogMetaResponse(blog, authorName, h.baseURL))
+}
+
+// GetOGImageHandler serves the social share image of a published blog as a
+// PNG, rendered on demand.
+func (h *BlogHandler) GetOGImageHandler(cxt *gin.Context) {
+	slug := cxt.Param("slug")
+	data, err := h.blogUsecase.RenderShareImage(cxt.Request.Context(), slug)
+	if err != nil {
+		if strings.Contains(err.Error(), "not enabled") {
+			ErrorHandler(cxt, http.StatusNotFound, "Share images are not enabled")
+			return
+		}
+		ErrorHandler(cxt, http.StatusNotFound, "Blog not found")
+		return
+	}
+
+	cxt.Header("Cache-Control", "public, max-age=3600")
+	cxt.Data(http.StatusOK, "image/png", data)
+}
+
 // UpdateBlogHandler
 func (h *BlogHandler) UpdateBlogHandler(cxt *gin.Context) {
 	userIDAny, exists := cxt.Get("userID")
@@ -183,7 +290,7 @@ func (h *BlogHandler) UpdateBlogHandler(cxt *gin.Context) {
 		s := entity.BlogStatus(*req.Status)
 		statusPtr = &s
 	}
-	blog, err := h.blogUsecase.UpdateBlog(cxt.Request.Context(), blogID, userID, req.Title, req.Content, statusPtr, req.FeaturedImageID)
+	blog, err := h.blogUsecase.UpdateBlog(cxt.Request.Context(), blogID, userID, req.Title, req.Content, statusPtr, req.FeaturedImageID, req.Language, req.CanonicalURL, req.OriginalSource, req.ContentRating, req.AccessLevel)
 
 	if err != nil {
 		ErrorHandler(cxt, http.StatusInternalServerError, "Failed to update blog")
@@ -238,12 +345,15 @@ func (h *BlogHandler) TrackBlogViewHandler(c *gin.Context) {
 	blogID := c.Param("blogID")
 	ipAddress := c.ClientIP()
 	userAgent := c.Request.UserAgent()
+	// Client-computed device fingerprint; absent for clients that don't
+	// send one, which just skips the device rotation check.
+	fingerprint := c.GetHeader("X-Device-Fingerprint")
 
 	// User can be anonymous, so we don't fail if userID is not present.
 	userIDAny, _ := c.Get("userID")
 	userID, _ := userIDAny.(string)
 
-	err := h.blogUsecase.TrackBlogView(c.Request.Context(), blogID, userID, ipAddress, userAgent)
+	err := h.blogUsecase.TrackBlogView(c.Request.Context(), blogID, userID, ipAddress, userAgent, fingerprint)
 	if err != nil {
 		errMsg := err.Error()
 		switch {
@@ -256,20 +366,343 @@ func (h *BlogHandler) TrackBlogViewHandler(c *gin.Context) {
 		case errMsg == "exceeded IP rotation limit: too many IPs used by this user recently":
 			ErrorHandler(c, 429, "Exceeded IP rotation limit")
 			return
+		case errMsg == "exceeded device rotation limit: too many devices used by this user recently":
+			ErrorHandler(c, 429, "Exceeded device rotation limit")
+			return
 		default:
 			ErrorHandler(c, http.StatusInternalServerError, "Failed to process blog view")
 			return
 		}
 	}
 
+	SuccessHandler(c, http.StatusOK, "view pending confirmation")
+}
+
+// ConfirmBlogViewHandler handles the client's dwell-time heartbeat; the view
+// registered by TrackBlogViewHandler only counts once this confirms it.
+func (h *BlogHandler) ConfirmBlogViewHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	ipAddress := c.ClientIP()
+
+	userIDAny, _ := c.Get("userID")
+	userID, _ := userIDAny.(string)
+
+	err := h.blogUsecase.ConfirmBlogView(c.Request.Context(), blogID, userID, ipAddress)
+	if err != nil {
+		switch err.Error() {
+		case "no pending view":
+			ErrorHandler(c, http.StatusNotFound, "No pending view to confirm")
+			return
+		case "view heartbeat arrived too early":
+			ErrorHandler(c, http.StatusBadRequest, "View heartbeat arrived too early")
+			return
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to confirm blog view")
+			return
+		}
+	}
+
 	SuccessHandler(c, http.StatusOK, "view tracked successfully")
 }
 
+// ListViewAnomaliesHandler returns the blogs with the highest view-fraud
+// scores for review (admin only)
+func (h *BlogHandler) ListViewAnomaliesHandler(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	anomalies, err := h.blogUsecase.ListViewAnomalies(c.Request.Context(), limit)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list view anomalies")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"anomalies": anomalies})
+}
+
+// RollbackBlogViewsHandler removes suspicious views from a blog after an
+// anomaly review (admin only)
+func (h *BlogHandler) RollbackBlogViewsHandler(c *gin.Context) {
+	var req dto.RollbackViewsRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	blogID := c.Param("blogID")
+	if err := h.blogUsecase.RollbackBlogViews(c.Request.Context(), blogID, req.Count); err != nil {
+		if err.Error() == "blog not found" {
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+			return
+		}
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Views rolled back successfully")
+}
+
+// GetBlogViewGeoHandler returns the per-country view breakdown for a blog (author or admin only)
+func (h *BlogHandler) GetBlogViewGeoHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User Unauthorized")
+		return
+	}
+
+	var isAdmin bool
+	if userRole, exists := c.Get("userRole"); exists {
+		if roleEnum, ok := userRole.(entity.UserRole); ok {
+			isAdmin = roleEnum == entity.UserRoleAdmin
+		} else if role, ok := userRole.(string); ok {
+			isAdmin = role == "admin"
+		}
+	}
+
+	stats, err := h.blogUsecase.GetBlogViewGeoBreakdown(c.Request.Context(), blogID, userID.(string), isAdmin)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "unauthorized") {
+			ErrorHandler(c, http.StatusForbidden, "Only the author can view blog analytics")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve view breakdown")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, stats)
+}
+
+// GetBlogViewGeoInternalHandler serves the view breakdown to internal
+// services authenticated with a machine token; scope checks happen in the
+// middleware, so the ownership check is skipped.
+func (h *BlogHandler) GetBlogViewGeoInternalHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	stats, err := h.blogUsecase.GetBlogViewGeoBreakdown(c.Request.Context(), blogID, "", true)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve view breakdown")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, stats)
+}
+
+// SubmitBlogForReviewHandler lets a writer submit their draft to the
+// editorial review queue
+func (h *BlogHandler) SubmitBlogForReviewHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	blog, err := h.blogUsecase.SubmitBlogForReview(c.Request.Context(), blogID, userID.(string))
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+		case strings.HasPrefix(err.Error(), "unauthorized"):
+			ErrorHandler(c, http.StatusForbidden, "Only the author can submit this blog for review")
+		case strings.Contains(err.Error(), "only drafts"):
+			ErrorHandler(c, http.StatusConflict, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to submit blog for review")
+		}
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToBlogResponse(blog))
+}
+
+// ReviewBlogHandler records an editor's verdict on a submitted blog
+// (approve or request changes with an inline note)
+func (h *BlogHandler) ReviewBlogHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+
+	var req dto.ReviewBlogRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	reviewerID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	approve := req.Action == "approve"
+	blog, err := h.blogUsecase.ReviewBlog(c.Request.Context(), blogID, reviewerID.(string), approve, req.Note)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+		case strings.Contains(err.Error(), "not in review"):
+			ErrorHandler(c, http.StatusConflict, err.Error())
+		case strings.Contains(err.Error(), "note is required"):
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to review blog")
+		}
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToBlogResponse(blog))
+}
+
+// GetReviewNotesHandler returns the review notes for a blog (author or admin only)
+func (h *BlogHandler) GetReviewNotesHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var isAdmin bool
+	if userRole, exists := c.Get("userRole"); exists {
+		if roleEnum, ok := userRole.(entity.UserRole); ok {
+			isAdmin = roleEnum == entity.UserRoleAdmin
+		} else if role, ok := userRole.(string); ok {
+			isAdmin = role == "admin"
+		}
+	}
+
+	notes, err := h.blogUsecase.GetReviewNotes(c.Request.Context(), blogID, userID.(string), isAdmin)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+		case strings.HasPrefix(err.Error(), "unauthorized"):
+			ErrorHandler(c, http.StatusForbidden, "Only the author or an admin can read review notes")
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve review notes")
+		}
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToReviewNoteResponses(notes))
+}
+
+// acceptedAnswerError maps SetAcceptedAnswer errors onto HTTP responses.
+func acceptedAnswerError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not enabled"):
+		ErrorHandler(c, http.StatusNotFound, "Accepted answers are not enabled")
+	case strings.Contains(err.Error(), "not found"):
+		ErrorHandler(c, http.StatusNotFound, err.Error())
+	case strings.HasPrefix(err.Error(), "unauthorized"):
+		ErrorHandler(c, http.StatusForbidden, "Only the author can accept an answer")
+	case strings.Contains(err.Error(), "only question posts"):
+		ErrorHandler(c, http.StatusConflict, "Only question posts can have an accepted answer")
+	default:
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to update accepted answer")
+	}
+}
+
+// AcceptAnswerHandler records the author's accepted answer on a question
+// post.
+func (h *BlogHandler) AcceptAnswerHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.AcceptAnswerRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	blog, err := h.blogUsecase.SetAcceptedAnswer(c.Request.Context(), c.Param("blogID"), userID.(string), req.CommentID)
+	if err != nil {
+		acceptedAnswerError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToBlogResponse(blog))
+}
+
+// ClearAcceptedAnswerHandler removes the accepted answer from a question
+// post.
+func (h *BlogHandler) ClearAcceptedAnswerHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	blog, err := h.blogUsecase.SetAcceptedAnswer(c.Request.Context(), c.Param("blogID"), userID.(string), "")
+	if err != nil {
+		acceptedAnswerError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToBlogResponse(blog))
+}
+
+// ListBlogRevisionsHandler returns the revision history of a post, newest
+// first, for the author or an org editor.
+func (h *BlogHandler) ListBlogRevisionsHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	revisions, err := h.blogUsecase.ListBlogRevisions(c.Request.Context(), c.Param("blogID"), userID.(string))
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not enabled"):
+			ErrorHandler(c, http.StatusNotFound, "Revision history is not enabled")
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+		case strings.HasPrefix(err.Error(), "unauthorized"):
+			ErrorHandler(c, http.StatusForbidden, "Only the author can view revisions")
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve revisions")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"revisions": revisions})
+}
+
+// GetBlogRevisionDiffHandler compares two revisions of a post and returns a
+// unified diff of the content plus the title change, so authors and editors
+// can review exactly what changed.
+func (h *BlogHandler) GetBlogRevisionDiffHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	from, errA := strconv.Atoi(c.Param("a"))
+	to, errB := strconv.Atoi(c.Param("b"))
+	if errA != nil || errB != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Revision numbers must be integers")
+		return
+	}
+
+	diff, err := h.blogUsecase.GetBlogRevisionDiff(c.Request.Context(), c.Param("blogID"), userID.(string), from, to)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not enabled"):
+			ErrorHandler(c, http.StatusNotFound, "Revision history is not enabled")
+		case strings.Contains(err.Error(), "must be positive"):
+			ErrorHandler(c, http.StatusBadRequest, "Revision numbers must be positive")
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, err.Error())
+		case strings.HasPrefix(err.Error(), "unauthorized"):
+			ErrorHandler(c, http.StatusForbidden, "Only the author can view revisions")
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to compute revision diff")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusOK, diff)
+}
+
 // SearchAndFilterBlogsHandler handles searching and filtering blogs
 func (h *BlogHandler) SearchAndFilterBlogsHandler(c *gin.Context) {
 	// Query and filter params
 	query := c.Query("q")
 	tags := c.QueryArray("tags")
+	// tags matches any of the tags; tags_all requires all of them
+	tagsAll := c.QueryArray("tags_all")
 	var dateFrom, dateTo *time.Time
 	if v := c.Query("dateFrom"); v != "" {
 		if t, err := time.Parse(time.RFC3339, v); err == nil {
@@ -303,17 +736,60 @@ func (h *BlogHandler) SearchAndFilterBlogsHandler(c *gin.Context) {
 			maxLikes = &n
 		}
 	}
-	// Author filter
+	// Reading time range (in minutes)
+	var minReadingTime, maxReadingTime *int
+	if v := c.Query("minReadingTime"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			minReadingTime = &n
+		}
+	}
+	if v := c.Query("maxReadingTime"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			maxReadingTime = &n
+		}
+	}
+	// Language and publication status filters ("lang" is the short alias)
+	var language *string
+	if v := c.Query("language"); v != "" {
+		language = &v
+	} else if v := c.Query("lang"); v != "" {
+		language = &v
+	}
+	var status *string
+	if v := c.Query("status"); v != "" {
+		status = &v
+	}
+	// Author filter (by ID or by username)
 	var authorID *string
 	if v := c.Query("authorID"); v != "" {
 		authorID = &v
 	}
+	var authorUsername *string
+	if v := c.Query("authorUsername"); v != "" {
+		authorUsername = &v
+	}
+	// Tenant scoping (custom-domain publications)
+	orgID := tenantOrgID(c)
+	// Sorting
+	sortBy := c.Query("sortBy")
+	sortOrder := c.DefaultQuery("sortOrder", "desc")
 	// Pagination
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	// Viewer (if authenticated), for paywall checks
+	var viewerID *string
+	if v, exists := c.Get("userID"); exists {
+		if uid, ok := v.(string); ok {
+			viewerID = &uid
+		}
+	}
 	// Call usecase
-	blogs, total, current, pages, err := h.blogUsecase.SearchAndFilterBlogs(c.Request.Context(), query, tags, dateFrom, dateTo, minViews, maxViews, minLikes, maxLikes, authorID, page, pageSize)
+	blogs, total, current, pages, err := h.blogUsecase.SearchAndFilterBlogs(c.Request.Context(), query, tags, tagsAll, dateFrom, dateTo, minViews, maxViews, minLikes, maxLikes, minReadingTime, maxReadingTime, authorID, authorUsername, orgID, language, status, sortBy, sortOrder, page, pageSize, viewerID)
 	if err != nil {
+		if strings.Contains(err.Error(), "status filter") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		ErrorHandler(c, http.StatusInternalServerError, "Failed to search and filter blogs")
 		return
 	}
@@ -326,11 +802,131 @@ func (h *BlogHandler) SearchAndFilterBlogsHandler(c *gin.Context) {
 	SuccessHandler(c, http.StatusOK, result)
 }
 
+// SuggestBlogsHandler handles the search type-ahead: title prefix matches
+// plus the most popular search queries.
+func (h *BlogHandler) SuggestBlogsHandler(c *gin.Context) {
+	suggestions, popularQueries, err := h.blogUsecase.SuggestBlogs(c.Request.Context(), c.Query("q"))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to suggest blogs")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToBlogSuggestResponse(suggestions, popularQueries))
+}
+
+// RecordSearchClickHandler records a click on a search result for the search
+// analytics. The user ID is attached when the reader is logged in.
+func (h *BlogHandler) RecordSearchClickHandler(c *gin.Context) {
+	var req dto.SearchClickRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID := ""
+	if userIDAny, exists := c.Get("userID"); exists {
+		if id, ok := userIDAny.(string); ok {
+			userID = id
+		}
+	}
+
+	if err := h.blogUsecase.RecordSearchClick(c.Request.Context(), req.Query, req.BlogID, userID); err != nil {
+		if strings.Contains(err.Error(), "required") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to record search click")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Search click recorded")
+}
+
+// GetSearchAnalyticsHandler returns the top and zero-result search queries
+// for a window of the last N days (admin only).
+func (h *BlogHandler) GetSearchAnalyticsHandler(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid days value")
+		return
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	topQueries, zeroResultQueries, err := h.blogUsecase.GetSearchAnalytics(c.Request.Context(), since)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get search analytics")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{
+		"top_queries":         topQueries,
+		"zero_result_queries": zeroResultQueries,
+	})
+}
+
+// GetBlogArchiveHandler returns published-post counts grouped by year and
+// month for the archive navigation.
+func (h *BlogHandler) GetBlogArchiveHandler(c *gin.Context) {
+	periods, err := h.blogUsecase.GetBlogArchive(c.Request.Context(), tenantOrgID(c))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get blog archive")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"archive": periods})
+}
+
+// GetArchivePeriodBlogsHandler lists the posts published in one year/month
+// of the archive.
+func (h *BlogHandler) GetArchivePeriodBlogsHandler(c *gin.Context) {
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid year")
+		return
+	}
+	month, err := strconv.Atoi(c.Param("month"))
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid month")
+		return
+	}
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	// Viewer (if authenticated), for paywall checks
+	var viewerID *string
+	if v, exists := c.Get("userID"); exists {
+		if uid, ok := v.(string); ok {
+			viewerID = &uid
+		}
+	}
+
+	blogs, total, current, pages, err := h.blogUsecase.GetArchivePeriodBlogs(c.Request.Context(), year, month, page, pageSize, tenantOrgID(c), viewerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get archive blogs")
+		return
+	}
+
+	var resp []dto.BlogResponse
+	for _, b := range blogs {
+		resp = append(resp, dto.ToBlogResponse(&b))
+	}
+	result := dto.PaginatedBlogResponse{Blogs: resp, TotalCount: total, CurrentPage: current, TotalPages: pages}
+	SuccessHandler(c, http.StatusOK, result)
+}
+
 // GetPopularBlogsHandler handles retrieval of popular blogs
 func (h *BlogHandler) GetPopularBlogsHandler(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
-	blogs, total, current, pages, err := h.blogUsecase.GetPopularBlogs(c.Request.Context(), page, pageSize)
+	// Viewer (if authenticated), for paywall checks
+	var viewerID *string
+	if v, exists := c.Get("userID"); exists {
+		if uid, ok := v.(string); ok {
+			viewerID = &uid
+		}
+	}
+	blogs, total, current, pages, err := h.blogUsecase.GetPopularBlogs(c.Request.Context(), page, pageSize, viewerID)
 	if err != nil {
 		ErrorHandler(c, http.StatusInternalServerError, "Failed to get popular blogs")
 		return
@@ -343,6 +939,23 @@ func (h *BlogHandler) GetPopularBlogsHandler(c *gin.Context) {
 	SuccessHandler(c, http.StatusOK, result)
 }
 
+// GetTopAuthorsHandler returns the top-authors leaderboard for a window
+// ("week", "month" or "all"). Authors who opted out are not listed.
+func (h *BlogHandler) GetTopAuthorsHandler(c *gin.Context) {
+	window := c.DefaultQuery("window", "all")
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	authors, err := h.blogUsecase.GetTopAuthors(c.Request.Context(), window, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid window") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get top authors")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"window": window, "authors": authors})
+}
+
 // SearchAndFilterBlogsHandler
 
 // GetRecommendedBlogsHandler
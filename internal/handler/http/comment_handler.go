@@ -1,17 +1,23 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
 type CommentHandler struct {
-	commentUC usecasecontract.ICommentUseCase
+	commentUC       usecasecontract.ICommentUseCase
+	captchaVerifier contract.ICaptchaVerifier
 }
 
 func NewCommentHandler(commentUC usecasecontract.ICommentUseCase) *CommentHandler {
@@ -20,6 +26,11 @@ func NewCommentHandler(commentUC usecasecontract.ICommentUseCase) *CommentHandle
 	}
 }
 
+// SetCaptchaVerifier enables CAPTCHA verification on comment creation.
+func (h *CommentHandler) SetCaptchaVerifier(verifier contract.ICaptchaVerifier) {
+	h.captchaVerifier = verifier
+}
+
 // Core CRUD Operations
 func (h *CommentHandler) CreateComment(c *gin.Context) {
 	var req dto.CreateCommentRequest
@@ -32,15 +43,55 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Blog ID is required"})
 		return
 	}
-	userIDStr, exists := c.Get("userID")
-	if !exists {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
-	userID := userIDStr.(string)
+
+	if !VerifyCaptcha(c, h.captchaVerifier, req.CaptchaToken) {
+		return
+	}
 
 	// parent_id and target_id are handled in req (DTO)
 	comment, err := h.commentUC.CreateComment(c.Request.Context(), req, userID, blogID)
+	if err != nil {
+		if respondCommentRateLimited(c, err) {
+			return
+		}
+		if err.Error() == "blog not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, comment)
+}
+
+// CreateGuestComment handles comment creation from unauthenticated visitors.
+// The route is only registered when anonymous commenting is enabled.
+func (h *CommentHandler) CreateGuestComment(c *gin.Context) {
+	var req dto.CreateGuestCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	blogID := c.Param("blogID")
+	if blogID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Blog ID is required"})
+		return
+	}
+	if req.DisplayName == "" || req.Email == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Display name and email are required"})
+		return
+	}
+
+	if !VerifyCaptcha(c, h.captchaVerifier, req.CaptchaToken) {
+		return
+	}
+
+	comment, err := h.commentUC.CreateGuestComment(c.Request.Context(), req, blogID)
 	if err != nil {
 		if err.Error() == "blog not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -62,11 +113,8 @@ func (h *CommentHandler) GetComment(c *gin.Context) {
 
 	// Get user ID if authenticated (optional for viewing)
 	var userID *string
-	if userIDStr, exists := c.Get("user_id"); exists {
-		if uid, err := uuid.Parse(userIDStr.(string)); err == nil {
-			uidStr := uid.String()
-			userID = &uidStr
-		}
+	if uid, ok := middleware.UserIDFromContext(c); ok {
+		userID = &uid
 	}
 
 	comment, err := h.commentUC.GetComment(c.Request.Context(), commentID.String(), userID)
@@ -97,18 +145,13 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 	}
 
 	// Get user ID from auth middleware
-	userIDStr, exists := c.Get("userID")
-	if !exists {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
-		return
-	}
 
-	comment, err := h.commentUC.UpdateComment(c.Request.Context(), commentID.String(), userID.String(), req)
+	comment, err := h.commentUC.UpdateComment(c.Request.Context(), commentID.String(), userID, req)
 	if err != nil {
 		if err.Error() == "comment not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -135,18 +178,13 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
 	}
 
 	// Get user ID from auth middleware
-	userIDStr, exists := c.Get("userID")
-	if !exists {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
-		return
-	}
 
-	err = h.commentUC.DeleteComment(c.Request.Context(), commentID.String(), userID.String())
+	err = h.commentUC.DeleteComment(c.Request.Context(), commentID.String(), userID)
 	if err != nil {
 		if err.Error() == "comment not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -177,11 +215,8 @@ func (h *CommentHandler) GetBlogComments(c *gin.Context) {
 
 	// Get user ID if authenticated (optional)
 	var userID *string
-	if userIDStr, exists := c.Get("user_id"); exists {
-		if uid, err := uuid.Parse(userIDStr.(string)); err == nil {
-			uidStr := uid.String()
-			userID = &uidStr
-		}
+	if uid, ok := middleware.UserIDFromContext(c); ok {
+		userID = &uid
 	}
 
 	comments, err := h.commentUC.GetBlogComments(c.Request.Context(), blogID, page, pageSize, userID)
@@ -203,11 +238,8 @@ func (h *CommentHandler) GetCommentThread(c *gin.Context) {
 
 	// Get user ID if authenticated (optional)
 	var userID *string
-	if userIDStr, exists := c.Get("userID"); exists {
-		if uid, err := uuid.Parse(userIDStr.(string)); err == nil {
-			uidStr := uid.String()
-			userID = &uidStr
-		}
+	if uid, ok := middleware.UserIDFromContext(c); ok {
+		userID = &uid
 	}
 
 	thread, err := h.commentUC.GetCommentThread(c.Request.Context(), commentID.String(), userID)
@@ -254,19 +286,13 @@ func (h *CommentHandler) UpdateCommentStatus(c *gin.Context) {
 
 	commentIDStr := c.Param("commentID")
 
-	moderatorIDStr, exists := c.Get("user_id")
-	if !exists {
+	moderatorID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	moderatorID, err := uuid.Parse(moderatorIDStr.(string))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid moderator ID"})
-		return
-	}
-
-	err = h.commentUC.UpdateCommentStatus(c.Request.Context(), commentIDStr, moderatorID.String(), req)
+	err := h.commentUC.UpdateCommentStatus(c.Request.Context(), commentIDStr, moderatorID, req)
 	if err != nil {
 		if err.Error() == "comment not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -279,29 +305,69 @@ func (h *CommentHandler) UpdateCommentStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Comment status updated successfully"})
 }
 
+// respondCommentRateLimited writes a 429 response with Retry-After info when
+// the error is a comment rate limit; it reports whether it handled the error.
+func respondCommentRateLimited(c *gin.Context, err error) bool {
+	var rateErr *usecasecontract.CommentRateLimitError
+	if !errors.As(err, &rateErr) {
+		return false
+	}
+
+	retryAfter := int(rateErr.RetryAfter.Seconds())
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"error":               rateErr.Error(),
+		"retry_after_seconds": retryAfter,
+	})
+	return true
+}
+
 // Engagement
 func (h *CommentHandler) LikeComment(c *gin.Context) {
 	commentIDStr := c.Param("commentID")
 
-	userIDStr, exists := c.Get("user_id")
-	if !exists {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
+	err := h.commentUC.LikeComment(c.Request.Context(), commentIDStr, userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		if err.Error() == "comment not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "comment already liked by user" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Comment liked successfully"})
+}
+
+func (h *CommentHandler) UnlikeComment(c *gin.Context) {
+	commentIDStr := c.Param("commentID")
+
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	err = h.commentUC.LikeComment(c.Request.Context(), commentIDStr, userID.String())
+	err := h.commentUC.UnlikeComment(c.Request.Context(), commentIDStr, userID)
 	if err != nil {
 		if err.Error() == "comment not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
 		}
-		if err.Error() == "comment already liked by user" {
+		if err.Error() == "comment not liked by user" {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
@@ -309,31 +375,73 @@ func (h *CommentHandler) LikeComment(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Comment liked successfully"})
+	c.JSON(http.StatusOK, gin.H{"message": "Comment unliked successfully"})
 }
 
-func (h *CommentHandler) UnlikeComment(c *gin.Context) {
+// ReactToComment records an emoji reaction on a comment
+func (h *CommentHandler) ReactToComment(c *gin.Context) {
 	commentIDStr := c.Param("commentID")
 
-	userIDStr, exists := c.Get("user_id")
-	if !exists {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
+	var req dto.CommentReactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	err := h.commentUC.ReactToComment(c.Request.Context(), commentIDStr, userID, req.Reaction)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		if err.Error() == "comment not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "invalid reaction") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "user already reacted with this emoji" {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	err = h.commentUC.UnlikeComment(c.Request.Context(), commentIDStr, userID.String())
+	c.JSON(http.StatusOK, gin.H{"message": "Reaction added successfully"})
+}
+
+// RemoveCommentReaction removes the caller's emoji reaction from a comment
+func (h *CommentHandler) RemoveCommentReaction(c *gin.Context) {
+	commentIDStr := c.Param("commentID")
+
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req dto.CommentReactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	err := h.commentUC.RemoveCommentReaction(c.Request.Context(), commentIDStr, userID, req.Reaction)
 	if err != nil {
 		if err.Error() == "comment not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
 		}
-		if err.Error() == "comment not liked by user" {
+		if strings.HasPrefix(err.Error(), "invalid reaction") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "user has not reacted with this emoji" {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
@@ -341,7 +449,7 @@ func (h *CommentHandler) UnlikeComment(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Comment unliked successfully"})
+	c.JSON(http.StatusOK, gin.H{"message": "Reaction removed successfully"})
 }
 
 // Reporting
@@ -353,20 +461,14 @@ func (h *CommentHandler) ReportComment(c *gin.Context) {
 	}
 
 	commentIDStr := c.Param("commentID")
-	userIDStr, exists := c.Get("user_id")
 
-	if !exists {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
-		return
-	}
-
-	err = h.commentUC.ReportComment(c.Request.Context(), commentIDStr, userID.String(), req)
+	err := h.commentUC.ReportComment(c.Request.Context(), commentIDStr, userID, req)
 	if err != nil {
 		if err.Error() == "comment not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -393,6 +495,66 @@ func (h *CommentHandler) GetCommentReports(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"reports": reports})
 }
 
+// UpdateReportStatus resolves a comment report, optionally applying a
+// moderation action to the reported comment
+func (h *CommentHandler) UpdateReportStatus(c *gin.Context) {
+	reportID := c.Param("reportID")
+
+	reviewerID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req dto.ResolveReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	err := h.commentUC.UpdateReportStatus(c.Request.Context(), reportID, reviewerID, req.Status, req.CommentAction)
+	if err != nil {
+		switch {
+		case err.Error() == "report not found" || err.Error() == "comment not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case err.Error() == "report has already been resolved":
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case strings.HasPrefix(err.Error(), "invalid report status"),
+			strings.HasPrefix(err.Error(), "invalid comment action"),
+			err.Error() == "comment action is required when actioning a report":
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Report resolved successfully"})
+}
+
+// GetCommentModerationHistory returns the moderation audit trail of a comment
+func (h *CommentHandler) GetCommentModerationHistory(c *gin.Context) {
+	actions, err := h.commentUC.GetCommentModerationHistory(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"actions": actions})
+}
+
+// GetUserModerationHistory returns the moderation actions taken against a
+// user's comments
+func (h *CommentHandler) GetUserModerationHistory(c *gin.Context) {
+	actions, err := h.commentUC.GetUserModerationHistory(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"actions": actions})
+}
+
 // Additional handler methods for the new comment endpoints
 
 // CreateReply creates a reply to a comment
@@ -411,14 +573,12 @@ func (h *CommentHandler) CreateReply(c *gin.Context) {
 	}
 
 	// Get user ID from auth middleware
-	userIDStr, exists := c.Get("userID")
-	if !exists {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 		return
 	}
 
-	userID := userIDStr.(string)
-
 	// Set the parent comment ID in the request
 	req.ParentID = &parentcommentID
 
@@ -436,6 +596,9 @@ func (h *CommentHandler) CreateReply(c *gin.Context) {
 	// Create the reply using the parent comment's blog ID
 	comment, err := h.commentUC.CreateComment(c.Request.Context(), req, userID, parentComment.BlogID)
 	if err != nil {
+		if respondCommentRateLimited(c, err) {
+			return
+		}
 		if err.Error() == "blog not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
@@ -477,11 +640,7 @@ func (h *CommentHandler) GetCommentReplies(c *gin.Context) {
 
 	// Get optional user ID for personalized data
 	var userID *string
-	if userIDStr, exists := c.Get("user_id"); exists {
-		uid := userIDStr.(string)
-		userID = &uid
-	} else if userIDStr, exists := c.Get("userID"); exists {
-		uid := userIDStr.(string)
+	if uid, ok := middleware.UserIDFromContext(c); ok {
 		userID = &uid
 	}
 
@@ -536,11 +695,7 @@ func (h *CommentHandler) GetCommentDepth(c *gin.Context) {
 
 	// Get optional user ID
 	var userID *string
-	if userIDStr, exists := c.Get("user_id"); exists {
-		uid := userIDStr.(string)
-		userID = &uid
-	} else if userIDStr, exists := c.Get("userID"); exists {
-		uid := userIDStr.(string)
+	if uid, ok := middleware.UserIDFromContext(c); ok {
 		userID = &uid
 	}
 
@@ -648,17 +803,12 @@ func (h *CommentHandler) LikeCommentToggle(c *gin.Context) {
 		return
 	}
 
-	userIDStr, exists := c.Get("user_id")
-	if !exists {
-		userIDStr, exists = c.Get("userID")
-		if !exists {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
-			return
-		}
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
 	}
 
-	userID := userIDStr.(string)
-
 	// Check if user has already liked the comment
 	comment, err := h.commentUC.GetComment(c.Request.Context(), commentID, &userID)
 	if err != nil {
@@ -704,11 +854,7 @@ func (h *CommentHandler) GetCommentStatistics(c *gin.Context) {
 
 	// Get optional user ID for personalized stats
 	var userID *string
-	if userIDStr, exists := c.Get("user_id"); exists {
-		uid := userIDStr.(string)
-		userID = &uid
-	} else if userIDStr, exists := c.Get("userID"); exists {
-		uid := userIDStr.(string)
+	if uid, ok := middleware.UserIDFromContext(c); ok {
 		userID = &uid
 	}
 
@@ -745,18 +891,20 @@ func (h *CommentHandler) GetCommentStatistics(c *gin.Context) {
 	})
 }
 
-// BulkDeleteComments allows admins to delete multiple comments
-func (h *CommentHandler) BulkDeleteComments(c *gin.Context) {
+// BulkModerateComments allows admins to delete, approve or hide multiple
+// comments in one batched operation
+func (h *CommentHandler) BulkModerateComments(c *gin.Context) {
 	// Check if user is admin
-	userRole, exists := c.Get("user_role")
-	if !exists || userRole.(string) != "admin" {
+	userRole, ok := middleware.UserRoleFromContext(c)
+	if !ok || userRole != entity.UserRoleAdmin {
 		c.JSON(http.StatusForbidden, gin.H{"error": "Admin access required"})
 		return
 	}
 
 	var req struct {
-		CommentIDs []string `json:"comment_ids" validate:"required,min=1,max=100"`
-		Reason     string   `json:"reason" validate:"max=500"`
+		CommentIDs []string `json:"comment_ids" binding:"required,min=1,max=100"`
+		Action     string   `json:"action" binding:"required,oneof=delete approve hide"`
+		Reason     string   `json:"reason" binding:"max=500"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -764,25 +912,22 @@ func (h *CommentHandler) BulkDeleteComments(c *gin.Context) {
 		return
 	}
 
-	userIDStr, _ := c.Get("user_id")
-	userID := userIDStr.(string)
+	userID, _ := middleware.UserIDFromContext(c)
 
-	deletedCount := 0
-	errors := make([]string, 0)
-
-	for _, commentID := range req.CommentIDs {
-		err := h.commentUC.DeleteComment(c.Request.Context(), commentID, userID)
-		if err != nil {
-			errors = append(errors, commentID+": "+err.Error())
-		} else {
-			deletedCount++
+	modified, err := h.commentUC.BulkModerateComments(c.Request.Context(), userID, true, req.CommentIDs, req.Action, req.Reason)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid bulk action") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
 		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to moderate comments", "details": err.Error()})
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"deleted_count":   deletedCount,
+		"modified_count":  modified,
 		"total_requested": len(req.CommentIDs),
-		"errors":          errors,
+		"action":          req.Action,
 		"reason":          req.Reason,
 	})
 }
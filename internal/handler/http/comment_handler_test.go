@@ -0,0 +1,128 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	handler "github.com/mikiasgoitom/Articulate/internal/handler/http"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
+	mocks "github.com/mikiasgoitom/Articulate/internal/handler/http/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupCommentRouter mirrors the real route layout: comment reads are public
+// with optional auth, comment writes require authentication.
+func setupCommentRouter(uc *mocks.MockCommentUsecase, jwtService *mocks.MockJWTService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	h := handler.NewCommentHandler(uc)
+	r := gin.Default()
+
+	comments := r.Group("/comments")
+	comments.Use(middleware.OptionalAuth(jwtService))
+	{
+		comments.GET("/:commentID", h.GetComment)
+		comments.GET("/:commentID/thread", h.GetCommentThread)
+	}
+	blogs := r.Group("/blogs")
+	blogs.Use(middleware.OptionalAuth(jwtService))
+	{
+		blogs.GET("/:blogID/comments", h.GetBlogComments)
+	}
+
+	protected := r.Group("/")
+	protected.Use(middleware.AuthMiddleWare(jwtService, mocks.NewMockUserUsecase()))
+	{
+		protected.PUT("/comments/:commentID", h.UpdateComment)
+		protected.DELETE("/comments/:commentID", h.DeleteComment)
+	}
+	return r
+}
+
+func TestGetComment_Anonymous(t *testing.T) {
+	mockUsecase := mocks.NewMockCommentUsecase()
+	r := setupCommentRouter(mockUsecase, mocks.NewMockJWTService())
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/comments/b2f5ff47-2a86-4f5c-9c1b-0a2d6e9c3f10", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "mock comment content")
+	assert.Nil(t, mockUsecase.LastUserID)
+}
+
+func TestGetComment_Authenticated(t *testing.T) {
+	mockUsecase := mocks.NewMockCommentUsecase()
+	jwtService := mocks.NewMockJWTService()
+	r := setupCommentRouter(mockUsecase, jwtService)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/comments/b2f5ff47-2a86-4f5c-9c1b-0a2d6e9c3f10", nil)
+	req.Header.Set("Authorization", "Bearer "+mocks.MockAccessToken)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"is_liked":true`)
+	if assert.NotNil(t, mockUsecase.LastUserID) {
+		assert.Equal(t, jwtService.MockUserID, *mockUsecase.LastUserID)
+	}
+}
+
+func TestGetComment_InvalidTokenStillPublic(t *testing.T) {
+	mockUsecase := mocks.NewMockCommentUsecase()
+	r := setupCommentRouter(mockUsecase, mocks.NewMockJWTService())
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/comments/b2f5ff47-2a86-4f5c-9c1b-0a2d6e9c3f10", nil)
+	req.Header.Set("Authorization", "Bearer garbage")
+	r.ServeHTTP(w, req)
+
+	// An invalid token degrades to the anonymous persona instead of a 401
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Nil(t, mockUsecase.LastUserID)
+}
+
+func TestGetBlogComments_Anonymous(t *testing.T) {
+	mockUsecase := mocks.NewMockCommentUsecase()
+	r := setupCommentRouter(mockUsecase, mocks.NewMockJWTService())
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/blogs/mock-blog-id/comments", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "mock comment content")
+	assert.Nil(t, mockUsecase.LastUserID)
+}
+
+func TestUpdateComment_Anonymous(t *testing.T) {
+	mockUsecase := mocks.NewMockCommentUsecase()
+	r := setupCommentRouter(mockUsecase, mocks.NewMockJWTService())
+
+	body, _ := json.Marshal(map[string]string{"content": "edited"})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/comments/b2f5ff47-2a86-4f5c-9c1b-0a2d6e9c3f10", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestUpdateComment_Authenticated(t *testing.T) {
+	mockUsecase := mocks.NewMockCommentUsecase()
+	r := setupCommentRouter(mockUsecase, mocks.NewMockJWTService())
+
+	body, _ := json.Marshal(map[string]string{"content": "edited"})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/comments/b2f5ff47-2a86-4f5c-9c1b-0a2d6e9c3f10", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+mocks.MockAccessToken)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "edited")
+}
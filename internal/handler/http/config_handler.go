@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// ConfigHandler exposes the runtime-tunable configuration to admins: reading
+// the current values, applying validated overrides, resetting back to the
+// booted environment and reviewing the audited change history.
+type ConfigHandler struct {
+	config usecasecontract.IReloadableConfig
+}
+
+func NewConfigHandler(config usecasecontract.IReloadableConfig) *ConfigHandler {
+	return &ConfigHandler{config: config}
+}
+
+// GetValuesHandler returns the current effective runtime-tunable values.
+func (h *ConfigHandler) GetValuesHandler(c *gin.Context) {
+	SuccessHandler(c, http.StatusOK, gin.H{"values": h.config.ReloadableValues()})
+}
+
+// ApplyOverridesHandler validates and applies runtime overrides from the
+// request body; if any key or value is invalid nothing is applied.
+func (h *ConfigHandler) ApplyOverridesHandler(c *gin.Context) {
+	var overrides map[string]string
+	if err := c.ShouldBindJSON(&overrides); err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if len(overrides) == 0 {
+		ErrorHandler(c, http.StatusBadRequest, "No config values provided")
+		return
+	}
+	changes, err := h.config.ApplyOverrides(changeSource(c), overrides)
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"changes": changes})
+}
+
+// ReloadHandler discards runtime overrides and re-resolves the tunable
+// values from the process environment, mirroring a SIGHUP.
+func (h *ConfigHandler) ReloadHandler(c *gin.Context) {
+	SuccessHandler(c, http.StatusOK, gin.H{"changes": h.config.Reload(changeSource(c))})
+}
+
+// ListChangesHandler returns the audited change history, newest first.
+func (h *ConfigHandler) ListChangesHandler(c *gin.Context) {
+	SuccessHandler(c, http.StatusOK, gin.H{"changes": h.config.Changes()})
+}
+
+// changeSource tags a config change with the acting admin for the audit
+// trail.
+func changeSource(c *gin.Context) string {
+	if userID, ok := middleware.UserIDFromContext(c); ok {
+		return "admin:" + userID
+	}
+	return "admin"
+}
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// DeviceHandler exposes push notification device registration for the
+// authenticated user's clients.
+type DeviceHandler struct {
+	pushUseCase usecasecontract.IPushUseCase
+}
+
+func NewDeviceHandler(pushUseCase usecasecontract.IPushUseCase) *DeviceHandler {
+	return &DeviceHandler{
+		pushUseCase: pushUseCase,
+	}
+}
+
+// RegisterDeviceHandler stores a device token so the client receives push
+// notifications.
+func (h *DeviceHandler) RegisterDeviceHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	var req dto.RegisterDeviceRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	if err := h.pushUseCase.RegisterDevice(c.Request.Context(), userIDStr, req.Token, req.Platform); err != nil {
+		if strings.Contains(err.Error(), "invalid platform") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to register device")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Device registered successfully")
+}
+
+// UnregisterDeviceHandler removes a device token so the client no longer
+// receives push notifications.
+func (h *DeviceHandler) UnregisterDeviceHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	token := c.Param("token")
+	if err := h.pushUseCase.UnregisterDevice(c.Request.Context(), userIDStr, token); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Device not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to unregister device")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Device unregistered successfully")
+}
+
+// ListDevicesHandler returns the authenticated user's registered devices.
+func (h *DeviceHandler) ListDevicesHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	devices, err := h.pushUseCase.ListDevices(c.Request.Context(), userIDStr)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list devices")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"devices": devices})
+}
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// DraftCommentHandler exposes the editorial review comments on drafts,
+// separate from the public comment endpoints.
+type DraftCommentHandler struct {
+	draftComments usecase.IDraftCommentUseCase
+}
+
+func NewDraftCommentHandler(draftComments usecase.IDraftCommentUseCase) *DraftCommentHandler {
+	return &DraftCommentHandler{
+		draftComments: draftComments,
+	}
+}
+
+// draftCommentError maps usecase errors onto HTTP responses shared by the
+// draft comment handlers.
+func draftCommentError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		ErrorHandler(c, http.StatusNotFound, err.Error())
+	case strings.HasPrefix(err.Error(), "unauthorized"):
+		ErrorHandler(c, http.StatusForbidden, "Only the author or a reviewer can access draft comments")
+	case strings.Contains(err.Error(), "invalid content range"):
+		ErrorHandler(c, http.StatusBadRequest, "Invalid content range")
+	default:
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to process draft comment")
+	}
+}
+
+// CreateDraftCommentHandler attaches an editorial comment to a content
+// range of the draft.
+func (h *DraftCommentHandler) CreateDraftCommentHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateDraftCommentRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	comment, err := h.draftComments.AddComment(c.Request.Context(), c.Param("blogID"), userID.(string), req.Body, *req.StartOffset, *req.EndOffset, req.Quote)
+	if err != nil {
+		draftCommentError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, comment)
+}
+
+// ListDraftCommentsHandler returns a draft's editorial comments, oldest
+// first.
+func (h *DraftCommentHandler) ListDraftCommentsHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	comments, err := h.draftComments.ListComments(c.Request.Context(), c.Param("blogID"), userID.(string))
+	if err != nil {
+		draftCommentError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"comments": comments})
+}
+
+// ResolveDraftCommentHandler resolves or reopens a draft comment.
+func (h *DraftCommentHandler) ResolveDraftCommentHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.ResolveDraftCommentRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	comment, err := h.draftComments.SetResolved(c.Request.Context(), c.Param("blogID"), c.Param("commentID"), userID.(string), *req.Resolved)
+	if err != nil {
+		draftCommentError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusOK, comment)
+}
@@ -3,26 +3,53 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
+// resendCooldown is the minimum interval between verification emails for the
+// same address.
+const resendCooldown = time.Minute
+
 type EmailHandler struct {
 	emailVerificationUC usecasecontract.IEmailVerificationUC
 	userRepository      contract.IUserRepository
+	config              usecasecontract.IConfigProvider
+
+	mu            sync.Mutex
+	lastRequested map[string]time.Time
 }
 
-func NewEmailHandler(eu usecasecontract.IEmailVerificationUC, uc contract.IUserRepository) *EmailHandler {
+func NewEmailHandler(eu usecasecontract.IEmailVerificationUC, uc contract.IUserRepository, config usecasecontract.IConfigProvider) *EmailHandler {
 	return &EmailHandler{
 		emailVerificationUC: eu,
 		userRepository:      uc,
+		config:              config,
+		lastRequested:       make(map[string]time.Time),
 	}
 }
 
 type requestEmailVerificationRequest struct {
-	UserID string `json:"user_id" binding:"required"`
+	Email string `json:"email" binding:"required,email"`
+}
+
+// allowResend reports whether a verification email may be sent to the address
+// and records the attempt. It applies regardless of whether an account exists,
+// so the rate limit itself leaks nothing.
+func (h *EmailHandler) allowResend(email string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if last, ok := h.lastRequested[email]; ok && time.Since(last) < resendCooldown {
+		return false
+	}
+	h.lastRequested[email] = time.Now()
+	return true
 }
 
 func (h *EmailHandler) HandleRequestEmailVerification(ctx *gin.Context) {
@@ -34,47 +61,62 @@ func (h *EmailHandler) HandleRequestEmailVerification(ctx *gin.Context) {
 		return
 	}
 
-	// fetch user base on the req.userid
-	user, err := h.userRepository.GetUserByID(requestCtx, req.UserID)
-	if err != nil {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": "Invalid request body"})
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if !h.allowResend(email) {
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests, please try again later."})
 		return
 	}
 
-	// send a email validation request
-	if err = h.emailVerificationUC.RequestVerificationEmail(requestCtx, user); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send verification email"})
-		return
+	// Look the user up internally and respond uniformly whether or not an
+	// account exists, so the endpoint cannot be used to probe for accounts.
+	user, err := h.userRepository.GetUserByEmail(requestCtx, email)
+	if err == nil && !user.IsVerified {
+		// Best effort: a delivery failure is not surfaced either.
+		_ = h.emailVerificationUC.RequestVerificationEmail(requestCtx, user)
 	}
-	// send a successfull message
-	ctx.JSON(http.StatusOK, gin.H{"message": "Verification email sent successfully"})
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "If an account with that email exists, a verification email has been sent."})
 }
 
+// HandleVerifyEmailToken completes email verification. Browser requests are
+// redirected to the configured frontend success/failure pages with a reason
+// query param; clients sending "Accept: application/json" get JSON instead.
 func (h *EmailHandler) HandleVerifyEmailToken(ctx *gin.Context) {
 	requestCtx := ctx.Request.Context()
 	verifier := ctx.Query("verifier")
 	plainToken := ctx.Query("token")
+	wantsJSON := strings.Contains(ctx.GetHeader("Accept"), "application/json")
+
+	fail := func(status int, reason, message string) {
+		if wantsJSON {
+			ctx.JSON(status, gin.H{"error": message})
+			return
+		}
+		ctx.Redirect(http.StatusFound, fmt.Sprintf("%s?reason=%s", h.config.GetEmailVerifyFailureURL(), url.QueryEscape(reason)))
+	}
 
 	if verifier == "" || plainToken == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Missing verifier or token"})
+		fail(http.StatusBadRequest, "missing_params", "Missing verifier or token")
 		return
 	}
 
 	// call the verify email token usecase
 	user, err := h.emailVerificationUC.VerifyEmailToken(requestCtx, verifier, plainToken)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid token or expired token"})
+		fail(http.StatusBadRequest, "invalid_token", "invalid token or expired token")
 		return
 	}
 	user.IsVerified = true
 	user.IsActive = true
 	// update the user
-	if _, err := h.userRepository.UpdateUser(ctx, user); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+	if _, err := h.userRepository.UpdateUser(requestCtx, user); err != nil {
+		fail(http.StatusInternalServerError, "server_error", "Failed to update user")
+		return
+	}
+
+	if wantsJSON {
+		ctx.JSON(http.StatusOK, gin.H{"message": "Email verified successfully", "user": user})
 		return
 	}
-	// send a success message
-	ctx.JSON(http.StatusOK, gin.H{"message": "Email verified successfully", "user": user})
-	// redirect to success page
-	ctx.Redirect(http.StatusFound, fmt.Sprintf("/email-verified-success?username=%s", user.Username))
+	ctx.Redirect(http.StatusFound, fmt.Sprintf("%s?username=%s", h.config.GetEmailVerifySuccessURL(), url.QueryEscape(user.Username)))
 }
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// EventHandler exposes event posts: editing a post's event block, RSVPing
+// with capacity enforcement, attendee lists and iCal export.
+type EventHandler struct {
+	events usecase.IEventUseCase
+}
+
+func NewEventHandler(events usecase.IEventUseCase) *EventHandler {
+	return &EventHandler{
+		events: events,
+	}
+}
+
+// eventError maps event usecase errors onto HTTP responses.
+func eventError(c *gin.Context, err error, fallback string) {
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		ErrorHandler(c, http.StatusNotFound, err.Error())
+	case strings.Contains(err.Error(), "not an event"):
+		ErrorHandler(c, http.StatusNotFound, "This post is not an event")
+	case strings.HasPrefix(err.Error(), "unauthorized"):
+		ErrorHandler(c, http.StatusForbidden, "Only the author can edit event details")
+	case strings.Contains(err.Error(), "at capacity"):
+		ErrorHandler(c, http.StatusConflict, "The event is at capacity")
+	case strings.Contains(err.Error(), "already started"), strings.Contains(err.Error(), "only published"):
+		ErrorHandler(c, http.StatusConflict, err.Error())
+	case strings.Contains(err.Error(), "required"), strings.Contains(err.Error(), "must be"), strings.Contains(err.Error(), "cannot be"):
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+	default:
+		ErrorHandler(c, http.StatusInternalServerError, fallback)
+	}
+}
+
+// SetEventInfoHandler attaches or updates the event block on a post.
+func (h *EventHandler) SetEventInfoHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.SetEventInfoRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	info := &entity.EventInfo{
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Location:  req.Location,
+		Capacity:  req.Capacity,
+	}
+	blog, err := h.events.SetEventInfo(c.Request.Context(), c.Param("blogID"), userID.(string), info)
+	if err != nil {
+		eventError(c, err, "Failed to update event details")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToBlogResponse(blog))
+}
+
+// ClearEventInfoHandler removes the event block from a post.
+func (h *EventHandler) ClearEventInfoHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	blog, err := h.events.SetEventInfo(c.Request.Context(), c.Param("blogID"), userID.(string), nil)
+	if err != nil {
+		eventError(c, err, "Failed to update event details")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToBlogResponse(blog))
+}
+
+// RSVPHandler records the reader's RSVP to an event post.
+func (h *EventHandler) RSVPHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	rsvp, err := h.events.RSVP(c.Request.Context(), c.Param("blogID"), userID.(string))
+	if err != nil {
+		eventError(c, err, "Failed to record RSVP")
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, rsvp)
+}
+
+// CancelRSVPHandler withdraws the reader's RSVP.
+func (h *EventHandler) CancelRSVPHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if err := h.events.CancelRSVP(c.Request.Context(), c.Param("blogID"), userID.(string)); err != nil {
+		eventError(c, err, "Failed to cancel RSVP")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "RSVP cancelled")
+}
+
+// ListAttendeesHandler returns an event's attendees in RSVP order.
+func (h *EventHandler) ListAttendeesHandler(c *gin.Context) {
+	attendees, err := h.events.ListAttendees(c.Request.Context(), c.Param("blogID"))
+	if err != nil {
+		eventError(c, err, "Failed to list attendees")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"attendees": attendees, "count": len(attendees)})
+}
+
+// ExportCalendarHandler serves the reader's RSVPed events as an iCalendar
+// file.
+func (h *EventHandler) ExportCalendarHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	calendar, err := h.events.ExportCalendar(c.Request.Context(), userID.(string))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to export calendar")
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="rsvps-`+time.Now().Format("2006-01-02")+`.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(calendar))
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
+)
+
+// ExperimentsHandler exposes variant assignment to clients and the admin
+// API for experiment definitions.
+type ExperimentsHandler struct {
+	experiments contract.IExperiments
+}
+
+func NewExperimentsHandler(experiments contract.IExperiments) *ExperimentsHandler {
+	return &ExperimentsHandler{
+		experiments: experiments,
+	}
+}
+
+// GetAssignmentHandler returns the caller's variant for an experiment.
+// Authenticated users are bucketed by user ID, anonymous visitors by client
+// IP, so assignments stay stable across requests.
+func (h *ExperimentsHandler) GetAssignmentHandler(c *gin.Context) {
+	subjectID, ok := middleware.UserIDFromContext(c)
+	if !ok || subjectID == "" {
+		subjectID = "anon:" + c.ClientIP()
+	}
+
+	experiment := c.Param("name")
+	variant, ok := h.experiments.Assign(c.Request.Context(), experiment, subjectID)
+	if !ok {
+		ErrorHandler(c, http.StatusNotFound, "Experiment not found or inactive")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, gin.H{
+		"experiment": experiment,
+		"variant":    variant,
+	})
+}
+
+// ListExperimentsHandler returns all experiment definitions.
+func (h *ExperimentsHandler) ListExperimentsHandler(c *gin.Context) {
+	experiments, err := h.experiments.ListExperiments(c.Request.Context())
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list experiments")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, gin.H{"experiments": experiments})
+}
+
+// SetExperimentHandler creates or updates an experiment by name.
+func (h *ExperimentsHandler) SetExperimentHandler(c *gin.Context) {
+	var req dto.SetExperimentRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	variants := make([]entity.ExperimentVariant, 0, len(req.Variants))
+	for _, variant := range req.Variants {
+		variants = append(variants, entity.ExperimentVariant{Name: variant.Name, Weight: variant.Weight})
+	}
+
+	if err := h.experiments.SetExperiment(c.Request.Context(), c.Param("name"), variants, req.Active); err != nil {
+		if strings.Contains(err.Error(), "invalid") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to set experiment")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Experiment updated successfully")
+}
+
+// DeleteExperimentHandler removes an experiment definition.
+func (h *ExperimentsHandler) DeleteExperimentHandler(c *gin.Context) {
+	if err := h.experiments.DeleteExperiment(c.Request.Context(), c.Param("name")); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to delete experiment")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Experiment deleted successfully")
+}
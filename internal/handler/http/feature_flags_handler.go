@@ -0,0 +1,71 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+)
+
+// FeatureFlagsHandler exposes the admin API for runtime feature flags.
+type FeatureFlagsHandler struct {
+	flags contract.IFeatureFlags
+}
+
+func NewFeatureFlagsHandler(flags contract.IFeatureFlags) *FeatureFlagsHandler {
+	return &FeatureFlagsHandler{
+		flags: flags,
+	}
+}
+
+// ListFeatureFlagsHandler returns all persisted flags.
+func (h *FeatureFlagsHandler) ListFeatureFlagsHandler(c *gin.Context) {
+	flags, err := h.flags.ListFlags(c.Request.Context())
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list feature flags")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, gin.H{"flags": flags})
+}
+
+// SetFeatureFlagHandler creates or updates a flag by name.
+func (h *FeatureFlagsHandler) SetFeatureFlagHandler(c *gin.Context) {
+	var req dto.SetFeatureFlagRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	rolloutPercent := 100
+	if req.RolloutPercent != nil {
+		rolloutPercent = *req.RolloutPercent
+	}
+
+	if err := h.flags.SetFlag(c.Request.Context(), c.Param("name"), req.Enabled, rolloutPercent); err != nil {
+		if strings.Contains(err.Error(), "invalid") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to set feature flag")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Feature flag updated successfully")
+}
+
+// DeleteFeatureFlagHandler removes a flag; checks fall back to their
+// defaults afterwards.
+func (h *FeatureFlagsHandler) DeleteFeatureFlagHandler(c *gin.Context) {
+	if err := h.flags.DeleteFlag(c.Request.Context(), c.Param("name")); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to delete feature flag")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Feature flag deleted successfully")
+}
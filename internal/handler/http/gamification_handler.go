@@ -0,0 +1,31 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// GamificationHandler exposes the badges users earned through the
+// gamification system.
+type GamificationHandler struct {
+	gamification usecase.IGamificationUseCase
+}
+
+func NewGamificationHandler(gamification usecase.IGamificationUseCase) *GamificationHandler {
+	return &GamificationHandler{
+		gamification: gamification,
+	}
+}
+
+// ListUserBadgesHandler returns the badges held by a user.
+func (h *GamificationHandler) ListUserBadgesHandler(c *gin.Context) {
+	badges, err := h.gamification.GetUserBadges(c.Request.Context(), c.Param("userId"))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve badges")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"badges": dto.ToBadgeResponses(badges)})
+}
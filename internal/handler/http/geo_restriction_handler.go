@@ -0,0 +1,104 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// GeoRestrictionHandler exposes the admin API for per-country content
+// restrictions (legal takedowns) and their audit trail.
+type GeoRestrictionHandler struct {
+	restrictions usecase.IGeoRestrictionUseCase
+}
+
+func NewGeoRestrictionHandler(restrictions usecase.IGeoRestrictionUseCase) *GeoRestrictionHandler {
+	return &GeoRestrictionHandler{
+		restrictions: restrictions,
+	}
+}
+
+// adminUserID extracts the acting admin's user ID or writes the error
+// response.
+func adminUserID(c *gin.Context) (string, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return "", false
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return "", false
+	}
+	return userIDStr, true
+}
+
+// RestrictBlogHandler blocks a blog from being served in the listed
+// countries.
+func (h *GeoRestrictionHandler) RestrictBlogHandler(c *gin.Context) {
+	actorID, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RestrictBlogRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	restriction, err := h.restrictions.RestrictBlog(c.Request.Context(), c.Param("blogID"), actorID, req.CountryCodes, req.Reason)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+		case strings.Contains(err.Error(), "country code"):
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to restrict blog")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusOK, restriction)
+}
+
+// UnrestrictBlogHandler lifts a blog's restriction.
+func (h *GeoRestrictionHandler) UnrestrictBlogHandler(c *gin.Context) {
+	actorID, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.restrictions.UnrestrictBlog(c.Request.Context(), c.Param("blogID"), actorID); err != nil {
+		if strings.Contains(err.Error(), "no geo restriction") {
+			ErrorHandler(c, http.StatusNotFound, "Blog is not restricted")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to lift restriction")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Restriction lifted")
+}
+
+// ListGeoRestrictionsHandler returns every restricted blog.
+func (h *GeoRestrictionHandler) ListGeoRestrictionsHandler(c *gin.Context) {
+	restrictions, err := h.restrictions.ListRestrictions(c.Request.Context())
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list restrictions")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"restrictions": restrictions})
+}
+
+// GetGeoRestrictionAuditHandler returns a blog's restriction history.
+func (h *GeoRestrictionHandler) GetGeoRestrictionAuditHandler(c *gin.Context) {
+	entries, err := h.restrictions.GetAuditTrail(c.Request.Context(), c.Param("blogID"))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve audit trail")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"audit": entries})
+}
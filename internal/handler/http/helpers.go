@@ -4,7 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/mikiasgoitom/Articulate/internal/handler/http/dto"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
 )
 
 // ErrorHandler centralizes error handling for HTTP responses
@@ -22,9 +23,41 @@ func MessageHandler(c *gin.Context, statusCode int, message string) {
 	c.JSON(statusCode, dto.MessageResponse{Message: message})
 }
 
-// BindAndValidate binds JSON request and validates it
+// VerifyCaptcha validates a CAPTCHA token when a verifier is configured.
+// It writes the error response and returns false on failure; a nil verifier
+// means CAPTCHA is disabled and the check passes.
+func VerifyCaptcha(c *gin.Context, verifier contract.ICaptchaVerifier, token string) bool {
+	if verifier == nil {
+		return true
+	}
+	if token == "" {
+		ErrorHandler(c, http.StatusBadRequest, "CAPTCHA token required")
+		return false
+	}
+	ok, err := verifier.VerifyToken(c.Request.Context(), token, c.ClientIP())
+	if err != nil {
+		ErrorHandler(c, http.StatusServiceUnavailable, "Failed to verify CAPTCHA")
+		return false
+	}
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "CAPTCHA verification failed")
+		return false
+	}
+	return true
+}
+
+// BindAndValidate binds JSON request and validates it. Validation failures
+// respond with field-level details; malformed JSON keeps the plain error
+// envelope.
 func BindAndValidate(c *gin.Context, req interface{}) error {
 	if err := c.ShouldBindJSON(req); err != nil {
+		if errs, ok := asValidationErrors(err); ok {
+			c.JSON(http.StatusBadRequest, dto.ValidationErrorResponse{
+				Error:   "Request validation failed",
+				Details: translateValidationErrors(errs),
+			})
+			return err
+		}
 		ErrorHandler(c, http.StatusBadRequest, err.Error())
 		return err
 	}
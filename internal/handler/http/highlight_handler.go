@@ -0,0 +1,107 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// HighlightHandler exposes reader highlights: creating and removing a
+// user's own highlights, listing them, and the per-post popular highlights
+// aggregate.
+type HighlightHandler struct {
+	highlights usecase.IHighlightUseCase
+}
+
+func NewHighlightHandler(highlights usecase.IHighlightUseCase) *HighlightHandler {
+	return &HighlightHandler{
+		highlights: highlights,
+	}
+}
+
+// CreateHighlightHandler stores a highlighted passage of a post for the
+// authenticated reader.
+func (h *HighlightHandler) CreateHighlightHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateHighlightRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	highlight, err := h.highlights.AddHighlight(c.Request.Context(), c.Param("blogID"), userID.(string), *req.StartOffset, *req.EndOffset, req.Text, req.Note)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+		case strings.Contains(err.Error(), "only published"):
+			ErrorHandler(c, http.StatusConflict, "Only published posts can be highlighted")
+		case strings.Contains(err.Error(), "invalid content range"), strings.Contains(err.Error(), "must not exceed"):
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to create highlight")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, highlight)
+}
+
+// DeleteHighlightHandler removes one of the reader's own highlights.
+func (h *HighlightHandler) DeleteHighlightHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	err := h.highlights.RemoveHighlight(c.Request.Context(), c.Param("highlightID"), userID.(string))
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Highlight not found")
+		case strings.HasPrefix(err.Error(), "unauthorized"):
+			ErrorHandler(c, http.StatusForbidden, "Only the owner can remove a highlight")
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to remove highlight")
+		}
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Highlight removed")
+}
+
+// ListMyHighlightsHandler returns the reader's highlights across all posts.
+func (h *HighlightHandler) ListMyHighlightsHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	highlights, err := h.highlights.ListMyHighlights(c.Request.Context(), userID.(string))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list highlights")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"highlights": highlights})
+}
+
+// GetPopularHighlightsHandler returns a post's most-highlighted passages,
+// optionally limited with ?limit=.
+func (h *HighlightHandler) GetPopularHighlightsHandler(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "0"))
+
+	stats, err := h.highlights.GetPopularHighlights(c.Request.Context(), c.Param("blogID"), limit)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve popular highlights")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"highlights": stats})
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// ImageProxyHandler serves external images through the signed image proxy.
+type ImageProxyHandler struct {
+	proxy contract.IImageProxyService
+}
+
+func NewImageProxyHandler(proxy contract.IImageProxyService) *ImageProxyHandler {
+	return &ImageProxyHandler{
+		proxy: proxy,
+	}
+}
+
+// ProxyImageHandler fetches an external image on behalf of the client. The
+// request must carry a valid signature generated by the server, so the
+// endpoint cannot be used as an open proxy.
+func (h *ImageProxyHandler) ProxyImageHandler(c *gin.Context) {
+	rawURL := c.Query("url")
+	if rawURL == "" {
+		ErrorHandler(c, http.StatusBadRequest, "Image URL is required")
+		return
+	}
+
+	width := 0
+	if w := c.Query("w"); w != "" {
+		parsed, err := strconv.Atoi(w)
+		if err != nil || parsed < 0 {
+			ErrorHandler(c, http.StatusBadRequest, "Invalid width")
+			return
+		}
+		width = parsed
+	}
+
+	if !h.proxy.VerifySignature(rawURL, width, c.Query("sig")) {
+		ErrorHandler(c, http.StatusForbidden, "Invalid signature")
+		return
+	}
+
+	data, contentType, err := h.proxy.Fetch(c.Request.Context(), rawURL, width)
+	if err != nil {
+		ErrorHandler(c, http.StatusBadGateway, "Failed to fetch image")
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, contentType, data)
+}
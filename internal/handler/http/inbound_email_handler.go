@@ -0,0 +1,84 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// maxInboundEmailSize bounds the inbound email webhook payload.
+const maxInboundEmailSize = 256 * 1024
+
+// replyAddressPattern extracts the reply token from a "reply+<token>@domain"
+// recipient address.
+var replyAddressPattern = regexp.MustCompile(`reply\+([A-Za-z0-9_-]+)@`)
+
+// InboundEmailHandler receives inbound email webhooks (SendGrid inbound
+// parse / SES style) and turns replies to comment notification emails into
+// comments.
+type InboundEmailHandler struct {
+	commentUsecase *usecase.CommentUseCaseImpl
+	secret         string
+}
+
+func NewInboundEmailHandler(commentUsecase *usecase.CommentUseCaseImpl, secret string) *InboundEmailHandler {
+	return &InboundEmailHandler{
+		commentUsecase: commentUsecase,
+		secret:         secret,
+	}
+}
+
+// InboundEmailHandlerFunc parses the inbound email, extracts the reply token
+// from the recipient address and posts the reply as a comment.
+func (h *InboundEmailHandler) InboundEmailHandlerFunc(c *gin.Context) {
+	if h.secret != "" && c.GetHeader("X-Inbound-Secret") != h.secret && c.Query("secret") != h.secret {
+		ErrorHandler(c, http.StatusUnauthorized, "Invalid inbound email secret")
+		return
+	}
+
+	to, text := h.parseInboundEmail(c)
+	match := replyAddressPattern.FindStringSubmatch(to)
+	if match == nil {
+		ErrorHandler(c, http.StatusBadRequest, "No reply token in recipient address")
+		return
+	}
+
+	comment, err := h.commentUsecase.ReplyByEmail(c.Request.Context(), match[1], text)
+	if err != nil {
+		if strings.Contains(err.Error(), "token") || strings.Contains(err.Error(), "empty") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to post reply")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, comment)
+}
+
+// parseInboundEmail reads the recipient address and plain-text body from the
+// webhook, accepting SendGrid-style multipart form fields with a JSON
+// fallback for other providers.
+func (h *InboundEmailHandler) parseInboundEmail(c *gin.Context) (to, text string) {
+	contentType := c.ContentType()
+	if strings.Contains(contentType, "multipart/form-data") || strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		return c.PostForm("to"), c.PostForm("text")
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, maxInboundEmailSize))
+	if err != nil {
+		return "", ""
+	}
+	var body struct {
+		To   string `json:"to"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return "", ""
+	}
+	return body.To, body.Text
+}
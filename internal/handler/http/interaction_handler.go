@@ -2,9 +2,11 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
 	usecase "github.com/mikiasgoitom/Articulate/internal/usecase"
 )
 
@@ -44,6 +46,42 @@ func (h *InteractionHandler) LikeBlogHandler(c *gin.Context) {
 	}
 }
 
+// ClapBlogHandler adds claps from the authenticated user on a blog. Each
+// user can clap up to entity.MaxClapsPerUser times per blog.
+func (h *InteractionHandler) ClapBlogHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	var req dto.ClapRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	userTotal, blogTotal, err := h.likeUsecase.ClapBlog(c.Request.Context(), userIDStr, blogID, req.Count)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+			return
+		}
+		if strings.Contains(err.Error(), "clap count") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to clap blog")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"user_claps": userTotal, "blog_claps": blogTotal})
+}
+
 func (h *InteractionHandler) DislikeBlogHandler(c *gin.Context) {
 
 	blogID := c.Param("blogID")
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// liveThrottleInterval is the minimum gap between events sent to one SSE
+// connection; updates arriving faster are coalesced into the latest snapshot.
+const liveThrottleInterval = time.Second
+
+// liveHeartbeatInterval keeps idle SSE connections alive through proxies.
+const liveHeartbeatInterval = 25 * time.Second
+
+// LiveEngagementHandler streams a blog's live engagement counters over
+// Server-Sent Events so post pages show them without polling.
+type LiveEngagementHandler struct {
+	streamer    usecase.LiveEngagementStreamer
+	heartbeater usecase.PresenceHeartbeater // optional, enables the reading-now indicator
+}
+
+func NewLiveEngagementHandler(streamer usecase.LiveEngagementStreamer) *LiveEngagementHandler {
+	return &LiveEngagementHandler{
+		streamer: streamer,
+	}
+}
+
+// SetPresenceHeartbeater enables the presence heartbeat endpoint feeding the
+// "N people reading this post" counter.
+func (h *LiveEngagementHandler) SetPresenceHeartbeater(heartbeater usecase.PresenceHeartbeater) {
+	h.heartbeater = heartbeater
+}
+
+// HeartbeatPresenceHandler records that the caller is currently reading the
+// post. Anonymous readers identify themselves with a client-generated
+// reader_id; otherwise the client IP is used.
+func (h *LiveEngagementHandler) HeartbeatPresenceHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+
+	readerID := c.Query("reader_id")
+	if readerID == "" {
+		if userID, exists := c.Get("userID"); exists {
+			readerID, _ = userID.(string)
+		}
+	}
+	if readerID == "" {
+		readerID = c.ClientIP()
+	}
+
+	if err := h.heartbeater.HeartbeatPresence(c.Request.Context(), blogID, readerID); err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to record presence")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Presence recorded")
+}
+
+// StreamEngagementHandler opens the SSE stream for the blog, sending the
+// current counters immediately and a throttled event per subsequent change.
+func (h *LiveEngagementHandler) StreamEngagementHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+
+	initial, updates, cancel, err := h.streamer.StreamEngagement(c.Request.Context(), blogID)
+	if err != nil {
+		ErrorHandler(c, http.StatusNotFound, "Blog not found")
+		return
+	}
+	defer cancel()
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+
+	sendEvent := func(update entity.EngagementUpdate) {
+		data, err := json.Marshal(update)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(c.Writer, "event: engagement\ndata: %s\n\n", data)
+		c.Writer.Flush()
+	}
+	sendEvent(initial)
+	lastSent := time.Now()
+
+	var pending *entity.EngagementUpdate
+	ticker := time.NewTicker(liveThrottleInterval)
+	defer ticker.Stop()
+	heartbeat := time.NewTicker(liveHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case update := <-updates:
+			if time.Since(lastSent) < liveThrottleInterval {
+				pending = &update
+				continue
+			}
+			sendEvent(update)
+			lastSent = time.Now()
+			pending = nil
+		case <-ticker.C:
+			if pending != nil {
+				sendEvent(*pending)
+				lastSent = time.Now()
+				pending = nil
+			}
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": ping\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
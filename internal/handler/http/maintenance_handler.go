@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
+)
+
+// MaintenanceHandler exposes the admin switch for maintenance mode, backed
+// by the feature flag service so the change takes effect without a
+// redeploy.
+type MaintenanceHandler struct {
+	flags contract.IFeatureFlags
+}
+
+func NewMaintenanceHandler(flags contract.IFeatureFlags) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		flags: flags,
+	}
+}
+
+// GetMaintenanceHandler returns the current maintenance state.
+func (h *MaintenanceHandler) GetMaintenanceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	SuccessHandler(c, http.StatusOK, gin.H{
+		"enabled":       h.flags.IsEnabled(ctx, middleware.MaintenanceFlag, false),
+		"all_endpoints": h.flags.IsEnabled(ctx, middleware.MaintenanceAllFlag, false),
+	})
+}
+
+// SetMaintenanceHandler switches maintenance mode on or off at runtime.
+func (h *MaintenanceHandler) SetMaintenanceHandler(c *gin.Context) {
+	var req dto.SetMaintenanceRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.flags.SetFlag(ctx, middleware.MaintenanceFlag, req.Enabled, 100); err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to update maintenance mode")
+		return
+	}
+	if err := h.flags.SetFlag(ctx, middleware.MaintenanceAllFlag, req.Enabled && req.AllEndpoints, 100); err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to update maintenance mode")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Maintenance mode updated successfully")
+}
@@ -0,0 +1,339 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// MediaHandler exposes media uploads. Every upload passes the
+// content-security pipeline (magic-byte validation, metadata stripping and
+// optional virus scanning) before it is persisted.
+type MediaHandler struct {
+	mediaUsecase usecase.IMediaUseCase
+}
+
+func NewMediaHandler(mediaUsecase usecase.IMediaUseCase) *MediaHandler {
+	return &MediaHandler{
+		mediaUsecase: mediaUsecase,
+	}
+}
+
+// UploadMediaHandler accepts a multipart upload under the "file" form field
+// for the authenticated user.
+func (h *MediaHandler) UploadMediaHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "A file upload under the \"file\" field is required")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Failed to read uploaded file")
+		return
+	}
+
+	media, err := h.mediaUsecase.UploadMedia(c.Request.Context(), userIDStr, header.Filename, header.Header.Get("Content-Type"), data)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case strings.Contains(msg, "unsupported") ||
+			strings.Contains(msg, "does not match") ||
+			strings.Contains(msg, "empty") ||
+			strings.Contains(msg, "upload limit"):
+			ErrorHandler(c, http.StatusBadRequest, msg)
+		case strings.Contains(msg, "quota exceeded"):
+			ErrorHandler(c, http.StatusRequestEntityTooLarge, msg)
+		case strings.Contains(msg, "malware"):
+			ErrorHandler(c, http.StatusUnprocessableEntity, msg)
+		case strings.Contains(msg, "scan"):
+			ErrorHandler(c, http.StatusServiceUnavailable, "Upload could not be scanned, try again later")
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to upload media")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, dto.ToMediaResponse(media))
+}
+
+// uploadUserID extracts the authenticated user ID or writes the error
+// response.
+func uploadUserID(c *gin.Context) (string, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return "", false
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return "", false
+	}
+	return userIDStr, true
+}
+
+// uploadSessionError maps upload-session errors onto HTTP statuses.
+func uploadSessionError(c *gin.Context, err error) {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "not found"):
+		ErrorHandler(c, http.StatusNotFound, "Upload session not found")
+	case strings.Contains(msg, "not your upload"):
+		ErrorHandler(c, http.StatusForbidden, msg)
+	case strings.Contains(msg, "expired"):
+		ErrorHandler(c, http.StatusGone, msg)
+	case strings.Contains(msg, "offset mismatch"):
+		ErrorHandler(c, http.StatusConflict, msg)
+	case strings.Contains(msg, "unsupported") ||
+		strings.Contains(msg, "does not match") ||
+		strings.Contains(msg, "empty") ||
+		strings.Contains(msg, "limit") ||
+		strings.Contains(msg, "incomplete") ||
+		strings.Contains(msg, "declared total size") ||
+		strings.Contains(msg, "must be positive"):
+		ErrorHandler(c, http.StatusBadRequest, msg)
+	case strings.Contains(msg, "quota exceeded"):
+		ErrorHandler(c, http.StatusRequestEntityTooLarge, msg)
+	case strings.Contains(msg, "malware"):
+		ErrorHandler(c, http.StatusUnprocessableEntity, msg)
+	case strings.Contains(msg, "scan"):
+		ErrorHandler(c, http.StatusServiceUnavailable, "Upload could not be scanned, try again later")
+	default:
+		ErrorHandler(c, http.StatusInternalServerError, "Upload failed")
+	}
+}
+
+// BeginUploadHandler opens a chunked upload session for a large file.
+func (h *MediaHandler) BeginUploadHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.BeginUploadRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	session, err := h.mediaUsecase.BeginUpload(c.Request.Context(), userID, req.FileName, req.MimeType, req.TotalSize)
+	if err != nil {
+		uploadSessionError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, dto.ToUploadSessionResponse(session))
+}
+
+// UploadChunkHandler appends the request body as the next chunk of a
+// session. The Upload-Offset header must equal the bytes received so far.
+func (h *MediaHandler) UploadChunkHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		ErrorHandler(c, http.StatusBadRequest, "A non-negative Upload-Offset header is required")
+		return
+	}
+
+	chunk, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Failed to read chunk body")
+		return
+	}
+
+	session, err := h.mediaUsecase.AppendChunk(c.Request.Context(), userID, c.Param("sessionID"), offset, chunk)
+	if err != nil {
+		uploadSessionError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToUploadSessionResponse(session))
+}
+
+// GetUploadSessionHandler returns a session's progress so an interrupted
+// client can resume from the right offset.
+func (h *MediaHandler) GetUploadSessionHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	session, err := h.mediaUsecase.GetUploadSession(c.Request.Context(), userID, c.Param("sessionID"))
+	if err != nil {
+		uploadSessionError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToUploadSessionResponse(session))
+}
+
+// CompleteUploadHandler assembles a fully received session into the final
+// media object via the content-security pipeline.
+func (h *MediaHandler) CompleteUploadHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	media, err := h.mediaUsecase.CompleteUpload(c.Request.Context(), userID, c.Param("sessionID"))
+	if err != nil {
+		uploadSessionError(c, err)
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, dto.ToMediaResponse(media))
+}
+
+// AbortUploadHandler discards a session and its partial data.
+func (h *MediaHandler) AbortUploadHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.mediaUsecase.AbortUpload(c.Request.Context(), userID, c.Param("sessionID")); err != nil {
+		uploadSessionError(c, err)
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Upload session aborted")
+}
+
+// ListMediaHandler returns a page of the authenticated user's media library,
+// filtered by type, upload date range and whether the media is used by a
+// blog.
+func (h *MediaHandler) ListMediaHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	var mimeType *string
+	if v := c.Query("type"); v != "" {
+		mimeType = &v
+	}
+	var dateFrom, dateTo *time.Time
+	if v := c.Query("dateFrom"); v != "" {
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			ErrorHandler(c, http.StatusBadRequest, "Invalid dateFrom format. Use RFC3339 (e.g., 2025-08-06T15:04:05Z)")
+			return
+		}
+		dateFrom = &parsed
+	}
+	if v := c.Query("dateTo"); v != "" {
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			ErrorHandler(c, http.StatusBadRequest, "Invalid dateTo format. Use RFC3339 (e.g., 2025-08-06T15:04:05Z)")
+			return
+		}
+		dateTo = &parsed
+	}
+	var used *bool
+	if v := c.Query("used"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			ErrorHandler(c, http.StatusBadRequest, "Invalid used filter, expected true or false")
+			return
+		}
+		used = &parsed
+	}
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	media, total, currentPage, totalPages, err := h.mediaUsecase.ListMedia(c.Request.Context(), userID, mimeType, dateFrom, dateTo, used, page, pageSize)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list media")
+		return
+	}
+
+	resp := dto.PaginatedMediaResponse{
+		Media:       make([]dto.MediaResponse, 0, len(media)),
+		TotalCount:  total,
+		CurrentPage: currentPage,
+		TotalPages:  totalPages,
+	}
+	for _, m := range media {
+		resp.Media = append(resp.Media, dto.ToMediaResponse(m))
+	}
+	SuccessHandler(c, http.StatusOK, resp)
+}
+
+// BulkDeleteMediaHandler soft-deletes a batch of the user's media records.
+func (h *MediaHandler) BulkDeleteMediaHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.BulkDeleteMediaRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	deleted, err := h.mediaUsecase.BulkDeleteMedia(c.Request.Context(), userID, req.MediaIDs)
+	if err != nil {
+		if strings.Contains(err.Error(), "media ID") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to delete media")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"deleted_count": deleted})
+}
+
+// GetStorageUsageHandler reports the user's storage usage against the
+// configured quota, broken down by MIME type.
+func (h *MediaHandler) GetStorageUsageHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	total, quota, usage, err := h.mediaUsecase.GetStorageUsage(c.Request.Context(), userID)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to compute storage usage")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToStorageUsageResponse(total, quota, usage))
+}
+
+// GetMediaUsageHandler reports which blogs reference a media record.
+func (h *MediaHandler) GetMediaUsageHandler(c *gin.Context) {
+	userID, ok := uploadUserID(c)
+	if !ok {
+		return
+	}
+
+	media, blogs, err := h.mediaUsecase.GetMediaUsage(c.Request.Context(), userID, c.Param("mediaID"))
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Media not found")
+		case strings.Contains(err.Error(), "not your media"):
+			ErrorHandler(c, http.StatusForbidden, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to look up media usage")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToMediaUsageResponse(media, blogs))
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// maxWebhookPayloadBytes caps how much of a payments webhook is read.
+const maxWebhookPayloadBytes = 1 << 20
+
+// MembershipHandler exposes reader memberships: the viewer's own tier,
+// subscription checkout and the payments provider webhook.
+type MembershipHandler struct {
+	memberships usecase.IMembershipUseCase
+}
+
+func NewMembershipHandler(memberships usecase.IMembershipUseCase) *MembershipHandler {
+	return &MembershipHandler{
+		memberships: memberships,
+	}
+}
+
+// GetMyMembershipHandler returns the viewer's membership record.
+func (h *MembershipHandler) GetMyMembershipHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	membership, err := h.memberships.GetMembership(c.Request.Context(), userID.(string))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "No membership found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve membership")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, membership)
+}
+
+// SubscribeHandler starts a subscription checkout and returns the URL the
+// reader completes payment on.
+func (h *MembershipHandler) SubscribeHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.SubscribeRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	url, err := h.memberships.Subscribe(c.Request.Context(), userID.(string), req.Tier)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not configured"):
+			ErrorHandler(c, http.StatusServiceUnavailable, "Payments are not configured")
+		case strings.Contains(err.Error(), "invalid membership tier"):
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to start checkout")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"checkout_url": url})
+}
+
+// PaymentWebhookHandler applies a payments provider notification. The raw
+// payload is authenticated by its signature header, not by a user session.
+func (h *MembershipHandler) PaymentWebhookHandler(c *gin.Context) {
+	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookPayloadBytes))
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Failed to read webhook payload")
+		return
+	}
+
+	err = h.memberships.HandleWebhook(c.Request.Context(), payload, c.GetHeader("Stripe-Signature"))
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "invalid webhook signature"):
+			ErrorHandler(c, http.StatusUnauthorized, "Invalid webhook signature")
+		case strings.Contains(err.Error(), "not configured"):
+			ErrorHandler(c, http.StatusServiceUnavailable, "Payments are not configured")
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Subscription not found")
+		default:
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Webhook processed")
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// APIKeyHeader carries the plaintext key on programmatic requests.
+const APIKeyHeader = "X-API-Key"
+
+// ContextAPIKeyScopesKey holds the []string scopes of the authenticating API
+// key, when the request was authenticated by one.
+const ContextAPIKeyScopesKey = "apiKeyScopes"
+
+// APIKeyAuth authenticates requests carrying an X-API-Key header and populates
+// the same context keys as the bearer-token middleware, so API-key requests
+// pass through protected routes unchanged. Requests without the header fall
+// through untouched; read-only keys are rejected on write methods.
+func APIKeyAuth(apiKeyUseCase usecasecontract.IAPIKeyUseCase) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		rawKey := ctx.GetHeader(APIKeyHeader)
+		if rawKey == "" {
+			ctx.Next()
+			return
+		}
+
+		key, user, err := apiKeyUseCase.Authenticate(ctx.Request.Context(), rawKey)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
+			return
+		}
+
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			if !key.HasScope(entity.APIKeyScopeWrite) {
+				ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "API key lacks write scope"})
+				return
+			}
+		}
+
+		ctx.Set(ContextUserIDKey, user.ID)
+		ctx.Set(ContextUserRoleKey, user.Role)
+		ctx.Set(ContextAPIKeyScopesKey, key.Scopes)
+
+		ctx.Next()
+	}
+}
@@ -5,12 +5,55 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"github.com/mikiasgoitom/Articulate/internal/usecase"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
+// Context keys set by the auth middlewares. Handlers must read them through
+// UserIDFromContext/UserRoleFromContext rather than raw gin keys.
+const (
+	ContextUserIDKey   = "userID"
+	ContextUserRoleKey = "userRole"
+)
+
+// UserIDFromContext returns the authenticated user's ID from the request
+// context, or false when the request is anonymous.
+func UserIDFromContext(c *gin.Context) (string, bool) {
+	value, exists := c.Get(ContextUserIDKey)
+	if !exists {
+		return "", false
+	}
+	userID, ok := value.(string)
+	if !ok || userID == "" {
+		return "", false
+	}
+	return userID, true
+}
+
+// UserRoleFromContext returns the authenticated user's role from the request
+// context, or false when the request is anonymous.
+func UserRoleFromContext(c *gin.Context) (entity.UserRole, bool) {
+	value, exists := c.Get(ContextUserRoleKey)
+	if !exists {
+		return "", false
+	}
+	role, ok := value.(entity.UserRole)
+	if !ok {
+		return "", false
+	}
+	return role, true
+}
+
 func AuthMiddleWare(jwtService usecase.JWTService, userUseCase usecasecontract.IUserUseCase) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		// An earlier middleware (e.g. APIKeyAuth) may have already
+		// authenticated the request.
+		if _, ok := UserIDFromContext(ctx); ok {
+			ctx.Next()
+			return
+		}
+
 		authHeader := ctx.GetHeader("Authorization")
 		if authHeader == "" {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
@@ -29,9 +72,56 @@ func AuthMiddleWare(jwtService usecase.JWTService, userUseCase usecasecontract.I
 			return
 		}
 
-		ctx.Set("userID", claims.UserID)
-		ctx.Set("userRole", claims.Role)
+		ctx.Set(ContextUserIDKey, claims.UserID)
+		ctx.Set(ContextUserRoleKey, claims.Role)
+
+		ctx.Next()
+	}
+}
+
+// OptionalAuth populates the auth context keys when a valid bearer token is
+// provided but never rejects the request. It lets public endpoints personalize
+// responses (IsLiked, view attribution, shadow-ban masking) for logged-in
+// users while staying accessible to anonymous visitors.
+func OptionalAuth(jwtService usecase.JWTService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authHeader := ctx.GetHeader("Authorization")
+		if authHeader == "" {
+			ctx.Next()
+			return
+		}
+		parts := strings.Split(authHeader, " ")
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			ctx.Next()
+			return
+		}
+
+		claims, err := jwtService.ParseAccessToken(parts[1])
+		if err != nil {
+			ctx.Next()
+			return
+		}
+
+		ctx.Set(ContextUserIDKey, claims.UserID)
+		ctx.Set(ContextUserRoleKey, claims.Role)
+
+		ctx.Next()
+	}
+}
 
+// AdminOnly ensures the authenticated user has the admin role.
+// It must be used after AuthMiddleWare.
+func AdminOnly() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		role, exists := ctx.Get(ContextUserRoleKey)
+		if !exists {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "User role not found"})
+			return
+		}
+		if userRole, ok := role.(entity.UserRole); !ok || userRole != entity.UserRoleAdmin {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Admin access required"})
+			return
+		}
 		ctx.Next()
 	}
 }
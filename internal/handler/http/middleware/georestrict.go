@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// GeoRestrictionChecker answers whether a blog may be served to a country.
+// It is the subset of the geo restriction usecase the middleware needs.
+type GeoRestrictionChecker interface {
+	IsSlugBlockedForCountry(ctx context.Context, slug, countryCode string) bool
+}
+
+// GeoRestrict blocks blog reads that are geo-restricted for the requester's
+// country (legal takedowns), resolved from the client IP. Requests whose
+// location cannot be resolved fail open: a resolver outage never takes
+// content down globally.
+func GeoRestrict(restrictions GeoRestrictionChecker, resolver contract.IGeoIPResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		slug := c.Param("slug")
+		if slug == "" {
+			c.Next()
+			return
+		}
+
+		location, err := resolver.ResolveIP(c.Request.Context(), c.ClientIP())
+		if err != nil || location == nil || location.CountryCode == "" {
+			c.Next()
+			return
+		}
+
+		if restrictions.IsSlugBlockedForCountry(c.Request.Context(), slug, location.CountryCode) {
+			c.AbortWithStatusJSON(http.StatusUnavailableForLegalReasons, gin.H{
+				"error": "This content is not available in your country for legal reasons.",
+			})
+			return
+		}
+		c.Next()
+	}
+}
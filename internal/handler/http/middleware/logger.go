@@ -1 +1,259 @@
 package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxAuditBodyBytes caps how much of a request or response body is captured
+// per exchange; anything beyond it is truncated, not dropped.
+const maxAuditBodyBytes = 4096
+
+// AuditEntry is one captured request/response exchange. Bodies are stored
+// after redaction only.
+type AuditEntry struct {
+	Method       string    `json:"method"`
+	Path         string    `json:"path"`
+	Status       int       `json:"status"`
+	ClientIP     string    `json:"client_ip"`
+	UserID       string    `json:"user_id,omitempty"`
+	RequestBody  string    `json:"request_body,omitempty"`
+	ResponseBody string    `json:"response_body,omitempty"`
+	DurationMS   int64     `json:"duration_ms"`
+	At           time.Time `json:"at"`
+}
+
+// AuditSink receives every captured entry, for forwarding to an external log
+// pipeline. Implementations must be safe for concurrent use.
+type AuditSink interface {
+	Write(entry AuditEntry)
+}
+
+// AuditLog holds the most recent captured exchanges in a fixed-size ring
+// buffer, so an incident can be debugged from recent traffic without an
+// external log store. Capture is limited to the configured route prefixes.
+type AuditLog struct {
+	mu       sync.Mutex
+	entries  []AuditEntry
+	next     int
+	filled   bool
+	prefixes []string
+	sink     AuditSink
+}
+
+// NewAuditLog creates a ring buffer of the given size capturing requests
+// whose path starts with any of the given prefixes.
+func NewAuditLog(size int, routePrefixes []string) *AuditLog {
+	prefixes := make([]string, 0, len(routePrefixes))
+	for _, p := range routePrefixes {
+		if p = strings.TrimSpace(p); p != "" {
+			prefixes = append(prefixes, p)
+		}
+	}
+	return &AuditLog{
+		entries:  make([]AuditEntry, size),
+		prefixes: prefixes,
+	}
+}
+
+// SetSink forwards every captured entry to an external sink as well.
+func (l *AuditLog) SetSink(sink AuditSink) {
+	l.sink = sink
+}
+
+// shouldCapture reports whether the path is covered by a configured prefix.
+func (l *AuditLog) shouldCapture(path string) bool {
+	for _, prefix := range l.prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// record appends the entry to the ring buffer, overwriting the oldest entry
+// once the buffer is full, and forwards it to the sink when one is set.
+func (l *AuditLog) record(entry AuditEntry) {
+	l.mu.Lock()
+	l.entries[l.next] = entry
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.filled = true
+	}
+	l.mu.Unlock()
+
+	if l.sink != nil {
+		l.sink.Write(entry)
+	}
+}
+
+// Entries returns the captured exchanges, newest first.
+func (l *AuditLog) Entries() []AuditEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := l.next
+	if l.filled {
+		count = len(l.entries)
+	}
+	out := make([]AuditEntry, 0, count)
+	for i := 1; i <= count; i++ {
+		out = append(out, l.entries[(l.next-i+len(l.entries))%len(l.entries)])
+	}
+	return out
+}
+
+// redactedKeys are JSON field names whose values are always replaced before
+// an exchange is stored, matched case-insensitively.
+var redactedKeys = map[string]struct{}{
+	"password":      {},
+	"old_password":  {},
+	"new_password":  {},
+	"password_hash": {},
+	"token":         {},
+	"access_token":  {},
+	"refresh_token": {},
+	"reset_token":   {},
+	"captcha_token": {},
+	"verifier":      {},
+	"api_key":       {},
+	"secret":        {},
+	"email":         {},
+	"guest_email":   {},
+}
+
+// emailPattern matches email addresses embedded in free-form string values.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// sensitiveKeyPattern matches `"key": value` pairs for the redacted keys in
+// bodies that fail JSON parsing — typically a JSON prefix cut at
+// maxAuditBodyBytes — including a string value cut off before its closing
+// quote.
+var sensitiveKeyPattern = func() *regexp.Regexp {
+	keys := make([]string, 0, len(redactedKeys))
+	for key := range redactedKeys {
+		keys = append(keys, key)
+	}
+	return regexp.MustCompile(`(?i)"(` + strings.Join(keys, "|") + `)"\s*:\s*("(?:\\.|[^"\\])*"?|[^,}\]\s]+)`)
+}()
+
+const redactedPlaceholder = "[REDACTED]"
+
+// redactValue walks a decoded JSON value, replacing sensitive field values
+// and any email address found inside strings.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if _, sensitive := redactedKeys[strings.ToLower(key)]; sensitive {
+				v[key] = redactedPlaceholder
+				continue
+			}
+			v[key] = redactValue(inner)
+		}
+		return v
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+		return v
+	case string:
+		return emailPattern.ReplaceAllString(v, redactedPlaceholder)
+	default:
+		return v
+	}
+}
+
+// redactBody redacts a captured body. JSON bodies are redacted field-aware;
+// anything else falls back to pattern redaction. The result is truncated to
+// maxAuditBodyBytes.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		if encoded, err := json.Marshal(redactValue(decoded)); err == nil {
+			body = encoded
+		}
+	} else {
+		// Unparseable bodies still get sensitive pairs scrubbed by pattern,
+		// so a truncated JSON capture never stores a credential verbatim.
+		body = sensitiveKeyPattern.ReplaceAll(body, []byte(`"$1":"`+redactedPlaceholder+`"`))
+		body = []byte(emailPattern.ReplaceAllString(string(body), redactedPlaceholder))
+	}
+
+	if len(body) > maxAuditBodyBytes {
+		body = body[:maxAuditBodyBytes]
+	}
+	return string(body)
+}
+
+// auditResponseWriter tees the first maxAuditBodyBytes of the response body
+// into a buffer while the full response is written to the client, so large
+// downloads are not duplicated in memory.
+type auditResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if remaining := maxAuditBodyBytes - w.body.Len(); remaining > 0 {
+		if len(b) > remaining {
+			w.body.Write(b[:remaining])
+		} else {
+			w.body.Write(b)
+		}
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// AuditLogger captures request/response bodies on the audit log's configured
+// routes, with passwords, tokens and emails redacted before anything is
+// stored. Routes outside the configuration pass through untouched.
+func AuditLogger(log *AuditLog) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !log.shouldCapture(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		// Capture only the audited prefix of the request body; the rest
+		// streams through to the handler untouched, so large uploads on
+		// audited routes are not duplicated in memory.
+		var requestBody []byte
+		if c.Request.Body != nil {
+			requestBody, _ = io.ReadAll(io.LimitReader(c.Request.Body, maxAuditBodyBytes))
+			c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(requestBody), c.Request.Body))
+		}
+
+		writer := &auditResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		start := time.Now()
+		c.Next()
+
+		entry := AuditEntry{
+			Method:       c.Request.Method,
+			Path:         c.Request.URL.Path,
+			Status:       writer.Status(),
+			ClientIP:     c.ClientIP(),
+			RequestBody:  redactBody(requestBody),
+			ResponseBody: redactBody(writer.body.Bytes()),
+			DurationMS:   time.Since(start).Milliseconds(),
+			At:           start,
+		}
+		if userID, ok := UserIDFromContext(c); ok {
+			entry.UserID = userID
+		}
+		log.record(entry)
+	}
+}
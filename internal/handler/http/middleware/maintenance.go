@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// Feature flags controlling maintenance mode at runtime.
+const (
+	// MaintenanceFlag blocks write endpoints while enabled.
+	MaintenanceFlag = "maintenance_mode"
+	// MaintenanceAllFlag blocks every endpoint, not just writes.
+	MaintenanceAllFlag = "maintenance_mode_all"
+)
+
+// MaintenanceMode rejects requests with a 503 JSON notice while maintenance
+// is on. By default only write methods are blocked; the all-endpoints flag
+// extends the block to reads. Metrics, login and admin routes stay reachable
+// so operators can monitor the service and turn maintenance off again.
+// defaultOn seeds the behavior while the flag is undefined (from config).
+func MaintenanceMode(flags contract.IFeatureFlags, defaultOn bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		if strings.HasSuffix(path, "/metrics") ||
+			strings.HasPrefix(path, "/api/v1/admin") ||
+			path == "/api/v1/auth/login" {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		blockAll := flags.IsEnabled(ctx, MaintenanceAllFlag, false)
+		blockWrites := blockAll || flags.IsEnabled(ctx, MaintenanceFlag, defaultOn)
+
+		isWrite := true
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			isWrite = false
+		}
+
+		if blockAll || (blockWrites && isWrite) {
+			c.Header("Retry-After", "300")
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":       "Service is under maintenance, please try again later.",
+				"maintenance": true,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	mathrand "math/rand/v2"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/tasks"
+)
+
+// requestIDKey is the gin context key the request's correlation ID is stored
+// under.
+const requestIDKey = "requestID"
+
+// RequestIDFromContext returns the request's correlation ID, set by the
+// ErrorReporting middleware.
+func RequestIDFromContext(c *gin.Context) (string, bool) {
+	id, ok := c.Get(requestIDKey)
+	if !ok {
+		return "", false
+	}
+	requestID, ok := id.(string)
+	return requestID, ok && requestID != ""
+}
+
+// newRequestID generates a short random correlation ID for requests that
+// didn't arrive with one.
+func newRequestID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
+
+// ErrorReporting recovers panics and reports them — along with sampled 5xx
+// responses — to the configured error reporter. Every request gets a
+// correlation ID (taken from X-Request-ID or generated) that is echoed back
+// to the client and attached to reports, so an incident report can be tied
+// to the exact request. sampleRate in (0,1] thins the 5xx stream for busy
+// deployments; panics are always reported.
+func ErrorReporting(reporter contract.IErrorReporter, sampleRate float64) gin.HandlerFunc {
+	if sampleRate <= 0 || sampleRate > 1 {
+		sampleRate = 1
+	}
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-ID")
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		c.Set(requestIDKey, requestID)
+		c.Writer.Header().Set("X-Request-ID", requestID)
+
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				report(c, reporter, &contract.ErrorReport{
+					Kind:    "panic",
+					Message: scrubMessage(fmt.Sprintf("%v", recovered)),
+					Stack:   string(debug.Stack()),
+					Status:  http.StatusInternalServerError,
+				})
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error":      "Internal server error",
+					"request_id": requestID,
+				})
+			}
+		}()
+
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusInternalServerError && mathrand.Float64() < sampleRate {
+			message := http.StatusText(c.Writer.Status())
+			if len(c.Errors) > 0 {
+				message = c.Errors.String()
+			}
+			report(c, reporter, &contract.ErrorReport{
+				Kind:    "http_5xx",
+				Message: scrubMessage(message),
+				Status:  c.Writer.Status(),
+			})
+		}
+	}
+}
+
+// report fills in the request identity and hands the report off without
+// blocking the response.
+func report(c *gin.Context, reporter contract.IErrorReporter, errReport *contract.ErrorReport) {
+	errReport.Route = c.FullPath()
+	if errReport.Route == "" {
+		errReport.Route = c.Request.URL.Path
+	}
+	errReport.Method = c.Request.Method
+	if requestID, ok := RequestIDFromContext(c); ok {
+		errReport.RequestID = requestID
+	}
+	if userID, ok := UserIDFromContext(c); ok {
+		errReport.UserID = userID
+	}
+	// The request context dies with the response; delivery gets its own.
+	tasks.Go(func() {
+		reporter.Report(context.Background(), errReport)
+	})
+}
+
+// scrubMessage removes sensitive data that may be embedded in panic values
+// or error strings before they leave the process.
+func scrubMessage(message string) string {
+	return emailPattern.ReplaceAllString(message, redactedPlaceholder)
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// Context keys set by ServiceAuth.
+const (
+	ContextServiceClientIDKey = "serviceClientID"
+	ContextServiceScopesKey   = "serviceScopes"
+)
+
+// ServiceAuth authenticates internal-service requests carrying a bearer
+// machine token from the client-credentials flow. User tokens are rejected;
+// scope enforcement is left to RequireServiceScope per endpoint.
+func ServiceAuth(serviceAuth usecasecontract.IServiceAuthUseCase) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authHeader := ctx.GetHeader("Authorization")
+		if authHeader == "" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
+			return
+		}
+		parts := strings.Split(authHeader, " ")
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid Authorization header format"})
+			return
+		}
+
+		claims, err := serviceAuth.ParseToken(parts[1])
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			return
+		}
+
+		ctx.Set(ContextServiceClientIDKey, claims.Subject)
+		ctx.Set(ContextServiceScopesKey, claims.Scopes)
+
+		ctx.Next()
+	}
+}
+
+// RequireServiceScope ensures the machine token carries the given scope.
+// It must be used after ServiceAuth.
+func RequireServiceScope(scope string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		value, exists := ctx.Get(ContextServiceScopesKey)
+		if !exists {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Service authentication required"})
+			return
+		}
+		scopes, ok := value.([]string)
+		if !ok || !hasScope(scopes, scope) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Token lacks scope " + scope})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
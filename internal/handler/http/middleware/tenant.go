@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ContextTenantOrgKey is the context key holding the organization resolved
+// from the request's Host header, when the host is a mapped custom domain.
+const ContextTenantOrgKey = "tenantOrg"
+
+// tenantCacheTTL bounds how long a resolved (or unresolved) host is reused
+// before hitting the database again.
+const tenantCacheTTL = 5 * time.Minute
+
+type cachedTenant struct {
+	org     *entity.Organization // nil means the host is not a custom domain
+	expires time.Time
+}
+
+// TenantFromContext returns the organization the request's host resolved to,
+// if the request came in on a mapped custom domain.
+func TenantFromContext(c *gin.Context) (*entity.Organization, bool) {
+	value, exists := c.Get(ContextTenantOrgKey)
+	if !exists {
+		return nil, false
+	}
+	org, ok := value.(*entity.Organization)
+	return org, ok && org != nil
+}
+
+// TenantResolver resolves the request's Host header to an organization with a
+// mapped custom domain and stores it in the request context. Unknown hosts
+// (including the main site's own domain) pass through unscoped; resolution
+// results are cached briefly so the lookup does not hit the database on every
+// request.
+func TenantResolver(orgRepo contract.IOrganizationRepository) gin.HandlerFunc {
+	var mu sync.Mutex
+	cache := make(map[string]cachedTenant)
+
+	return func(c *gin.Context) {
+		host := strings.ToLower(c.Request.Host)
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if host == "" {
+			c.Next()
+			return
+		}
+
+		mu.Lock()
+		entry, ok := cache[host]
+		mu.Unlock()
+
+		if !ok || time.Now().After(entry.expires) {
+			org, err := orgRepo.GetOrganizationByDomain(c.Request.Context(), host)
+			if err != nil {
+				org = nil // not a custom domain (or lookup failed): serve unscoped
+			}
+			entry = cachedTenant{org: org, expires: time.Now().Add(tenantCacheTTL)}
+			mu.Lock()
+			cache[host] = entry
+			mu.Unlock()
+		}
+
+		if entry.org != nil {
+			c.Set(ContextTenantOrgKey, entry.org)
+		}
+		c.Next()
+	}
+}
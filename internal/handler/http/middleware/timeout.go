@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Per-route request deadlines. Most endpoints should answer well within the
+// default; AI assistant routes talk to slow backends and upload routes must
+// accommodate large bodies on slow links. Streaming routes hold the
+// connection open by design and get no deadline at all.
+const (
+	DefaultRequestTimeout = 5 * time.Second
+	AIRequestTimeout      = 60 * time.Second
+	UploadRequestTimeout  = 2 * time.Minute
+)
+
+// Deadline overrides keyed by the matched route template, so a rename or a
+// new route never silently inherits the wrong budget the way substring
+// matching on the raw path would.
+var (
+	// streamingRoutes hold the connection open (SSE); a deadline would cut
+	// the stream mid-session.
+	streamingRoutes = map[string]struct{}{
+		"/api/v1/blogs/:blogID/live": {},
+	}
+
+	// aiRoutes wait on the AI backend.
+	aiRoutes = map[string]struct{}{
+		"/api/v1/ai/outline":        {},
+		"/api/v1/ai/expand-section": {},
+		"/api/v1/ai/rewrite-tone":   {},
+	}
+
+	// uploadRoutes receive or move large bodies: direct media uploads, the
+	// resumable chunk protocol and bulk imports.
+	uploadRoutes = map[string]struct{}{
+		"/api/v1/me/media":                       {},
+		"/api/v1/me/media/bulk-delete":           {},
+		"/api/v1/me/media/:mediaID/usage":        {},
+		"/api/v1/me/uploads":                     {},
+		"/api/v1/me/uploads/:sessionID":          {},
+		"/api/v1/me/uploads/:sessionID/complete": {},
+		"/api/v1/admin/users/import":             {},
+	}
+)
+
+// timeoutFor picks the deadline for a matched route; ok is false for
+// streaming routes, which run without one.
+func timeoutFor(route string) (time.Duration, bool) {
+	if _, streaming := streamingRoutes[route]; streaming {
+		return 0, false
+	}
+	if _, ok := aiRoutes[route]; ok {
+		return AIRequestTimeout, true
+	}
+	if _, ok := uploadRoutes[route]; ok {
+		return UploadRequestTimeout, true
+	}
+	return DefaultRequestTimeout, true
+}
+
+// RequestTimeout attaches a per-route deadline to the request context, so
+// downstream Mongo, Redis and AI calls are cancelled when a dependency gets
+// stuck instead of holding the connection open. If the handler has not
+// written a response by the deadline, the client gets a 504.
+func RequestTimeout() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			// Unmatched request; gin will 404 it, no deadline needed.
+			c.Next()
+			return
+		}
+		timeout, ok := timeoutFor(route)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		if ctx.Err() == context.DeadlineExceeded && !c.Writer.Written() {
+			c.AbortWithStatusJSON(http.StatusGatewayTimeout, gin.H{
+				"error": "The request timed out, please try again later.",
+			})
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package mocks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// MockCommentUsecase is a mock implementation of the comment usecase interface
+type MockCommentUsecase struct {
+	// Control mock behavior
+	ShouldFailCreateComment bool
+	ShouldFailGetComment    bool
+	ShouldFailUpdateComment bool
+	ShouldFailDeleteComment bool
+	ShouldFailListComments  bool
+
+	// LastUserID records the userID the handler forwarded on the last read
+	// call, so tests can verify anonymous vs authenticated personas.
+	LastUserID *string
+
+	// Return values
+	MockComment dto.CommentResponse
+}
+
+// Ensure MockCommentUsecase implements the interface used by handler.NewCommentHandler
+var _ usecasecontract.ICommentUseCase = (*MockCommentUsecase)(nil)
+
+func NewMockCommentUsecase() *MockCommentUsecase {
+	return &MockCommentUsecase{
+		MockComment: dto.CommentResponse{
+			ID:         "mock-comment-id",
+			BlogID:     "mock-blog-id",
+			Type:       "comment",
+			AuthorID:   "mock-user-id",
+			AuthorName: "testuser",
+			Content:    "mock comment content",
+			Status:     "approved",
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		},
+	}
+}
+
+// commentFor returns the mock comment personalized for the given viewer:
+// authenticated viewers see IsLiked set, anonymous viewers do not.
+func (m *MockCommentUsecase) commentFor(userID *string) *dto.CommentResponse {
+	m.LastUserID = userID
+	comment := m.MockComment
+	comment.IsLiked = userID != nil
+	return &comment
+}
+
+func (m *MockCommentUsecase) CreateComment(ctx context.Context, req dto.CreateCommentRequest, userID, blogID string) (*dto.CommentResponse, error) {
+	if m.ShouldFailCreateComment {
+		return nil, errors.New("blog not found")
+	}
+	comment := m.MockComment
+	comment.AuthorID = userID
+	comment.BlogID = blogID
+	comment.Content = req.Content
+	return &comment, nil
+}
+
+func (m *MockCommentUsecase) CreateGuestComment(ctx context.Context, req dto.CreateGuestCommentRequest, blogID string) (*dto.CommentResponse, error) {
+	if m.ShouldFailCreateComment {
+		return nil, errors.New("blog not found")
+	}
+	comment := m.MockComment
+	comment.AuthorID = ""
+	comment.AuthorName = req.DisplayName
+	comment.IsGuest = true
+	comment.BlogID = blogID
+	comment.Content = req.Content
+	comment.Status = "pending"
+	return &comment, nil
+}
+
+func (m *MockCommentUsecase) GetComment(ctx context.Context, commentID string, userID *string) (*dto.CommentResponse, error) {
+	if m.ShouldFailGetComment {
+		return nil, errors.New("comment not found")
+	}
+	return m.commentFor(userID), nil
+}
+
+func (m *MockCommentUsecase) UpdateComment(ctx context.Context, commentID, userID string, req dto.UpdateCommentRequest) (*dto.CommentResponse, error) {
+	if m.ShouldFailUpdateComment {
+		return nil, errors.New("comment not found")
+	}
+	comment := m.MockComment
+	comment.Content = req.Content
+	return &comment, nil
+}
+
+func (m *MockCommentUsecase) DeleteComment(ctx context.Context, commentID, userID string) error {
+	if m.ShouldFailDeleteComment {
+		return errors.New("comment not found")
+	}
+	return nil
+}
+
+func (m *MockCommentUsecase) GetBlogComments(ctx context.Context, blogID string, page, pageSize int, userID *string) (*dto.CommentsResponse, error) {
+	if m.ShouldFailListComments {
+		return nil, errors.New("failed to get comments")
+	}
+	return &dto.CommentsResponse{
+		Comments: []*dto.CommentResponse{m.commentFor(userID)},
+		Pagination: dto.PaginationMeta{
+			CurrentPage: page,
+			PageSize:    pageSize,
+			TotalItems:  1,
+		},
+	}, nil
+}
+
+func (m *MockCommentUsecase) GetCommentThread(ctx context.Context, commentID string, userID *string) (*dto.CommentThreadResponse, error) {
+	if m.ShouldFailGetComment {
+		return nil, errors.New("comment not found")
+	}
+	return &dto.CommentThreadResponse{
+		Comment: m.commentFor(userID),
+		Replies: []*dto.CommentThreadResponse{},
+		Depth:   1,
+	}, nil
+}
+
+func (m *MockCommentUsecase) GetUserComments(ctx context.Context, userID string, page, pageSize int) (*dto.CommentsResponse, error) {
+	if m.ShouldFailListComments {
+		return nil, errors.New("failed to get comments")
+	}
+	comment := m.MockComment
+	return &dto.CommentsResponse{
+		Comments: []*dto.CommentResponse{&comment},
+		Pagination: dto.PaginationMeta{
+			CurrentPage: page,
+			PageSize:    pageSize,
+			TotalItems:  1,
+		},
+	}, nil
+}
+
+func (m *MockCommentUsecase) GetBlogCommentsCount(ctx context.Context, blogID string) (int64, error) {
+	if m.ShouldFailListComments {
+		return 0, errors.New("blog not found")
+	}
+	return 1, nil
+}
+
+func (m *MockCommentUsecase) BulkModerateComments(ctx context.Context, moderatorID string, isAdmin bool, commentIDs []string, action, reason string) (int64, error) {
+	return int64(len(commentIDs)), nil
+}
+
+func (m *MockCommentUsecase) UpdateCommentStatus(ctx context.Context, commentID, moderatorID string, req dto.UpdateCommentStatusRequest) error {
+	if m.ShouldFailUpdateComment {
+		return errors.New("comment not found")
+	}
+	return nil
+}
+
+func (m *MockCommentUsecase) LikeComment(ctx context.Context, commentID, userID string) error {
+	return nil
+}
+
+func (m *MockCommentUsecase) UnlikeComment(ctx context.Context, commentID, userID string) error {
+	return nil
+}
+
+func (m *MockCommentUsecase) ReactToComment(ctx context.Context, commentID, userID, reaction string) error {
+	return nil
+}
+
+func (m *MockCommentUsecase) RemoveCommentReaction(ctx context.Context, commentID, userID, reaction string) error {
+	return nil
+}
+
+func (m *MockCommentUsecase) ReportComment(ctx context.Context, commentID, userID string, req dto.ReportCommentRequest) error {
+	return nil
+}
+
+func (m *MockCommentUsecase) GetCommentReports(ctx context.Context, page, pageSize int) (*dto.ReportsResponse, error) {
+	return &dto.ReportsResponse{
+		Reports: []*dto.CommentReportResponse{},
+		Pagination: dto.PaginationMeta{
+			CurrentPage: page,
+			PageSize:    pageSize,
+		},
+	}, nil
+}
+
+func (m *MockCommentUsecase) UpdateReportStatus(ctx context.Context, reportID, reviewerID string, status string, commentAction string) error {
+	return nil
+}
+
+func (m *MockCommentUsecase) GetCommentModerationHistory(ctx context.Context, commentID string) ([]*entity.ModerationAction, error) {
+	return []*entity.ModerationAction{}, nil
+}
+
+func (m *MockCommentUsecase) GetUserModerationHistory(ctx context.Context, userID string) ([]*entity.ModerationAction, error) {
+	return []*entity.ModerationAction{}, nil
+}
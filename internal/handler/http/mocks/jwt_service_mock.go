@@ -0,0 +1,72 @@
+package mocks
+
+import (
+	"errors"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// MockAccessToken is the only access token MockJWTService accepts.
+const MockAccessToken = "mock_valid_access_token"
+
+// MockJWTService is a mock implementation of the JWT service for handler tests.
+// It accepts MockAccessToken and rejects everything else.
+type MockJWTService struct {
+	MockUserID string
+	MockRole   entity.UserRole
+}
+
+var _ usecase.JWTService = (*MockJWTService)(nil)
+
+func NewMockJWTService() *MockJWTService {
+	return &MockJWTService{
+		MockUserID: "mock-user-id",
+		MockRole:   entity.UserRoleUser,
+	}
+}
+
+func (m *MockJWTService) claims() *entity.Claims {
+	return &entity.Claims{
+		UserID: m.MockUserID,
+		Role:   m.MockRole,
+	}
+}
+
+func (m *MockJWTService) GenerateAccessToken(userID string, role entity.UserRole, clientType entity.ClientType) (string, error) {
+	return MockAccessToken, nil
+}
+
+func (m *MockJWTService) GenerateRefreshToken(userID string, role entity.UserRole, clientType entity.ClientType, rememberMe bool) (string, error) {
+	return "mock_refresh_token", nil
+}
+
+func (m *MockJWTService) ParseAccessToken(token string) (*entity.Claims, error) {
+	if token != MockAccessToken {
+		return nil, errors.New("invalid token")
+	}
+	return m.claims(), nil
+}
+
+func (m *MockJWTService) ParseRefreshToken(token string) (*entity.Claims, error) {
+	if token != "mock_refresh_token" {
+		return nil, errors.New("invalid token")
+	}
+	return m.claims(), nil
+}
+
+func (m *MockJWTService) GeneratePasswordResetToken(userID string) (string, error) {
+	return "mock_password_reset_token", nil
+}
+
+func (m *MockJWTService) ParsePasswordResetToken(token string) (*entity.Claims, error) {
+	return m.claims(), nil
+}
+
+func (m *MockJWTService) GenerateEmailVerificationToken(userID string) (string, error) {
+	return "mock_email_verification_token", nil
+}
+
+func (m *MockJWTService) ParseEmailVerificationToken(token string) (*entity.Claims, error) {
+	return m.claims(), nil
+}
@@ -11,19 +11,23 @@ import (
 // MockUserUsecase is a mock implementation of the UserUsecase interface
 type MockUserUsecase struct {
 	// Control mock behavior
-	ShouldFailCreateUser     bool
-	ShouldFailVerifyEmail    bool
-	ShouldFailLogin          bool
-	ShouldFailGetByID        bool
-	ShouldFailUpdateUser     bool
-	ShouldFailForgotPassword bool
-	ShouldFailResetPassword  bool
-	ShouldFailRefreshToken   bool
-	ShouldFailLogout         bool
-	ShouldFailAuthenticate   bool
-	ShouldFailPromoteUser    bool
-	ShouldFailDemoteUser     bool
-	ShouldFailLoginWithOAuth bool
+	ShouldFailCreateUser        bool
+	ShouldFailVerifyEmail       bool
+	ShouldFailLogin             bool
+	ShouldFailGetByID           bool
+	ShouldFailUpdateUser        bool
+	ShouldFailForgotPassword    bool
+	ShouldFailResetPassword     bool
+	ShouldFailRefreshToken      bool
+	ShouldFailLogout            bool
+	ShouldFailAuthenticate      bool
+	ShouldFailPromoteUser       bool
+	ShouldFailDemoteUser        bool
+	ShouldFailUpdateUserRole    bool
+	ShouldFailLoginWithOAuth    bool
+	ShouldFailSetShadowBan      bool
+	ShouldFailListShadowBanned  bool
+	ShouldFailGetSecurityEvents bool
 
 	// Return values
 	MockUser         entity.User
@@ -47,13 +51,17 @@ func NewMockUserUsecase() *MockUserUsecase {
 	}
 }
 
-func (m *MockUserUsecase) Register(ctx context.Context, username, email, password, firstName, lastName string) (*entity.User, error) {
+func (m *MockUserUsecase) Register(ctx context.Context, username, email, password, firstName, lastName, referralCode, ipAddress, userAgent string) (*entity.User, error) {
 	if m.ShouldFailCreateUser {
 		return nil, errors.New("user creation failed")
 	}
 	return &m.MockUser, nil
 }
 
+func (m *MockUserUsecase) GetMyReferrals(ctx context.Context, userID string) (string, []*entity.User, error) {
+	return m.MockUser.ReferralCode, nil, nil
+}
+
 func (m *MockUserUsecase) VerifyEmail(ctx context.Context, token string) error {
 	if m.ShouldFailVerifyEmail {
 		return errors.New("email verification failed")
@@ -61,7 +69,7 @@ func (m *MockUserUsecase) VerifyEmail(ctx context.Context, token string) error {
 	return nil
 }
 
-func (m *MockUserUsecase) Login(ctx context.Context, email, password string) (*entity.User, string, string, error) {
+func (m *MockUserUsecase) Login(ctx context.Context, email, password, ipAddress, userAgent string, clientType entity.ClientType, rememberMe bool) (*entity.User, string, string, error) {
 	if m.ShouldFailLogin {
 		return nil, "", "", errors.New("login failed")
 	}
@@ -135,6 +143,54 @@ func (m *MockUserUsecase) DemoteUser(ctx context.Context, userID string) (*entit
 	return &user, nil
 }
 
+func (m *MockUserUsecase) UpdateUserRole(ctx context.Context, userID, actorID string, role entity.UserRole) (*entity.User, error) {
+	if m.ShouldFailUpdateUserRole {
+		return nil, errors.New("failed to update user role")
+	}
+	user := m.MockUser
+	user.Role = role
+	return &user, nil
+}
+
+func (m *MockUserUsecase) SetShadowBan(ctx context.Context, userID string, banned bool) (*entity.User, error) {
+	if m.ShouldFailSetShadowBan {
+		return nil, errors.New("shadow ban update failed")
+	}
+	user := m.MockUser
+	user.IsShadowBanned = banned
+	return &user, nil
+}
+
+func (m *MockUserUsecase) SetActive(ctx context.Context, userID string, active bool) (*entity.User, error) {
+	user := m.MockUser
+	user.IsActive = active
+	return &user, nil
+}
+
+func (m *MockUserUsecase) DeleteUser(ctx context.Context, userID string) error {
+	return nil
+}
+
+func (m *MockUserUsecase) ListShadowBannedUsers(ctx context.Context) ([]*entity.User, error) {
+	if m.ShouldFailListShadowBanned {
+		return nil, errors.New("listing shadow banned users failed")
+	}
+	user := m.MockUser
+	user.IsShadowBanned = true
+	return []*entity.User{&user}, nil
+}
+
+func (m *MockUserUsecase) GetSecurityEvents(ctx context.Context, userID string, page, pageSize int) ([]*entity.SecurityEvent, int64, error) {
+	if m.ShouldFailGetSecurityEvents {
+		return nil, 0, errors.New("retrieving security events failed")
+	}
+	return []*entity.SecurityEvent{}, 0, nil
+}
+
+func (m *MockUserUsecase) GetActiveSessions(ctx context.Context, userID string) ([]*entity.Token, error) {
+	return []*entity.Token{}, nil
+}
+
 func (m *MockUserUsecase) LoginWithOAuth(ctx context.Context, firstName, lastName, email string) (string, string, error) {
 	if m.ShouldFailLoginWithOAuth {
 		return "", "", errors.New("login with OAuth failed")
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// NewsletterHandler exposes newsletter subscriptions (double opt-in) and
+// author broadcasts of published posts.
+type NewsletterHandler struct {
+	newsletterUseCase usecasecontract.INewsletterUseCase
+}
+
+func NewNewsletterHandler(newsletterUseCase usecasecontract.INewsletterUseCase) *NewsletterHandler {
+	return &NewsletterHandler{
+		newsletterUseCase: newsletterUseCase,
+	}
+}
+
+// SubscribeHandler starts a double opt-in subscription; the reader gets a
+// confirmation email before receiving any broadcast.
+func (h *NewsletterHandler) SubscribeHandler(c *gin.Context) {
+	var req dto.SubscribeNewsletterRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	if err := h.newsletterUseCase.Subscribe(c.Request.Context(), req.Email, req.AuthorID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Author not found")
+			return
+		}
+		if strings.Contains(err.Error(), "already subscribed") {
+			ErrorHandler(c, http.StatusConflict, "Already subscribed")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to subscribe")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Confirmation email sent")
+}
+
+// ConfirmHandler completes the double opt-in for the emailed token.
+func (h *NewsletterHandler) ConfirmHandler(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		ErrorHandler(c, http.StatusBadRequest, "Missing token")
+		return
+	}
+	if err := h.newsletterUseCase.Confirm(c.Request.Context(), token); err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid confirmation token")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Subscription confirmed")
+}
+
+// UnsubscribeHandler removes the subscription behind an unsubscribe link.
+func (h *NewsletterHandler) UnsubscribeHandler(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		ErrorHandler(c, http.StatusBadRequest, "Missing token")
+		return
+	}
+	if err := h.newsletterUseCase.Unsubscribe(c.Request.Context(), token); err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid unsubscribe token")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Unsubscribed successfully")
+}
+
+// BroadcastHandler sends a published post to the author's confirmed
+// subscribers; delivery continues in the background.
+func (h *NewsletterHandler) BroadcastHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	broadcast, err := h.newsletterUseCase.Broadcast(c.Request.Context(), blogID, userIDStr)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+			return
+		}
+		if strings.Contains(err.Error(), "only the author") {
+			ErrorHandler(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if strings.Contains(err.Error(), "only published") || strings.Contains(err.Error(), "no confirmed subscribers") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to broadcast blog")
+		return
+	}
+	SuccessHandler(c, http.StatusAccepted, dto.ToNewsletterBroadcastResponse(broadcast))
+}
+
+// ListBroadcastsHandler returns the authenticated author's broadcasts with
+// delivery stats.
+func (h *NewsletterHandler) ListBroadcastsHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	broadcasts, err := h.newsletterUseCase.ListBroadcasts(c.Request.Context(), userIDStr)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve broadcasts")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"broadcasts": dto.ToNewsletterBroadcastResponses(broadcasts)})
+}
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+type OrganizationHandler struct {
+	orgUsecase usecasecontract.IOrganizationUseCase
+}
+
+func NewOrganizationHandler(orgUsecase usecasecontract.IOrganizationUseCase) *OrganizationHandler {
+	return &OrganizationHandler{
+		orgUsecase: orgUsecase,
+	}
+}
+
+// orgErrorStatus maps organization usecase errors to HTTP status codes.
+func orgErrorStatus(err error) int {
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		return http.StatusNotFound
+	case strings.HasPrefix(err.Error(), "unauthorized"):
+		return http.StatusForbidden
+	case strings.Contains(err.Error(), "already exists"),
+		strings.Contains(err.Error(), "already a member"):
+		return http.StatusConflict
+	case strings.Contains(err.Error(), "invalid organization role"),
+		strings.Contains(err.Error(), "owners cannot"):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// CreateOrganizationHandler creates an organization with the caller as owner.
+func (h *OrganizationHandler) CreateOrganizationHandler(c *gin.Context) {
+	var req dto.CreateOrganizationRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	org, err := h.orgUsecase.CreateOrganization(c.Request.Context(), req.Name, req.Slug, req.Description, userID)
+	if err != nil {
+		ErrorHandler(c, orgErrorStatus(err), err.Error())
+		return
+	}
+
+	SuccessHandler(c, http.StatusCreated, dto.ToOrganizationResponse(org))
+}
+
+// GetOrganizationHandler handles the public org profile page.
+func (h *OrganizationHandler) GetOrganizationHandler(c *gin.Context) {
+	profile, err := h.orgUsecase.GetOrganizationProfile(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Organization not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get organization")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToOrganizationProfileResponse(profile))
+}
+
+// UpdateOrganizationHandler updates org profile and tenant settings
+// (description, custom domain, theme); owners only.
+func (h *OrganizationHandler) UpdateOrganizationHandler(c *gin.Context) {
+	var req dto.UpdateOrganizationRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	actorID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	org, err := h.orgUsecase.UpdateOrganization(c.Request.Context(), c.Param("slug"), actorID, req.Description, req.CustomDomain, req.Theme)
+	if err != nil {
+		ErrorHandler(c, orgErrorStatus(err), err.Error())
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToOrganizationResponse(org))
+}
+
+// GetTenantHandler returns the publication the current host resolves to so
+// frontends can apply per-tenant branding. Requests on the main domain get
+// an empty object.
+func (h *OrganizationHandler) GetTenantHandler(c *gin.Context) {
+	if org, ok := middleware.TenantFromContext(c); ok {
+		SuccessHandler(c, http.StatusOK, dto.ToOrganizationResponse(org))
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{})
+}
+
+// AddOrgMemberHandler adds a member to an organization (owners only).
+func (h *OrganizationHandler) AddOrgMemberHandler(c *gin.Context) {
+	var req dto.OrgMemberRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	actorID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	err := h.orgUsecase.AddMember(c.Request.Context(), c.Param("slug"), actorID, req.UserID, entity.OrgRole(req.Role))
+	if err != nil {
+		ErrorHandler(c, orgErrorStatus(err), err.Error())
+		return
+	}
+
+	MessageHandler(c, http.StatusCreated, "Member added successfully")
+}
+
+// UpdateOrgMemberHandler changes a member's role (owners only).
+func (h *OrganizationHandler) UpdateOrgMemberHandler(c *gin.Context) {
+	var req dto.OrgMemberRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	actorID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	err := h.orgUsecase.UpdateMemberRole(c.Request.Context(), c.Param("slug"), actorID, req.UserID, entity.OrgRole(req.Role))
+	if err != nil {
+		ErrorHandler(c, orgErrorStatus(err), err.Error())
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Member role updated successfully")
+}
+
+// RemoveOrgMemberHandler removes a member from an organization (owners only).
+func (h *OrganizationHandler) RemoveOrgMemberHandler(c *gin.Context) {
+	actorID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	err := h.orgUsecase.RemoveMember(c.Request.Context(), c.Param("slug"), actorID, c.Param("userID"))
+	if err != nil {
+		ErrorHandler(c, orgErrorStatus(err), err.Error())
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Member removed successfully")
+}
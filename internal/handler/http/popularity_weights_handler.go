@@ -0,0 +1,51 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+	"github.com/mikiasgoitom/Articulate/internal/utils"
+)
+
+// PopularityWeightsHandler exposes the admin API for tuning the popularity
+// formula. A weights change recalculates every stored score in the
+// background.
+type PopularityWeightsHandler struct {
+	weights usecase.PopularityWeightsManager
+}
+
+func NewPopularityWeightsHandler(weights usecase.PopularityWeightsManager) *PopularityWeightsHandler {
+	return &PopularityWeightsHandler{
+		weights: weights,
+	}
+}
+
+// GetPopularityWeightsHandler returns the weights currently in effect.
+func (h *PopularityWeightsHandler) GetPopularityWeightsHandler(c *gin.Context) {
+	SuccessHandler(c, http.StatusOK, gin.H{"weights": h.weights.PopularityWeights()})
+}
+
+// SetPopularityWeightsHandler swaps the popularity formula weights at
+// runtime.
+func (h *PopularityWeightsHandler) SetPopularityWeightsHandler(c *gin.Context) {
+	var req dto.SetPopularityWeightsRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	weights := utils.PopularityWeights{
+		View:                 req.View,
+		Like:                 req.Like,
+		Dislike:              req.Dislike,
+		Comment:              req.Comment,
+		RecencyHalfLifeHours: req.RecencyHalfLifeHours,
+	}
+	if err := h.weights.SetPopularityWeights(c.Request.Context(), weights); err != nil {
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Popularity weights updated successfully")
+}
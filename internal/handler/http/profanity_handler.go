@@ -0,0 +1,71 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+)
+
+// ProfanityHandler exposes the admin API for the profanity wordlist.
+type ProfanityHandler struct {
+	filter contract.IProfanityFilter
+}
+
+func NewProfanityHandler(filter contract.IProfanityFilter) *ProfanityHandler {
+	return &ProfanityHandler{
+		filter: filter,
+	}
+}
+
+// ListProfanityWordsHandler returns the admin-added wordlist entries,
+// optionally filtered by the "locale" query parameter.
+func (h *ProfanityHandler) ListProfanityWordsHandler(c *gin.Context) {
+	words, err := h.filter.ListWords(c.Request.Context(), c.Query("locale"))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list profanity words")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, gin.H{"words": words})
+}
+
+// AddProfanityWordHandler adds a word to the profanity wordlist.
+func (h *ProfanityHandler) AddProfanityWordHandler(c *gin.Context) {
+	var req dto.AddProfanityWordRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	if err := h.filter.AddWord(c.Request.Context(), req.Word, req.Locale, req.Severity); err != nil {
+		switch {
+		case strings.Contains(err.Error(), "invalid"):
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		case strings.Contains(err.Error(), "already in the list"):
+			ErrorHandler(c, http.StatusConflict, err.Error())
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to add profanity word")
+		}
+		return
+	}
+
+	MessageHandler(c, http.StatusCreated, "Word added successfully")
+}
+
+// RemoveProfanityWordHandler removes an admin-added wordlist entry; the
+// "locale" query parameter defaults to the filter's default locale.
+func (h *ProfanityHandler) RemoveProfanityWordHandler(c *gin.Context) {
+	err := h.filter.RemoveWord(c.Request.Context(), c.Param("word"), c.Query("locale"))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to remove profanity word")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Word removed successfully")
+}
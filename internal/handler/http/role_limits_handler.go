@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// RoleLimitsHandler exposes the admin API for the per-role content limits
+// (posts per day, comment length, tags and images per post).
+type RoleLimitsHandler struct {
+	limits *usecase.LimitsPolicy
+}
+
+func NewRoleLimitsHandler(limits *usecase.LimitsPolicy) *RoleLimitsHandler {
+	return &RoleLimitsHandler{
+		limits: limits,
+	}
+}
+
+// ListRoleLimitsHandler returns the limits in effect for every role,
+// including defaults for roles no admin has configured yet.
+func (h *RoleLimitsHandler) ListRoleLimitsHandler(c *gin.Context) {
+	limits, err := h.limits.ListLimits(c.Request.Context())
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list role limits")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"limits": limits})
+}
+
+// SetRoleLimitsHandler configures the content limits for a role.
+func (h *RoleLimitsHandler) SetRoleLimitsHandler(c *gin.Context) {
+	var req dto.SetRoleLimitsRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	limits := &entity.RoleLimits{
+		Role:             entity.UserRole(c.Param("role")),
+		MaxPostsPerDay:   req.MaxPostsPerDay,
+		MaxCommentLength: req.MaxCommentLength,
+		MaxTagsPerPost:   req.MaxTagsPerPost,
+		MaxImagesPerPost: req.MaxImagesPerPost,
+	}
+	if err := h.limits.ConfigureLimits(c.Request.Context(), limits); err != nil {
+		if strings.Contains(err.Error(), "unknown role") || strings.Contains(err.Error(), "negative") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to update role limits")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Role limits updated successfully")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
 	"github.com/mikiasgoitom/Articulate/internal/usecase"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
@@ -15,34 +16,329 @@ import (
 )
 
 type Router struct {
-	userHandler        *UserHandler
-	blogHandler        *BlogHandler
-	aiHandler          *AIHandler
-	emailHandler       *EmailHandler
-	interactionHandler *InteractionHandler
-	userUsecase        *usecase.UserUsecase
-	jwtService         usecase.JWTService
-	authHandler        *AuthHandler
-	commentHandler     *CommentHandler
-}
-
-func NewRouter(userUsecase usecasecontract.IUserUseCase, blogUsecase usecase.IBlogUseCase, likeUsecase *usecase.LikeUsecase, emailVerUC usecasecontract.IEmailVerificationUC, userRepo contract.IUserRepository, tokenRepo contract.ITokenRepository, hasher contract.IHasher, jwtService usecase.JWTService, mailService contract.IEmailService, logger usecasecontract.IAppLogger, config usecasecontract.IConfigProvider, validator usecasecontract.IValidator, uuidGen contract.IUUIDGenerator, randomGen contract.IRandomGenerator, commentRepo contract.ICommentRepository, blogRepo contract.IBlogRepository, aiUsecase usecasecontract.IAIUseCase) *Router {
+	userHandler            *UserHandler
+	blogHandler            *BlogHandler
+	aiHandler              *AIHandler
+	emailHandler           *EmailHandler
+	interactionHandler     *InteractionHandler
+	userUsecase            *usecase.UserUsecase
+	jwtService             usecase.JWTService
+	authHandler            *AuthHandler
+	commentHandler         *CommentHandler
+	tagHandler             *TagHandler
+	tagUsecase             *usecase.TagUseCaseImpl
+	commentUsecase         *usecase.CommentUseCaseImpl
+	orgHandler             *OrganizationHandler
+	orgRepo                contract.IOrganizationRepository
+	emailBlocklist         contract.IEmailBlocklist
+	profanityFilter        contract.IProfanityFilter
+	imageProxy             contract.IImageProxyService
+	featureFlags           contract.IFeatureFlags
+	apiKeyUseCase          usecasecontract.IAPIKeyUseCase
+	apiKeyHandler          *APIKeyHandler
+	serviceAuthUseCase     usecasecontract.IServiceAuthUseCase
+	serviceAuthHandler     *ServiceAuthHandler
+	tipHandler             *TipHandler
+	newsletterHandler      *NewsletterHandler
+	inboundEmailHandler    *InboundEmailHandler
+	deviceHandler          *DeviceHandler
+	liveEngagementHandler  *LiveEngagementHandler
+	savedSearchHandler     *SavedSearchHandler
+	rssFeedHandler         *RSSFeedHandler
+	mediaHandler           *MediaHandler
+	experiments            contract.IExperiments
+	popularityWeights      usecase.PopularityWeightsManager
+	roleLimits             *usecase.LimitsPolicy
+	geoRestrictions        usecase.IGeoRestrictionUseCase
+	geoResolver            contract.IGeoIPResolver
+	takedowns              usecase.ITakedownUseCase
+	userImport             usecase.IUserImportUseCase
+	draftComments          usecase.IDraftCommentUseCase
+	highlights             usecase.IHighlightUseCase
+	events                 usecase.IEventUseCase
+	memberships            usecase.IMembershipUseCase
+	gamification           usecase.IGamificationUseCase
+	auditLog               *middleware.AuditLog
+	errorReporter          contract.IErrorReporter
+	errorSampleRate        float64
+	reloadableConfig       usecasecontract.IReloadableConfig
+	allowAnonymousComments bool
+	maintenanceDefault     bool
+}
+
+func NewRouter(userUsecase usecasecontract.IUserUseCase, blogUsecase usecase.IBlogUseCase, likeUsecase *usecase.LikeUsecase, emailVerUC usecasecontract.IEmailVerificationUC, userRepo contract.IUserRepository, tokenRepo contract.ITokenRepository, hasher contract.IHasher, jwtService usecase.JWTService, mailService contract.IEmailService, logger usecasecontract.IAppLogger, config usecasecontract.IConfigProvider, validator usecasecontract.IValidator, uuidGen contract.IUUIDGenerator, randomGen contract.IRandomGenerator, commentRepo contract.ICommentRepository, blogRepo contract.IBlogRepository, tagRepo contract.ITagRepository, orgRepo contract.IOrganizationRepository, aiUsecase usecasecontract.IAIUseCase) *Router {
 	baseURL := config.GetAppBaseURL()
-	commentUC := usecase.NewCommentUseCase(commentRepo, blogRepo, userRepo)
+	commentUC := usecase.NewCommentUseCase(commentRepo, blogRepo, userRepo, config)
+	if refresher, ok := blogUsecase.(usecase.PopularityRefresher); ok {
+		commentUC.SetPopularityRefresher(refresher)
+	}
+	tagUC := usecase.NewTagUseCase(tagRepo, blogRepo, uuidGen)
+	if gater, ok := blogUsecase.(usecase.BlogAccessGater); ok {
+		tagUC.SetAccessGater(gater)
+	}
+	orgUC := usecase.NewOrganizationUseCase(orgRepo, blogRepo, uuidGen)
+	weightsManager, _ := blogUsecase.(usecase.PopularityWeightsManager)
 	return &Router{
-		userHandler:        NewUserHandler(userUsecase),
-		blogHandler:        NewBlogHandler(blogUsecase),
-		aiHandler:          NewAIHandler(aiUsecase),
-		emailHandler:       NewEmailHandler(emailVerUC, userRepo),
-		interactionHandler: NewInteractionHandler(likeUsecase),
-		userUsecase:        usecase.NewUserUsecase(userRepo, tokenRepo, emailVerUC, hasher, jwtService, mailService, logger, config, validator, uuidGen, randomGen),
-		jwtService:         jwtService,
-		authHandler:        NewAuthHandler(userUsecase, baseURL),
-		commentHandler:     NewCommentHandler(commentUC),
+		userHandler:            NewUserHandler(userUsecase),
+		blogHandler:            NewBlogHandler(blogUsecase, baseURL),
+		aiHandler:              NewAIHandler(aiUsecase),
+		emailHandler:           NewEmailHandler(emailVerUC, userRepo, config),
+		interactionHandler:     NewInteractionHandler(likeUsecase),
+		userUsecase:            usecase.NewUserUsecase(userRepo, tokenRepo, emailVerUC, hasher, jwtService, mailService, logger, config, validator, uuidGen, randomGen),
+		jwtService:             jwtService,
+		authHandler:            NewAuthHandler(userUsecase, baseURL),
+		commentHandler:         NewCommentHandler(commentUC),
+		tagHandler:             NewTagHandler(tagUC),
+		tagUsecase:             tagUC,
+		commentUsecase:         commentUC,
+		orgHandler:             NewOrganizationHandler(orgUC),
+		orgRepo:                orgRepo,
+		popularityWeights:      weightsManager,
+		allowAnonymousComments: config.GetAllowAnonymousComments(),
+		maintenanceDefault:     config.GetMaintenanceMode(),
+	}
+}
+
+// SetCaptchaVerifier enables CAPTCHA verification on the abuse-prone endpoints
+// (registration, forgot password and comment creation).
+func (r *Router) SetCaptchaVerifier(verifier contract.ICaptchaVerifier) {
+	r.userHandler.SetCaptchaVerifier(verifier)
+	r.commentHandler.SetCaptchaVerifier(verifier)
+}
+
+// SetTagCache enables Redis caching of tag auto-complete suggestions.
+func (r *Router) SetTagCache(cache contract.ITagCache) {
+	r.tagUsecase.SetTagCache(cache)
+}
+
+// SetCommentRateLimiter enables per-user comment rate limiting backed by
+// Redis counters.
+func (r *Router) SetCommentRateLimiter(counter contract.IRateLimitCounter) {
+	r.commentUsecase.SetRateLimiter(counter)
+}
+
+// SetProbationPolicy enables new-account restrictions on commenting.
+func (r *Router) SetProbationPolicy(policy *usecase.ProbationPolicy) {
+	r.commentUsecase.SetProbationPolicy(policy)
+}
+
+// SetLimitsPolicy enables the per-role content limits on commenting and
+// their admin configuration API. Must be called before SetupRoutes.
+func (r *Router) SetLimitsPolicy(policy *usecase.LimitsPolicy) {
+	r.commentUsecase.SetLimitsPolicy(policy)
+	r.roleLimits = policy
+}
+
+// SetGeoRestrictions enables per-country content restrictions: the blocking
+// middleware on blog reads and the admin management API. Must be called
+// before SetupRoutes.
+func (r *Router) SetGeoRestrictions(restrictions usecase.IGeoRestrictionUseCase, resolver contract.IGeoIPResolver) {
+	r.geoRestrictions = restrictions
+	r.geoResolver = resolver
+}
+
+// SetTakedowns enables the takedown workflow: the public submission endpoint
+// and the admin review queue. Must be called before SetupRoutes.
+func (r *Router) SetTakedowns(takedowns usecase.ITakedownUseCase) {
+	r.takedowns = takedowns
+}
+
+// SetUserImport enables the admin bulk user import endpoint used for
+// platform migrations. Must be called before SetupRoutes.
+func (r *Router) SetUserImport(imports usecase.IUserImportUseCase) {
+	r.userImport = imports
+}
+
+// SetDraftComments enables inline editorial comments on drafts for the
+// review workflow. Must be called before SetupRoutes.
+func (r *Router) SetDraftComments(draftComments usecase.IDraftCommentUseCase) {
+	r.draftComments = draftComments
+}
+
+// SetHighlights enables reader highlights on posts and the per-post
+// popular highlights aggregate. Must be called before SetupRoutes.
+func (r *Router) SetHighlights(highlights usecase.IHighlightUseCase) {
+	r.highlights = highlights
+}
+
+// SetEvents enables event posts: the event metadata block, RSVPs with
+// capacity enforcement, attendee lists and iCal export. Must be called
+// before SetupRoutes.
+func (r *Router) SetEvents(events usecase.IEventUseCase) {
+	r.events = events
+}
+
+// SetMemberships enables reader memberships behind the paywall: the
+// viewer's tier, subscription checkout and the payments webhook. Must be
+// called before SetupRoutes.
+func (r *Router) SetMemberships(memberships usecase.IMembershipUseCase) {
+	r.memberships = memberships
+}
+
+// SetGamification enables the public badge listing on user profiles. Must be
+// called before SetupRoutes.
+func (r *Router) SetGamification(gamification usecase.IGamificationUseCase) {
+	r.gamification = gamification
+}
+
+// SetAuditCapture enables audit-grade request/response capture on the given
+// route prefixes, kept in an in-memory ring buffer of the given size. The
+// returned log can be given an external sink. Must be called before
+// SetupRoutes.
+func (r *Router) SetAuditCapture(size int, routePrefixes []string) *middleware.AuditLog {
+	r.auditLog = middleware.NewAuditLog(size, routePrefixes)
+	return r.auditLog
+}
+
+// SetErrorReporting enables panic recovery with reporting of panics and
+// sampled 5xx responses to the given error tracker, and tags every request
+// with a correlation ID. Must be called before SetupRoutes.
+func (r *Router) SetErrorReporting(reporter contract.IErrorReporter, sampleRate float64) {
+	r.errorReporter = reporter
+	r.errorSampleRate = sampleRate
+}
+
+// SetConfigReload enables the admin API for runtime-tunable configuration:
+// reading values, applying validated overrides and reviewing the change
+// history. Must be called before SetupRoutes.
+func (r *Router) SetConfigReload(config usecasecontract.IReloadableConfig) {
+	r.reloadableConfig = config
+}
+
+// SetModerationLog enables recording of comment moderation actions for the
+// admin audit history endpoints.
+func (r *Router) SetModerationLog(repo contract.IModerationActionRepository) {
+	r.commentUsecase.SetModerationLog(repo)
+}
+
+// SetEmailBlocklist enables the admin API for managing the disposable email
+// domain blocklist. Must be called before SetupRoutes.
+func (r *Router) SetEmailBlocklist(blocklist contract.IEmailBlocklist) {
+	r.emailBlocklist = blocklist
+}
+
+// SetFeatureFlags enables the admin API for runtime feature flags. Must be
+// called before SetupRoutes.
+func (r *Router) SetFeatureFlags(flags contract.IFeatureFlags) {
+	r.featureFlags = flags
+}
+
+// SetExperiments enables A/B experiment assignment and the admin API for
+// experiment definitions. Must be called before SetupRoutes.
+func (r *Router) SetExperiments(experiments contract.IExperiments) {
+	r.experiments = experiments
+}
+
+// SetImageProxy enables the signed image proxy endpoint. Must be called
+// before SetupRoutes.
+func (r *Router) SetImageProxy(proxy contract.IImageProxyService) {
+	r.imageProxy = proxy
+}
+
+// SetLinkPreviewService enables unfurling of URLs in comments into stored
+// link previews.
+func (r *Router) SetLinkPreviewService(service contract.ILinkPreviewService) {
+	r.commentUsecase.SetLinkPreviewService(service)
+}
+
+// SetProfanityFilter enables profanity screening of comments and the admin
+// API for managing the wordlist. Must be called before SetupRoutes.
+func (r *Router) SetProfanityFilter(filter contract.IProfanityFilter) {
+	r.profanityFilter = filter
+	r.commentUsecase.SetProfanityFilter(filter)
+}
+
+// SetFeedProjection serves GET /me/feed from the precomputed feed lists
+// instead of per-request Mongo queries.
+func (r *Router) SetFeedProjection(projection contract.IFeedProjection) {
+	r.tagUsecase.SetFeedProjection(projection)
+}
+
+// SetAPIKeyService enables personal API keys for programmatic access: the
+// X-API-Key auth path and the /me/api-keys management endpoints. Must be
+// called before SetupRoutes.
+func (r *Router) SetAPIKeyService(apiKeyUseCase usecasecontract.IAPIKeyUseCase) {
+	r.apiKeyUseCase = apiKeyUseCase
+	r.apiKeyHandler = NewAPIKeyHandler(apiKeyUseCase)
+}
+
+// SetServiceAuth enables the client-credentials flow for internal services:
+// the token endpoint, the admin client registry and the scoped /internal
+// routes. Must be called before SetupRoutes.
+func (r *Router) SetServiceAuth(serviceAuthUseCase usecasecontract.IServiceAuthUseCase) {
+	r.serviceAuthUseCase = serviceAuthUseCase
+	r.serviceAuthHandler = NewServiceAuthHandler(serviceAuthUseCase)
+}
+
+// SetTipping enables tipping authors on posts: the checkout endpoint, the
+// payment webhook receiver and the earnings dashboard. Must be called
+// before SetupRoutes.
+func (r *Router) SetTipping(tipUseCase usecasecontract.ITipUseCase) {
+	r.tipHandler = NewTipHandler(tipUseCase)
+}
+
+// SetNewsletter enables newsletter subscriptions and author broadcasts.
+// Must be called before SetupRoutes.
+func (r *Router) SetNewsletter(newsletterUseCase usecasecontract.INewsletterUseCase) {
+	r.newsletterHandler = NewNewsletterHandler(newsletterUseCase)
+}
+
+// SetReplyByEmail enables comment reply notification emails with a unique
+// reply address, plus the inbound email webhook that posts those replies as
+// comments. Must be called before SetupRoutes.
+func (r *Router) SetReplyByEmail(mailService contract.IEmailService, replyTokenRepo contract.IEmailReplyTokenRepository, randomGenerator contract.IRandomGenerator, replyDomain, inboundSecret string) {
+	r.commentUsecase.SetReplyByEmail(mailService, replyTokenRepo, randomGenerator, replyDomain)
+	r.inboundEmailHandler = NewInboundEmailHandler(r.commentUsecase, inboundSecret)
+}
+
+// SetPushNotifications enables device token registration and push alerts for
+// replies and likes. Must be called before SetupRoutes.
+func (r *Router) SetPushNotifications(pushUseCase usecasecontract.IPushUseCase) {
+	r.deviceHandler = NewDeviceHandler(pushUseCase)
+	r.commentUsecase.SetPushNotifier(pushUseCase)
+}
+
+// SetSavedSearches enables saved searches with publish-time alerting.
+// Must be called before SetupRoutes.
+func (r *Router) SetSavedSearches(savedSearchUseCase usecasecontract.ISavedSearchUseCase) {
+	r.savedSearchHandler = NewSavedSearchHandler(savedSearchUseCase)
+}
+
+// SetRSSImport enables author-registered RSS feeds whose new items are
+// imported as draft posts. Must be called before SetupRoutes.
+func (r *Router) SetRSSImport(rssImportUseCase usecasecontract.IRSSImportUseCase) {
+	r.rssFeedHandler = NewRSSFeedHandler(rssImportUseCase)
+}
+
+// SetMedia enables media uploads through the content-security pipeline.
+// Must be called before SetupRoutes.
+func (r *Router) SetMedia(mediaUseCase usecase.IMediaUseCase) {
+	r.mediaHandler = NewMediaHandler(mediaUseCase)
+}
+
+// SetLiveEngagement enables the SSE stream of live engagement counters on
+// post pages. Must be called before SetupRoutes.
+func (r *Router) SetLiveEngagement(bus contract.IEngagementBus, streamer usecase.LiveEngagementStreamer) {
+	r.commentUsecase.SetEngagementBus(bus)
+	r.liveEngagementHandler = NewLiveEngagementHandler(streamer)
+}
+
+// SetPresence enables the reader presence heartbeat feeding the live
+// "reading now" counter. Must be called after SetLiveEngagement.
+func (r *Router) SetPresence(heartbeater usecase.PresenceHeartbeater) {
+	if r.liveEngagementHandler != nil {
+		r.liveEngagementHandler.SetPresenceHeartbeater(heartbeater)
 	}
 }
 
 func (r *Router) SetupRoutes(router *gin.Engine) {
+	// Error reporting wraps everything else so panics anywhere downstream are
+	// recovered, tagged with the request's correlation ID and shipped to the
+	// tracker before the 500 goes out
+	if r.errorReporter != nil {
+		router.Use(middleware.ErrorReporting(r.errorReporter, r.errorSampleRate))
+	}
+
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
@@ -57,6 +353,34 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 	lmt.SetMessage("Too many requests, please try again later.")
 	router.Use(middleware.RateLimiter(lmt))
 
+	// Per-route deadlines: cancel downstream Mongo/Redis/AI work and answer
+	// 504 when a dependency hangs instead of holding the connection open
+	router.Use(middleware.RequestTimeout())
+
+	// Resolve custom-domain tenants before routing so list endpoints can be
+	// scoped to the owning publication
+	router.Use(middleware.TenantResolver(r.orgRepo))
+
+	// Audit-grade request/response capture on the configured routes, with
+	// passwords, tokens and emails redacted before anything is stored
+	if r.auditLog != nil {
+		router.Use(middleware.AuditLogger(r.auditLog))
+	}
+
+	// Maintenance mode: reject writes (or all traffic) with a 503 while the
+	// flag is on. Metrics, login and admin routes stay reachable so an admin
+	// can switch it off again.
+	if r.featureFlags != nil {
+		router.Use(middleware.MaintenanceMode(r.featureFlags, r.maintenanceDefault))
+	}
+
+	// API key authentication: requests carrying X-API-Key are authenticated
+	// before the bearer-token middleware runs, so integrations can call
+	// protected routes without an OAuth or password login.
+	if r.apiKeyUseCase != nil {
+		router.Use(middleware.APIKeyAuth(r.apiKeyUseCase))
+	}
+
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 	router.GET("/api/v1/metrics", gin.WrapH(promhttp.Handler()))
 	// API v1 routes
@@ -74,6 +398,11 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 
 		auth.POST("/request-verification-email", r.emailHandler.HandleRequestEmailVerification)
 
+		// Client-credentials token exchange for internal services
+		if r.serviceAuthHandler != nil {
+			auth.POST("/token", r.serviceAuthHandler.TokenHandler)
+		}
+
 		// Google OAuth endpoints
 		auth.GET("/google/login", r.authHandler.HandleGoogleLogin)
 		auth.GET("/google/callback", r.authHandler.HandleGoogleCallback)
@@ -83,15 +412,131 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 	users := v1.Group("/users")
 	{
 		users.GET("/profile/:id", r.userHandler.GetUser)
+		if r.gamification != nil {
+			gamificationHandler := NewGamificationHandler(r.gamification)
+			users.GET("/:userId/badges", gamificationHandler.ListUserBadgesHandler)
+		}
+	}
+
+	// Top-authors leaderboard (public)
+	v1.GET("/authors/top", r.blogHandler.GetTopAuthorsHandler)
+
+	// Takedown submission (public: complainants do not need an account)
+	if r.takedowns != nil {
+		takedownHandler := NewTakedownHandler(r.takedowns)
+		v1.POST("/takedown-requests", takedownHandler.SubmitTakedownHandler)
 	}
 
-	// Public blog routes
+	// Public blog routes (optional auth so responses can be personalized for
+	// logged-in readers)
 	blogs := v1.Group("/blogs")
+	blogs.Use(middleware.OptionalAuth(r.jwtService))
+	// Per-country content restrictions (legal takedowns) on blog reads
+	if r.geoRestrictions != nil && r.geoResolver != nil {
+		blogs.Use(middleware.GeoRestrict(r.geoRestrictions, r.geoResolver))
+	}
 	{
 		blogs.GET("", r.blogHandler.GetBlogsHandler)
 		blogs.GET("/search", r.blogHandler.SearchAndFilterBlogsHandler)
+		blogs.GET("/suggest", r.blogHandler.SuggestBlogsHandler)
+		blogs.POST("/search/click", r.blogHandler.RecordSearchClickHandler)
 		blogs.GET("/popular", r.blogHandler.GetPopularBlogsHandler)
+		blogs.GET("/archive", r.blogHandler.GetBlogArchiveHandler)
+		blogs.GET("/archive/:year/:month", r.blogHandler.GetArchivePeriodBlogsHandler)
 		blogs.GET("/slug/:slug", r.blogHandler.GetBlogDetailHandler)
+		blogs.GET("/slug/:slug/meta", r.blogHandler.GetBlogMetaHandler)
+		blogs.GET("/slug/:slug/og-image.png", r.blogHandler.GetOGImageHandler)
+
+		// Comment listing is publicly readable; logged-in readers get
+		// personalized fields (IsLiked) via the optional auth middleware.
+		if r.liveEngagementHandler != nil {
+			blogs.GET("/:blogID/live", r.liveEngagementHandler.StreamEngagementHandler)
+			if r.liveEngagementHandler.heartbeater != nil {
+				blogs.POST("/:blogID/presence", r.liveEngagementHandler.HeartbeatPresenceHandler)
+			}
+		}
+		// Most-highlighted passages of a post
+		if r.highlights != nil {
+			highlightHandler := NewHighlightHandler(r.highlights)
+			blogs.GET("/:blogID/highlights/popular", highlightHandler.GetPopularHighlightsHandler)
+		}
+
+		blogs.GET("/:blogID/comments", r.commentHandler.GetBlogComments)
+		blogs.GET("/:blogID/comments/count", r.commentHandler.GetBlogCommentsCount) // Total comments in a blog
+
+		// Guest commenting (optional deployment mode) with a stricter rate limit
+		if r.allowAnonymousComments {
+			guestLmt := tollbooth.NewLimiter(1, &limiter.ExpirableOptions{DefaultExpirationTTL: time.Hour})
+			guestLmt.SetIPLookups([]string{"RemoteAddr", "X-Forwarded-For", "X-Real-IP"})
+			guestLmt.SetMessage("Too many comments, please try again later.")
+			blogs.POST("/:blogID/comments/guest", middleware.RateLimiter(guestLmt), r.commentHandler.CreateGuestComment)
+		}
+	}
+
+	// Public tag pages (optional auth so follow state can be included)
+	tags := v1.Group("/tags")
+	tags.Use(middleware.OptionalAuth(r.jwtService))
+	{
+		tags.GET("/suggest", r.tagHandler.GetTagSuggestionsHandler)
+		tags.GET("/:slug", r.tagHandler.GetTagDetailHandler)
+	}
+
+	// Public organization profile pages
+	orgs := v1.Group("/orgs")
+	{
+		orgs.GET("/:slug", r.orgHandler.GetOrganizationHandler)
+	}
+
+	// Tenant branding for custom-domain publications
+	v1.GET("/tenant", r.orgHandler.GetTenantHandler)
+
+	// Signed image proxy for external images referenced in blog content
+	if r.imageProxy != nil {
+		imageProxyHandler := NewImageProxyHandler(r.imageProxy)
+		v1.GET("/images/proxy", imageProxyHandler.ProxyImageHandler)
+	}
+
+	// Payment provider webhook for tips (unauthenticated; the provider's
+	// signature is verified inside)
+	if r.tipHandler != nil {
+		v1.POST("/payments/webhook", r.tipHandler.TipWebhookHandler)
+	}
+
+	// Payment provider webhook for membership subscriptions (unauthenticated;
+	// the provider's signature is verified inside)
+	if r.memberships != nil {
+		membershipHandler := NewMembershipHandler(r.memberships)
+		v1.POST("/payments/membership/webhook", membershipHandler.PaymentWebhookHandler)
+	}
+
+	// Newsletter subscription flow (public; confirm and unsubscribe are
+	// reached from emailed links)
+	if r.newsletterHandler != nil {
+		v1.POST("/newsletter/subscribe", r.newsletterHandler.SubscribeHandler)
+		v1.GET("/newsletter/confirm", r.newsletterHandler.ConfirmHandler)
+		v1.GET("/newsletter/unsubscribe", r.newsletterHandler.UnsubscribeHandler)
+	}
+
+	// Inbound email webhook (reply-by-email for comment notifications)
+	if r.inboundEmailHandler != nil {
+		v1.POST("/email/inbound", r.inboundEmailHandler.InboundEmailHandlerFunc)
+	}
+
+	// A/B experiment variant assignment
+	if r.experiments != nil {
+		experimentsHandler := NewExperimentsHandler(r.experiments)
+		v1.GET("/experiments/:name/assignment", middleware.OptionalAuth(r.jwtService), experimentsHandler.GetAssignmentHandler)
+	}
+
+	// Public comment read routes (optional auth, same personalization as blogs)
+	comments := v1.Group("/comments")
+	comments.Use(middleware.OptionalAuth(r.jwtService))
+	{
+		comments.GET("/:commentID", r.commentHandler.GetComment)                 // Single comment by ID
+		comments.GET("/:commentID/replies", r.commentHandler.GetCommentReplies)  // Fetch all replies (nested) for a comment
+		comments.GET("/:commentID/count", r.commentHandler.GetCommentStatistics) // Fetch comment by ID with total reply count
+		comments.GET("/:commentID/depth", r.commentHandler.GetCommentDepth)      // Depth of a comment thread
+		comments.GET("/:commentID/thread", r.commentHandler.GetCommentThread)    // Fetch comment thread (all nested replies)
 	}
 
 	// Protected routes (authentication required)
@@ -101,40 +546,304 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 		// Current user routes
 		protected.GET("/me", r.userHandler.GetCurrentUser)
 		protected.PUT("/me", r.userHandler.UpdateUser)
+		protected.GET("/me/sessions", r.userHandler.GetSessions)
+		protected.GET("/me/referrals", r.userHandler.GetMyReferrals)
+
+		// Personal API keys
+		if r.apiKeyHandler != nil {
+			protected.POST("/me/api-keys", r.apiKeyHandler.CreateAPIKeyHandler)
+			protected.GET("/me/api-keys", r.apiKeyHandler.ListAPIKeysHandler)
+			protected.DELETE("/me/api-keys/:id", r.apiKeyHandler.RevokeAPIKeyHandler)
+		}
 
 		// Blog routes
 		protected.POST("/blogs", r.blogHandler.CreateBlogHandler)
 		protected.POST("/blogs/generateBlog", r.aiHandler.HandleBlogContentGeneration)
 		protected.POST("/blogs/suggestModificationByAI", r.aiHandler.HandleSuggestAndModifyContent)
+
+		// Granular writing assistant endpoints, quota-limited per user
+		protected.POST("/ai/outline", r.aiHandler.HandleOutline)
+		protected.POST("/ai/expand-section", r.aiHandler.HandleExpandSection)
+		protected.POST("/ai/rewrite-tone", r.aiHandler.HandleRewriteTone)
+		protected.GET("/me/ai-usage", r.aiHandler.HandleMyAIUsage)
+
 		protected.PUT("/blogs/:blogID", r.blogHandler.UpdateBlogHandler)
 		protected.DELETE("/blogs/:blogID", r.blogHandler.DeleteBlogHandler)
 
+		// Editorial workflow
+		protected.POST("/blogs/:blogID/submit", r.blogHandler.SubmitBlogForReviewHandler)
+		protected.GET("/blogs/:blogID/review-notes", r.blogHandler.GetReviewNotesHandler)
+
+		// revision history and diffing for authors/editors
+		protected.GET("/blogs/:blogID/revisions", r.blogHandler.ListBlogRevisionsHandler)
+		protected.GET("/blogs/:blogID/revisions/:a/diff/:b", r.blogHandler.GetBlogRevisionDiffHandler)
+
+		// accepted answer on question posts
+		protected.PUT("/blogs/:blogID/accepted-answer", r.blogHandler.AcceptAnswerHandler)
+		protected.DELETE("/blogs/:blogID/accepted-answer", r.blogHandler.ClearAcceptedAnswerHandler)
+
+		// reader memberships: the viewer's tier and subscription checkout
+		if r.memberships != nil {
+			membershipHandler := NewMembershipHandler(r.memberships)
+			protected.GET("/me/membership", membershipHandler.GetMyMembershipHandler)
+			protected.POST("/me/membership/checkout", membershipHandler.SubscribeHandler)
+		}
+
+		// event posts: event block edits, RSVPs, attendee lists and iCal
+		// export of the reader's RSVPs
+		if r.events != nil {
+			eventHandler := NewEventHandler(r.events)
+			protected.PUT("/blogs/:blogID/event", eventHandler.SetEventInfoHandler)
+			protected.DELETE("/blogs/:blogID/event", eventHandler.ClearEventInfoHandler)
+			protected.POST("/blogs/:blogID/rsvp", eventHandler.RSVPHandler)
+			protected.DELETE("/blogs/:blogID/rsvp", eventHandler.CancelRSVPHandler)
+			protected.GET("/blogs/:blogID/attendees", eventHandler.ListAttendeesHandler)
+			protected.GET("/me/rsvps/calendar.ics", eventHandler.ExportCalendarHandler)
+		}
+
+		// reader highlights with optional private notes
+		if r.highlights != nil {
+			highlightHandler := NewHighlightHandler(r.highlights)
+			protected.POST("/blogs/:blogID/highlights", highlightHandler.CreateHighlightHandler)
+			protected.DELETE("/highlights/:highlightID", highlightHandler.DeleteHighlightHandler)
+			protected.GET("/me/highlights", highlightHandler.ListMyHighlightsHandler)
+		}
+
+		// inline editorial comments on drafts
+		if r.draftComments != nil {
+			draftCommentHandler := NewDraftCommentHandler(r.draftComments)
+			protected.POST("/blogs/:blogID/review-comments", draftCommentHandler.CreateDraftCommentHandler)
+			protected.GET("/blogs/:blogID/review-comments", draftCommentHandler.ListDraftCommentsHandler)
+			protected.PUT("/blogs/:blogID/review-comments/:commentID", draftCommentHandler.ResolveDraftCommentHandler)
+		}
+
+		// Organization management
+		protected.POST("/orgs", r.orgHandler.CreateOrganizationHandler)
+		protected.PUT("/orgs/:slug", r.orgHandler.UpdateOrganizationHandler)
+		protected.POST("/orgs/:slug/members", r.orgHandler.AddOrgMemberHandler)
+		protected.PUT("/orgs/:slug/members", r.orgHandler.UpdateOrgMemberHandler)
+		protected.DELETE("/orgs/:slug/members/:userID", r.orgHandler.RemoveOrgMemberHandler)
+
+		// Tag follows and the personalized feed
+		protected.POST("/tags/:slug/follow", r.tagHandler.FollowTagHandler)
+		protected.DELETE("/tags/:slug/follow", r.tagHandler.UnfollowTagHandler)
+		protected.GET("/feed", r.tagHandler.GetFeedHandler)
+		protected.GET("/me/feed", r.tagHandler.GetProjectedFeedHandler)
+
 		// Interaction routes
 		protected.POST("/blogs/:blogID/like", r.interactionHandler.LikeBlogHandler)
 		protected.POST("/blogs/:blogID/dislike", r.interactionHandler.DislikeBlogHandler)
+		protected.POST("/blogs/:blogID/clap", r.interactionHandler.ClapBlogHandler)
+		if r.tipHandler != nil {
+			protected.POST("/blogs/:blogID/tip", r.tipHandler.CreateTipHandler)
+			protected.GET("/me/earnings", r.tipHandler.GetEarningsHandler)
+		}
+		if r.newsletterHandler != nil {
+			protected.POST("/blogs/:blogID/broadcast", r.newsletterHandler.BroadcastHandler)
+			protected.GET("/me/broadcasts", r.newsletterHandler.ListBroadcastsHandler)
+		}
+		if r.savedSearchHandler != nil {
+			protected.POST("/me/saved-searches", r.savedSearchHandler.CreateSavedSearchHandler)
+			protected.GET("/me/saved-searches", r.savedSearchHandler.ListSavedSearchesHandler)
+			protected.DELETE("/me/saved-searches/:searchID", r.savedSearchHandler.DeleteSavedSearchHandler)
+		}
+		if r.rssFeedHandler != nil {
+			protected.POST("/me/rss-feeds", r.rssFeedHandler.RegisterFeedHandler)
+			protected.GET("/me/rss-feeds", r.rssFeedHandler.ListFeedsHandler)
+			protected.DELETE("/me/rss-feeds/:feedID", r.rssFeedHandler.DeleteFeedHandler)
+		}
+		if r.mediaHandler != nil {
+			protected.POST("/me/media", r.mediaHandler.UploadMediaHandler)
+			// Media library management
+			protected.GET("/me/media", r.mediaHandler.ListMediaHandler)
+			protected.POST("/me/media/bulk-delete", r.mediaHandler.BulkDeleteMediaHandler)
+			protected.GET("/me/media/:mediaID/usage", r.mediaHandler.GetMediaUsageHandler)
+			protected.GET("/me/storage", r.mediaHandler.GetStorageUsageHandler)
+			// Chunked/resumable uploads for large files
+			protected.POST("/me/uploads", r.mediaHandler.BeginUploadHandler)
+			protected.GET("/me/uploads/:sessionID", r.mediaHandler.GetUploadSessionHandler)
+			protected.PATCH("/me/uploads/:sessionID", r.mediaHandler.UploadChunkHandler)
+			protected.POST("/me/uploads/:sessionID/complete", r.mediaHandler.CompleteUploadHandler)
+			protected.DELETE("/me/uploads/:sessionID", r.mediaHandler.AbortUploadHandler)
+		}
+		if r.deviceHandler != nil {
+			protected.POST("/me/devices", r.deviceHandler.RegisterDeviceHandler)
+			protected.GET("/me/devices", r.deviceHandler.ListDevicesHandler)
+			protected.DELETE("/me/devices/:token", r.deviceHandler.UnregisterDeviceHandler)
+		}
 		protected.POST("/blogs/:blogID/view", r.blogHandler.TrackBlogViewHandler)
+		protected.POST("/blogs/:blogID/view/heartbeat", r.blogHandler.ConfirmBlogViewHandler)
+		protected.GET("/blogs/:blogID/views/geo", r.blogHandler.GetBlogViewGeoHandler)
 
-		// Comment CRUD routes
+		// Comment write routes
 		protected.POST("/blogs/:blogID/comment", r.commentHandler.CreateComment)
 		protected.POST("/comments/:commentID/reply", r.commentHandler.CreateReply) // Create a reply to a comment
-		protected.GET("/blogs/:blogID/comments", r.commentHandler.GetBlogComments)
-		protected.GET("/blogs/:blogID/comments/count", r.commentHandler.GetBlogCommentsCount) // Total comments in a blog
-		protected.GET("/comments/:commentID", r.commentHandler.GetComment)                    // Single comment by ID
-		protected.GET("/comments/:commentID/replies", r.commentHandler.GetCommentReplies)     // Fetch all replies (nested) for a comment
-		protected.GET("/comments/:commentID/count", r.commentHandler.GetCommentStatistics)    // Fetch comment by ID with total reply count
-		protected.GET("/comments/:commentID/depth", r.commentHandler.GetCommentDepth)         // Depth of a comment thread
 		protected.PUT("/comments/:commentID", r.commentHandler.UpdateComment)
 		protected.DELETE("/comments/:commentID", r.commentHandler.DeleteComment)
-		protected.GET("/comments/:commentID/thread", r.commentHandler.GetCommentThread) // Fetch comment thread (all nested replies)
 
 		// Comment engagement & moderation
 		protected.POST("/comments/:commentID/like", r.commentHandler.LikeComment)
 		protected.POST("/comments/:commentID/unlike", r.commentHandler.UnlikeComment)
+		protected.POST("/comments/:commentID/react", r.commentHandler.ReactToComment)
+		protected.POST("/comments/:commentID/unreact", r.commentHandler.RemoveCommentReaction)
 		protected.POST("/comments/:commentID/report", r.commentHandler.ReportComment)
 		protected.PUT("/comments/:commentID/status", r.commentHandler.UpdateCommentStatus)
 		protected.GET("/users/:userId/comments", r.commentHandler.GetUserComments)
 	}
 
+	// Admin routes (authentication + admin role required)
+	admin := v1.Group("/admin")
+	admin.Use(middleware.AuthMiddleWare(r.jwtService, r.userUsecase), middleware.AdminOnly())
+	{
+		// Shadow ban management
+		admin.POST("/users/:id/shadow-ban", r.userHandler.ShadowBanUser)
+		admin.DELETE("/users/:id/shadow-ban", r.userHandler.UnshadowBanUser)
+		admin.GET("/users/shadow-banned", r.userHandler.ListShadowBannedUsers)
+
+		// Role management
+		admin.PUT("/users/:id/role", r.userHandler.UpdateUserRole)
+
+		// Account lifecycle: deactivation hides the user's content from
+		// listings, deletion scrubs PII and anonymizes their comments
+		admin.POST("/users/:id/deactivate", r.userHandler.DeactivateUser)
+		admin.DELETE("/users/:id/deactivate", r.userHandler.ReactivateUser)
+		admin.DELETE("/users/:id", r.userHandler.DeleteUser)
+
+		// Editorial review verdicts
+		admin.POST("/blogs/:blogID/review", r.blogHandler.ReviewBlogHandler)
+
+		// View-fraud review: high anomaly scores and spike rollback
+		admin.GET("/view-anomalies", r.blogHandler.ListViewAnomaliesHandler)
+		admin.POST("/blogs/:blogID/views/rollback", r.blogHandler.RollbackBlogViewsHandler)
+
+		// Captured (redacted) request/response exchanges for incident
+		// debugging
+		if r.auditLog != nil {
+			auditHandler := NewAuditLogHandler(r.auditLog)
+			admin.GET("/audit-log", auditHandler.ListEntriesHandler)
+		}
+
+		// Runtime-tunable configuration: hot reload without a restart
+		if r.reloadableConfig != nil {
+			configHandler := NewConfigHandler(r.reloadableConfig)
+			admin.GET("/config", configHandler.GetValuesHandler)
+			admin.PUT("/config", configHandler.ApplyOverridesHandler)
+			admin.POST("/config/reload", configHandler.ReloadHandler)
+			admin.GET("/config/changes", configHandler.ListChangesHandler)
+		}
+
+		// Search analytics: top and zero-result queries
+		admin.GET("/search/analytics", r.blogHandler.GetSearchAnalyticsHandler)
+
+		// Tag merge (duplicate tag cleanup)
+		admin.POST("/tags/:id/merge", r.tagHandler.MergeTagsHandler)
+
+		// Security event audit trail
+		admin.GET("/users/:id/security-events", r.userHandler.GetUserSecurityEvents)
+
+		// Comment report moderation
+		admin.GET("/reports", r.commentHandler.GetCommentReports)
+		admin.PUT("/reports/:reportID", r.commentHandler.UpdateReportStatus)
+
+		// Bulk comment moderation
+		admin.POST("/comments/bulk", r.commentHandler.BulkModerateComments)
+
+		// Moderation audit history
+		admin.GET("/comments/:id/history", r.commentHandler.GetCommentModerationHistory)
+		admin.GET("/users/:id/moderation-history", r.commentHandler.GetUserModerationHistory)
+
+		// Registration email domain blocklist
+		if r.emailBlocklist != nil {
+			blocklistHandler := NewBlocklistHandler(r.emailBlocklist)
+			admin.GET("/blocked-domains", blocklistHandler.ListBlockedDomainsHandler)
+			admin.POST("/blocked-domains", blocklistHandler.AddBlockedDomainHandler)
+			admin.DELETE("/blocked-domains/:domain", blocklistHandler.RemoveBlockedDomainHandler)
+		}
+
+		// A/B experiment management
+		if r.experiments != nil {
+			experimentsHandler := NewExperimentsHandler(r.experiments)
+			admin.GET("/experiments", experimentsHandler.ListExperimentsHandler)
+			admin.PUT("/experiments/:name", experimentsHandler.SetExperimentHandler)
+			admin.DELETE("/experiments/:name", experimentsHandler.DeleteExperimentHandler)
+		}
+
+		// Runtime feature flags
+		if r.featureFlags != nil {
+			featureFlagsHandler := NewFeatureFlagsHandler(r.featureFlags)
+			admin.GET("/feature-flags", featureFlagsHandler.ListFeatureFlagsHandler)
+			admin.PUT("/feature-flags/:name", featureFlagsHandler.SetFeatureFlagHandler)
+			admin.DELETE("/feature-flags/:name", featureFlagsHandler.DeleteFeatureFlagHandler)
+
+			// Maintenance mode switch
+			maintenanceHandler := NewMaintenanceHandler(r.featureFlags)
+			admin.GET("/maintenance", maintenanceHandler.GetMaintenanceHandler)
+			admin.PUT("/maintenance", maintenanceHandler.SetMaintenanceHandler)
+		}
+
+		// Per-country content restrictions and their audit trail
+		if r.geoRestrictions != nil {
+			geoRestrictionHandler := NewGeoRestrictionHandler(r.geoRestrictions)
+			admin.GET("/geo-restrictions", geoRestrictionHandler.ListGeoRestrictionsHandler)
+			admin.PUT("/blogs/:blogID/geo-restrictions", geoRestrictionHandler.RestrictBlogHandler)
+			admin.DELETE("/blogs/:blogID/geo-restrictions", geoRestrictionHandler.UnrestrictBlogHandler)
+			admin.GET("/blogs/:blogID/geo-restrictions/audit", geoRestrictionHandler.GetGeoRestrictionAuditHandler)
+		}
+
+		// Takedown request review queue
+		if r.takedowns != nil {
+			takedownHandler := NewTakedownHandler(r.takedowns)
+			admin.GET("/takedown-requests", takedownHandler.ListTakedownRequestsHandler)
+			admin.PUT("/takedown-requests/:requestID", takedownHandler.ReviewTakedownRequestHandler)
+		}
+
+		// Bulk user import for platform migrations
+		if r.userImport != nil {
+			userImportHandler := NewUserImportHandler(r.userImport)
+			admin.POST("/users/import", userImportHandler.ImportUsersHandler)
+		}
+
+		// Per-role content limits
+		if r.roleLimits != nil {
+			roleLimitsHandler := NewRoleLimitsHandler(r.roleLimits)
+			admin.GET("/limits", roleLimitsHandler.ListRoleLimitsHandler)
+			admin.PUT("/limits/:role", roleLimitsHandler.SetRoleLimitsHandler)
+		}
+
+		// Popularity formula weights
+		if r.popularityWeights != nil {
+			popularityWeightsHandler := NewPopularityWeightsHandler(r.popularityWeights)
+			admin.GET("/popularity-weights", popularityWeightsHandler.GetPopularityWeightsHandler)
+			admin.PUT("/popularity-weights", popularityWeightsHandler.SetPopularityWeightsHandler)
+		}
+
+		// Service client registry (client-credentials flow)
+		if r.serviceAuthHandler != nil {
+			admin.POST("/service-clients", r.serviceAuthHandler.RegisterClientHandler)
+			admin.GET("/service-clients", r.serviceAuthHandler.ListClientsHandler)
+			admin.DELETE("/service-clients/:id", r.serviceAuthHandler.RevokeClientHandler)
+		}
+
+		// Profanity wordlist management
+		if r.profanityFilter != nil {
+			profanityHandler := NewProfanityHandler(r.profanityFilter)
+			admin.GET("/profanity-words", profanityHandler.ListProfanityWordsHandler)
+			admin.POST("/profanity-words", profanityHandler.AddProfanityWordHandler)
+			admin.DELETE("/profanity-words/:word", profanityHandler.RemoveProfanityWordHandler)
+		}
+	}
+
+	// Internal routes for services authenticated with machine tokens; each
+	// endpoint family is gated on its own scope
+	if r.serviceAuthUseCase != nil {
+		internal := v1.Group("/internal")
+		internal.Use(middleware.ServiceAuth(r.serviceAuthUseCase))
+		{
+			internal.GET("/blogs", middleware.RequireServiceScope(entity.ServiceScopeSearchIndex), r.blogHandler.SearchAndFilterBlogsHandler)
+			internal.GET("/blogs/:blogID/views/geo", middleware.RequireServiceScope(entity.ServiceScopeAnalyticsRead), r.blogHandler.GetBlogViewGeoInternalHandler)
+		}
+	}
+
 	// Logout route (no authentication required just accept the refresh token from the request body and invalidate the user session)
 	v1.POST("/logout", r.userHandler.Logout)
 }
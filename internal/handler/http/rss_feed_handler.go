@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// RSSFeedHandler exposes RSS import for syndicated cross-posting: authors
+// register external feeds whose new items arrive as draft posts.
+type RSSFeedHandler struct {
+	rssImportUseCase usecasecontract.IRSSImportUseCase
+}
+
+func NewRSSFeedHandler(rssImportUseCase usecasecontract.IRSSImportUseCase) *RSSFeedHandler {
+	return &RSSFeedHandler{
+		rssImportUseCase: rssImportUseCase,
+	}
+}
+
+// RegisterFeedHandler registers an external feed for the authenticated user.
+func (h *RSSFeedHandler) RegisterFeedHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	var req dto.RegisterRSSFeedRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	feed, err := h.rssImportUseCase.RegisterFeed(c.Request.Context(), userIDStr, req.URL)
+	if err != nil {
+		if strings.Contains(err.Error(), "valid http") ||
+			strings.Contains(err.Error(), "limit") ||
+			strings.Contains(err.Error(), "could not be fetched") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to register RSS feed")
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, feed)
+}
+
+// ListFeedsHandler returns the authenticated user's feeds with their import
+// status and last poll error.
+func (h *RSSFeedHandler) ListFeedsHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	feeds, err := h.rssImportUseCase.ListFeeds(c.Request.Context(), userIDStr)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list RSS feeds")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"feeds": feeds})
+}
+
+// DeleteFeedHandler removes one of the authenticated user's feeds.
+func (h *RSSFeedHandler) DeleteFeedHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	if err := h.rssImportUseCase.DeleteFeed(c.Request.Context(), userIDStr, c.Param("feedID")); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "RSS feed not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to delete RSS feed")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "RSS feed deleted successfully")
+}
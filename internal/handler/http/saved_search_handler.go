@@ -0,0 +1,99 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// SavedSearchHandler exposes saved searches: users store search/filter
+// combinations and get alerted when new posts match.
+type SavedSearchHandler struct {
+	savedSearchUseCase usecasecontract.ISavedSearchUseCase
+}
+
+func NewSavedSearchHandler(savedSearchUseCase usecasecontract.ISavedSearchUseCase) *SavedSearchHandler {
+	return &SavedSearchHandler{
+		savedSearchUseCase: savedSearchUseCase,
+	}
+}
+
+// CreateSavedSearchHandler saves a search/filter combination for the
+// authenticated user.
+func (h *SavedSearchHandler) CreateSavedSearchHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	var req dto.CreateSavedSearchRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	search, err := h.savedSearchUseCase.CreateSavedSearch(c.Request.Context(), userIDStr, req.Name, req.Query, req.Tags)
+	if err != nil {
+		if strings.Contains(err.Error(), "needs a query") || strings.Contains(err.Error(), "limit") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to create saved search")
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, search)
+}
+
+// ListSavedSearchesHandler returns the authenticated user's saved searches.
+func (h *SavedSearchHandler) ListSavedSearchesHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	searches, err := h.savedSearchUseCase.ListSavedSearches(c.Request.Context(), userIDStr)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list saved searches")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"saved_searches": searches})
+}
+
+// DeleteSavedSearchHandler removes one of the authenticated user's saved
+// searches.
+func (h *SavedSearchHandler) DeleteSavedSearchHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	if err := h.savedSearchUseCase.DeleteSavedSearch(c.Request.Context(), userIDStr, c.Param("searchID")); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Saved search not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to delete saved search")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Saved search deleted successfully")
+}
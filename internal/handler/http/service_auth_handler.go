@@ -0,0 +1,95 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// ServiceAuthHandler exposes the client-credentials flow: the token exchange
+// for internal services and the admin registry of service clients.
+type ServiceAuthHandler struct {
+	serviceAuthUseCase usecasecontract.IServiceAuthUseCase
+}
+
+func NewServiceAuthHandler(serviceAuthUseCase usecasecontract.IServiceAuthUseCase) *ServiceAuthHandler {
+	return &ServiceAuthHandler{
+		serviceAuthUseCase: serviceAuthUseCase,
+	}
+}
+
+// TokenHandler exchanges client credentials for a scoped machine token.
+func (h *ServiceAuthHandler) TokenHandler(c *gin.Context) {
+	var req dto.ServiceTokenRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	token, scopes, ttl, err := h.serviceAuthUseCase.IssueToken(c.Request.Context(), req.ClientID, req.ClientSecret, strings.Fields(req.Scope))
+	if err != nil {
+		if strings.Contains(err.Error(), "scope") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusUnauthorized, "Invalid client credentials")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ServiceTokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(ttl.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	})
+}
+
+// RegisterClientHandler registers a service client and returns its secret
+// once.
+func (h *ServiceAuthHandler) RegisterClientHandler(c *gin.Context) {
+	var req dto.RegisterServiceClientRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	client, secret, err := h.serviceAuthUseCase.RegisterClient(c.Request.Context(), req.Name, req.Scopes)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to register service client")
+		return
+	}
+
+	SuccessHandler(c, http.StatusCreated, dto.RegisterServiceClientResponse{
+		ServiceClientResponse: dto.ToServiceClientResponse(client),
+		ClientSecret:          secret,
+	})
+}
+
+// ListClientsHandler lists registered service clients.
+func (h *ServiceAuthHandler) ListClientsHandler(c *gin.Context) {
+	clients, err := h.serviceAuthUseCase.ListClients(c.Request.Context())
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve service clients")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"clients": dto.ToServiceClientResponses(clients)})
+}
+
+// RevokeClientHandler revokes a service client.
+func (h *ServiceAuthHandler) RevokeClientHandler(c *gin.Context) {
+	clientID := c.Param("id")
+	if err := h.serviceAuthUseCase.RevokeClient(c.Request.Context(), clientID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Service client not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to revoke service client")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Service client revoked successfully")
+}
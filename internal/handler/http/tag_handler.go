@@ -0,0 +1,196 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+type TagHandler struct {
+	tagUsecase usecasecontract.ITagUseCase
+}
+
+func NewTagHandler(tagUsecase usecasecontract.ITagUseCase) *TagHandler {
+	return &TagHandler{
+		tagUsecase: tagUsecase,
+	}
+}
+
+// GetTagDetailHandler handles the public tag page: tag metadata, blog count,
+// follower count and trending posts. Logged-in readers also get their follow
+// state via the optional auth middleware.
+func (h *TagHandler) GetTagDetailHandler(c *gin.Context) {
+	slug := c.Param("slug")
+
+	var userID *string
+	if uid, ok := middleware.UserIDFromContext(c); ok {
+		userID = &uid
+	}
+
+	detail, err := h.tagUsecase.GetTagDetail(c.Request.Context(), slug, userID)
+	if err != nil {
+		if strings.Contains(err.Error(), "tag not found") {
+			ErrorHandler(c, http.StatusNotFound, "Tag not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get tag")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToTagDetailResponse(detail))
+}
+
+// GetTagSuggestionsHandler handles tag auto-complete for the editor's tag
+// input: prefix-matched tags ordered by usage count.
+func (h *TagHandler) GetTagSuggestionsHandler(c *gin.Context) {
+	suggestions, err := h.tagUsecase.SuggestTags(c.Request.Context(), c.Query("q"))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to suggest tags")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToTagSuggestionResponses(suggestions))
+}
+
+// FollowTagHandler subscribes the authenticated user to a tag.
+func (h *TagHandler) FollowTagHandler(c *gin.Context) {
+	slug := c.Param("slug")
+
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if err := h.tagUsecase.FollowTag(c.Request.Context(), slug, userID); err != nil {
+		if strings.Contains(err.Error(), "tag not found") {
+			ErrorHandler(c, http.StatusNotFound, "Tag not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to follow tag")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Tag followed successfully")
+}
+
+// UnfollowTagHandler removes the authenticated user's follow for a tag.
+func (h *TagHandler) UnfollowTagHandler(c *gin.Context) {
+	slug := c.Param("slug")
+
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if err := h.tagUsecase.UnfollowTag(c.Request.Context(), slug, userID); err != nil {
+		if strings.Contains(err.Error(), "tag not found") {
+			ErrorHandler(c, http.StatusNotFound, "Tag not found")
+			return
+		}
+		if strings.Contains(err.Error(), "tag not followed") {
+			ErrorHandler(c, http.StatusNotFound, "Tag not followed")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to unfollow tag")
+		return
+	}
+
+	MessageHandler(c, http.StatusOK, "Tag unfollowed successfully")
+}
+
+// MergeTagsHandler folds a duplicate tag into another (admin only). Blogs and
+// followers are reassigned and the old slug keeps resolving via an alias.
+func (h *TagHandler) MergeTagsHandler(c *gin.Context) {
+	sourceTagID := c.Param("id")
+
+	var req dto.MergeTagsRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	target, err := h.tagUsecase.MergeTags(c.Request.Context(), sourceTagID, req.Into)
+	if err != nil {
+		if strings.Contains(err.Error(), "tag not found") {
+			ErrorHandler(c, http.StatusNotFound, err.Error())
+			return
+		}
+		if strings.Contains(err.Error(), "merge a tag into itself") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to merge tags")
+		return
+	}
+
+	SuccessHandler(c, http.StatusOK, dto.ToTagResponse(target))
+}
+
+// GetFeedHandler returns the personalized feed: recent blogs under the tags
+// the authenticated user follows.
+func (h *TagHandler) GetFeedHandler(c *gin.Context) {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	blogs, total, err := h.tagUsecase.GetFollowedFeed(c.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get feed")
+		return
+	}
+
+	resp := make([]dto.BlogResponse, 0, len(blogs))
+	for _, b := range blogs {
+		resp = append(resp, dto.ToBlogResponse(b))
+	}
+
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+	}
+	result := dto.PaginatedBlogResponse{Blogs: resp, TotalCount: int(total), CurrentPage: page, TotalPages: totalPages}
+	SuccessHandler(c, http.StatusOK, result)
+}
+
+// GetProjectedFeedHandler serves the home feed from the precomputed
+// projection (latest + followed tags), falling back to the per-request query
+// when no projection is configured.
+func (h *TagHandler) GetProjectedFeedHandler(c *gin.Context) {
+	userID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	blogs, total, err := h.tagUsecase.GetProjectedFeed(c.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to get feed")
+		return
+	}
+
+	resp := make([]dto.BlogResponse, 0, len(blogs))
+	for _, b := range blogs {
+		resp = append(resp, dto.ToBlogResponse(b))
+	}
+
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+	}
+	result := dto.PaginatedBlogResponse{Blogs: resp, TotalCount: int(total), CurrentPage: page, TotalPages: totalPages}
+	SuccessHandler(c, http.StatusOK, result)
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// TakedownHandler exposes the public takedown submission endpoint and the
+// admin review queue.
+type TakedownHandler struct {
+	takedowns usecase.ITakedownUseCase
+}
+
+func NewTakedownHandler(takedowns usecase.ITakedownUseCase) *TakedownHandler {
+	return &TakedownHandler{
+		takedowns: takedowns,
+	}
+}
+
+// SubmitTakedownHandler files a takedown request against a post. Public:
+// complainants do not need an account.
+func (h *TakedownHandler) SubmitTakedownHandler(c *gin.Context) {
+	var req dto.SubmitTakedownRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	request, err := h.takedowns.SubmitRequest(c.Request.Context(), req.BlogID, req.ReporterName, req.ReporterEmail, req.Reason)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to submit takedown request")
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, gin.H{"request_id": request.ID, "status": request.Status})
+}
+
+// ListTakedownRequestsHandler returns the admin review queue, optionally
+// filtered with ?status=pending|accepted|rejected.
+func (h *TakedownHandler) ListTakedownRequestsHandler(c *gin.Context) {
+	var status *entity.TakedownStatus
+	if s := c.Query("status"); s != "" {
+		switch entity.TakedownStatus(s) {
+		case entity.TakedownStatusPending, entity.TakedownStatusAccepted, entity.TakedownStatusRejected:
+			v := entity.TakedownStatus(s)
+			status = &v
+		default:
+			ErrorHandler(c, http.StatusBadRequest, "Invalid status filter")
+			return
+		}
+	}
+
+	requests, err := h.takedowns.ListRequests(c.Request.Context(), status)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list takedown requests")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"requests": requests})
+}
+
+// ReviewTakedownRequestHandler records an admin verdict on a takedown
+// request. Accepting unpublishes the post behind a tombstone page.
+func (h *TakedownHandler) ReviewTakedownRequestHandler(c *gin.Context) {
+	reviewerID, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReviewTakedownRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	request, err := h.takedowns.ReviewRequest(c.Request.Context(), c.Param("requestID"), reviewerID, *req.Accept, req.Note)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			ErrorHandler(c, http.StatusNotFound, "Takedown request not found")
+		case strings.Contains(err.Error(), "already been reviewed"):
+			ErrorHandler(c, http.StatusConflict, "Takedown request has already been reviewed")
+		default:
+			ErrorHandler(c, http.StatusInternalServerError, "Failed to review takedown request")
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusOK, request)
+}
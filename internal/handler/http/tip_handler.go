@@ -0,0 +1,104 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// maxWebhookBodySize bounds the payment webhook payload.
+const maxWebhookBodySize = 64 * 1024
+
+// TipHandler exposes tipping: readers open a checkout for a post, the
+// payment provider confirms completed tips over a webhook, and authors read
+// their earnings.
+type TipHandler struct {
+	tipUseCase usecasecontract.ITipUseCase
+}
+
+func NewTipHandler(tipUseCase usecasecontract.ITipUseCase) *TipHandler {
+	return &TipHandler{
+		tipUseCase: tipUseCase,
+	}
+}
+
+// CreateTipHandler opens a checkout session for a tip on the blog and
+// returns the URL to redirect the reader to.
+func (h *TipHandler) CreateTipHandler(c *gin.Context) {
+	blogID := c.Param("blogID")
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	var req dto.TipRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	checkoutURL, err := h.tipUseCase.CreateTip(c.Request.Context(), blogID, userIDStr, req.AmountCents, req.Currency)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			ErrorHandler(c, http.StatusNotFound, "Blog not found")
+			return
+		}
+		if strings.Contains(err.Error(), "own blog") || strings.Contains(err.Error(), "amount") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to create tip")
+		return
+	}
+	SuccessHandler(c, http.StatusCreated, gin.H{"checkout_url": checkoutURL})
+}
+
+// TipWebhookHandler receives payment provider webhooks and records
+// completed tips. The signature is verified by the provider implementation.
+func (h *TipHandler) TipWebhookHandler(c *gin.Context) {
+	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookBodySize))
+	if err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Failed to read webhook payload")
+		return
+	}
+
+	if err := h.tipUseCase.HandleWebhook(c.Request.Context(), payload, c.GetHeader("Stripe-Signature")); err != nil {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid webhook")
+		return
+	}
+	MessageHandler(c, http.StatusOK, "Webhook processed")
+}
+
+// GetEarningsHandler returns the authenticated author's completed-tip
+// totals and most recent tips.
+func (h *TipHandler) GetEarningsHandler(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid user ID format in token")
+		return
+	}
+
+	earnings, tips, err := h.tipUseCase.GetEarnings(c.Request.Context(), userIDStr)
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve earnings")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{
+		"earnings":    earnings,
+		"recent_tips": dto.ToTipResponses(tips),
+	})
+}
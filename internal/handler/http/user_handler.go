@@ -1,11 +1,14 @@
 package http
 
 import (
-	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/mikiasgoitom/Articulate/internal/handler/http/dto"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/handler/http/middleware"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
@@ -26,7 +29,8 @@ type UserHandlerInterface interface {
 var _ UserHandlerInterface = (*UserHandler)(nil)
 
 type UserHandler struct {
-	userUsecase usecasecontract.IUserUseCase
+	userUsecase     usecasecontract.IUserUseCase
+	captchaVerifier contract.ICaptchaVerifier
 }
 
 func NewUserHandler(userUsecase usecasecontract.IUserUseCase) *UserHandler {
@@ -35,6 +39,11 @@ func NewUserHandler(userUsecase usecasecontract.IUserUseCase) *UserHandler {
 	}
 }
 
+// SetCaptchaVerifier enables CAPTCHA verification on registration and password reset requests.
+func (h *UserHandler) SetCaptchaVerifier(verifier contract.ICaptchaVerifier) {
+	h.captchaVerifier = verifier
+}
+
 // CreateUser handles user registration (signup)
 func (h *UserHandler) CreateUser(c *gin.Context) {
 	var req dto.CreateUserRequest
@@ -42,7 +51,25 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	_, err := h.userUsecase.Register(c.Request.Context(), req.Username, req.Email, req.Password, req.FirstName, req.LastName)
+	// Honeypot: real users never see (or fill) this field, so a value means
+	// the signup came from a bot.
+	if req.Website != "" {
+		ErrorHandler(c, http.StatusBadRequest, "Invalid request")
+		return
+	}
+
+	if !VerifyCaptcha(c, h.captchaVerifier, req.CaptchaToken) {
+		return
+	}
+
+	// Referral attribution: the signup form forwards the ?ref= link
+	// parameter in the body, but accept it straight off the query string too.
+	referralCode := req.ReferralCode
+	if referralCode == "" {
+		referralCode = c.Query("ref")
+	}
+
+	_, err := h.userUsecase.Register(c.Request.Context(), req.Username, req.Email, req.Password, req.FirstName, req.LastName, referralCode, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		ErrorHandler(c, http.StatusConflict, err.Error())
 		return
@@ -59,7 +86,7 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
-	user, accessToken, refreshToken, err := h.userUsecase.Login(c.Request.Context(), req.Email, req.Password)
+	user, accessToken, refreshToken, err := h.userUsecase.Login(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.Request.UserAgent(), entity.NormalizeClientType(req.ClientType), req.RememberMe)
 	if err != nil {
 		ErrorHandler(c, http.StatusUnauthorized, "Invalid credentials or unverified email")
 		return
@@ -115,7 +142,6 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 		return
 	}
 
-	fmt.Printf("Request received: %+v\n", req)
 	updates := updateUserRequestToMap(req)
 	updatedUser, err := h.userUsecase.UpdateProfile(c.Request.Context(), userID.(string), updates)
 	if err != nil {
@@ -125,6 +151,40 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 	SuccessHandler(c, http.StatusOK, dto.ToUserResponse(*updatedUser))
 }
 
+// GetSessions lists the authenticated user's active sessions with their
+// device metadata.
+func (h *UserHandler) GetSessions(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	sessions, err := h.userUsecase.GetActiveSessions(c.Request.Context(), userID.(string))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve sessions")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, gin.H{"sessions": dto.ToSessionResponses(sessions)})
+}
+
+// GetMyReferrals returns the authenticated user's referral code and the
+// signups attributed to it with their conversion status.
+func (h *UserHandler) GetMyReferrals(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	code, referred, err := h.userUsecase.GetMyReferrals(c.Request.Context(), userID.(string))
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve referrals")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToReferralsResponse(code, referred))
+}
+
 // ForgotPassword handles password reset request
 func (h *UserHandler) ForgotPassword(c *gin.Context) {
 	var req dto.ForgotPasswordRequest
@@ -134,6 +194,10 @@ func (h *UserHandler) ForgotPassword(c *gin.Context) {
 		return
 	}
 
+	if !VerifyCaptcha(c, h.captchaVerifier, req.CaptchaToken) {
+		return
+	}
+
 	err := h.userUsecase.ForgotPassword(c.Request.Context(), req.Email)
 	if err != nil {
 		// Don't reveal if email exists or not for security
@@ -213,6 +277,150 @@ func (h *UserHandler) Logout(c *gin.Context) {
 	MessageHandler(c, http.StatusOK, "Logged out successfully")
 }
 
+// ShadowBanUser handles shadow banning a user (admin only)
+func (h *UserHandler) ShadowBanUser(c *gin.Context) {
+	userID := c.Param("id")
+	user, err := h.userUsecase.SetShadowBan(c.Request.Context(), userID, true)
+	if err != nil {
+		if err.Error() == "user not found" {
+			ErrorHandler(c, http.StatusNotFound, "User not found")
+			return
+		}
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToUserResponse(*user))
+}
+
+// UnshadowBanUser handles lifting a shadow ban from a user (admin only)
+func (h *UserHandler) UnshadowBanUser(c *gin.Context) {
+	userID := c.Param("id")
+	user, err := h.userUsecase.SetShadowBan(c.Request.Context(), userID, false)
+	if err != nil {
+		if err.Error() == "user not found" {
+			ErrorHandler(c, http.StatusNotFound, "User not found")
+			return
+		}
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToUserResponse(*user))
+}
+
+// DeactivateUser handles deactivating a user account (admin only). The
+// user's blogs and comments are hidden from listings until reactivation.
+func (h *UserHandler) DeactivateUser(c *gin.Context) {
+	userID := c.Param("id")
+	user, err := h.userUsecase.SetActive(c.Request.Context(), userID, false)
+	if err != nil {
+		if err.Error() == "user not found" {
+			ErrorHandler(c, http.StatusNotFound, "User not found")
+			return
+		}
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToUserResponse(*user))
+}
+
+// ReactivateUser handles reactivating a deactivated user account (admin only)
+func (h *UserHandler) ReactivateUser(c *gin.Context) {
+	userID := c.Param("id")
+	user, err := h.userUsecase.SetActive(c.Request.Context(), userID, true)
+	if err != nil {
+		if err.Error() == "user not found" {
+			ErrorHandler(c, http.StatusNotFound, "User not found")
+			return
+		}
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToUserResponse(*user))
+}
+
+// DeleteUser handles soft-deleting a user account (admin only). The account's
+// PII is scrubbed and its comments anonymized; content stays visible.
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	userID := c.Param("id")
+	if err := h.userUsecase.DeleteUser(c.Request.Context(), userID); err != nil {
+		if err.Error() == "user not found" {
+			ErrorHandler(c, http.StatusNotFound, "User not found")
+			return
+		}
+		ErrorHandler(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	MessageHandler(c, http.StatusOK, "User deleted successfully")
+}
+
+// UpdateUserRole handles changing a user's role (admin only)
+func (h *UserHandler) UpdateUserRole(c *gin.Context) {
+	var req dto.UpdateUserRoleRequest
+	if err := BindAndValidate(c, &req); err != nil {
+		return
+	}
+
+	userID := c.Param("id")
+	actorID, ok := middleware.UserIDFromContext(c)
+	if !ok {
+		ErrorHandler(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	user, err := h.userUsecase.UpdateUserRole(c.Request.Context(), userID, actorID, entity.UserRole(req.Role))
+	if err != nil {
+		switch err.Error() {
+		case "user not found":
+			ErrorHandler(c, http.StatusNotFound, "User not found")
+		case "admins cannot demote themselves":
+			ErrorHandler(c, http.StatusForbidden, err.Error())
+		default:
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	SuccessHandler(c, http.StatusOK, dto.ToUserResponse(*user))
+}
+
+// ListShadowBannedUsers handles listing all shadow banned accounts (admin only)
+func (h *UserHandler) ListShadowBannedUsers(c *gin.Context) {
+	users, err := h.userUsecase.ListShadowBannedUsers(c.Request.Context())
+	if err != nil {
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to list shadow banned users")
+		return
+	}
+
+	responses := make([]dto.UserResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, dto.ToUserResponse(*user))
+	}
+	SuccessHandler(c, http.StatusOK, responses)
+}
+
+// GetUserSecurityEvents handles listing a user's recorded security events (admin only)
+func (h *UserHandler) GetUserSecurityEvents(c *gin.Context) {
+	userID := c.Param("id")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	events, total, err := h.userUsecase.GetSecurityEvents(c.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		if err.Error() == "user not found" {
+			ErrorHandler(c, http.StatusNotFound, "User not found")
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to retrieve security events")
+		return
+	}
+
+	response := gin.H{
+		"events": events,
+		"total":  total,
+		"page":   page,
+	}
+	SuccessHandler(c, http.StatusOK, response)
+}
+
 func updateUserRequestToMap(req dto.UpdateUserRequest) map[string]interface{} {
 	updates := make(map[string]interface{})
 
@@ -220,13 +428,31 @@ func updateUserRequestToMap(req dto.UpdateUserRequest) map[string]interface{} {
 		updates["username"] = *req.Username
 	}
 	if req.FirstName != nil {
-		updates["firstname"] = *req.FirstName
+		updates["first_name"] = *req.FirstName
 	}
 	if req.LastName != nil {
-		updates["lastname"] = *req.LastName
+		updates["last_name"] = *req.LastName
 	}
 	if req.AvatarURL != nil {
-		updates["avatarURL"] = *req.AvatarURL
+		updates["avatar_url"] = *req.AvatarURL
+	}
+	if req.SecurityEmailsOptOut != nil {
+		updates["security_emails_opt_out"] = *req.SecurityEmailsOptOut
+	}
+	if req.LeaderboardOptOut != nil {
+		updates["leaderboard_opt_out"] = *req.LeaderboardOptOut
+	}
+	if req.PushNotificationsOptOut != nil {
+		updates["push_notifications_opt_out"] = *req.PushNotificationsOptOut
+	}
+	if req.CommentDigest != nil {
+		updates["comment_digest"] = *req.CommentDigest
+	}
+	if req.MatureContentOptIn != nil {
+		updates["mature_content_opt_in"] = *req.MatureContentOptIn
+	}
+	if req.PerformanceReport != nil {
+		updates["performance_report"] = *req.PerformanceReport
 	}
 
 	return updates
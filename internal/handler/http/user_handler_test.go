@@ -10,14 +10,18 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	dto "github.com/mikiasgoitom/Articulate/internal/dto"
 	handler "github.com/mikiasgoitom/Articulate/internal/handler/http"
-	dto "github.com/mikiasgoitom/Articulate/internal/handler/http/dto"
 	mocks "github.com/mikiasgoitom/Articulate/internal/handler/http/mocks"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/validator"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
+	// Request DTOs use custom validation tags (e.g. containsuppercase);
+	// binding panics unless they are registered, just as main does at startup.
+	validator.RegisterCustomValidators()
 	os.Exit(m.Run())
 }
 
@@ -35,10 +39,11 @@ func TestCreateUser(t *testing.T) {
 	h := handler.NewUserHandler(mockUsecase)
 	r := setupRouter(h)
 	payload := dto.CreateUserRequest{
-		Username: "testuser",
-		Email:    "test@example.com",
-		Password: "Password123!",
-		LastName: "User",
+		Username:  "testuser",
+		Email:     "test@example.com",
+		Password:  "Password123!",
+		FirstName: "Test",
+		LastName:  "User",
 	}
 	body, _ := json.Marshal(payload)
 
@@ -72,8 +77,9 @@ func TestCreateUser_Fail(t *testing.T) {
 	r.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "Field validation for 'FirstName' failed on the 'required' tag")
-	assert.Contains(t, w.Body.String(), "Field validation for 'LastName' failed on the 'required' tag")
+	// Validation failures come back as structured field/rule details.
+	assert.Contains(t, w.Body.String(), `{"field":"firstname","rule":"required"`)
+	assert.Contains(t, w.Body.String(), `{"field":"lastname","rule":"required"`)
 }
 
 func TestLogin(t *testing.T) {
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+	"github.com/mikiasgoitom/Articulate/internal/usecase"
+)
+
+// UserImportHandler exposes the admin bulk user import endpoint used when
+// migrating accounts from another platform.
+type UserImportHandler struct {
+	imports usecase.IUserImportUseCase
+}
+
+func NewUserImportHandler(imports usecase.IUserImportUseCase) *UserImportHandler {
+	return &UserImportHandler{
+		imports: imports,
+	}
+}
+
+// ImportUsersHandler imports a batch of users from the request body. The
+// body is either CSV (Content-Type text/csv, header row with email,
+// username, role, password_hash columns) or JSON ({"users": [...]}). The
+// response is a report listing how many rows were imported and why the rest
+// were skipped.
+func (h *UserImportHandler) ImportUsersHandler(c *gin.Context) {
+	var rows []usecase.ImportUserInput
+	if strings.Contains(c.ContentType(), "csv") {
+		parsed, err := parseUserImportCSV(c.Request.Body)
+		if err != nil {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		rows = parsed
+	} else {
+		var req dto.ImportUsersRequest
+		if err := BindAndValidate(c, &req); err != nil {
+			return
+		}
+		rows = make([]usecase.ImportUserInput, len(req.Users))
+		for i, user := range req.Users {
+			rows[i] = usecase.ImportUserInput{
+				Email:        user.Email,
+				Username:     user.Username,
+				Role:         user.Role,
+				PasswordHash: user.PasswordHash,
+			}
+		}
+	}
+
+	report, err := h.imports.ImportUsers(c.Request.Context(), rows)
+	if err != nil {
+		if strings.Contains(err.Error(), "no users") || strings.Contains(err.Error(), "too many rows") {
+			ErrorHandler(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorHandler(c, http.StatusInternalServerError, "Failed to import users")
+		return
+	}
+	SuccessHandler(c, http.StatusOK, report)
+}
+
+// parseUserImportCSV reads a CSV export with a header row into import rows.
+// Only the email column is required; username, role and password_hash are
+// matched by header name when present.
+func parseUserImportCSV(r io.Reader) ([]usecase.ImportUserInput, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	emailCol, ok := columns["email"]
+	if !ok {
+		return nil, fmt.Errorf("CSV header must contain an email column")
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	var rows []usecase.ImportUserInput
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed CSV on line %d", len(rows)+2)
+		}
+		if emailCol >= len(record) {
+			continue
+		}
+		rows = append(rows, usecase.ImportUserInput{
+			Email:        record[emailCol],
+			Username:     field(record, "username"),
+			Role:         field(record, "role"),
+			PasswordHash: field(record, "password_hash"),
+		})
+	}
+	return rows, nil
+}
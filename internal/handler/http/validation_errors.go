@@ -0,0 +1,68 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mikiasgoitom/Articulate/internal/dto"
+)
+
+// translateValidationErrors converts validator failures into field-level
+// details so clients get one entry per failed field instead of the raw
+// validator string.
+func translateValidationErrors(errs validator.ValidationErrors) []dto.FieldError {
+	details := make([]dto.FieldError, 0, len(errs))
+	for _, fe := range errs {
+		details = append(details, dto.FieldError{
+			Field:   fe.Field(),
+			Rule:    fe.Tag(),
+			Message: validationMessage(fe),
+		})
+	}
+	return details
+}
+
+// validationMessage renders a human-readable message for one failed rule.
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", fe.Field())
+	case "email":
+		return fmt.Sprintf("%s must be a valid email address", fe.Field())
+	case "url":
+		return fmt.Sprintf("%s must be a valid URL", fe.Field())
+	case "min":
+		if fe.Kind().String() == "string" {
+			return fmt.Sprintf("%s must be at least %s characters long", fe.Field(), fe.Param())
+		}
+		return fmt.Sprintf("%s must be at least %s", fe.Field(), fe.Param())
+	case "max":
+		if fe.Kind().String() == "string" {
+			return fmt.Sprintf("%s must be at most %s characters long", fe.Field(), fe.Param())
+		}
+		return fmt.Sprintf("%s must be at most %s", fe.Field(), fe.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", fe.Field(), fe.Param())
+	case "containsuppercase":
+		return fmt.Sprintf("%s must contain at least one uppercase letter", fe.Field())
+	case "containslowercase":
+		return fmt.Sprintf("%s must contain at least one lowercase letter", fe.Field())
+	case "containsdigit":
+		return fmt.Sprintf("%s must contain at least one number", fe.Field())
+	case "containssymbol":
+		return fmt.Sprintf("%s must contain at least one special character", fe.Field())
+	default:
+		return fmt.Sprintf("%s is invalid", fe.Field())
+	}
+}
+
+// asValidationErrors extracts validator failures from a binding error, if
+// that is what it carries. Malformed JSON and type mismatches return false.
+func asValidationErrors(err error) (validator.ValidationErrors, bool) {
+	var errs validator.ValidationErrors
+	if errors.As(err, &errs) {
+		return errs, true
+	}
+	return nil, false
+}
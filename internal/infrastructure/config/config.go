@@ -3,18 +3,48 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
 // Config holds application configuration values.
 type Config struct {
+	// mu guards the runtime-tunable fields and the reload bookkeeping below;
+	// everything else is read-only after NewConfig.
+	mu        sync.RWMutex
+	overrides map[string]string
+	changes   []usecasecontract.ConfigChange
+	onReload  []func(changes []usecasecontract.ConfigChange)
+
 	SendActivationEmail          bool
 	AppBaseURL                   string
 	RefreshTokenExpiry           time.Duration
+	RefreshTokenExpiryMobile     time.Duration
+	RememberMeRefreshTokenExpiry time.Duration
+	AccessTokenExpiryWeb         time.Duration
+	AccessTokenExpiryMobile      time.Duration
+	AccessTokenExpiryAPI         time.Duration
 	PasswordResetTokenExpiry     time.Duration
 	EmailVerificationTokenExpiry time.Duration
+	CaptchaEnabled               bool
+	CaptchaProvider              string
+	CaptchaSecret                string
+	AllowAnonymousComments       bool
+	MaintenanceMode              bool
+	EmailVerifySuccessURL        string
+	EmailVerifyFailureURL        string
+	PasswordHashAlgorithm        string
+	BcryptCost                   int
+	CommentRateLimitPerMinute    int
+	NewAccountCommentCooldown    time.Duration
+	NewAccountProbationPeriod    time.Duration
+	ProbationCommentRateLimit    int
+	AICacheTTLMinutes            int
+	ProfanityWords               []string
 }
 
 // NewConfig creates a new Config instance, loading values from environment variables.
@@ -22,9 +52,29 @@ func NewConfig() usecasecontract.IConfigProvider {
 	return &Config{
 		SendActivationEmail:          getEnvAsBool("SEND_ACTIVATION_EMAIL", false),
 		AppBaseURL:                   getEnv("APP_BASE_URL", "http://localhost:8080"),
-		RefreshTokenExpiry:           time.Hour * time.Duration(getEnvAsInt("REFRESH_TOKEN_EXPIRY_HOURS", 168)), // 7 days
+		RefreshTokenExpiry:           time.Hour * time.Duration(getEnvAsInt("REFRESH_TOKEN_EXPIRY_HOURS", 168)),           // 7 days
+		RefreshTokenExpiryMobile:     time.Hour * time.Duration(getEnvAsInt("REFRESH_TOKEN_EXPIRY_MOBILE_HOURS", 720)),    // 30 days
+		RememberMeRefreshTokenExpiry: time.Hour * time.Duration(getEnvAsInt("REMEMBER_ME_REFRESH_EXPIRY_HOURS", 720)),     // 30 days
+		AccessTokenExpiryWeb:         time.Minute * time.Duration(getEnvAsInt("ACCESS_TOKEN_EXPIRY_WEB_MINUTES", 360)),    // 6 hours
+		AccessTokenExpiryMobile:      time.Minute * time.Duration(getEnvAsInt("ACCESS_TOKEN_EXPIRY_MOBILE_MINUTES", 720)), // 12 hours
+		AccessTokenExpiryAPI:         time.Minute * time.Duration(getEnvAsInt("ACCESS_TOKEN_EXPIRY_API_MINUTES", 60)),     // 1 hour
 		PasswordResetTokenExpiry:     time.Minute * time.Duration(getEnvAsInt("PASSWORD_RESET_TOKEN_EXPIRY_MINUTES", 15)),
 		EmailVerificationTokenExpiry: time.Minute * time.Duration(getEnvAsInt("EMAIL_VERIFICATION_TOKEN_EXPIRY_MINUTES", 60)),
+		CaptchaEnabled:               getEnvAsBool("CAPTCHA_ENABLED", false),
+		CaptchaProvider:              getEnv("CAPTCHA_PROVIDER", "recaptcha"),
+		CaptchaSecret:                getEnv("CAPTCHA_SECRET", ""),
+		AllowAnonymousComments:       getEnvAsBool("ALLOW_ANONYMOUS_COMMENTS", false),
+		MaintenanceMode:              getEnvAsBool("MAINTENANCE_MODE", false),
+		EmailVerifySuccessURL:        getEnv("EMAIL_VERIFY_SUCCESS_URL", ""),
+		EmailVerifyFailureURL:        getEnv("EMAIL_VERIFY_FAILURE_URL", ""),
+		PasswordHashAlgorithm:        getEnv("PASSWORD_HASH_ALGORITHM", "bcrypt"),
+		BcryptCost:                   getEnvAsInt("BCRYPT_COST", 12),
+		CommentRateLimitPerMinute:    getEnvAsInt("COMMENT_RATE_LIMIT_PER_MINUTE", 5),
+		NewAccountCommentCooldown:    time.Minute * time.Duration(getEnvAsInt("NEW_ACCOUNT_COMMENT_COOLDOWN_MINUTES", 10)),
+		NewAccountProbationPeriod:    time.Hour * time.Duration(getEnvAsInt("NEW_ACCOUNT_PROBATION_HOURS", 72)),
+		ProbationCommentRateLimit:    getEnvAsInt("PROBATION_COMMENT_RATE_LIMIT_PER_MINUTE", 2),
+		AICacheTTLMinutes:            getEnvAsInt("AI_CACHE_TTL_MINUTES", 24*60),
+		ProfanityWords:               getEnvAsSlice("PROFANITY_WORDS"),
 	}
 }
 
@@ -43,6 +93,32 @@ func (c *Config) GetRefreshTokenExpiry() time.Duration {
 	return c.RefreshTokenExpiry
 }
 
+// GetAccessTokenExpiryFor returns the access token lifetime for a client type.
+func (c *Config) GetAccessTokenExpiryFor(clientType entity.ClientType) time.Duration {
+	switch clientType {
+	case entity.ClientTypeMobile:
+		return c.AccessTokenExpiryMobile
+	case entity.ClientTypeAPI:
+		return c.AccessTokenExpiryAPI
+	default:
+		return c.AccessTokenExpiryWeb
+	}
+}
+
+// GetRefreshTokenExpiryFor returns the refresh token lifetime for a client
+// type; a remember-me login extends it when the remember-me lifetime is
+// longer.
+func (c *Config) GetRefreshTokenExpiryFor(clientType entity.ClientType, rememberMe bool) time.Duration {
+	expiry := c.RefreshTokenExpiry
+	if clientType == entity.ClientTypeMobile {
+		expiry = c.RefreshTokenExpiryMobile
+	}
+	if rememberMe && c.RememberMeRefreshTokenExpiry > expiry {
+		expiry = c.RememberMeRefreshTokenExpiry
+	}
+	return expiry
+}
+
 // GetPasswordResetTokenExpiry returns the expiry duration for password reset tokens.
 func (c *Config) GetPasswordResetTokenExpiry() time.Duration {
 	return c.PasswordResetTokenExpiry
@@ -70,6 +146,22 @@ func getEnvAsInt(name string, fallback int) int {
 	return fallback
 }
 
+// Helper function to get a comma-separated environment variable as a slice;
+// empty or missing values yield a nil slice.
+func getEnvAsSlice(name string) []string {
+	valueStr := getEnv(name, "")
+	if valueStr == "" {
+		return nil
+	}
+	var values []string
+	for _, value := range strings.Split(valueStr, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 // Helper function to get an environment variable as a boolean or return a default value.
 func getEnvAsBool(name string, fallback bool) bool {
 	valStr := getEnv(name, "")
@@ -82,3 +174,105 @@ func getEnvAsBool(name string, fallback bool) bool {
 func (c *Config) GetAIServiceAPIKey() string {
 	return getEnv("AI_SERVICE_API_KEY", "")
 }
+
+// GetCaptchaEnabled returns whether CAPTCHA verification is enabled.
+func (c *Config) GetCaptchaEnabled() bool {
+	return c.CaptchaEnabled
+}
+
+// GetCaptchaProvider returns the configured CAPTCHA provider ("recaptcha" or "hcaptcha").
+func (c *Config) GetCaptchaProvider() string {
+	return c.CaptchaProvider
+}
+
+// GetCaptchaSecret returns the CAPTCHA provider secret key.
+func (c *Config) GetCaptchaSecret() string {
+	return c.CaptchaSecret
+}
+
+// GetAllowAnonymousComments returns whether unauthenticated visitors may comment.
+func (c *Config) GetAllowAnonymousComments() bool {
+	return c.AllowAnonymousComments
+}
+
+// GetMaintenanceMode returns whether the service starts in maintenance mode.
+// The runtime flag takes precedence once an admin sets it.
+func (c *Config) GetMaintenanceMode() bool {
+	return c.MaintenanceMode
+}
+
+// GetPasswordHashAlgorithm returns the configured password hashing algorithm
+// ("bcrypt" or "argon2id").
+func (c *Config) GetPasswordHashAlgorithm() string {
+	return c.PasswordHashAlgorithm
+}
+
+// GetBcryptCost returns the configured bcrypt cost factor.
+func (c *Config) GetBcryptCost() int {
+	return c.BcryptCost
+}
+
+// GetProfanityWords returns extra profanity words configured via
+// environment, comma-separated.
+func (c *Config) GetProfanityWords() []string {
+	return c.ProfanityWords
+}
+
+// GetCommentRateLimitPerMinute returns how many comments a user may post per
+// minute; zero disables the limit. Runtime-tunable.
+func (c *Config) GetCommentRateLimitPerMinute() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CommentRateLimitPerMinute
+}
+
+// GetNewAccountCommentCooldown returns how long a freshly registered account
+// must wait before it can comment; zero disables the cooldown.
+// Runtime-tunable.
+func (c *Config) GetNewAccountCommentCooldown() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.NewAccountCommentCooldown
+}
+
+// GetNewAccountProbationPeriod returns how long new accounts stay in
+// probation mode; zero disables probation restrictions. Runtime-tunable.
+func (c *Config) GetNewAccountProbationPeriod() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.NewAccountProbationPeriod
+}
+
+// GetProbationCommentRateLimitPerMinute returns the tighter per-minute
+// comment cap applied to accounts on probation. Runtime-tunable.
+func (c *Config) GetProbationCommentRateLimitPerMinute() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ProbationCommentRateLimit
+}
+
+// GetAICacheTTLMinutes returns how long cached AI responses stay valid, in
+// minutes. Runtime-tunable.
+func (c *Config) GetAICacheTTLMinutes() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.AICacheTTLMinutes
+}
+
+// GetEmailVerifySuccessURL returns the frontend URL to redirect to after a
+// successful email verification, falling back to a page on the app base URL.
+func (c *Config) GetEmailVerifySuccessURL() string {
+	if c.EmailVerifySuccessURL != "" {
+		return c.EmailVerifySuccessURL
+	}
+	return c.AppBaseURL + "/email-verified-success"
+}
+
+// GetEmailVerifyFailureURL returns the frontend URL to redirect to after a
+// failed email verification, falling back to a page on the app base URL.
+func (c *Config) GetEmailVerifyFailureURL() string {
+	if c.EmailVerifyFailureURL != "" {
+		return c.EmailVerifyFailureURL
+	}
+	return c.AppBaseURL + "/email-verified-failed"
+}
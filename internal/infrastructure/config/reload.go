@@ -0,0 +1,184 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// maxConfigChangeHistory caps the audited change history kept in memory.
+const maxConfigChangeHistory = 100
+
+// reloadableKeys lists the runtime-tunable environment keys and the
+// validator applied to a value before it may replace the current one. Keys
+// outside this set stay fixed for the life of the process (secrets,
+// connection strings, token lifetimes).
+var reloadableKeys = map[string]func(value string) error{
+	"COMMENT_RATE_LIMIT_PER_MINUTE":           validateNonNegativeInt,
+	"PROBATION_COMMENT_RATE_LIMIT_PER_MINUTE": validateNonNegativeInt,
+	"NEW_ACCOUNT_COMMENT_COOLDOWN_MINUTES":    validateNonNegativeInt,
+	"NEW_ACCOUNT_PROBATION_HOURS":             validateNonNegativeInt,
+	"AI_CACHE_TTL_MINUTES":                    validatePositiveInt,
+}
+
+var _ usecasecontract.IReloadableConfig = (*Config)(nil)
+
+// ReloadableValues returns the current effective value of every
+// runtime-tunable key.
+func (c *Config) ReloadableValues() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.snapshotLocked()
+}
+
+// ApplyOverrides validates and applies runtime overrides. If any key is
+// unknown or any value invalid, nothing is applied.
+func (c *Config) ApplyOverrides(source string, values map[string]string) ([]usecasecontract.ConfigChange, error) {
+	for key, value := range values {
+		validate, ok := reloadableKeys[key]
+		if !ok {
+			return nil, fmt.Errorf("config key %s is unknown or not reloadable", key)
+		}
+		if err := validate(value); err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+	}
+
+	c.mu.Lock()
+	if c.overrides == nil {
+		c.overrides = make(map[string]string, len(values))
+	}
+	for key, value := range values {
+		c.overrides[key] = value
+	}
+	changes := c.reapplyLocked(source)
+	hooks := c.onReload
+	c.mu.Unlock()
+
+	notifyReload(hooks, changes)
+	return changes, nil
+}
+
+// Reload discards runtime overrides and re-resolves the tunable keys from
+// the process environment, restoring the configuration the server booted
+// with.
+func (c *Config) Reload(source string) []usecasecontract.ConfigChange {
+	c.mu.Lock()
+	c.overrides = nil
+	changes := c.reapplyLocked(source)
+	hooks := c.onReload
+	c.mu.Unlock()
+
+	notifyReload(hooks, changes)
+	return changes
+}
+
+// Changes returns the audited change history, newest first.
+func (c *Config) Changes() []usecasecontract.ConfigChange {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	changes := make([]usecasecontract.ConfigChange, len(c.changes))
+	for i, change := range c.changes {
+		changes[len(c.changes)-1-i] = change
+	}
+	return changes
+}
+
+// OnReload registers a hook invoked after changes are applied.
+func (c *Config) OnReload(fn func(changes []usecasecontract.ConfigChange)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onReload = append(c.onReload, fn)
+}
+
+// reapplyLocked recomputes the tunable fields from overrides and the
+// environment, records the resulting changes in the audit history and
+// returns them. Callers must hold the write lock.
+func (c *Config) reapplyLocked(source string) []usecasecontract.ConfigChange {
+	before := c.snapshotLocked()
+
+	c.CommentRateLimitPerMinute = c.intValueLocked("COMMENT_RATE_LIMIT_PER_MINUTE", 5)
+	c.ProbationCommentRateLimit = c.intValueLocked("PROBATION_COMMENT_RATE_LIMIT_PER_MINUTE", 2)
+	c.NewAccountCommentCooldown = time.Minute * time.Duration(c.intValueLocked("NEW_ACCOUNT_COMMENT_COOLDOWN_MINUTES", 10))
+	c.NewAccountProbationPeriod = time.Hour * time.Duration(c.intValueLocked("NEW_ACCOUNT_PROBATION_HOURS", 72))
+	c.AICacheTTLMinutes = c.intValueLocked("AI_CACHE_TTL_MINUTES", 24*60)
+
+	after := c.snapshotLocked()
+	now := time.Now().UTC()
+	var changes []usecasecontract.ConfigChange
+	for key, newValue := range after {
+		if oldValue := before[key]; oldValue != newValue {
+			changes = append(changes, usecasecontract.ConfigChange{
+				Key:    key,
+				Old:    oldValue,
+				New:    newValue,
+				Source: source,
+				At:     now,
+			})
+		}
+	}
+	c.changes = append(c.changes, changes...)
+	if excess := len(c.changes) - maxConfigChangeHistory; excess > 0 {
+		c.changes = c.changes[excess:]
+	}
+	return changes
+}
+
+// snapshotLocked formats the current tunable values, keyed by environment
+// variable name. Callers must hold at least the read lock.
+func (c *Config) snapshotLocked() map[string]string {
+	return map[string]string{
+		"COMMENT_RATE_LIMIT_PER_MINUTE":           strconv.Itoa(c.CommentRateLimitPerMinute),
+		"PROBATION_COMMENT_RATE_LIMIT_PER_MINUTE": strconv.Itoa(c.ProbationCommentRateLimit),
+		"NEW_ACCOUNT_COMMENT_COOLDOWN_MINUTES":    strconv.Itoa(int(c.NewAccountCommentCooldown / time.Minute)),
+		"NEW_ACCOUNT_PROBATION_HOURS":             strconv.Itoa(int(c.NewAccountProbationPeriod / time.Hour)),
+		"AI_CACHE_TTL_MINUTES":                    strconv.Itoa(c.AICacheTTLMinutes),
+	}
+}
+
+// intValueLocked resolves a tunable key — override first, then environment,
+// then the boot default. Callers must hold the write lock.
+func (c *Config) intValueLocked(key string, fallback int) int {
+	value, ok := c.overrides[key]
+	if !ok {
+		value = getEnv(key, "")
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		return n
+	}
+	return fallback
+}
+
+func notifyReload(hooks []func(changes []usecasecontract.ConfigChange), changes []usecasecontract.ConfigChange) {
+	if len(changes) == 0 {
+		return
+	}
+	for _, hook := range hooks {
+		hook(changes)
+	}
+}
+
+func validateNonNegativeInt(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.New("must be an integer")
+	}
+	if n < 0 {
+		return errors.New("must not be negative")
+	}
+	return nil
+}
+
+func validatePositiveInt(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.New("must be an integer")
+	}
+	if n <= 0 {
+		return errors.New("must be a positive integer")
+	}
+	return nil
+}
@@ -54,17 +54,50 @@ func createIndexes(ctx context.Context, db *mongo.Database) error {
 		return fmt.Errorf("failed to create TTL index for blog_views: %w", err)
 	}
 
-	// Unique index for user email
+	// TTL index for pending_views: views whose dwell-time heartbeat never
+	// arrives are swept instead of counting
+	pendingViewsCollection := db.Collection("pending_views")
+	pendingViewTTLIndex := mongo.IndexModel{
+		Keys:    bson.M{"viewed_at": 1},
+		Options: options.Index().SetExpireAfterSeconds(30 * 60), // 30 minutes
+	}
+	_, err = pendingViewsCollection.Indexes().CreateOne(ctx, pendingViewTTLIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create TTL index for pending_views: %w", err)
+	}
+	// Heartbeats look pending views up by blog
+	pendingViewBlogIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "blog_id", Value: 1}},
+	}
+	_, err = pendingViewsCollection.Indexes().CreateOne(ctx, pendingViewBlogIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create index for pending_views: %w", err)
+	}
+
+	// Unique index for user email; this, not the usecase's existence check,
+	// is what decides a race between concurrent registrations. Sparse so
+	// soft-deleted accounts (whose email is scrubbed) don't collide.
 	usersCollection := db.Collection("users")
 	emailIndex := mongo.IndexModel{
 		Keys:    bson.M{"email": 1},
-		Options: options.Index().SetUnique(true),
+		Options: options.Index().SetUnique(true).SetSparse(true),
 	}
 	_, err = usersCollection.Indexes().CreateOne(ctx, emailIndex)
 	if err != nil {
 		return fmt.Errorf("failed to create unique index for users email: %w", err)
 	}
 
+	// Unique index for users username (also serves the blogs author lookup),
+	// sparse for the same reason as the email index
+	usernameIndex := mongo.IndexModel{
+		Keys:    bson.M{"username": 1},
+		Options: options.Index().SetUnique(true).SetSparse(true),
+	}
+	_, err = usersCollection.Indexes().CreateOne(ctx, usernameIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for users username: %w", err)
+	}
+
 	// Compound index for blogs: author_id + created_at (for author timeline queries)
 	blogsCollection := db.Collection("blogs")
 	authorCreatedIndex := mongo.IndexModel{
@@ -113,6 +146,235 @@ func createIndexes(ctx context.Context, db *mongo.Database) error {
 		return fmt.Errorf("failed to create index for blog_tags: %w", err)
 	}
 
+	// Unique indexes for tags name and slug (also back the auto-complete
+	// prefix queries)
+	tagsCollection := db.Collection("tags")
+	tagNameIndex := mongo.IndexModel{
+		Keys:    bson.M{"name": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = tagsCollection.Indexes().CreateOne(ctx, tagNameIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for tags name: %w", err)
+	}
+	tagSlugIndex := mongo.IndexModel{
+		Keys:    bson.M{"slug": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = tagsCollection.Indexes().CreateOne(ctx, tagSlugIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for tags slug: %w", err)
+	}
+
+	// Unique index for organizations slug and unique compound index for
+	// org_members (one membership per user per org)
+	orgsCollection := db.Collection("organizations")
+	orgSlugIndex := mongo.IndexModel{
+		Keys:    bson.M{"slug": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = orgsCollection.Indexes().CreateOne(ctx, orgSlugIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for organizations slug: %w", err)
+	}
+	// Sparse so orgs without a mapped hostname don't collide on the empty value
+	orgDomainIndex := mongo.IndexModel{
+		Keys:    bson.M{"custom_domain": 1},
+		Options: options.Index().SetUnique(true).SetSparse(true),
+	}
+	_, err = orgsCollection.Indexes().CreateOne(ctx, orgDomainIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for organizations custom_domain: %w", err)
+	}
+	orgMembersCollection := db.Collection("org_members")
+	orgMemberIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "org_id", Value: 1}, {Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = orgMembersCollection.Indexes().CreateOne(ctx, orgMemberIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create index for org_members: %w", err)
+	}
+
+	// Unique compound index for tag_follows: one follow per user per tag
+	tagFollowsCollection := db.Collection("tag_follows")
+	tagFollowIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "tag_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = tagFollowsCollection.Indexes().CreateOne(ctx, tagFollowIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create index for tag_follows: %w", err)
+	}
+
+	// Unique index for admin-added blocked email domains
+	blockedDomainsCollection := db.Collection("blocked_email_domains")
+	blockedDomainIndex := mongo.IndexModel{
+		Keys:    bson.M{"domain": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = blockedDomainsCollection.Indexes().CreateOne(ctx, blockedDomainIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for blocked_email_domains: %w", err)
+	}
+
+	// Unique compound index for the profanity wordlist (same word may exist
+	// in different locales)
+	profanityWordsCollection := db.Collection("profanity_words")
+	profanityWordIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "word", Value: 1}, {Key: "locale", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = profanityWordsCollection.Indexes().CreateOne(ctx, profanityWordIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for profanity_words: %w", err)
+	}
+
+	// Unique index for feature flags, addressed by name
+	featureFlagsCollection := db.Collection("feature_flags")
+	featureFlagIndex := mongo.IndexModel{
+		Keys:    bson.M{"name": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = featureFlagsCollection.Indexes().CreateOne(ctx, featureFlagIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for feature_flags: %w", err)
+	}
+
+	// Unique index for experiments, addressed by name; exposure records are
+	// unique per experiment + subject
+	experimentsCollection := db.Collection("experiments")
+	experimentIndex := mongo.IndexModel{
+		Keys:    bson.M{"name": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = experimentsCollection.Indexes().CreateOne(ctx, experimentIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for experiments: %w", err)
+	}
+	exposuresCollection := db.Collection("experiment_exposures")
+	exposureIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "experiment", Value: 1}, {Key: "subject_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = exposuresCollection.Indexes().CreateOne(ctx, exposureIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for experiment_exposures: %w", err)
+	}
+
+	// TTL index for tokens: Mongo's TTL monitor acts as the session cleanup
+	// job, sweeping stale sessions a week after their expiry
+	tokensCollection := db.Collection("tokens")
+	tokenTTLIndex := mongo.IndexModel{
+		Keys:    bson.M{"expires_at": 1},
+		Options: options.Index().SetExpireAfterSeconds(7 * 24 * 60 * 60),
+	}
+	_, err = tokensCollection.Indexes().CreateOne(ctx, tokenTTLIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create TTL index for tokens: %w", err)
+	}
+
+	// Unique index for api_keys: lookups go through the hash of the
+	// presented key, so it must be unique and indexed
+	apiKeysCollection := db.Collection("api_keys")
+	apiKeyHashIndex := mongo.IndexModel{
+		Keys:    bson.M{"key_hash": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = apiKeysCollection.Indexes().CreateOne(ctx, apiKeyHashIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for api_keys: %w", err)
+	}
+
+	// Compound index for outbox_events: the relay polls by status and due time
+	outboxCollection := db.Collection("outbox_events")
+	outboxIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+	}
+	_, err = outboxCollection.Indexes().CreateOne(ctx, outboxIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create index for outbox_events: %w", err)
+	}
+
+	// Unique compound index for newsletter_subscriptions: one subscription
+	// per email per list
+	newsletterCollection := db.Collection("newsletter_subscriptions")
+	newsletterIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "email", Value: 1}, {Key: "author_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = newsletterCollection.Indexes().CreateOne(ctx, newsletterIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for newsletter_subscriptions: %w", err)
+	}
+
+	// Unique compound index for blog_claps: one tally document per user per blog
+	clapsCollection := db.Collection("blog_claps")
+	clapIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "blog_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = clapsCollection.Indexes().CreateOne(ctx, clapIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for blog_claps: %w", err)
+	}
+
+	// Unique index for devices: a push token belongs to one registration,
+	// re-registering moves it to the new owner
+	devicesCollection := db.Collection("devices")
+	deviceIndex := mongo.IndexModel{
+		Keys:    bson.M{"token": 1},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = devicesCollection.Indexes().CreateOne(ctx, deviceIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for devices: %w", err)
+	}
+
+	// TTL index for email_reply_tokens: expired reply addresses are swept
+	// automatically once they pass their expiry
+	replyTokensCollection := db.Collection("email_reply_tokens")
+	replyTokenTTLIndex := mongo.IndexModel{
+		Keys:    bson.M{"expires_at": 1},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+	_, err = replyTokensCollection.Indexes().CreateOne(ctx, replyTokenTTLIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create TTL index for email_reply_tokens: %w", err)
+	}
+
+	// Unique compound index for rss_imported_items: one feed item is
+	// imported as a draft at most once
+	rssItemsCollection := db.Collection("rss_imported_items")
+	rssItemIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "feed_id", Value: 1}, {Key: "guid", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = rssItemsCollection.Indexes().CreateOne(ctx, rssItemIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index for rss_imported_items: %w", err)
+	}
+
+	// Index for upload_sessions: expiry sweep of abandoned chunked uploads
+	uploadSessionsCollection := db.Collection("upload_sessions")
+	uploadSessionIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "expires_at", Value: 1}},
+	}
+	_, err = uploadSessionsCollection.Indexes().CreateOne(ctx, uploadSessionIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create index for upload_sessions: %w", err)
+	}
+
+	// Compound index for security_events: user_id + created_at (for per-user audit queries)
+	securityEventsCollection := db.Collection("security_events")
+	securityEventIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+	}
+	_, err = securityEventsCollection.Indexes().CreateOne(ctx, securityEventIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create index for security_events: %w", err)
+	}
+
 	log.Println("Successfully created database indexes.")
 	return nil
 }
@@ -0,0 +1,201 @@
+package experiments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/uuidgen"
+)
+
+// experimentsRefreshInterval controls how often experiment definitions are
+// reloaded from the repository; assignments between refreshes are served
+// from memory.
+const experimentsRefreshInterval = 30 * time.Second
+
+// ExperimentService assigns subjects to experiment variants using a
+// deterministic weighted hash of the experiment name and subject ID, and
+// records exposure events for later analysis.
+type ExperimentService struct {
+	repo contract.IExperimentRepository
+	bus  contract.ICacheInvalidationBus // optional, coherency across instances
+
+	mu          sync.RWMutex
+	experiments map[string]*entity.Experiment
+	lastRefresh time.Time
+}
+
+// Ensure ExperimentService implements IExperiments
+var _ contract.IExperiments = (*ExperimentService)(nil)
+
+// NewExperimentService creates an experiment assignment service.
+func NewExperimentService(repo contract.IExperimentRepository) *ExperimentService {
+	return &ExperimentService{
+		repo:        repo,
+		experiments: make(map[string]*entity.Experiment),
+	}
+}
+
+// SetInvalidationBus enables cross-instance coherency: experiment writes
+// broadcast an invalidation, and broadcasts from other instances drop the
+// local snapshot so the next assignment re-reads the repository.
+func (s *ExperimentService) SetInvalidationBus(bus contract.ICacheInvalidationBus) {
+	s.bus = bus
+	bus.SubscribeInvalidations(func(topic string) {
+		if topic == contract.InvalidationTopicExperiments {
+			s.markStale()
+		}
+	})
+}
+
+// markStale forces the next assignment to refresh the snapshot.
+func (s *ExperimentService) markStale() {
+	s.mu.Lock()
+	s.lastRefresh = time.Time{}
+	s.mu.Unlock()
+}
+
+// Assign returns the subject's variant for an active experiment and records
+// the exposure; ok is false when the experiment is unknown or inactive.
+// Exposure recording is best-effort so a storage problem never changes the
+// assignment.
+func (s *ExperimentService) Assign(ctx context.Context, experiment, subjectID string) (string, bool) {
+	s.refreshIfStale(ctx)
+
+	s.mu.RLock()
+	definition, ok := s.experiments[strings.TrimSpace(strings.ToLower(experiment))]
+	s.mu.RUnlock()
+	if !ok || !definition.Active || len(definition.Variants) == 0 {
+		return "", false
+	}
+
+	variant := pickVariant(definition, subjectID)
+	if variant == "" {
+		return "", false
+	}
+
+	_ = s.repo.RecordExposure(ctx, &entity.ExperimentExposure{
+		ID:         uuidgen.NewGenerator().NewUUID(),
+		Experiment: definition.Name,
+		SubjectID:  subjectID,
+		Variant:    variant,
+	})
+	return variant, true
+}
+
+// SetExperiment creates or updates an experiment and applies it immediately.
+func (s *ExperimentService) SetExperiment(ctx context.Context, name string, variants []entity.ExperimentVariant, active bool) error {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if name == "" {
+		return errors.New("invalid experiment name")
+	}
+	if len(variants) < 2 {
+		return errors.New("invalid variants: an experiment needs at least two variants")
+	}
+	for _, variant := range variants {
+		if strings.TrimSpace(variant.Name) == "" || variant.Weight <= 0 {
+			return errors.New("invalid variants: every variant needs a name and a positive weight")
+		}
+	}
+
+	experiment := &entity.Experiment{
+		ID:       uuidgen.NewGenerator().NewUUID(),
+		Name:     name,
+		Variants: variants,
+		Active:   active,
+	}
+	if err := s.repo.UpsertExperiment(ctx, experiment); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.experiments[name] = experiment
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicExperiments)
+	}
+	return nil
+}
+
+// ListExperiments returns all experiment definitions.
+func (s *ExperimentService) ListExperiments(ctx context.Context) ([]*entity.Experiment, error) {
+	return s.repo.ListExperiments(ctx)
+}
+
+// DeleteExperiment removes an experiment definition.
+func (s *ExperimentService) DeleteExperiment(ctx context.Context, name string) error {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if err := s.repo.DeleteExperiment(ctx, name); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.experiments, name)
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicExperiments)
+	}
+	return nil
+}
+
+// pickVariant maps the subject into the experiment's weighted variants; the
+// hash covers the experiment name so different experiments split traffic
+// independently.
+func pickVariant(experiment *entity.Experiment, subjectID string) string {
+	totalWeight := 0
+	for _, variant := range experiment.Variants {
+		totalWeight += variant.Weight
+	}
+	if totalWeight <= 0 {
+		return ""
+	}
+
+	hasher := fnv.New32a()
+	fmt.Fprintf(hasher, "%s:%s", experiment.Name, subjectID)
+	bucket := int(hasher.Sum32() % uint32(totalWeight))
+
+	for _, variant := range experiment.Variants {
+		if bucket < variant.Weight {
+			return variant.Name
+		}
+		bucket -= variant.Weight
+	}
+	return experiment.Variants[len(experiment.Variants)-1].Name
+}
+
+// refreshIfStale reloads the experiment snapshot once the refresh interval
+// has elapsed. Repository failures keep the previous snapshot; the refresh
+// timestamp advances regardless so a broken source is not hammered on every
+// assignment.
+func (s *ExperimentService) refreshIfStale(ctx context.Context) {
+	s.mu.RLock()
+	stale := time.Since(s.lastRefresh) >= experimentsRefreshInterval
+	s.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	snapshot := make(map[string]*entity.Experiment)
+	if experiments, err := s.repo.ListExperiments(ctx); err == nil {
+		for _, experiment := range experiments {
+			snapshot[experiment.Name] = experiment
+		}
+	} else {
+		s.mu.RLock()
+		for name, experiment := range s.experiments {
+			snapshot[name] = experiment
+		}
+		s.mu.RUnlock()
+	}
+
+	s.mu.Lock()
+	s.experiments = snapshot
+	s.lastRefresh = time.Now()
+	s.mu.Unlock()
+}
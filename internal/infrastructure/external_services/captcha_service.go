@@ -0,0 +1,77 @@
+package external_services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// -------------- captcha res dto --------------
+
+type captchaVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// -------------- end of dto -------------------
+
+// CaptchaService verifies CAPTCHA response tokens against the configured
+// provider's siteverify endpoint. Supported providers: "recaptcha", "hcaptcha".
+type CaptchaService struct {
+	secret    string
+	verifyURL string
+	client    *http.Client
+}
+
+func NewCaptchaService(provider, secret string) *CaptchaService {
+	verifyURL := "https://www.google.com/recaptcha/api/siteverify"
+	if strings.EqualFold(provider, "hcaptcha") {
+		verifyURL = "https://hcaptcha.com/siteverify"
+	}
+	return &CaptchaService{
+		secret:    secret,
+		verifyURL: verifyURL,
+		client:    &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+var _ contract.ICaptchaVerifier = (*CaptchaService)(nil)
+
+// VerifyToken checks the given CAPTCHA response token with the provider.
+func (cs *CaptchaService) VerifyToken(ctx context.Context, token, remoteIP string) (bool, error) {
+	form := url.Values{}
+	form.Set("secret", cs.secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cs.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("failed to create CAPTCHA verify request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := cs.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to call CAPTCHA verify endpoint: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("CAPTCHA verify endpoint returned status: %d", res.StatusCode)
+	}
+
+	var payload captchaVerifyResponse
+	if err := json.NewDecoder(res.Body).Decode(&payload); err != nil {
+		return false, fmt.Errorf("failed to decode CAPTCHA verify response: %w", err)
+	}
+
+	return payload.Success, nil
+}
@@ -0,0 +1,83 @@
+package external_services
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+const (
+	clamScanTimeout = 30 * time.Second
+	// clamChunkSize is the INSTREAM chunk size; clamd's default stream
+	// length limit comfortably exceeds our upload limit.
+	clamChunkSize = 1 << 20
+)
+
+// ClamAVScanner implements IVirusScanner against a clamd daemon using its
+// INSTREAM protocol, so upload bytes never touch disk before scanning.
+type ClamAVScanner struct {
+	addr    string
+	timeout time.Duration
+}
+
+var _ contract.IVirusScanner = (*ClamAVScanner)(nil)
+
+// NewClamAVScanner creates a scanner talking to clamd at addr (host:port).
+func NewClamAVScanner(addr string) *ClamAVScanner {
+	return &ClamAVScanner{addr: addr, timeout: clamScanTimeout}
+}
+
+// Scan streams the bytes to clamd and fails when the daemon reports a
+// finding or cannot complete the scan. A scan failure rejects the upload:
+// unscanned files are never persisted.
+func (s *ClamAVScanner) Scan(ctx context.Context, fileName string, data []byte) error {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("virus scan unavailable: %w", err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(s.timeout))
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return fmt.Errorf("virus scan failed: %w", err)
+	}
+	chunkLen := make([]byte, 4)
+	for offset := 0; offset < len(data); offset += clamChunkSize {
+		end := offset + clamChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		binary.BigEndian.PutUint32(chunkLen, uint32(end-offset))
+		if _, err := conn.Write(chunkLen); err != nil {
+			return fmt.Errorf("virus scan failed: %w", err)
+		}
+		if _, err := conn.Write(data[offset:end]); err != nil {
+			return fmt.Errorf("virus scan failed: %w", err)
+		}
+	}
+	// Zero-length chunk terminates the stream.
+	binary.BigEndian.PutUint32(chunkLen, 0)
+	if _, err := conn.Write(chunkLen); err != nil {
+		return fmt.Errorf("virus scan failed: %w", err)
+	}
+
+	reply := make([]byte, 512)
+	n, err := conn.Read(reply)
+	if err != nil {
+		return fmt.Errorf("virus scan failed: %w", err)
+	}
+	result := strings.TrimRight(string(reply[:n]), "\x00\n")
+	if strings.HasSuffix(result, "OK") {
+		return nil
+	}
+	if strings.Contains(result, "FOUND") {
+		return fmt.Errorf("malware detected in %s: %s", fileName, strings.TrimPrefix(result, "stream: "))
+	}
+	return fmt.Errorf("virus scan failed: %s", result)
+}
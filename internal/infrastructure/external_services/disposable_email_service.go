@@ -0,0 +1,215 @@
+package external_services
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/uuidgen"
+)
+
+// blocklistRefreshInterval controls how often the remote list and the
+// admin-added entries are reloaded.
+const blocklistRefreshInterval = 6 * time.Hour
+
+// builtinDisposableDomains seeds the blocklist with well-known disposable
+// email providers so the feature works without a remote list configured.
+var builtinDisposableDomains = []string{
+	"mailinator.com",
+	"guerrillamail.com",
+	"10minutemail.com",
+	"yopmail.com",
+	"temp-mail.org",
+	"trashmail.com",
+	"sharklasers.com",
+	"getnada.com",
+	"dispostable.com",
+	"maildrop.cc",
+}
+
+// DisposableEmailService blocks registrations from disposable email domains.
+// It merges a built-in seed list, an optional remote list (newline-separated
+// domains fetched from blocklistURL) and admin-added entries persisted via
+// the blocked-domain repository, refreshing the merged set periodically.
+type DisposableEmailService struct {
+	repo         contract.IBlockedDomainRepository
+	blocklistURL string
+	client       *http.Client
+	bus          contract.ICacheInvalidationBus // optional, coherency across instances
+
+	mu          sync.RWMutex
+	domains     map[string]struct{}
+	lastRefresh time.Time
+}
+
+// Ensure DisposableEmailService implements IEmailBlocklist
+var _ contract.IEmailBlocklist = (*DisposableEmailService)(nil)
+
+// NewDisposableEmailService creates a blocklist service; blocklistURL may be
+// empty, in which case only the built-in and admin-added domains apply.
+func NewDisposableEmailService(repo contract.IBlockedDomainRepository, blocklistURL string) *DisposableEmailService {
+	s := &DisposableEmailService{
+		repo:         repo,
+		blocklistURL: blocklistURL,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		domains:      make(map[string]struct{}),
+	}
+	for _, domain := range builtinDisposableDomains {
+		s.domains[domain] = struct{}{}
+	}
+	return s
+}
+
+// SetInvalidationBus enables cross-instance coherency: blocklist writes
+// broadcast an invalidation, and broadcasts from other instances drop the
+// local domain set so the next check rebuilds it.
+func (s *DisposableEmailService) SetInvalidationBus(bus contract.ICacheInvalidationBus) {
+	s.bus = bus
+	bus.SubscribeInvalidations(func(topic string) {
+		if topic == contract.InvalidationTopicEmailBlocklist {
+			s.markStale()
+		}
+	})
+}
+
+// markStale forces the next check to rebuild the merged domain set.
+func (s *DisposableEmailService) markStale() {
+	s.mu.Lock()
+	s.lastRefresh = time.Time{}
+	s.mu.Unlock()
+}
+
+// IsBlocked reports whether the email's domain is on the blocklist.
+func (s *DisposableEmailService) IsBlocked(ctx context.Context, email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(strings.TrimSpace(email[at+1:]))
+	if domain == "" {
+		return false
+	}
+
+	s.refreshIfStale(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, blocked := s.domains[domain]
+	return blocked
+}
+
+// AddDomain persists an admin blocklist entry and applies it immediately.
+func (s *DisposableEmailService) AddDomain(ctx context.Context, domain string) error {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if domain == "" || !strings.Contains(domain, ".") {
+		return errors.New("invalid domain")
+	}
+
+	err := s.repo.AddDomain(ctx, &entity.BlockedDomain{
+		ID:     uuidgen.NewGenerator().NewUUID(),
+		Domain: domain,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.domains[domain] = struct{}{}
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicEmailBlocklist)
+	}
+	return nil
+}
+
+// RemoveDomain deletes an admin blocklist entry and applies it immediately.
+// Built-in and remote-listed domains cannot be removed.
+func (s *DisposableEmailService) RemoveDomain(ctx context.Context, domain string) error {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if err := s.repo.RemoveDomain(ctx, domain); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.domains, domain)
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicEmailBlocklist)
+	}
+	return nil
+}
+
+// ListDomains returns the admin-added blocklist entries.
+func (s *DisposableEmailService) ListDomains(ctx context.Context) ([]string, error) {
+	return s.repo.ListDomains(ctx)
+}
+
+// refreshIfStale rebuilds the merged domain set once the refresh interval
+// has elapsed. Fetch failures keep the previous set; the refresh timestamp
+// advances regardless so a broken source is not hammered on every signup.
+func (s *DisposableEmailService) refreshIfStale(ctx context.Context) {
+	s.mu.RLock()
+	stale := time.Since(s.lastRefresh) >= blocklistRefreshInterval
+	s.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	merged := make(map[string]struct{})
+	for _, domain := range builtinDisposableDomains {
+		merged[domain] = struct{}{}
+	}
+	for _, domain := range s.fetchRemoteDomains(ctx) {
+		merged[domain] = struct{}{}
+	}
+	if s.repo != nil {
+		if adminDomains, err := s.repo.ListDomains(ctx); err == nil {
+			for _, domain := range adminDomains {
+				merged[strings.ToLower(domain)] = struct{}{}
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.domains = merged
+	s.lastRefresh = time.Now()
+	s.mu.Unlock()
+}
+
+// fetchRemoteDomains downloads the newline-separated remote blocklist.
+func (s *DisposableEmailService) fetchRemoteDomains(ctx context.Context) []string {
+	if s.blocklistURL == "" {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.blocklistURL, nil)
+	if err != nil {
+		return nil
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	var domains []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		domain := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if domain == "" || strings.HasPrefix(domain, "#") {
+			continue
+		}
+		domains = append(domains, domain)
+	}
+
+	return domains
+}
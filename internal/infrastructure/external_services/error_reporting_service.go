@@ -0,0 +1,65 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// errorReportTimeout bounds a single delivery attempt; reports are
+// best-effort and never retried.
+const errorReportTimeout = 5 * time.Second
+
+// ErrorReportingService delivers error reports to an external tracking
+// endpoint (a Sentry/Rollbar ingestion proxy or any JSON webhook). The
+// optional auth token is sent as a bearer credential.
+type ErrorReportingService struct {
+	endpoint  string
+	authToken string
+	client    *http.Client
+}
+
+var _ contract.IErrorReporter = (*ErrorReportingService)(nil)
+
+func NewErrorReportingService(endpoint, authToken string) *ErrorReportingService {
+	return &ErrorReportingService{
+		endpoint:  endpoint,
+		authToken: authToken,
+		client:    &http.Client{Timeout: errorReportTimeout},
+	}
+}
+
+// Report posts the report to the configured endpoint. Delivery failures are
+// logged and swallowed: error reporting must never cause errors of its own.
+func (s *ErrorReportingService) Report(ctx context.Context, report *contract.ErrorReport) {
+	body, err := json.Marshal(report)
+	if err != nil {
+		log.Printf("failed to marshal error report: %v", err)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		log.Printf("failed to build error report request: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.authToken)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		log.Printf("failed to deliver error report: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Printf("error report endpoint answered %d", resp.StatusCode)
+	}
+}
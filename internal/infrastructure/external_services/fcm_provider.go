@@ -0,0 +1,68 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// fcmSendURL is the FCM legacy HTTP send endpoint. FCM delivers to Android,
+// iOS and browser (Web Push) registration tokens alike, so one provider
+// covers all three platforms.
+const fcmSendURL = "https://fcm.googleapis.com/fcm/send"
+
+// fcmTimeout bounds a single FCM API call.
+const fcmTimeout = 10 * time.Second
+
+// FCMProvider implements IPushProvider on Firebase Cloud Messaging using the
+// plain REST endpoint, so no SDK dependency is needed.
+type FCMProvider struct {
+	serverKey string
+	client    *http.Client
+}
+
+var _ contract.IPushProvider = (*FCMProvider)(nil)
+
+func NewFCMProvider(serverKey string) *FCMProvider {
+	return &FCMProvider{
+		serverKey: serverKey,
+		client:    &http.Client{Timeout: fcmTimeout},
+	}
+}
+
+// SendPush delivers a notification to the given registration token.
+func (p *FCMProvider) SendPush(ctx context.Context, token, title, body string) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"to": token,
+		"notification": map[string]string{
+			"title": title,
+			"body":  body,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode push payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fcmSendURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build push request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "key="+p.serverKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send push: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("push send failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package external_services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// -------------- geoip res dto --------------
+
+type geoIPResponse struct {
+	Status      string `json:"status"`
+	Country     string `json:"country"`
+	CountryCode string `json:"countryCode"`
+	RegionName  string `json:"regionName"`
+}
+
+// -------------- end of dto -----------------
+
+// GeoIPService resolves IP addresses to geographic locations using the
+// ip-api.com lookup service.
+type GeoIPService struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewGeoIPService(baseURL string) *GeoIPService {
+	if baseURL == "" {
+		baseURL = "http://ip-api.com/json"
+	}
+	return &GeoIPService{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+var _ contract.IGeoIPResolver = (*GeoIPService)(nil)
+
+// ResolveIP looks up the country and region for the given IP address.
+// Private and loopback addresses resolve to an empty location since they
+// carry no geographic information.
+func (gs *GeoIPService) ResolveIP(ctx context.Context, ipAddress string) (*contract.GeoLocation, error) {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address: %s", ipAddress)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() {
+		return &contract.GeoLocation{}, nil
+	}
+
+	url := fmt.Sprintf("%s/%s?fields=status,country,regionName", gs.baseURL, ipAddress)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GeoIP request: %w", err)
+	}
+
+	res, err := gs.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GeoIP service: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GeoIP service returned status: %d", res.StatusCode)
+	}
+
+	var payload geoIPResponse
+	if err := json.NewDecoder(res.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("failed to decode GeoIP response: %w", err)
+	}
+	if payload.Status != "success" {
+		return &contract.GeoLocation{}, nil
+	}
+
+	return &contract.GeoLocation{
+		Country:     payload.Country,
+		CountryCode: payload.CountryCode,
+		Region:      payload.RegionName,
+	}, nil
+}
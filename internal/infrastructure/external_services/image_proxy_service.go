@@ -0,0 +1,258 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+const (
+	// maxImageBytes limits how large a proxied image may be.
+	maxImageBytes = 5 << 20
+	// imageCacheTTL controls how long fetched images are reused.
+	imageCacheTTL = 1 * time.Hour
+	// maxProxyImageWidth caps resize requests so the proxy cannot be used to
+	// upscale images into huge allocations.
+	maxProxyImageWidth = 2048
+	// maxImageCacheEntries bounds the in-memory image cache; beyond it the
+	// entry closest to expiry is evicted.
+	maxImageCacheEntries = 256
+	// maxImageRedirects limits redirect chains; every hop is re-validated
+	// against the SSRF rules.
+	maxImageRedirects = 3
+)
+
+// imgSrcPattern matches external image sources in HTML content so they can
+// be rewritten to proxied URLs.
+var imgSrcPattern = regexp.MustCompile(`(?i)(<img[^>]+src\s*=\s*["'])(https?://[^"']+)(["'])`)
+
+type cachedImage struct {
+	data        []byte
+	contentType string
+	expires     time.Time
+}
+
+// ImageProxyService fetches external images server-side, caching and
+// optionally downscaling them. Proxy URLs carry an HMAC signature over the
+// source URL and width so only URLs the server itself generated are served.
+type ImageProxyService struct {
+	secret   []byte
+	basePath string
+	client   *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cachedImage
+}
+
+// Ensure ImageProxyService implements IImageProxyService
+var _ contract.IImageProxyService = (*ImageProxyService)(nil)
+
+// NewImageProxyService creates an image proxy; basePath is the route the
+// proxy handler is mounted on (e.g. "/api/v1/images/proxy").
+func NewImageProxyService(secret, basePath string) *ImageProxyService {
+	return &ImageProxyService{
+		secret:   []byte(secret),
+		basePath: basePath,
+		client: &http.Client{
+			Timeout: 15 * time.Second,
+			// Redirects are re-validated hop by hop so a public URL cannot
+			// bounce the proxy onto an internal address.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxImageRedirects {
+					return http.ErrUseLastResponse
+				}
+				if !isPublicHTTPURL(req.URL) {
+					return http.ErrUseLastResponse
+				}
+				return nil
+			},
+		},
+		cache: make(map[string]cachedImage),
+	}
+}
+
+// SignURL returns the signed proxy path for an external image URL.
+func (s *ImageProxyService) SignURL(rawURL string, width int) string {
+	query := url.Values{}
+	query.Set("url", rawURL)
+	if width > 0 {
+		query.Set("w", strconv.Itoa(width))
+	}
+	query.Set("sig", s.signature(rawURL, width))
+	return s.basePath + "?" + query.Encode()
+}
+
+// VerifySignature reports whether sig is valid for the URL and width.
+func (s *ImageProxyService) VerifySignature(rawURL string, width int, sig string) bool {
+	expected := s.signature(rawURL, width)
+	return hmac.Equal([]byte(expected), []byte(sig))
+}
+
+// Fetch returns the (possibly resized) image bytes and content type, served
+// from cache when possible.
+func (s *ImageProxyService) Fetch(ctx context.Context, rawURL string, width int) ([]byte, string, error) {
+	if width < 0 || width > maxProxyImageWidth {
+		return nil, "", errors.New("invalid width")
+	}
+
+	cacheKey := fmt.Sprintf("%s|%d", rawURL, width)
+	s.mu.Lock()
+	cached, hit := s.cache[cacheKey]
+	s.mu.Unlock()
+	if hit && time.Now().Before(cached.expires) {
+		return cached.data, cached.contentType, nil
+	}
+
+	data, contentType, err := s.fetchImage(ctx, rawURL, width)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.Lock()
+	s.storeLocked(cacheKey, cachedImage{data: data, contentType: contentType, expires: time.Now().Add(imageCacheTTL)})
+	s.mu.Unlock()
+	return data, contentType, nil
+}
+
+// storeLocked inserts a cache entry, evicting to stay within
+// maxImageCacheEntries: expired entries go first, then the entry closest to
+// expiry. Callers must hold s.mu.
+func (s *ImageProxyService) storeLocked(key string, entry cachedImage) {
+	if _, exists := s.cache[key]; !exists && len(s.cache) >= maxImageCacheEntries {
+		now := time.Now()
+		for k, v := range s.cache {
+			if now.After(v.expires) {
+				delete(s.cache, k)
+			}
+		}
+		for len(s.cache) >= maxImageCacheEntries {
+			oldestKey := ""
+			var oldest time.Time
+			for k, v := range s.cache {
+				if oldestKey == "" || v.expires.Before(oldest) {
+					oldestKey, oldest = k, v.expires
+				}
+			}
+			delete(s.cache, oldestKey)
+		}
+	}
+	s.cache[key] = entry
+}
+
+// RewriteContent replaces external image references in HTML content with
+// signed proxy URLs.
+func (s *ImageProxyService) RewriteContent(content string) string {
+	return imgSrcPattern.ReplaceAllStringFunc(content, func(tag string) string {
+		parts := imgSrcPattern.FindStringSubmatch(tag)
+		if parts == nil {
+			return tag
+		}
+		return parts[1] + s.SignURL(parts[2], 0) + parts[3]
+	})
+}
+
+// fetchImage downloads and optionally downscales the remote image.
+func (s *ImageProxyService) fetchImage(ctx context.Context, rawURL string, width int) ([]byte, string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || !isPublicHTTPURL(parsed) {
+		return nil, "", errors.New("invalid image URL")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch image: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("image source returned status %d", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, "", errors.New("source is not an image")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(data) > maxImageBytes {
+		return nil, "", errors.New("image too large")
+	}
+
+	if width > 0 {
+		if resized, resizedType, err := resizeImage(data, width); err == nil {
+			return resized, resizedType, nil
+		}
+		// Fall back to the original bytes when the format cannot be decoded.
+	}
+	return data, contentType, nil
+}
+
+// resizeImage downscales the image to the given width, preserving the aspect
+// ratio. Images already narrower than width are returned re-encoded as-is.
+func resizeImage(data []byte, width int) ([]byte, string, error) {
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+
+	bounds := img.Bounds()
+	if width < bounds.Dx() {
+		height := bounds.Dy() * width / bounds.Dx()
+		if height < 1 {
+			height = 1
+		}
+		dst := image.NewRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			srcY := bounds.Min.Y + y*bounds.Dy()/height
+			for x := 0; x < width; x++ {
+				srcX := bounds.Min.X + x*bounds.Dx()/width
+				dst.Set(x, y, img.At(srcX, srcY))
+			}
+		}
+		img = dst
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+		return buf.Bytes(), "image/png", err
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+		return buf.Bytes(), "image/gif", err
+	default:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+		return buf.Bytes(), "image/jpeg", err
+	}
+}
+
+// signature computes the HMAC over the URL and width.
+func (s *ImageProxyService) signature(rawURL string, width int) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s|%d", rawURL, width)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,123 @@
+package external_services
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// variantJPEGQuality trades a little fidelity for much smaller responsive
+// variants.
+const variantJPEGQuality = 85
+
+// ImageResizerService implements IImageResizer with the standard library's
+// image package: bilinear downscaling, re-encoded in the source format.
+// WebP/AVIF output needs an external encoder and can be swapped in behind
+// the same contract.
+type ImageResizerService struct{}
+
+var _ contract.IImageResizer = (*ImageResizerService)(nil)
+
+func NewImageResizerService() *ImageResizerService {
+	return &ImageResizerService{}
+}
+
+// ResizeImage scales the image down to the given width, keeping the aspect
+// ratio. Asking for a width at or above the original returns an error so
+// callers skip upscaled variants.
+func (s *ImageResizerService) ResizeImage(data []byte, mimeType string, width int) ([]byte, string, int, error) {
+	if width < 1 {
+		return nil, "", 0, fmt.Errorf("invalid variant width %d", width)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if src.Bounds().Dx() <= width {
+		return nil, "", 0, fmt.Errorf("image is not wider than %dpx", width)
+	}
+
+	resized := resizeBilinear(src, width)
+
+	var buf bytes.Buffer
+	switch mimeType {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: variantJPEGQuality})
+	case "image/png":
+		err = png.Encode(&buf, resized)
+	default:
+		return nil, "", 0, fmt.Errorf("unsupported variant source type %q", mimeType)
+	}
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("failed to encode variant: %w", err)
+	}
+	return buf.Bytes(), mimeType, resized.Bounds().Dy(), nil
+}
+
+// resizeBilinear scales the image to the target width with bilinear
+// interpolation.
+func resizeBilinear(src image.Image, width int) *image.RGBA {
+	b := src.Bounds()
+	srcW, srcH := b.Dx(), b.Dy()
+	height := (srcH*width + srcW/2) / srcW
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	xScale := float64(srcW) / float64(width)
+	yScale := float64(srcH) / float64(height)
+	for y := 0; y < height; y++ {
+		sy := (float64(y)+0.5)*yScale - 0.5
+		y0 := int(sy)
+		if y0 < 0 {
+			y0 = 0
+		}
+		y1 := y0 + 1
+		if y1 >= srcH {
+			y1 = srcH - 1
+		}
+		fy := sy - float64(y0)
+		if fy < 0 {
+			fy = 0
+		}
+		for x := 0; x < width; x++ {
+			sx := (float64(x)+0.5)*xScale - 0.5
+			x0 := int(sx)
+			if x0 < 0 {
+				x0 = 0
+			}
+			x1 := x0 + 1
+			if x1 >= srcW {
+				x1 = srcW - 1
+			}
+			fx := sx - float64(x0)
+			if fx < 0 {
+				fx = 0
+			}
+
+			c00 := rgbaAt(src, b.Min.X+x0, b.Min.Y+y0)
+			c10 := rgbaAt(src, b.Min.X+x1, b.Min.Y+y0)
+			c01 := rgbaAt(src, b.Min.X+x0, b.Min.Y+y1)
+			c11 := rgbaAt(src, b.Min.X+x1, b.Min.Y+y1)
+			for i := 0; i < 4; i++ {
+				top := c00[i] + (c10[i]-c00[i])*fx
+				bottom := c01[i] + (c11[i]-c01[i])*fx
+				value := top + (bottom-top)*fy
+				dst.Pix[y*dst.Stride+x*4+i] = uint8(value/257 + 0.5)
+			}
+		}
+	}
+	return dst
+}
+
+// rgbaAt returns the pixel's 16-bit channels as floats for interpolation.
+func rgbaAt(img image.Image, x, y int) [4]float64 {
+	r, g, b, a := img.At(x, y).RGBA()
+	return [4]float64{float64(r), float64(g), float64(b), float64(a)}
+}
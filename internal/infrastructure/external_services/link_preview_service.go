@@ -0,0 +1,260 @@
+package external_services
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/html"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+const (
+	// maxLinkPreviews caps how many URLs are unfurled per piece of content.
+	maxLinkPreviews = 3
+	// linkPreviewCacheTTL controls how long fetched metadata is reused.
+	linkPreviewCacheTTL = 1 * time.Hour
+	// maxPreviewBodyBytes limits how much of the target page is read.
+	maxPreviewBodyBytes = 512 << 10
+	// maxPreviewRedirects limits redirect chains; every hop is re-validated
+	// against the SSRF rules.
+	maxPreviewRedirects = 3
+	// maxPreviewCacheEntries bounds the in-memory preview cache — URLs come
+	// from user-submitted content; beyond it the entry closest to expiry is
+	// evicted.
+	maxPreviewCacheEntries = 256
+)
+
+// previewURLPattern matches http(s) URLs inside free-form text.
+var previewURLPattern = regexp.MustCompile(`https?://[^\s<>"')]+`)
+
+type cachedLinkPreview struct {
+	preview entity.LinkPreview
+	ok      bool
+	expires time.Time
+}
+
+// LinkPreviewService fetches Open Graph metadata for URLs found in
+// user-submitted content. Only public http(s) hosts are fetched: URLs whose
+// hosts resolve to loopback, private or link-local addresses are rejected so
+// the server cannot be used to probe internal services. Results (including
+// failures) are cached in memory.
+type LinkPreviewService struct {
+	client *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cachedLinkPreview
+}
+
+// Ensure LinkPreviewService implements ILinkPreviewService
+var _ contract.ILinkPreviewService = (*LinkPreviewService)(nil)
+
+// NewLinkPreviewService creates a link preview service.
+func NewLinkPreviewService() *LinkPreviewService {
+	s := &LinkPreviewService{
+		cache: make(map[string]cachedLinkPreview),
+	}
+	s.client = &http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxPreviewRedirects {
+				return http.ErrUseLastResponse
+			}
+			if !isPublicHTTPURL(req.URL) {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+	return s
+}
+
+// ExtractPreviews returns previews for up to maxLinkPreviews distinct URLs in
+// content; URLs that cannot be fetched safely are skipped.
+func (s *LinkPreviewService) ExtractPreviews(ctx context.Context, content string) []entity.LinkPreview {
+	var previews []entity.LinkPreview
+	seen := make(map[string]struct{})
+	for _, rawURL := range previewURLPattern.FindAllString(content, -1) {
+		if len(previews) >= maxLinkPreviews {
+			break
+		}
+		if _, dup := seen[rawURL]; dup {
+			continue
+		}
+		seen[rawURL] = struct{}{}
+
+		if preview, ok := s.previewFor(ctx, rawURL); ok {
+			previews = append(previews, preview)
+		}
+	}
+	return previews
+}
+
+// previewFor returns the cached preview for rawURL, fetching it on a miss.
+func (s *LinkPreviewService) previewFor(ctx context.Context, rawURL string) (entity.LinkPreview, bool) {
+	s.mu.Lock()
+	cached, hit := s.cache[rawURL]
+	s.mu.Unlock()
+	if hit && time.Now().Before(cached.expires) {
+		return cached.preview, cached.ok
+	}
+
+	preview, ok := s.fetchPreview(ctx, rawURL)
+
+	s.mu.Lock()
+	s.storeLocked(rawURL, cachedLinkPreview{preview: preview, ok: ok, expires: time.Now().Add(linkPreviewCacheTTL)})
+	s.mu.Unlock()
+	return preview, ok
+}
+
+// storeLocked inserts a cache entry, evicting to stay within
+// maxPreviewCacheEntries: expired entries go first, then the entry closest to
+// expiry. Callers must hold s.mu.
+func (s *LinkPreviewService) storeLocked(key string, entry cachedLinkPreview) {
+	if _, exists := s.cache[key]; !exists && len(s.cache) >= maxPreviewCacheEntries {
+		now := time.Now()
+		for k, v := range s.cache {
+			if now.After(v.expires) {
+				delete(s.cache, k)
+			}
+		}
+		for len(s.cache) >= maxPreviewCacheEntries {
+			oldestKey := ""
+			var oldest time.Time
+			for k, v := range s.cache {
+				if oldestKey == "" || v.expires.Before(oldest) {
+					oldestKey, oldest = k, v.expires
+				}
+			}
+			delete(s.cache, oldestKey)
+		}
+	}
+	s.cache[key] = entry
+}
+
+// fetchPreview downloads the target page and extracts its Open Graph
+// metadata.
+func (s *LinkPreviewService) fetchPreview(ctx context.Context, rawURL string) (entity.LinkPreview, bool) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || !isPublicHTTPURL(parsed) {
+		return entity.LinkPreview{}, false
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return entity.LinkPreview{}, false
+	}
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return entity.LinkPreview{}, false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return entity.LinkPreview{}, false
+	}
+	if contentType := resp.Header.Get("Content-Type"); !strings.Contains(contentType, "text/html") {
+		return entity.LinkPreview{}, false
+	}
+
+	preview := parseOpenGraph(io.LimitReader(resp.Body, maxPreviewBodyBytes))
+	if preview.Title == "" && preview.Description == "" {
+		return entity.LinkPreview{}, false
+	}
+	preview.URL = rawURL
+	preview.FetchedAt = time.Now()
+	return preview, true
+}
+
+// parseOpenGraph extracts og:* meta tags (falling back to <title> and the
+// description meta tag) from an HTML document.
+func parseOpenGraph(r io.Reader) entity.LinkPreview {
+	var preview entity.LinkPreview
+	var pageTitle, metaDescription string
+
+	tokenizer := html.NewTokenizer(r)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if preview.Title == "" {
+				preview.Title = pageTitle
+			}
+			if preview.Description == "" {
+				preview.Description = metaDescription
+			}
+			return preview
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "title":
+				if tokenizer.Next() == html.TextToken {
+					pageTitle = strings.TrimSpace(tokenizer.Token().Data)
+				}
+			case "meta":
+				var property, content string
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "property", "name":
+						property = attr.Val
+					case "content":
+						content = attr.Val
+					}
+				}
+				switch property {
+				case "og:title":
+					preview.Title = content
+				case "og:description":
+					preview.Description = content
+				case "og:image":
+					preview.ImageURL = content
+				case "og:site_name":
+					preview.SiteName = content
+				case "description":
+					metaDescription = content
+				}
+			case "body":
+				// Open Graph tags live in <head>; stop once the body starts.
+				if preview.Title == "" {
+					preview.Title = pageTitle
+				}
+				if preview.Description == "" {
+					preview.Description = metaDescription
+				}
+				return preview
+			}
+		}
+	}
+}
+
+// isPublicHTTPURL reports whether u is an http(s) URL whose host resolves
+// only to public addresses, rejecting anything that would let a preview
+// fetch reach internal services.
+func isPublicHTTPURL(u *url.URL) bool {
+	if u == nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	host := u.Hostname()
+	if host == "" {
+		return false
+	}
+
+	ips, err := net.LookupIP(host)
+	if err != nil || len(ips) == 0 {
+		return false
+	}
+	for _, ip := range ips {
+		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+			return false
+		}
+	}
+	return true
+}
@@ -44,6 +44,27 @@ func (es *EmailService) SendEmail(ctx context.Context, to, subject, body string)
 			to, es.From, subject, body,
 		),
 	)
+	return es.send(to, msg)
+}
+
+// SendEmailWithReplyTo sends an email whose Reply-To header points at the
+// given address, so answering it reaches that address instead of the sender.
+func (es *EmailService) SendEmailWithReplyTo(ctx context.Context, to, subject, body, replyTo string) error {
+	msg := []byte(
+		fmt.Sprintf(
+			"To: %s\r\n"+
+				"From: %s\r\n"+
+				"Reply-To: %s\r\n"+
+				"Subject: %s\r\n"+
+				"\r\n"+
+				"%s\r\n",
+			to, es.From, replyTo, subject, body,
+		),
+	)
+	return es.send(to, msg)
+}
+
+func (es *EmailService) send(to string, msg []byte) error {
 	// smtp auth
 	auth := smtp.PlainAuth("", es.Username, es.AppPassword, es.Host)
 	// send address
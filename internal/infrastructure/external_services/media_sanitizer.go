@@ -0,0 +1,148 @@
+package external_services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// mediaMagicBytes maps the allowed upload MIME types to their file
+// signatures. A declared type outside this map is rejected outright.
+var mediaMagicBytes = map[string][][]byte{
+	"image/jpeg":      {{0xFF, 0xD8, 0xFF}},
+	"image/png":       {{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}},
+	"image/gif":       {[]byte("GIF87a"), []byte("GIF89a")},
+	"image/webp":      {[]byte("RIFF")},
+	"application/pdf": {[]byte("%PDF-")},
+	"video/mp4":       {[]byte("ftyp")}, // matched at offset 4, after the box length
+	"video/webm":      {{0x1A, 0x45, 0xDF, 0xA3}},
+}
+
+// MediaSanitizerService implements IMediaSanitizer: it verifies that upload
+// bytes match the declared MIME type and strips privacy-sensitive metadata
+// (EXIF including GPS, comments) from JPEG and PNG images.
+type MediaSanitizerService struct{}
+
+var _ contract.IMediaSanitizer = (*MediaSanitizerService)(nil)
+
+func NewMediaSanitizerService() *MediaSanitizerService {
+	return &MediaSanitizerService{}
+}
+
+// SanitizeUpload validates the magic bytes against the declared MIME type
+// and returns the bytes with image metadata removed.
+func (s *MediaSanitizerService) SanitizeUpload(fileName, declaredMIME string, data []byte) ([]byte, error) {
+	signatures, ok := mediaMagicBytes[declaredMIME]
+	if !ok {
+		return nil, fmt.Errorf("unsupported media type %q", declaredMIME)
+	}
+
+	matched := false
+	if declaredMIME == "video/mp4" {
+		// The MP4 signature sits after the leading box length.
+		matched = len(data) >= 8 && bytes.Equal(data[4:8], []byte("ftyp"))
+	} else {
+		for _, sig := range signatures {
+			if bytes.HasPrefix(data, sig) {
+				matched = true
+				break
+			}
+		}
+		// WebP is a RIFF container; require the WEBP form type as well.
+		if matched && declaredMIME == "image/webp" {
+			matched = len(data) >= 12 && bytes.Equal(data[8:12], []byte("WEBP"))
+		}
+	}
+	if !matched {
+		return nil, fmt.Errorf("file content does not match declared type %s", declaredMIME)
+	}
+
+	switch declaredMIME {
+	case "image/jpeg":
+		return stripJPEGMetadata(data), nil
+	case "image/png":
+		return stripPNGMetadata(data), nil
+	}
+	return data, nil
+}
+
+// stripJPEGMetadata removes EXIF (APP1, which carries GPS coordinates), other
+// application segments that only hold metadata, and comment segments. The
+// JFIF header (APP0) and Adobe color transform (APP14) are kept since
+// decoders rely on them.
+func stripJPEGMetadata(data []byte) []byte {
+	if len(data) < 2 {
+		return data
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, data[0], data[1]) // SOI
+	i := 2
+	for i+4 <= len(data) && data[i] == 0xFF {
+		marker := data[i+1]
+		// SOS: the rest is entropy-coded image data, copy it verbatim.
+		if marker == 0xDA {
+			out = append(out, data[i:]...)
+			return out
+		}
+		segLen := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		end := i + 2 + segLen
+		if segLen < 2 || end > len(data) {
+			return data // malformed lengths: leave untouched
+		}
+		keep := true
+		if marker >= 0xE1 && marker <= 0xED { // APP1..APP13
+			keep = false
+		}
+		if marker == 0xFE { // COM
+			keep = false
+		}
+		if keep {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+	if i < len(data) {
+		out = append(out, data[i:]...)
+	}
+	return out
+}
+
+// pngMetadataChunks are the ancillary PNG chunk types that carry textual or
+// EXIF metadata and are safe to drop.
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+// stripPNGMetadata removes metadata chunks from a PNG, keeping every chunk a
+// decoder needs.
+func stripPNGMetadata(data []byte) []byte {
+	const sigLen = 8
+	if len(data) < sigLen {
+		return data
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:sigLen]...)
+	i := sigLen
+	for i+8 <= len(data) {
+		chunkLen := int(binary.BigEndian.Uint32(data[i : i+4]))
+		chunkType := string(data[i+4 : i+8])
+		end := i + 8 + chunkLen + 4 // length + type + data + CRC
+		if chunkLen < 0 || end > len(data) {
+			return data // malformed lengths: leave untouched
+		}
+		if !pngMetadataChunks[chunkType] {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+	if i < len(data) {
+		out = append(out, data[i:]...)
+	}
+	return out
+}
@@ -0,0 +1,211 @@
+package external_services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+const (
+	// maxEmbedsPerContent caps how many media URLs are resolved per post.
+	maxEmbedsPerContent = 5
+	// oembedCacheTTL controls how long resolved embed metadata is reused.
+	oembedCacheTTL = 6 * time.Hour
+	// maxOEmbedBodyBytes limits how much of the oEmbed response is read.
+	maxOEmbedBodyBytes = 64 << 10
+	// maxEmbedCacheEntries bounds the in-memory embed cache — URLs come from
+	// user-submitted content; beyond it the entry closest to expiry is
+	// evicted.
+	maxEmbedCacheEntries = 256
+)
+
+// oembedProviders whitelists the hosts whose media may be embedded, mapped
+// to the provider's oEmbed endpoint.
+var oembedProviders = map[string]oembedProvider{
+	"youtube.com":     {name: "YouTube", endpoint: "https://www.youtube.com/oembed"},
+	"www.youtube.com": {name: "YouTube", endpoint: "https://www.youtube.com/oembed"},
+	"youtu.be":        {name: "YouTube", endpoint: "https://www.youtube.com/oembed"},
+	"twitter.com":     {name: "Twitter", endpoint: "https://publish.twitter.com/oembed"},
+	"www.twitter.com": {name: "Twitter", endpoint: "https://publish.twitter.com/oembed"},
+	"x.com":           {name: "Twitter", endpoint: "https://publish.twitter.com/oembed"},
+	"vimeo.com":       {name: "Vimeo", endpoint: "https://vimeo.com/api/oembed.json"},
+}
+
+type oembedProvider struct {
+	name     string
+	endpoint string
+}
+
+// iframePattern matches iframe elements (with any closing tag) so embeds
+// from unapproved sources can be stripped from content.
+var iframePattern = regexp.MustCompile(`(?is)<iframe[^>]*>(?:.*?</iframe>)?`)
+
+// iframeSrcPattern extracts the src attribute of a matched iframe.
+var iframeSrcPattern = regexp.MustCompile(`(?i)src\s*=\s*["']([^"']+)["']`)
+
+type cachedEmbed struct {
+	embed   entity.EmbeddedMedia
+	ok      bool
+	expires time.Time
+}
+
+// OEmbedService resolves whitelisted media URLs into oEmbed metadata so blog
+// content can embed videos and tweets without trusting arbitrary markup.
+// Results (including failures) are cached in memory.
+type OEmbedService struct {
+	client *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cachedEmbed
+}
+
+// Ensure OEmbedService implements IOEmbedService
+var _ contract.IOEmbedService = (*OEmbedService)(nil)
+
+// NewOEmbedService creates an oEmbed resolution service.
+func NewOEmbedService() *OEmbedService {
+	return &OEmbedService{
+		client: &http.Client{Timeout: 10 * time.Second},
+		cache:  make(map[string]cachedEmbed),
+	}
+}
+
+// ResolveEmbeds returns embed metadata for up to maxEmbedsPerContent distinct
+// whitelisted media URLs in content.
+func (s *OEmbedService) ResolveEmbeds(ctx context.Context, content string) []entity.EmbeddedMedia {
+	var embeds []entity.EmbeddedMedia
+	seen := make(map[string]struct{})
+	for _, rawURL := range previewURLPattern.FindAllString(content, -1) {
+		if len(embeds) >= maxEmbedsPerContent {
+			break
+		}
+		if _, dup := seen[rawURL]; dup {
+			continue
+		}
+		seen[rawURL] = struct{}{}
+
+		provider, ok := providerFor(rawURL)
+		if !ok {
+			continue
+		}
+		if embed, ok := s.embedFor(ctx, provider, rawURL); ok {
+			embeds = append(embeds, embed)
+		}
+	}
+	return embeds
+}
+
+// SanitizeContent removes iframe tags whose source is not a whitelisted
+// provider; approved provider iframes are kept as-is.
+func (s *OEmbedService) SanitizeContent(content string) string {
+	return iframePattern.ReplaceAllStringFunc(content, func(iframe string) string {
+		src := iframeSrcPattern.FindStringSubmatch(iframe)
+		if src != nil {
+			if _, ok := providerFor(src[1]); ok {
+				return iframe
+			}
+		}
+		return ""
+	})
+}
+
+// embedFor returns the cached embed for rawURL, resolving it on a miss.
+func (s *OEmbedService) embedFor(ctx context.Context, provider oembedProvider, rawURL string) (entity.EmbeddedMedia, bool) {
+	s.mu.Lock()
+	cached, hit := s.cache[rawURL]
+	s.mu.Unlock()
+	if hit && time.Now().Before(cached.expires) {
+		return cached.embed, cached.ok
+	}
+
+	embed, ok := s.resolveEmbed(ctx, provider, rawURL)
+
+	s.mu.Lock()
+	s.storeLocked(rawURL, cachedEmbed{embed: embed, ok: ok, expires: time.Now().Add(oembedCacheTTL)})
+	s.mu.Unlock()
+	return embed, ok
+}
+
+// storeLocked inserts a cache entry, evicting to stay within
+// maxEmbedCacheEntries: expired entries go first, then the entry closest to
+// expiry. Callers must hold s.mu.
+func (s *OEmbedService) storeLocked(key string, entry cachedEmbed) {
+	if _, exists := s.cache[key]; !exists && len(s.cache) >= maxEmbedCacheEntries {
+		now := time.Now()
+		for k, v := range s.cache {
+			if now.After(v.expires) {
+				delete(s.cache, k)
+			}
+		}
+		for len(s.cache) >= maxEmbedCacheEntries {
+			oldestKey := ""
+			var oldest time.Time
+			for k, v := range s.cache {
+				if oldestKey == "" || v.expires.Before(oldest) {
+					oldestKey, oldest = k, v.expires
+				}
+			}
+			delete(s.cache, oldestKey)
+		}
+	}
+	s.cache[key] = entry
+}
+
+// resolveEmbed calls the provider's oEmbed endpoint for rawURL.
+func (s *OEmbedService) resolveEmbed(ctx context.Context, provider oembedProvider, rawURL string) (entity.EmbeddedMedia, bool) {
+	endpoint := provider.endpoint + "?format=json&url=" + url.QueryEscape(rawURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return entity.EmbeddedMedia{}, false
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return entity.EmbeddedMedia{}, false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return entity.EmbeddedMedia{}, false
+	}
+
+	var payload struct {
+		Type         string `json:"type"`
+		Title        string `json:"title"`
+		AuthorName   string `json:"author_name"`
+		HTML         string `json:"html"`
+		ThumbnailURL string `json:"thumbnail_url"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOEmbedBodyBytes)).Decode(&payload); err != nil {
+		return entity.EmbeddedMedia{}, false
+	}
+
+	return entity.EmbeddedMedia{
+		URL:          rawURL,
+		Provider:     provider.name,
+		Type:         payload.Type,
+		Title:        payload.Title,
+		AuthorName:   payload.AuthorName,
+		HTML:         payload.HTML,
+		ThumbnailURL: payload.ThumbnailURL,
+		FetchedAt:    time.Now(),
+	}, true
+}
+
+// providerFor returns the whitelisted provider for a URL, if any.
+func providerFor(rawURL string) (oembedProvider, bool) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return oembedProvider{}, false
+	}
+	provider, ok := oembedProviders[strings.ToLower(parsed.Hostname())]
+	return provider, ok
+}
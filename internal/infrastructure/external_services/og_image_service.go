@@ -0,0 +1,195 @@
+package external_services
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// Social share images use the standard Open Graph dimensions.
+const (
+	ogImageWidth  = 1200
+	ogImageHeight = 630
+
+	// Pixel scale of the embedded 5x7 bitmap font for title and byline.
+	ogTitleScale  = 8
+	ogBylineScale = 4
+
+	ogTitleMaxLines = 3
+	ogMargin        = 80
+)
+
+// OGImageService implements IOGImageRenderer with the standard library's
+// image package and an embedded 5x7 bitmap font, so no font or rendering
+// dependency is needed. Text is drawn uppercased since the font carries one
+// case.
+type OGImageService struct {
+	background color.RGBA
+	accent     color.RGBA
+	textColor  color.RGBA
+}
+
+var _ contract.IOGImageRenderer = (*OGImageService)(nil)
+
+func NewOGImageService() *OGImageService {
+	return &OGImageService{
+		background: color.RGBA{R: 0x1A, G: 0x20, B: 0x2C, A: 0xFF},
+		accent:     color.RGBA{R: 0x4C, G: 0x8B, B: 0xF5, A: 0xFF},
+		textColor:  color.RGBA{R: 0xF5, G: 0xF7, B: 0xFA, A: 0xFF},
+	}
+}
+
+// RenderShareImage draws the title (wrapped, truncated with an ellipsis when
+// too long) and the author byline over the template and encodes it as PNG.
+func (s *OGImageService) RenderShareImage(title, author string) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, ogImageWidth, ogImageHeight))
+
+	// Background and the accent bar along the top edge.
+	fillRect(img, 0, 0, ogImageWidth, ogImageHeight, s.background)
+	fillRect(img, 0, 0, ogImageWidth, 16, s.accent)
+
+	// Title, wrapped to the usable width.
+	charWidth := 6 * ogTitleScale // 5 font columns plus 1 of spacing
+	perLine := (ogImageWidth - 2*ogMargin) / charWidth
+	lines := wrapShareText(strings.ToUpper(title), perLine, ogTitleMaxLines)
+	y := ogMargin + 60
+	for _, line := range lines {
+		drawShareText(img, line, ogMargin, y, ogTitleScale, s.textColor)
+		y += 10 * ogTitleScale
+	}
+
+	// Author byline under the title.
+	if author != "" {
+		drawShareText(img, "BY "+strings.ToUpper(author), ogMargin, y+40, ogBylineScale, s.accent)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode share image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func fillRect(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+// wrapShareText breaks the text into at most maxLines lines of at most
+// perLine characters, ending with an ellipsis when it does not fit.
+func wrapShareText(text string, perLine, maxLines int) []string {
+	words := strings.Fields(text)
+	var lines []string
+	line := ""
+	truncated := false
+	for _, word := range words {
+		if len(word) > perLine {
+			word = word[:perLine]
+		}
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if len(candidate) <= perLine {
+			line = candidate
+			continue
+		}
+		if len(lines) == maxLines-1 {
+			truncated = true
+			break
+		}
+		lines = append(lines, line)
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	if truncated && len(lines) > 0 {
+		last := lines[len(lines)-1]
+		if len(last) > perLine-3 {
+			last = last[:perLine-3]
+		}
+		lines[len(lines)-1] = last + "..."
+	}
+	return lines
+}
+
+// drawShareText draws one line of text at the given origin, scaling each
+// font pixel to scale x scale device pixels. Characters outside the font are
+// drawn as spaces.
+func drawShareText(img *image.RGBA, text string, x, y, scale int, c color.RGBA) {
+	for _, r := range text {
+		glyph, ok := ogFont[r]
+		if ok {
+			for row := 0; row < 7; row++ {
+				for col := 0; col < 5; col++ {
+					if glyph[row]&(1<<(4-col)) != 0 {
+						fillRect(img, x+col*scale, y+row*scale, x+(col+1)*scale, y+(row+1)*scale, c)
+					}
+				}
+			}
+		}
+		x += 6 * scale
+	}
+}
+
+// ogFont is a 5x7 bitmap font covering uppercase letters, digits and the
+// punctuation share titles commonly carry. Each glyph is 7 rows of 5 bits,
+// most significant bit leftmost.
+var ogFont = map[rune][7]byte{
+	' ':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	'A':  {0x0E, 0x11, 0x11, 0x1F, 0x11, 0x11, 0x11},
+	'B':  {0x1E, 0x11, 0x11, 0x1E, 0x11, 0x11, 0x1E},
+	'C':  {0x0E, 0x11, 0x10, 0x10, 0x10, 0x11, 0x0E},
+	'D':  {0x1E, 0x11, 0x11, 0x11, 0x11, 0x11, 0x1E},
+	'E':  {0x1F, 0x10, 0x10, 0x1E, 0x10, 0x10, 0x1F},
+	'F':  {0x1F, 0x10, 0x10, 0x1E, 0x10, 0x10, 0x10},
+	'G':  {0x0E, 0x11, 0x10, 0x10, 0x13, 0x11, 0x0F},
+	'H':  {0x11, 0x11, 0x11, 0x1F, 0x11, 0x11, 0x11},
+	'I':  {0x0E, 0x04, 0x04, 0x04, 0x04, 0x04, 0x0E},
+	'J':  {0x07, 0x02, 0x02, 0x02, 0x02, 0x12, 0x0C},
+	'K':  {0x11, 0x12, 0x14, 0x18, 0x14, 0x12, 0x11},
+	'L':  {0x10, 0x10, 0x10, 0x10, 0x10, 0x10, 0x1F},
+	'M':  {0x11, 0x1B, 0x15, 0x15, 0x11, 0x11, 0x11},
+	'N':  {0x11, 0x19, 0x15, 0x13, 0x11, 0x11, 0x11},
+	'O':  {0x0E, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0E},
+	'P':  {0x1E, 0x11, 0x11, 0x1E, 0x10, 0x10, 0x10},
+	'Q':  {0x0E, 0x11, 0x11, 0x11, 0x15, 0x12, 0x0D},
+	'R':  {0x1E, 0x11, 0x11, 0x1E, 0x14, 0x12, 0x11},
+	'S':  {0x0F, 0x10, 0x10, 0x0E, 0x01, 0x01, 0x1E},
+	'T':  {0x1F, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04},
+	'U':  {0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0E},
+	'V':  {0x11, 0x11, 0x11, 0x11, 0x11, 0x0A, 0x04},
+	'W':  {0x11, 0x11, 0x11, 0x15, 0x15, 0x1B, 0x11},
+	'X':  {0x11, 0x11, 0x0A, 0x04, 0x0A, 0x11, 0x11},
+	'Y':  {0x11, 0x11, 0x0A, 0x04, 0x04, 0x04, 0x04},
+	'Z':  {0x1F, 0x01, 0x02, 0x04, 0x08, 0x10, 0x1F},
+	'0':  {0x0E, 0x11, 0x13, 0x15, 0x19, 0x11, 0x0E},
+	'1':  {0x04, 0x0C, 0x04, 0x04, 0x04, 0x04, 0x0E},
+	'2':  {0x0E, 0x11, 0x01, 0x02, 0x04, 0x08, 0x1F},
+	'3':  {0x1F, 0x02, 0x04, 0x02, 0x01, 0x11, 0x0E},
+	'4':  {0x02, 0x06, 0x0A, 0x12, 0x1F, 0x02, 0x02},
+	'5':  {0x1F, 0x10, 0x1E, 0x01, 0x01, 0x11, 0x0E},
+	'6':  {0x06, 0x08, 0x10, 0x1E, 0x11, 0x11, 0x0E},
+	'7':  {0x1F, 0x01, 0x02, 0x04, 0x08, 0x08, 0x08},
+	'8':  {0x0E, 0x11, 0x11, 0x0E, 0x11, 0x11, 0x0E},
+	'9':  {0x0E, 0x11, 0x11, 0x0F, 0x01, 0x02, 0x0C},
+	'-':  {0x00, 0x00, 0x00, 0x1F, 0x00, 0x00, 0x00},
+	'.':  {0x00, 0x00, 0x00, 0x00, 0x00, 0x0C, 0x0C},
+	',':  {0x00, 0x00, 0x00, 0x00, 0x0C, 0x04, 0x08},
+	':':  {0x00, 0x0C, 0x0C, 0x00, 0x0C, 0x0C, 0x00},
+	'!':  {0x04, 0x04, 0x04, 0x04, 0x04, 0x00, 0x04},
+	'?':  {0x0E, 0x11, 0x01, 0x02, 0x04, 0x00, 0x04},
+	'\'': {0x0C, 0x04, 0x08, 0x00, 0x00, 0x00, 0x00},
+	'&':  {0x08, 0x14, 0x14, 0x08, 0x15, 0x12, 0x0D},
+	'(':  {0x02, 0x04, 0x08, 0x08, 0x08, 0x04, 0x02},
+	')':  {0x08, 0x04, 0x02, 0x02, 0x02, 0x04, 0x08},
+}
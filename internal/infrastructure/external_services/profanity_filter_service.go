@@ -0,0 +1,237 @@
+package external_services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/uuidgen"
+)
+
+// wordlistRefreshInterval controls how often the admin-managed wordlist is
+// reloaded from the repository.
+const wordlistRefreshInterval = 10 * time.Minute
+
+// defaultProfanityLocale is assumed for config-seeded words and for checks
+// that do not specify a locale.
+const defaultProfanityLocale = "en"
+
+// builtinProfanityWords seeds the filter so it keeps rejecting the same
+// content the old hardcoded check did.
+var builtinProfanityWords = map[string]string{
+	"badword": entity.ProfanitySeveritySevere,
+}
+
+// leetReplacer undoes common character substitutions ("b4dw0rd") before
+// matching, so trivial leet-speak does not bypass the filter.
+var leetReplacer = strings.NewReplacer(
+	"0", "o",
+	"1", "i",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+	"@", "a",
+	"$", "s",
+	"!", "i",
+)
+
+// ProfanityFilterService screens content against locale-specific word lists
+// merged from a built-in seed, config-provided words and admin-added entries
+// persisted via the profanity-word repository.
+type ProfanityFilterService struct {
+	repo        contract.IProfanityWordRepository
+	configWords []string
+	bus         contract.ICacheInvalidationBus // optional, coherency across instances
+
+	mu sync.RWMutex
+	// words maps locale -> normalized word -> severity.
+	words       map[string]map[string]string
+	lastRefresh time.Time
+}
+
+// Ensure ProfanityFilterService implements IProfanityFilter
+var _ contract.IProfanityFilter = (*ProfanityFilterService)(nil)
+
+// NewProfanityFilterService creates a profanity filter; configWords may be
+// empty, in which case only the built-in and admin-added words apply.
+// Config-provided words are treated as severe and scoped to the default
+// locale.
+func NewProfanityFilterService(repo contract.IProfanityWordRepository, configWords []string) *ProfanityFilterService {
+	s := &ProfanityFilterService{
+		repo:        repo,
+		configWords: configWords,
+	}
+	s.words = s.baseWords()
+	return s
+}
+
+// SetInvalidationBus enables cross-instance coherency: wordlist writes
+// broadcast an invalidation, and broadcasts from other instances drop the
+// local word map so the next check reloads it.
+func (s *ProfanityFilterService) SetInvalidationBus(bus contract.ICacheInvalidationBus) {
+	s.bus = bus
+	bus.SubscribeInvalidations(func(topic string) {
+		if topic == contract.InvalidationTopicProfanityWords {
+			s.markStale()
+		}
+	})
+}
+
+// markStale forces the next check to reload the admin-added entries.
+func (s *ProfanityFilterService) markStale() {
+	s.mu.Lock()
+	s.lastRefresh = time.Time{}
+	s.mu.Unlock()
+}
+
+// Check returns the severity of the worst match in content, or an empty
+// string when the content is clean. An empty locale falls back to the
+// default locale.
+func (s *ProfanityFilterService) Check(ctx context.Context, content, locale string) string {
+	if locale == "" {
+		locale = defaultProfanityLocale
+	}
+	normalized := normalizeForProfanity(content)
+	if normalized == "" {
+		return ""
+	}
+
+	s.refreshIfStale(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	worst := ""
+	for word, severity := range s.words[locale] {
+		if !strings.Contains(normalized, word) {
+			continue
+		}
+		if severity == entity.ProfanitySeveritySevere {
+			return entity.ProfanitySeveritySevere
+		}
+		worst = severity
+	}
+	return worst
+}
+
+// AddWord persists an admin wordlist entry and applies it immediately.
+func (s *ProfanityFilterService) AddWord(ctx context.Context, word, locale, severity string) error {
+	word = normalizeForProfanity(word)
+	if word == "" {
+		return errors.New("invalid word")
+	}
+	if locale == "" {
+		locale = defaultProfanityLocale
+	}
+	if !entity.IsValidProfanitySeverity(severity) {
+		return errors.New("invalid severity: must be mild or severe")
+	}
+
+	err := s.repo.AddWord(ctx, &entity.ProfanityWord{
+		ID:       uuidgen.NewGenerator().NewUUID(),
+		Word:     word,
+		Locale:   locale,
+		Severity: severity,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.words[locale] == nil {
+		s.words[locale] = make(map[string]string)
+	}
+	s.words[locale][word] = severity
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicProfanityWords)
+	}
+	return nil
+}
+
+// RemoveWord deletes an admin wordlist entry and applies it immediately.
+// Built-in and config-provided words cannot be removed.
+func (s *ProfanityFilterService) RemoveWord(ctx context.Context, word, locale string) error {
+	word = normalizeForProfanity(word)
+	if locale == "" {
+		locale = defaultProfanityLocale
+	}
+	if err := s.repo.RemoveWord(ctx, word, locale); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.words[locale], word)
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicProfanityWords)
+	}
+	return nil
+}
+
+// ListWords returns the admin-added wordlist entries.
+func (s *ProfanityFilterService) ListWords(ctx context.Context, locale string) ([]*entity.ProfanityWord, error) {
+	return s.repo.ListWords(ctx, locale)
+}
+
+// baseWords builds the locale map holding the built-in seed and the
+// config-provided words.
+func (s *ProfanityFilterService) baseWords() map[string]map[string]string {
+	words := map[string]map[string]string{
+		defaultProfanityLocale: make(map[string]string),
+	}
+	for word, severity := range builtinProfanityWords {
+		words[defaultProfanityLocale][word] = severity
+	}
+	for _, word := range s.configWords {
+		if word = normalizeForProfanity(word); word != "" {
+			words[defaultProfanityLocale][word] = entity.ProfanitySeveritySevere
+		}
+	}
+	return words
+}
+
+// refreshIfStale reloads the admin-added entries once the refresh interval
+// has elapsed. Repository failures keep the previous set; the refresh
+// timestamp advances regardless so a broken source is not hammered on every
+// check.
+func (s *ProfanityFilterService) refreshIfStale(ctx context.Context) {
+	s.mu.RLock()
+	stale := time.Since(s.lastRefresh) >= wordlistRefreshInterval
+	s.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	merged := s.baseWords()
+	if s.repo != nil {
+		if entries, err := s.repo.ListWords(ctx, ""); err == nil {
+			for _, entry := range entries {
+				locale := entry.Locale
+				if locale == "" {
+					locale = defaultProfanityLocale
+				}
+				if merged[locale] == nil {
+					merged[locale] = make(map[string]string)
+				}
+				merged[locale][normalizeForProfanity(entry.Word)] = entry.Severity
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.words = merged
+	s.lastRefresh = time.Now()
+	s.mu.Unlock()
+}
+
+// normalizeForProfanity lowercases the text and undoes leet-speak
+// substitutions so word matching is robust against simple evasion.
+func normalizeForProfanity(text string) string {
+	return leetReplacer.Replace(strings.ToLower(strings.TrimSpace(text)))
+}
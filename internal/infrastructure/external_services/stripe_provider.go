@@ -0,0 +1,262 @@
+package external_services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// stripeAPIBase is the Stripe REST API root.
+const stripeAPIBase = "https://api.stripe.com/v1"
+
+// stripeTimeout bounds a single Stripe API call.
+const stripeTimeout = 10 * time.Second
+
+// StripeProvider implements IPaymentProvider on the Stripe Checkout API
+// using the plain REST endpoints, so no SDK dependency is needed.
+type StripeProvider struct {
+	secretKey     string
+	webhookSecret string
+	client        *http.Client
+	// subscriptionPrices maps membership tiers to the Stripe price objects
+	// backing their recurring charges.
+	subscriptionPrices map[entity.MembershipTier]string
+}
+
+var _ contract.IPaymentProvider = (*StripeProvider)(nil)
+
+func NewStripeProvider(secretKey, webhookSecret string) *StripeProvider {
+	return &StripeProvider{
+		secretKey:     secretKey,
+		webhookSecret: webhookSecret,
+		client:        &http.Client{Timeout: stripeTimeout},
+	}
+}
+
+// CreateCheckoutSession opens a Stripe-hosted checkout for the tip. The tip
+// ID travels as the session's client_reference_id so the webhook can match
+// the payment back to the tip record.
+func (s *StripeProvider) CreateCheckoutSession(ctx context.Context, tipID string, amountCents int64, currency, successURL, cancelURL string) (*contract.CheckoutSession, error) {
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("client_reference_id", tipID)
+	form.Set("success_url", successURL)
+	form.Set("cancel_url", cancelURL)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(amountCents, 10))
+	form.Set("line_items[0][price_data][product_data][name]", "Tip for the author")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, stripeAPIBase+"/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build checkout request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(s.secretKey, "")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("checkout session request returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var session struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+		return nil, fmt.Errorf("failed to decode checkout session: %w", err)
+	}
+	return &contract.CheckoutSession{ID: session.ID, URL: session.URL}, nil
+}
+
+// ParseCompletedTip verifies the Stripe-Signature header and returns the tip
+// ID when the event is a completed checkout; other event types are ignored.
+func (s *StripeProvider) ParseCompletedTip(payload []byte, signature string) (string, bool, error) {
+	if err := s.verifySignature(payload, signature); err != nil {
+		return "", false, err
+	}
+
+	var event struct {
+		Type string `json:"type"`
+		Data struct {
+			Object struct {
+				ClientReferenceID string `json:"client_reference_id"`
+			} `json:"object"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return "", false, fmt.Errorf("failed to parse webhook event: %w", err)
+	}
+
+	if event.Type != "checkout.session.completed" {
+		return "", false, nil
+	}
+	if event.Data.Object.ClientReferenceID == "" {
+		return "", false, fmt.Errorf("completed checkout has no client_reference_id")
+	}
+	return event.Data.Object.ClientReferenceID, true, nil
+}
+
+// verifySignature checks the v1 scheme of the Stripe-Signature header:
+// HMAC-SHA256 over "<timestamp>.<payload>" with the webhook secret.
+func (s *StripeProvider) verifySignature(payload []byte, signature string) error {
+	if s.webhookSecret == "" {
+		return fmt.Errorf("webhook secret not configured")
+	}
+
+	var timestamp string
+	var candidates []string
+	for _, part := range strings.Split(signature, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			candidates = append(candidates, value)
+		}
+	}
+	if timestamp == "" || len(candidates) == 0 {
+		return fmt.Errorf("malformed webhook signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.webhookSecret))
+	fmt.Fprintf(mac, "%s.%s", timestamp, payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	for _, candidate := range candidates {
+		if hmac.Equal([]byte(expected), []byte(candidate)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid webhook signature")
+}
+
+var _ contract.ISubscriptionProvider = (*StripeProvider)(nil)
+
+// SetSubscriptionPrice maps a membership tier to the Stripe price object
+// backing its recurring charge. Tiers without a price cannot be checked
+// out.
+func (s *StripeProvider) SetSubscriptionPrice(tier entity.MembershipTier, priceID string) {
+	if s.subscriptionPrices == nil {
+		s.subscriptionPrices = map[entity.MembershipTier]string{}
+	}
+	s.subscriptionPrices[tier] = priceID
+}
+
+// CreateSubscriptionCheckout opens a Stripe-hosted checkout for a recurring
+// membership. The user ID and tier travel as subscription metadata so the
+// lifecycle webhooks can be matched back to the reader.
+func (s *StripeProvider) CreateSubscriptionCheckout(ctx context.Context, userID, email string, tier entity.MembershipTier, successURL, cancelURL string) (*contract.CheckoutSession, error) {
+	priceID, ok := s.subscriptionPrices[tier]
+	if !ok {
+		return nil, fmt.Errorf("no price configured for membership tier %q", tier)
+	}
+
+	form := url.Values{}
+	form.Set("mode", "subscription")
+	form.Set("client_reference_id", userID)
+	form.Set("customer_email", email)
+	form.Set("success_url", successURL)
+	form.Set("cancel_url", cancelURL)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price]", priceID)
+	form.Set("subscription_data[metadata][user_id]", userID)
+	form.Set("subscription_data[metadata][tier]", string(tier))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, stripeAPIBase+"/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build checkout request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(s.secretKey, "")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("checkout session request returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var session struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+		return nil, fmt.Errorf("failed to decode checkout session: %w", err)
+	}
+	return &contract.CheckoutSession{ID: session.ID, URL: session.URL}, nil
+}
+
+// ParseSubscriptionEvent verifies the Stripe-Signature header and maps the
+// subscription lifecycle events onto membership events; other event types
+// are ignored.
+func (s *StripeProvider) ParseSubscriptionEvent(payload []byte, signature string) (*entity.PaymentEvent, bool, error) {
+	if err := s.verifySignature(payload, signature); err != nil {
+		return nil, false, err
+	}
+
+	var event struct {
+		Type string `json:"type"`
+		Data struct {
+			Object struct {
+				ID               string            `json:"id"`
+				Status           string            `json:"status"`
+				Metadata         map[string]string `json:"metadata"`
+				CurrentPeriodEnd int64             `json:"current_period_end"`
+			} `json:"object"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, false, fmt.Errorf("failed to parse webhook event: %w", err)
+	}
+
+	sub := event.Data.Object
+	result := &entity.PaymentEvent{
+		UserID:         sub.Metadata["user_id"],
+		Tier:           entity.MembershipTier(sub.Metadata["tier"]),
+		SubscriptionID: sub.ID,
+	}
+	if sub.CurrentPeriodEnd > 0 {
+		expiresAt := time.Unix(sub.CurrentPeriodEnd, 0)
+		result.ExpiresAt = &expiresAt
+	}
+
+	switch event.Type {
+	case "customer.subscription.created":
+		result.Type = entity.PaymentEventSubscriptionActivated
+	case "customer.subscription.updated":
+		if sub.Status != "active" {
+			return nil, false, nil
+		}
+		result.Type = entity.PaymentEventSubscriptionRenewed
+	case "customer.subscription.deleted":
+		result.Type = entity.PaymentEventSubscriptionCancelled
+	default:
+		return nil, false, nil
+	}
+	return result, true, nil
+}
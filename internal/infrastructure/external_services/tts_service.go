@@ -0,0 +1,78 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// ttsTimeout bounds a single synthesis call; long posts take a while to
+// render.
+const ttsTimeout = 120 * time.Second
+
+// ttsMaxAudioBytes caps how much audio is read back from the provider.
+const ttsMaxAudioBytes = 64 << 20
+
+// TTSService implements ITTSSynthesizer against an HTTP text-to-speech
+// backend: POST {endpoint}/synthesize returns the rendered MP3 bytes.
+type TTSService struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+var _ contract.ITTSSynthesizer = (*TTSService)(nil)
+
+func NewTTSService(endpoint, apiKey string) *TTSService {
+	return &TTSService{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		apiKey:   apiKey,
+		client:   &http.Client{Timeout: ttsTimeout},
+	}
+}
+
+// Synthesize renders the text into MP3 bytes.
+func (s *TTSService) Synthesize(ctx context.Context, text, language string) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{
+		"text":     text,
+		"language": language,
+		"format":   "mp3",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal synthesis request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/synthesize", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build synthesis request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to synthesize speech: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("TTS backend returned status %d", resp.StatusCode)
+	}
+
+	audio, err := io.ReadAll(io.LimitReader(resp.Body, ttsMaxAudioBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read synthesized audio: %w", err)
+	}
+	if len(audio) == 0 {
+		return nil, fmt.Errorf("TTS backend returned no audio")
+	}
+	return audio, nil
+}
@@ -0,0 +1,113 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// transcoderTimeout bounds a single API call to the transcoding backend.
+const transcoderTimeout = 15 * time.Second
+
+// VideoTranscoderService implements IVideoTranscoder against an HTTP
+// transcoding backend (typically an ffmpeg worker fleet): jobs are submitted
+// with POST {endpoint}/jobs and polled with GET {endpoint}/jobs/{id}.
+type VideoTranscoderService struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+var _ contract.IVideoTranscoder = (*VideoTranscoderService)(nil)
+
+func NewVideoTranscoderService(endpoint, apiKey string) *VideoTranscoderService {
+	return &VideoTranscoderService{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		apiKey:   apiKey,
+		client:   &http.Client{Timeout: transcoderTimeout},
+	}
+}
+
+// SubmitJob queues a transcode of the source file.
+func (s *VideoTranscoderService) SubmitJob(ctx context.Context, mediaID, sourceURL string) (string, error) {
+	body, err := json.Marshal(map[string]string{
+		"media_id":   mediaID,
+		"source_url": sourceURL,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal transcode job: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/jobs", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to build transcode request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit transcode job: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("transcoder returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		JobID string `json:"job_id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode transcode response: %w", err)
+	}
+	if result.JobID == "" {
+		return "", fmt.Errorf("transcoder returned no job ID")
+	}
+	return result.JobID, nil
+}
+
+// GetJobStatus polls a job's state; playback URLs are present once the
+// backend reports it ready.
+func (s *VideoTranscoderService) GetJobStatus(ctx context.Context, jobID string) (*entity.TranscodeJobStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+"/jobs/"+jobID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transcode status request: %w", err)
+	}
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transcode status: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("transcoder returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		State   string `json:"state"`
+		HLSURL  string `json:"hls_url"`
+		DASHURL string `json:"dash_url"`
+		Error   string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode transcode status: %w", err)
+	}
+	return &entity.TranscodeJobStatus{
+		State:           result.State,
+		HLSPlaybackURL:  result.HLSURL,
+		DASHPlaybackURL: result.DASHURL,
+		Message:         result.Error,
+	}, nil
+}
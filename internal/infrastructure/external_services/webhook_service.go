@@ -0,0 +1,72 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// webhookTimeout bounds a single delivery attempt; retries are handled by
+// the outbox relay.
+const webhookTimeout = 10 * time.Second
+
+// WebhookService delivers outbox events to an external endpoint as JSON.
+// When a secret is configured, the payload is signed with HMAC-SHA256 so the
+// receiver can verify the sender.
+type WebhookService struct {
+	endpoint string
+	secret   string
+	client   *http.Client
+}
+
+func NewWebhookService(endpoint, secret string) *WebhookService {
+	return &WebhookService{
+		endpoint: endpoint,
+		secret:   secret,
+		client:   &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Deliver posts the event to the configured endpoint. Any non-2xx response
+// counts as a failure so the relay retries it.
+func (s *WebhookService) Deliver(ctx context.Context, event *entity.OutboxEvent) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"id":         event.ID,
+		"event_type": event.EventType,
+		"payload":    event.Payload,
+		"created_at": event.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.secret))
+		mac.Write(body)
+		req.Header.Set("X-Webhook-Signature", hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook delivery failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook delivery failed: endpoint returned %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package featureflags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/uuidgen"
+)
+
+// flagsRefreshInterval controls how often flags are reloaded from the
+// repository; flag checks between refreshes are served from memory.
+const flagsRefreshInterval = 30 * time.Second
+
+// FeatureFlagService answers feature toggles from an in-memory snapshot of
+// the persisted flags, reloading it periodically so admin changes take
+// effect without a redeploy.
+type FeatureFlagService struct {
+	repo contract.IFeatureFlagRepository
+	bus  contract.ICacheInvalidationBus // optional, coherency across instances
+
+	mu          sync.RWMutex
+	flags       map[string]*entity.FeatureFlag
+	lastRefresh time.Time
+}
+
+// Ensure FeatureFlagService implements IFeatureFlags
+var _ contract.IFeatureFlags = (*FeatureFlagService)(nil)
+
+// NewFeatureFlagService creates a feature flag service.
+func NewFeatureFlagService(repo contract.IFeatureFlagRepository) *FeatureFlagService {
+	return &FeatureFlagService{
+		repo:  repo,
+		flags: make(map[string]*entity.FeatureFlag),
+	}
+}
+
+// SetInvalidationBus enables cross-instance coherency: flag writes broadcast
+// an invalidation, and broadcasts from other instances drop the local
+// snapshot so the next check re-reads the repository.
+func (s *FeatureFlagService) SetInvalidationBus(bus contract.ICacheInvalidationBus) {
+	s.bus = bus
+	bus.SubscribeInvalidations(func(topic string) {
+		if topic == contract.InvalidationTopicFeatureFlags {
+			s.markStale()
+		}
+	})
+}
+
+// markStale forces the next flag check to refresh the snapshot.
+func (s *FeatureFlagService) markStale() {
+	s.mu.Lock()
+	s.lastRefresh = time.Time{}
+	s.mu.Unlock()
+}
+
+// IsEnabled reports whether the flag is fully enabled; undefined flags
+// return the fallback.
+func (s *FeatureFlagService) IsEnabled(ctx context.Context, name string, fallback bool) bool {
+	flag, ok := s.flagFor(ctx, name)
+	if !ok {
+		return fallback
+	}
+	return flag.Enabled && flag.RolloutPercent >= 100
+}
+
+// IsEnabledFor reports whether the flag is enabled for a subject, honoring
+// percentage rollouts; undefined flags return the fallback. The bucket is
+// derived from the flag name and subject so a subject keeps its answer
+// across checks and flags roll out to independent subsets.
+func (s *FeatureFlagService) IsEnabledFor(ctx context.Context, name, subjectID string, fallback bool) bool {
+	flag, ok := s.flagFor(ctx, name)
+	if !ok {
+		return fallback
+	}
+	if !flag.Enabled {
+		return false
+	}
+	if flag.RolloutPercent >= 100 {
+		return true
+	}
+	if flag.RolloutPercent <= 0 {
+		return false
+	}
+
+	hasher := fnv.New32a()
+	fmt.Fprintf(hasher, "%s:%s", name, subjectID)
+	return int(hasher.Sum32()%100) < flag.RolloutPercent
+}
+
+// SetFlag creates or updates a flag and applies it immediately.
+func (s *FeatureFlagService) SetFlag(ctx context.Context, name string, enabled bool, rolloutPercent int) error {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if name == "" {
+		return errors.New("invalid flag name")
+	}
+	if rolloutPercent < 0 || rolloutPercent > 100 {
+		return errors.New("invalid rollout percent: must be between 0 and 100")
+	}
+
+	flag := &entity.FeatureFlag{
+		ID:             uuidgen.NewGenerator().NewUUID(),
+		Name:           name,
+		Enabled:        enabled,
+		RolloutPercent: rolloutPercent,
+	}
+	if err := s.repo.UpsertFlag(ctx, flag); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.flags[name] = flag
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicFeatureFlags)
+	}
+	return nil
+}
+
+// ListFlags returns all persisted flags.
+func (s *FeatureFlagService) ListFlags(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	return s.repo.ListFlags(ctx)
+}
+
+// DeleteFlag removes a flag; checks fall back to their defaults afterwards.
+func (s *FeatureFlagService) DeleteFlag(ctx context.Context, name string) error {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if err := s.repo.DeleteFlag(ctx, name); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.flags, name)
+	s.mu.Unlock()
+	if s.bus != nil {
+		s.bus.PublishInvalidation(ctx, contract.InvalidationTopicFeatureFlags)
+	}
+	return nil
+}
+
+// flagFor returns the cached flag by name, refreshing the snapshot when
+// stale.
+func (s *FeatureFlagService) flagFor(ctx context.Context, name string) (*entity.FeatureFlag, bool) {
+	s.refreshIfStale(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	flag, ok := s.flags[strings.TrimSpace(strings.ToLower(name))]
+	return flag, ok
+}
+
+// refreshIfStale reloads the flag snapshot once the refresh interval has
+// elapsed. Repository failures keep the previous snapshot; the refresh
+// timestamp advances regardless so a broken source is not hammered on every
+// check.
+func (s *FeatureFlagService) refreshIfStale(ctx context.Context) {
+	s.mu.RLock()
+	stale := time.Since(s.lastRefresh) >= flagsRefreshInterval
+	s.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	snapshot := make(map[string]*entity.FeatureFlag)
+	if flags, err := s.repo.ListFlags(ctx); err == nil {
+		for _, flag := range flags {
+			snapshot[flag.Name] = flag
+		}
+	} else {
+		s.mu.RLock()
+		for name, flag := range s.flags {
+			snapshot[name] = flag
+		}
+		s.mu.RUnlock()
+	}
+
+	s.mu.Lock()
+	s.flags = snapshot
+	s.lastRefresh = time.Now()
+	s.mu.Unlock()
+}
@@ -1,31 +1,52 @@
 package jwt
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"github.com/mikiasgoitom/Articulate/internal/usecase"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
 // JWTServiceAdapter adapts JWTManager to the usecase.JWTService interface.
-// It wraps JWTManager methods into the usecase-friendly interface.
+// It wraps JWTManager methods into the usecase-friendly interface and looks
+// up per-client-type token lifetimes from the config provider.
 type JWTServiceAdapter struct {
-	mgr *JWTManager
+	mgr    *JWTManager
+	config usecasecontract.IConfigProvider
 }
 
 // NewJWTService creates a new usecase.JWTService from JWTManager
-func NewJWTService(mgr *JWTManager) usecase.JWTService {
-	return &JWTServiceAdapter{mgr: mgr}
+func NewJWTService(mgr *JWTManager, config usecasecontract.IConfigProvider) usecase.JWTService {
+	return &JWTServiceAdapter{mgr: mgr, config: config}
 }
 
-// GenerateAccessToken issues an access token for a user.
-func (a *JWTServiceAdapter) GenerateAccessToken(userID string, role entity.UserRole) (string, error) {
-	return a.mgr.GenerateAccessToken(userID, string(role))
+// GenerateAccessToken issues an access token for a user with the lifetime
+// configured for the client type.
+func (a *JWTServiceAdapter) GenerateAccessToken(userID string, role entity.UserRole, clientType entity.ClientType) (string, error) {
+	return a.mgr.GenerateAccessToken(userID, string(role), string(clientType), a.accessTokenExpiry(clientType))
 }
 
-// GenerateRefreshToken issues a refresh token for a user.
-func (a *JWTServiceAdapter) GenerateRefreshToken(userID string, role entity.UserRole) (string, error) {
+// GenerateRefreshToken issues a refresh token for a user; remember-me logins
+// get the extended lifetime.
+func (a *JWTServiceAdapter) GenerateRefreshToken(userID string, role entity.UserRole, clientType entity.ClientType, rememberMe bool) (string, error) {
 	tokenID := uuid.New().String()
-	return a.mgr.GenerateRefreshToken(tokenID, userID)
+	return a.mgr.GenerateRefreshToken(tokenID, userID, string(clientType), rememberMe, a.refreshTokenExpiry(clientType, rememberMe))
+}
+
+func (a *JWTServiceAdapter) accessTokenExpiry(clientType entity.ClientType) time.Duration {
+	if a.config == nil {
+		return 0
+	}
+	return a.config.GetAccessTokenExpiryFor(clientType)
+}
+
+func (a *JWTServiceAdapter) refreshTokenExpiry(clientType entity.ClientType, rememberMe bool) time.Duration {
+	if a.config == nil {
+		return 0
+	}
+	return a.config.GetRefreshTokenExpiryFor(clientType, rememberMe)
 }
 
 // ParseAccessToken validates an access token and returns Claims.
@@ -37,6 +58,7 @@ func (a *JWTServiceAdapter) ParseAccessToken(tokenStr string) (*entity.Claims, e
 	return &entity.Claims{
 		UserID:           customClaims.Subject,
 		Role:             entity.UserRole(customClaims.Role),
+		ClientType:       entity.ClientType(customClaims.ClientType),
 		RegisteredClaims: customClaims.RegisteredClaims,
 	}, nil
 }
@@ -49,13 +71,15 @@ func (a *JWTServiceAdapter) ParseRefreshToken(tokenStr string) (*entity.Claims,
 	}
 	return &entity.Claims{
 		UserID:           customClaims.Subject,
+		ClientType:       entity.ClientType(customClaims.ClientType),
+		RememberMe:       customClaims.RememberMe,
 		RegisteredClaims: customClaims.RegisteredClaims,
 	}, nil
 }
 
 // GeneratePasswordResetToken issues a password reset token.
 func (a *JWTServiceAdapter) GeneratePasswordResetToken(userID string) (string, error) {
-	return a.GenerateRefreshToken(userID, "")
+	return a.GenerateRefreshToken(userID, "", entity.ClientTypeWeb, false)
 }
 
 // ParsePasswordResetToken validates a password reset token.
@@ -65,7 +89,7 @@ func (a *JWTServiceAdapter) ParsePasswordResetToken(tokenStr string) (*entity.Cl
 
 // GenerateEmailVerificationToken issues an email verification token.
 func (a *JWTServiceAdapter) GenerateEmailVerificationToken(userID string) (string, error) {
-	return a.GenerateRefreshToken(userID, "")
+	return a.GenerateRefreshToken(userID, "", entity.ClientTypeWeb, false)
 }
 
 // ParseEmailVerificationToken validates an email verification token.
@@ -22,11 +22,15 @@ func NewJWTManager(scrt string) *JWTManager {
 	}
 }
 
-func (j *JWTManager) GenerateAccessToken(userID, userRole string) (string, error) {
-	expirationTime := time.Now().Add(360 * time.Minute)
+func (j *JWTManager) GenerateAccessToken(userID, userRole, clientType string, ttl time.Duration) (string, error) {
+	if ttl <= 0 {
+		ttl = 360 * time.Minute
+	}
+	expirationTime := time.Now().Add(ttl)
 
 	claims := contract.CustomClaims{
-		Role: userRole,
+		Role:       userRole,
+		ClientType: clientType,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   userID,
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
@@ -43,9 +47,14 @@ func (j *JWTManager) GenerateAccessToken(userID, userRole string) (string, error
 	return tokenString, nil
 }
 
-func (j *JWTManager) GenerateRefreshToken(tokenID, userID string) (string, error) {
-	expirationTime := time.Now().Add(168 * time.Hour)
+func (j *JWTManager) GenerateRefreshToken(tokenID, userID, clientType string, rememberMe bool, ttl time.Duration) (string, error) {
+	if ttl <= 0 {
+		ttl = 168 * time.Hour
+	}
+	expirationTime := time.Now().Add(ttl)
 	claims := contract.RefreshClaims{
+		ClientType: clientType,
+		RememberMe: rememberMe,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   userID,
 			ID:        tokenID,
@@ -62,6 +71,31 @@ func (j *JWTManager) GenerateRefreshToken(tokenID, userID string) (string, error
 	return tokenString, nil
 }
 
+func (j *JWTManager) GenerateServiceToken(clientID string, scopes []string, ttl time.Duration) (string, error) {
+	if ttl <= 0 {
+		ttl = time.Hour
+	}
+	expirationTime := time.Now().Add(ttl)
+
+	claims := contract.ServiceClaims{
+		Scopes:   scopes,
+		TokenUse: "service",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   clientID,
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	tokenString, err := token.SignedString([]byte(j.Secret))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign service token: %w", err)
+	}
+	return tokenString, nil
+}
+
 func (j *JWTManager) VerifyToken(tokenString string) (*contract.CustomClaims, error) {
 	claims := &contract.CustomClaims{}
 
@@ -99,3 +133,22 @@ func (j *JWTManager) VerifyRefreshToken(tokenString string) (*contract.RefreshCl
 	}
 	return claims, nil
 }
+
+func (j *JWTManager) VerifyServiceToken(tokenString string) (*contract.ServiceClaims, error) {
+	claims := &contract.ServiceClaims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return []byte(j.Secret), nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse or validate token: %w", err)
+	}
+	if !token.Valid || claims.TokenUse != "service" {
+		return nil, fmt.Errorf("invalid token")
+	}
+	return claims, nil
+}
@@ -41,6 +41,30 @@ var (
 		Name:      "miss_duration_seconds",
 		Help:      "Total time spent serving cache misses (seconds)",
 	})
+
+	// AI consumption counters for cost monitoring, labelled by operation
+	// (generate, modify, outline, expand, rewrite)
+	AIRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ai",
+		Subsystem: "usage",
+		Name:      "requests_total",
+		Help:      "Total AI assistant requests",
+	}, []string{"operation"})
+	AIEstimatedTokens = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ai",
+		Subsystem: "usage",
+		Name:      "estimated_tokens_total",
+		Help:      "Total estimated tokens consumed by AI assistant requests",
+	}, []string{"operation"})
+
+	// Circuit breaker state per external service:
+	// 0 = closed, 1 = half-open, 2 = open
+	BreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "resilience",
+		Subsystem: "breaker",
+		Name:      "state",
+		Help:      "Circuit breaker state per external service (0 closed, 1 half-open, 2 open)",
+	}, []string{"service"})
 )
 
 func init() {
@@ -51,6 +75,9 @@ func init() {
 		BlogCacheListMiss,
 		BlogCacheHitDuration,
 		BlogCacheMissDuration,
+		AIRequests,
+		AIEstimatedTokens,
+		BreakerState,
 	)
 }
 
@@ -62,3 +89,14 @@ func IncListMiss()   { BlogCacheListMiss.Inc() }
 // Add duration (in seconds) to the total hit/miss duration counters
 func AddHitDuration(seconds float64)  { BlogCacheHitDuration.Add(seconds) }
 func AddMissDuration(seconds float64) { BlogCacheMissDuration.Add(seconds) }
+
+// Count one AI request and its estimated token consumption per operation
+func IncAIRequest(operation string) { AIRequests.WithLabelValues(operation).Inc() }
+func AddAITokens(operation string, tokens int64) {
+	AIEstimatedTokens.WithLabelValues(operation).Add(float64(tokens))
+}
+
+// Mirror a circuit breaker's state (0 closed, 1 half-open, 2 open)
+func SetBreakerState(service string, state int) {
+	BreakerState.WithLabelValues(service).Set(float64(state))
+}
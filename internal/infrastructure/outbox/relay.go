@@ -0,0 +1,118 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// HandlerFunc performs one side effect (cache invalidation, webhook delivery,
+// search indexing) for an outbox event. Handlers must be idempotent: an event
+// is retried as a whole when any of its handlers fails.
+type HandlerFunc func(ctx context.Context, event *entity.OutboxEvent) error
+
+const (
+	// batchSize bounds how many events one relay pass processes.
+	batchSize = 50
+	// maxAttempts is how often an event is retried before it is parked as
+	// failed for manual inspection.
+	maxAttempts = 10
+	// retryBaseDelay grows linearly with the attempt count, so transient
+	// downstream outages back off instead of hammering.
+	retryBaseDelay = time.Minute
+)
+
+// Relay drains the transactional outbox in the background and dispatches
+// events to the handlers registered for their type.
+type Relay struct {
+	repo     contract.IOutboxRepository
+	handlers map[string][]HandlerFunc
+	interval time.Duration
+	logger   usecasecontract.IAppLogger
+}
+
+func NewRelay(repo contract.IOutboxRepository, interval time.Duration, logger usecasecontract.IAppLogger) *Relay {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &Relay{
+		repo:     repo,
+		handlers: make(map[string][]HandlerFunc),
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// RegisterHandler subscribes a handler to an event type. Must be called
+// before Start.
+func (r *Relay) RegisterHandler(eventType string, handler HandlerFunc) {
+	r.handlers[eventType] = append(r.handlers[eventType], handler)
+}
+
+// Start runs the relay loop until the context is cancelled.
+func (r *Relay) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.processBatch(ctx)
+			}
+		}
+	}()
+}
+
+func (r *Relay) processBatch(ctx context.Context) {
+	events, err := r.repo.FetchPendingOutboxEvents(ctx, batchSize)
+	if err != nil {
+		r.logger.Errorf("outbox relay: failed to fetch pending events: %v", err)
+		return
+	}
+
+	for _, event := range events {
+		if err := r.dispatch(ctx, event); err != nil {
+			r.retry(ctx, event, err)
+			continue
+		}
+		if err := r.repo.MarkOutboxEventDelivered(ctx, event.ID); err != nil {
+			r.logger.Errorf("outbox relay: failed to mark event %s delivered: %v", event.ID, err)
+		}
+	}
+}
+
+func (r *Relay) dispatch(ctx context.Context, event *entity.OutboxEvent) error {
+	handlers := r.handlers[event.EventType]
+	if len(handlers) == 0 {
+		return fmt.Errorf("no handler registered for event type %s", event.EventType)
+	}
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Relay) retry(ctx context.Context, event *entity.OutboxEvent, cause error) {
+	attempts := event.Attempts + 1
+	if attempts >= maxAttempts {
+		r.logger.Errorf("outbox relay: event %s (%s) failed permanently after %d attempts: %v", event.ID, event.EventType, attempts, cause)
+		if err := r.repo.MarkOutboxEventFailed(ctx, event.ID, cause.Error()); err != nil {
+			r.logger.Errorf("outbox relay: failed to mark event %s failed: %v", event.ID, err)
+		}
+		return
+	}
+
+	nextAttempt := time.Now().Add(time.Duration(attempts) * retryBaseDelay)
+	r.logger.Warningf("outbox relay: event %s (%s) attempt %d failed, retrying at %s: %v", event.ID, event.EventType, attempts, nextAttempt.Format(time.RFC3339), cause)
+	if err := r.repo.RescheduleOutboxEvent(ctx, event.ID, nextAttempt, cause.Error()); err != nil {
+		r.logger.Errorf("outbox relay: failed to reschedule event %s: %v", event.ID, err)
+	}
+}
@@ -1,26 +1,78 @@
 package passwordservice
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-type Hasher struct{}
+// Supported password hashing algorithms.
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+// DefaultBcryptCost is used when no (or an invalid) cost is configured.
+const DefaultBcryptCost = 12
+
+// argon2Params holds the tuning parameters for Argon2id hashing.
+type argon2Params struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	saltLen uint32
+	keyLen  uint32
+}
+
+// defaultArgon2Params follows the OWASP recommended baseline.
+var defaultArgon2Params = argon2Params{
+	memory:  64 * 1024,
+	time:    3,
+	threads: 4,
+	saltLen: 16,
+	keyLen:  32,
+}
+
+type Hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     argon2Params
+}
 
 // check if IHasher was implemented at compile time
 var _ contract.IHasher = (*Hasher)(nil)
 
-func NewHasher() *Hasher {
-	return &Hasher{}
+// NewHasher returns a hasher using the given algorithm ("bcrypt" or
+// "argon2id") and bcrypt cost. Unknown algorithms fall back to bcrypt and
+// out-of-range costs fall back to DefaultBcryptCost.
+func NewHasher(algorithm string, bcryptCost int) *Hasher {
+	if algorithm != AlgorithmArgon2id {
+		algorithm = AlgorithmBcrypt
+	}
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		bcryptCost = DefaultBcryptCost
+	}
+	return &Hasher{
+		algorithm:  algorithm,
+		bcryptCost: bcryptCost,
+		argon2:     defaultArgon2Params,
+	}
 }
 
 func (h *Hasher) HashPassword(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 5)
+	if h.algorithm == AlgorithmArgon2id {
+		return h.hashArgon2id(password)
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
 	if err != nil {
 		return "", err
 	}
@@ -28,6 +80,9 @@ func (h *Hasher) HashPassword(password string) (string, error) {
 }
 
 func (h *Hasher) ComparePasswordHash(password, hashedPassword string) error {
+	if strings.HasPrefix(hashedPassword, "$argon2id$") {
+		return h.compareArgon2id(password, hashedPassword)
+	}
 	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 	if err != nil {
 		if err == bcrypt.ErrMismatchedHashAndPassword {
@@ -38,6 +93,90 @@ func (h *Hasher) ComparePasswordHash(password, hashedPassword string) error {
 	return nil
 }
 
+// NeedsRehash reports whether the stored hash was produced with different
+// parameters than currently configured, so callers can transparently upgrade
+// it after a successful verification.
+func (h *Hasher) NeedsRehash(hashedPassword string) bool {
+	if strings.HasPrefix(hashedPassword, "$argon2id$") {
+		if h.algorithm != AlgorithmArgon2id {
+			return true
+		}
+		params, _, _, err := decodeArgon2idHash(hashedPassword)
+		if err != nil {
+			return true
+		}
+		return params.memory != h.argon2.memory || params.time != h.argon2.time || params.threads != h.argon2.threads
+	}
+	if h.algorithm != AlgorithmBcrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	if err != nil {
+		return true
+	}
+	return cost != h.bcryptCost
+}
+
+func (h *Hasher) hashArgon2id(password string) (string, error) {
+	salt := make([]byte, h.argon2.saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.argon2.time, h.argon2.memory, h.argon2.threads, h.argon2.keyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.argon2.memory, h.argon2.time, h.argon2.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (h *Hasher) compareArgon2id(password, hashedPassword string) error {
+	params, salt, key, err := decodeArgon2idHash(hashedPassword)
+	if err != nil {
+		return fmt.Errorf("failed to check password hash: %w", err)
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return fmt.Errorf("password verification failed")
+	}
+	return nil
+}
+
+// decodeArgon2idHash parses the standard $argon2id$v=..$m=..,t=..,p=..$salt$key encoding.
+func decodeArgon2idHash(encoded string) (argon2Params, []byte, []byte, error) {
+	var params argon2Params
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, errors.New("invalid argon2id hash format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, err
+	}
+	if version != argon2.Version {
+		return params, nil, nil, errors.New("incompatible argon2 version")
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return params, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, err
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, err
+	}
+
+	params.saltLen = uint32(len(salt))
+	params.keyLen = uint32(len(key))
+	return params, salt, key, nil
+}
+
 func (h *Hasher) HashString(s string) string {
 	// Use SHA256 for hashing tokens (not passwords)
 	// This is more appropriate for long strings like JWT tokens
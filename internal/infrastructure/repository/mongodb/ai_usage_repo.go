@@ -0,0 +1,73 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AIUsageRepository is the MongoDB implementation of the IAIUsageRepository
+// interface.
+type AIUsageRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IAIUsageRepository = (*AIUsageRepository)(nil)
+
+// NewAIUsageRepository creates and returns a new AIUsageRepository instance.
+func NewAIUsageRepository(db *mongo.Database) *AIUsageRepository {
+	return &AIUsageRepository{
+		collection: db.Collection("ai_usage"),
+	}
+}
+
+// RecordUsage inserts one AI request charged to a user.
+func (r *AIUsageRepository) RecordUsage(ctx context.Context, record *entity.AIUsageRecord) error {
+	if record.ID == "" {
+		record.ID = primitive.NewObjectID().Hex()
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now()
+	}
+	if _, err := r.collection.InsertOne(ctx, record); err != nil {
+		return fmt.Errorf("failed to record AI usage: %w", err)
+	}
+	return nil
+}
+
+// SummarizeUsage totals a user's requests and estimated tokens since the
+// given time.
+func (r *AIUsageRepository) SummarizeUsage(ctx context.Context, userID string, since time.Time) (*entity.AIUsageSummary, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{
+			"user_id":    userID,
+			"created_at": bson.M{"$gte": since},
+		}}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id":              nil,
+			"requests":         bson.M{"$sum": 1},
+			"estimated_tokens": bson.M{"$sum": "$estimated_tokens"},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize AI usage: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []entity.AIUsageSummary
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("failed to decode AI usage summary: %w", err)
+	}
+	if len(results) == 0 {
+		return &entity.AIUsageSummary{}, nil
+	}
+	return &results[0], nil
+}
@@ -0,0 +1,85 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// APIKeyRepository is the MongoDB implementation of IAPIKeyRepository.
+type APIKeyRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IAPIKeyRepository = (*APIKeyRepository)(nil)
+
+func NewAPIKeyRepository(db *mongo.Database) *APIKeyRepository {
+	return &APIKeyRepository{
+		collection: db.Collection("api_keys"),
+	}
+}
+
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, key *entity.APIKey) error {
+	_, err := r.collection.InsertOne(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	var key entity.APIKey
+	err := r.collection.FindOne(ctx, bson.M{"key_hash": keyHash}).Decode(&key)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("api key not found")
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *APIKeyRepository) ListAPIKeysByUserID(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var keys []*entity.APIKey
+	for cursor.Next(ctx) {
+		var key entity.APIKey
+		if err := cursor.Decode(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, &key)
+	}
+	return keys, cursor.Err()
+}
+
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, keyID, userID string) error {
+	filter := bson.M{"_id": keyID, "user_id": userID, "revoked": false}
+	update := bson.M{"$set": bson.M{"revoked": true}}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("api key not found")
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) TouchAPIKey(ctx context.Context, keyID string) error {
+	filter := bson.M{"_id": keyID}
+	update := bson.M{"$set": bson.M{"last_used_at": time.Now()}}
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
@@ -0,0 +1,81 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BlockedDomainRepository represents the MongoDB implementation of the
+// IBlockedDomainRepository interface.
+type BlockedDomainRepository struct {
+	collection *mongo.Collection
+}
+
+// NewBlockedDomainRepository creates and returns a new BlockedDomainRepository instance.
+func NewBlockedDomainRepository(db *mongo.Database) *BlockedDomainRepository {
+	return &BlockedDomainRepository{
+		collection: db.Collection("blocked_email_domains"),
+	}
+}
+
+// AddDomain inserts an admin-added blocklist entry.
+func (r *BlockedDomainRepository) AddDomain(ctx context.Context, domain *entity.BlockedDomain) error {
+	if domain.CreatedAt.IsZero() {
+		domain.CreatedAt = time.Now()
+	}
+
+	_, err := r.collection.InsertOne(ctx, domain)
+	if err != nil {
+		var writeException mongo.WriteException
+		if errors.As(err, &writeException) {
+			for _, writeError := range writeException.WriteErrors {
+				if writeError.Code == 11000 {
+					return errors.New("domain is already blocked")
+				}
+			}
+		}
+		return fmt.Errorf("failed to add blocked domain: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveDomain deletes an admin-added blocklist entry.
+func (r *BlockedDomainRepository) RemoveDomain(ctx context.Context, domain string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"domain": domain})
+	if err != nil {
+		return fmt.Errorf("failed to remove blocked domain: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return errors.New("domain not found in blocklist")
+	}
+
+	return nil
+}
+
+// ListDomains returns all admin-added blocklist entries.
+func (r *BlockedDomainRepository) ListDomains(ctx context.Context) ([]string, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked domains: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var entries []*entity.BlockedDomain
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode blocked domains: %w", err)
+	}
+
+	domains := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		domains = append(domains, entry.Domain)
+	}
+
+	return domains, nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/uuidgen"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -16,19 +18,26 @@ import (
 
 // BlogRepository represents the MongoDB implementation of the BlogRepository interface.
 type BlogRepository struct {
-	collection          *mongo.Collection // For blog posts
-	usersCollection     *mongo.Collection // For accessing user data for search
-	blogViewsCollection *mongo.Collection // For tracking blog views
-	blogTagsCollection  *mongo.Collection
+	collection              *mongo.Collection // For blog posts
+	usersCollection         *mongo.Collection // For accessing user data for search
+	blogViewsCollection     *mongo.Collection // For tracking blog views
+	pendingViewsCollection  *mongo.Collection // For views awaiting the dwell-time heartbeat
+	viewAnomaliesCollection *mongo.Collection // For per-blog view-fraud scores
+	blogTagsCollection      *mongo.Collection
+	reviewNotesCollection   *mongo.Collection // For editorial review notes
+	outbox                  contract.IOutboxRepository
 }
 
 // NewBlogRepository creates and returns a new BlogRepository instance.
 func NewBlogRepository(db *mongo.Database, user *mongo.Collection) *BlogRepository {
 	return &BlogRepository{
-		collection:          db.Collection("blogs"),
-		blogTagsCollection:  db.Collection("blog_tags"),
-		usersCollection:     user,
-		blogViewsCollection: db.Collection("blog_views"),
+		collection:              db.Collection("blogs"),
+		blogTagsCollection:      db.Collection("blog_tags"),
+		usersCollection:         user,
+		blogViewsCollection:     db.Collection("blog_views"),
+		pendingViewsCollection:  db.Collection("pending_views"),
+		viewAnomaliesCollection: db.Collection("view_anomalies"),
+		reviewNotesCollection:   db.Collection("review_notes"),
 	}
 }
 
@@ -38,18 +47,104 @@ type sortStage struct {
 	sortOrder bson.M
 }
 
+// needsAuthorLookup reports whether the query must join author details from
+// the users collection (author-name sorting or username filtering).
+func needsAuthorLookup(opts *contract.BlogFilterOptions, sortKey string) bool {
+	if opts.AuthorUsername != nil && *opts.AuthorUsername != "" {
+		return true
+	}
+	return strings.HasPrefix(sortKey, "authorDetails.")
+}
+
+// authorLookupStages joins the blog's author from the users collection as
+// "authorDetails". The join compares both sides as strings so it holds
+// regardless of whether users._id is stored as a string UUID or an ObjectID.
+func authorLookupStages() []bson.D {
+	return []bson.D{
+		{{Key: "$lookup", Value: bson.M{
+			"from": "users",
+			"let":  bson.M{"authorID": "$author_id"},
+			"pipeline": mongo.Pipeline{
+				bson.D{{Key: "$match", Value: bson.M{
+					"$expr": bson.M{"$eq": bson.A{
+						bson.M{"$toString": "$_id"},
+						bson.M{"$toString": "$$authorID"},
+					}},
+				}}},
+			},
+			"as": "authorDetails",
+		}}},
+		{{Key: "$unwind", Value: bson.M{
+			"path":                       "$authorDetails",
+			"preserveNullAndEmptyArrays": true,
+		}}},
+	}
+}
+
+// countPipelineTotal runs the given match/lookup stages with a $count stage
+// appended, for totals that depend on joined author fields.
+func (r *BlogRepository) countPipelineTotal(ctx context.Context, stages mongo.Pipeline) (int64, error) {
+	pipeline := append(mongo.Pipeline{}, stages...)
+	pipeline = append(pipeline, bson.D{{Key: "$count", Value: "total"}})
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		Total int64 `bson:"total"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return 0, err
+	}
+	if len(result) == 0 {
+		return 0, nil
+	}
+	return result[0].Total, nil
+}
+
 // buildBlogFilterAndSort creates a BSON filter and a sort order based on BlogFilterOptions.
 func buildBlogFilterAndSort(opts *contract.BlogFilterOptions) (bson.M, *sortStage) {
-	filter := bson.M{"is_deleted": false}
+	filter := bson.M{"is_deleted": false, "author_hidden": bson.M{"$ne": true}}
 
 	// Filter by author ID
 	if opts.AuthorID != nil && *opts.AuthorID != "" {
 		filter["author_id"] = *opts.AuthorID
 	}
 
-	// Filter by tags
+	// Filter by owning organization
+	if opts.OrgID != nil && *opts.OrgID != "" {
+		filter["org_id"] = *opts.OrgID
+	}
+
+	// Filter by tags: TagIDs matches any of the tags, TagsAll requires all
+	// of them
+	tagFilter := bson.M{}
 	if len(opts.TagIDs) > 0 {
-		filter["tags"] = bson.M{"$in": opts.TagIDs}
+		tagFilter["$in"] = opts.TagIDs
+	}
+	if len(opts.TagsAll) > 0 {
+		tagFilter["$all"] = opts.TagsAll
+	}
+	if len(tagFilter) > 0 {
+		filter["tags"] = tagFilter
+	}
+
+	// Filter by language
+	if opts.Language != nil && *opts.Language != "" {
+		filter["language"] = *opts.Language
+	}
+
+	// Filter by publication status
+	if opts.Status != nil && *opts.Status != "" {
+		filter["status"] = *opts.Status
+	}
+
+	// Public feeds exclude mature-rated posts
+	if opts.ExcludeMature {
+		filter["content_rating"] = bson.M{"$ne": entity.ContentRatingMature}
 	}
 
 	// Filter by date range
@@ -88,6 +183,18 @@ func buildBlogFilterAndSort(opts *contract.BlogFilterOptions) (bson.M, *sortStag
 		filter["like_count"] = likeFilter
 	}
 
+	// Filter by reading time range
+	readingTimeFilter := bson.M{}
+	if opts.MinReadingTime != nil {
+		readingTimeFilter["$gte"] = *opts.MinReadingTime
+	}
+	if opts.MaxReadingTime != nil {
+		readingTimeFilter["$lte"] = *opts.MaxReadingTime
+	}
+	if len(readingTimeFilter) > 0 {
+		filter["reading_time_minutes"] = readingTimeFilter
+	}
+
 	// Handle sorting
 	var sortOrder int = -1 // default desc
 	if opts.SortOrder == "asc" {
@@ -115,13 +222,60 @@ func buildBlogFilterAndSort(opts *contract.BlogFilterOptions) (bson.M, *sortStag
 }
 
 // CreateBlog inserts a new blog post record into the database.
+// SetOutbox enables the transactional outbox: blog writes enqueue an event
+// in the same session, and the relay worker performs cache invalidation,
+// webhook delivery and search indexing from it.
+func (r *BlogRepository) SetOutbox(outbox contract.IOutboxRepository) {
+	r.outbox = outbox
+}
+
+// newOutboxEvent builds a pending outbox event for a blog write.
+func (r *BlogRepository) newOutboxEvent(eventType string, payload map[string]string) *entity.OutboxEvent {
+	now := time.Now()
+	return &entity.OutboxEvent{
+		ID:            uuidgen.NewGenerator().NewUUID(),
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        entity.OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// withOutbox runs the domain write together with the outbox insert in one
+// transaction, so either both land or neither does — a failure between the
+// two can never persist the blog change while losing its event. Without an
+// outbox configured the write runs directly.
+func (r *BlogRepository) withOutbox(ctx context.Context, write func(ctx context.Context) error, eventType string, payload map[string]string) error {
+	if r.outbox == nil {
+		return write(ctx)
+	}
+
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		if err := write(sc); err != nil {
+			return nil, err
+		}
+		return nil, r.outbox.EnqueueOutboxEvent(sc, r.newOutboxEvent(eventType, payload))
+	})
+	return err
+}
+
 func (r *BlogRepository) CreateBlog(ctx context.Context, blog *entity.Blog) error {
 	blog.CreatedAt = time.Now()
 	blog.UpdatedAt = time.Now()
 	if blog.Tags == nil {
 		blog.Tags = []string{} // Ensure tags is not nil to avoid DB errors
 	}
-	_, err := r.collection.InsertOne(ctx, blog)
+	err := r.withOutbox(ctx, func(ctx context.Context) error {
+		_, err := r.collection.InsertOne(ctx, blog)
+		return err
+	}, entity.OutboxEventBlogCreated, map[string]string{"blog_id": blog.ID, "slug": blog.Slug})
 	if err != nil {
 		return fmt.Errorf("failed to create blog post: %w", err)
 	}
@@ -160,6 +314,38 @@ func (r *BlogRepository) GetBlogBySlug(ctx context.Context, slug string) (*entit
 	return &blog, nil
 }
 
+// GetBlogsByFeaturedImageID returns the blogs referencing a media record as
+// their featured image, for the media library's usage lookup.
+func (r *BlogRepository) GetBlogsByFeaturedImageID(ctx context.Context, mediaID string) ([]*entity.Blog, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.collection.Find(ctx, bson.M{"featured_image_id": mediaID, "is_deleted": false}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blogs by featured image: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var blogs []*entity.Blog
+	if err := cursor.All(ctx, &blogs); err != nil {
+		return nil, fmt.Errorf("failed to decode blog posts: %w", err)
+	}
+	return blogs, nil
+}
+
+// CountBlogsByAuthorSince counts an author's posts created after the given
+// time, for the per-role daily post limit.
+func (r *BlogRepository) CountBlogsByAuthorSince(ctx context.Context, authorID string, since time.Time) (int64, error) {
+	filter := bson.M{
+		"author_id":  authorID,
+		"is_deleted": false,
+		"created_at": bson.M{"$gte": since},
+	}
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count blogs by author: %w", err)
+	}
+	return count, nil
+}
+
 // GetBlogs retrieves a list of blog posts with filtering, sorting, and pagination options.
 func (r *BlogRepository) GetBlogs(ctx context.Context, filterOptions *contract.BlogFilterOptions) ([]*entity.Blog, int64, error) {
 	filter, sortStage := buildBlogFilterAndSort(filterOptions)
@@ -169,23 +355,25 @@ func (r *BlogRepository) GetBlogs(ctx context.Context, filterOptions *contract.B
 
 	// Add conditional stages for author details and search when necessary
 	// This makes GetBlogs more flexible, addressing a point from the review
-	if filterOptions.AuthorID != nil || strings.HasPrefix(sortStage.sortKey, "authorDetails.") {
-		pipeline = append(pipeline,
-			bson.D{{Key: "$lookup", Value: bson.M{
-				"from":         "users",
-				"localField":   "author_id",
-				"foreignField": "_id",
-				"as":           "authorDetails",
-			}}},
-			bson.D{{Key: "$unwind", Value: bson.M{
-				"path":                       "$authorDetails",
-				"preserveNullAndEmptyArrays": true,
-			}}},
-		)
-	}
-
-	// First, get the total count with a separate CountDocuments query
-	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	filterByUsername := filterOptions.AuthorUsername != nil && *filterOptions.AuthorUsername != ""
+	if filterOptions.AuthorID != nil || needsAuthorLookup(filterOptions, sortStage.sortKey) {
+		pipeline = append(pipeline, authorLookupStages()...)
+	}
+	if filterByUsername {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{
+			"authorDetails.username": *filterOptions.AuthorUsername,
+		}}})
+	}
+
+	// Get the total count; username filtering depends on the joined author,
+	// so it must be counted through the pipeline rather than CountDocuments.
+	var totalCount int64
+	var err error
+	if filterByUsername {
+		totalCount, err = r.countPipelineTotal(ctx, pipeline)
+	} else {
+		totalCount, err = r.collection.CountDocuments(ctx, filter)
+	}
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get total blog count: %w", err)
 	}
@@ -219,14 +407,30 @@ func (r *BlogRepository) UpdateBlog(ctx context.Context, blogID string, updates
 	update := bson.M{"$set": updates}
 	filter := bson.M{"_id": blogID, "is_deleted": false}
 
-	res, err := r.collection.UpdateOne(ctx, filter, update)
+	payload := map[string]string{"blog_id": blogID}
+	if slug, ok := updates["slug"].(string); ok {
+		payload["slug"] = slug
+	}
+
+	var res *mongo.UpdateResult
+	err := r.withOutbox(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = r.collection.UpdateOne(ctx, filter, update)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return errors.New("blog post not found")
+		}
+		return nil
+	}, entity.OutboxEventBlogUpdated, payload)
 	if err != nil {
+		if err.Error() == "blog post not found" {
+			return err
+		}
 		return fmt.Errorf("failed to update blog: %w", err)
 	}
 
-	if res.MatchedCount == 0 {
-		return errors.New("blog post not found")
-	}
 	if res.ModifiedCount == 0 {
 		return errors.New("blog post was not modified")
 	}
@@ -239,13 +443,26 @@ func (r *BlogRepository) DeleteBlog(ctx context.Context, blogID string) error {
 	update := bson.M{"$set": bson.M{"is_deleted": true, "updated_at": time.Now()}}
 	filter := bson.M{"_id": blogID, "is_deleted": false}
 
-	res, err := r.collection.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return fmt.Errorf("failed to delete blog: %w", err)
+	payload := map[string]string{"blog_id": blogID}
+	if blog, err := r.GetBlogByID(ctx, blogID); err == nil {
+		payload["slug"] = blog.Slug
 	}
 
-	if res.MatchedCount == 0 {
-		return errors.New("blog post not found")
+	err := r.withOutbox(ctx, func(ctx context.Context) error {
+		res, err := r.collection.UpdateOne(ctx, filter, update)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return errors.New("blog post not found")
+		}
+		return nil
+	}, entity.OutboxEventBlogDeleted, payload)
+	if err != nil {
+		if err.Error() == "blog post not found" {
+			return err
+		}
+		return fmt.Errorf("failed to delete blog: %w", err)
 	}
 
 	return nil
@@ -263,20 +480,24 @@ func (r *BlogRepository) SearchBlogs(ctx context.Context, query string, filterOp
 	}
 
 	// Apply conditional stages for author details
-	if strings.HasPrefix(sortStage.sortKey, "authorDetails.") {
-		pipeline = append(pipeline,
-			bson.D{{Key: "$lookup", Value: bson.M{
-				"from":         "users",
-				"localField":   "author_id",
-				"foreignField": "_id",
-				"as":           "authorDetails",
-			}}},
-			bson.D{{Key: "$unwind", Value: "$authorDetails"}},
-		)
+	filterByUsername := filterOptions.AuthorUsername != nil && *filterOptions.AuthorUsername != ""
+	if needsAuthorLookup(filterOptions, sortStage.sortKey) {
+		pipeline = append(pipeline, authorLookupStages()...)
+	}
+	if filterByUsername {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{
+			"authorDetails.username": *filterOptions.AuthorUsername,
+		}}})
 	}
 
-	// First, get the total count for all matching documents
-	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	// Get the total count for all matching documents
+	var totalCount int64
+	var err error
+	if filterByUsername {
+		totalCount, err = r.countPipelineTotal(ctx, pipeline)
+	} else {
+		totalCount, err = r.collection.CountDocuments(ctx, filter)
+	}
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get total search count: %w", err)
 	}
@@ -304,6 +525,127 @@ func (r *BlogRepository) SearchBlogs(ctx context.Context, query string, filterOp
 	return blogs, totalCount, nil
 }
 
+// SuggestBlogTitles returns published blogs whose title starts with the given
+// prefix, most popular first. The regex is anchored so the query can use the
+// titles index, and only the fields the type-ahead needs are projected.
+func (r *BlogRepository) SuggestBlogTitles(ctx context.Context, prefix string, limit int) ([]*entity.BlogSuggestion, error) {
+	pattern := "^" + regexp.QuoteMeta(prefix)
+	filter := bson.M{
+		"is_deleted":    false,
+		"author_hidden": bson.M{"$ne": true},
+		"status":        entity.BlogStatusPublished,
+		"title":         bson.M{"$regex": pattern, "$options": "i"},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "popularity", Value: -1}, {Key: "title", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"title": 1, "slug": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest blog titles: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var suggestions []*entity.BlogSuggestion
+	if err := cursor.All(ctx, &suggestions); err != nil {
+		return nil, fmt.Errorf("failed to decode blog title suggestions: %w", err)
+	}
+	return suggestions, nil
+}
+
+// archivePublicationDate is the date the archive groups and filters by: the
+// publication time, falling back to the creation time for posts published
+// before published_at was recorded.
+var archivePublicationDate = bson.M{"$ifNull": bson.A{"$published_at", "$created_at"}}
+
+// GetArchiveCounts returns published-post counts grouped by publication year
+// and month, newest first.
+func (r *BlogRepository) GetArchiveCounts(ctx context.Context, orgID *string) ([]entity.ArchivePeriod, error) {
+	match := bson.M{
+		"is_deleted":    false,
+		"author_hidden": bson.M{"$ne": true},
+		"status":        entity.BlogStatusPublished,
+	}
+	if orgID != nil && *orgID != "" {
+		match["org_id"] = *orgID
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"year":  bson.M{"$year": archivePublicationDate},
+				"month": bson.M{"$month": archivePublicationDate},
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+		bson.D{{Key: "$project", Value: bson.M{
+			"_id":   0,
+			"year":  "$_id.year",
+			"month": "$_id.month",
+			"count": 1,
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "year", Value: -1}, {Key: "month", Value: -1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate archive counts: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var periods []entity.ArchivePeriod
+	if err := cursor.All(ctx, &periods); err != nil {
+		return nil, fmt.Errorf("failed to decode archive counts: %w", err)
+	}
+	return periods, nil
+}
+
+// GetBlogsByArchivePeriod pages over the posts published in the given year
+// and month, newest first.
+func (r *BlogRepository) GetBlogsByArchivePeriod(ctx context.Context, year, month int, orgID *string, page, pageSize int) ([]*entity.Blog, int64, error) {
+	match := bson.M{
+		"is_deleted":    false,
+		"author_hidden": bson.M{"$ne": true},
+		"status":        entity.BlogStatusPublished,
+		"$expr": bson.M{"$and": bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$year": archivePublicationDate}, year}},
+			bson.M{"$eq": bson.A{bson.M{"$month": archivePublicationDate}, month}},
+		}},
+	}
+	if orgID != nil && *orgID != "" {
+		match["org_id"] = *orgID
+	}
+
+	matchStage := mongo.Pipeline{bson.D{{Key: "$match", Value: match}}}
+	totalCount, err := r.countPipelineTotal(ctx, matchStage)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count archive period blogs: %w", err)
+	}
+
+	pipeline := append(mongo.Pipeline{}, matchStage...)
+	pipeline = append(pipeline,
+		bson.D{{Key: "$addFields", Value: bson.M{"archive_date": archivePublicationDate}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "archive_date", Value: -1}}}},
+		bson.D{{Key: "$skip", Value: int64((page - 1) * pageSize)}},
+		bson.D{{Key: "$limit", Value: int64(pageSize)}},
+	)
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve archive period blogs: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var blogs []*entity.Blog
+	if err := cursor.All(ctx, &blogs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode archive period blogs: %w", err)
+	}
+	return blogs, totalCount, nil
+}
+
 // IncrementViewCount increments the view count of a specific blog post.
 func (r *BlogRepository) IncrementViewCount(ctx context.Context, blogID string) error {
 	filter := bson.M{"_id": blogID, "is_deleted": false}
@@ -438,6 +780,101 @@ func (r *BlogRepository) GetBlogCounts(ctx context.Context, blogID string) (view
 	return blog.ViewCount, blog.LikeCount, blog.DislikeCount, blog.CommentCount, nil
 }
 
+// GetBlogsByIDs retrieves blogs by ID, preserving the input order so callers
+// paging through a precomputed list keep its ranking.
+func (r *BlogRepository) GetBlogsByIDs(ctx context.Context, blogIDs []string) ([]*entity.Blog, error) {
+	if len(blogIDs) == 0 {
+		return []*entity.Blog{}, nil
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": blogIDs}, "is_deleted": false}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blogs by ids: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	byID := make(map[string]*entity.Blog, len(blogIDs))
+	for cursor.Next(ctx) {
+		var blog entity.Blog
+		if err := cursor.Decode(&blog); err != nil {
+			return nil, err
+		}
+		byID[blog.ID] = &blog
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	blogs := make([]*entity.Blog, 0, len(blogIDs))
+	for _, id := range blogIDs {
+		if blog, ok := byID[id]; ok {
+			blogs = append(blogs, blog)
+		}
+	}
+	return blogs, nil
+}
+
+// ListBlogsByAuthorID returns the author's non-deleted blogs.
+func (r *BlogRepository) ListBlogsByAuthorID(ctx context.Context, authorID string) ([]*entity.Blog, error) {
+	filter := bson.M{"author_id": authorID, "is_deleted": false}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blogs by author: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var blogs []*entity.Blog
+	if err := cursor.All(ctx, &blogs); err != nil {
+		return nil, fmt.Errorf("failed to decode blogs: %w", err)
+	}
+	return blogs, nil
+}
+
+// SetAuthorHidden hides or unhides all of the author's blogs in list
+// queries. Direct lookups by ID or slug are unaffected.
+func (r *BlogRepository) SetAuthorHidden(ctx context.Context, authorID string, hidden bool) error {
+	update := bson.M{"$set": bson.M{"author_hidden": hidden}}
+	if !hidden {
+		update = bson.M{"$unset": bson.M{"author_hidden": ""}}
+	}
+	_, err := r.collection.UpdateMany(ctx, bson.M{"author_id": authorID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update author visibility: %w", err)
+	}
+	return nil
+}
+
+// ListBlogIDs pages over the IDs of all non-deleted blogs in creation order.
+func (r *BlogRepository) ListBlogIDs(ctx context.Context, offset, limit int) ([]string, error) {
+	filter := bson.M{"is_deleted": false}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetSort(bson.M{"created_at": 1}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blog ids: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var ids []string
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID string `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		ids = append(ids, doc.ID)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // AddTagsToBlog associates one or more tags with a blog post.
 func (r *BlogRepository) AddTagsToBlog(ctx context.Context, blogID string, tagIDs []string) error {
 	if len(tagIDs) == 0 {
@@ -517,11 +954,63 @@ func (r *BlogRepository) GetBlogsByTagIDs(ctx context.Context, tagIDs []string,
 		TagIDs:    tagIDs,
 		SortBy:    "created_at", // Default sort order
 		SortOrder: "desc",
+		// This method only serves the followed-tags home feed, which is
+		// general-audience like the public listing.
+		ExcludeMature: true,
 	}
 
 	return r.GetBlogs(ctx, filterOpts)
 }
 
+// ReassignTag replaces one tag with another on every blog that carries it and
+// returns the number of blogs touched. MongoDB rejects $addToSet and $pull on
+// the same field in a single update, so this runs as two passes: first the
+// target tag is added to every blog carrying the source tag, then the source
+// tag is pulled.
+func (r *BlogRepository) ReassignTag(ctx context.Context, fromTagID, toTagID string) (int64, error) {
+	filter := bson.M{"tags": fromTagID}
+
+	_, err := r.collection.UpdateMany(ctx, filter, bson.M{"$addToSet": bson.M{"tags": toTagID}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to add target tag to blogs: %w", err)
+	}
+
+	res, err := r.collection.UpdateMany(ctx, filter, bson.M{"$pull": bson.M{"tags": fromTagID}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove source tag from blogs: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// AddReviewNote inserts an editorial review note for a blog.
+func (r *BlogRepository) AddReviewNote(ctx context.Context, note *entity.ReviewNote) error {
+	if note.CreatedAt.IsZero() {
+		note.CreatedAt = time.Now()
+	}
+	_, err := r.reviewNotesCollection.InsertOne(ctx, note)
+	if err != nil {
+		return fmt.Errorf("failed to add review note: %w", err)
+	}
+	return nil
+}
+
+// GetReviewNotes retrieves the review notes for a blog, newest first.
+func (r *BlogRepository) GetReviewNotes(ctx context.Context, blogID string) ([]*entity.ReviewNote, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.reviewNotesCollection.Find(ctx, bson.M{"blog_id": blogID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve review notes: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var notes []*entity.ReviewNote
+	if err = cursor.All(ctx, &notes); err != nil {
+		return nil, fmt.Errorf("failed to decode review notes: %w", err)
+	}
+	return notes, nil
+}
+
 // HasViewedRecently checks if a user (by user ID or IP address) has viewed a blog within the last 24 hours.
 func (r *BlogRepository) HasViewedRecently(ctx context.Context, blogID, userID, ipAddress string) (bool, error) {
 	filter := bson.M{
@@ -541,14 +1030,10 @@ func (r *BlogRepository) HasViewedRecently(ctx context.Context, blogID, userID,
 	return count > 0, nil
 }
 
-// RecordView records a user's view of a blog, including IP address and user agent.
-func (r *BlogRepository) RecordView(ctx context.Context, blogID, userID, ipAddress, userAgent string) error {
-	view := entity.BlogView{
-		BlogID:    blogID,
-		UserID:    userID,
-		IPAddress: ipAddress,
-		UserAgent: userAgent,
-		ViewedAt:  time.Now(),
+// RecordView records a user's view of a blog, including IP address, user agent and geo data.
+func (r *BlogRepository) RecordView(ctx context.Context, view *entity.BlogView) error {
+	if view.ViewedAt.IsZero() {
+		view.ViewedAt = time.Now()
 	}
 	_, err := r.blogViewsCollection.InsertOne(ctx, view)
 	if err != nil {
@@ -557,6 +1042,195 @@ func (r *BlogRepository) RecordView(ctx context.Context, blogID, userID, ipAddre
 	return nil
 }
 
+// GetViewGeoBreakdown aggregates the recorded views of a blog by country, most viewed first.
+// Views without geo data are grouped under an empty country.
+func (r *BlogRepository) GetViewGeoBreakdown(ctx context.Context, blogID string) ([]entity.ViewGeoStat, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"blog_id": blogID}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"$ifNull": []interface{}{"$country", ""}},
+			"views": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.M{"views": -1}}},
+	}
+
+	cursor, err := r.blogViewsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate view geo breakdown: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var stats []entity.ViewGeoStat
+	if err = cursor.All(ctx, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode view geo breakdown: %w", err)
+	}
+
+	return stats, nil
+}
+
+// CountViewsSince aggregates the recorded views per blog since the given
+// time.
+func (r *BlogRepository) CountViewsSince(ctx context.Context, blogIDs []string, since time.Time) (map[string]int, error) {
+	if len(blogIDs) == 0 {
+		return map[string]int{}, nil
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"blog_id":   bson.M{"$in": blogIDs},
+			"viewed_at": bson.M{"$gte": since},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$blog_id",
+			"views": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.blogViewsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate view counts: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		BlogID string `bson:"_id"`
+		Views  int    `bson:"views"`
+	}
+	if err = cursor.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode view counts: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.BlogID] = row.Views
+	}
+	return counts, nil
+}
+
+// GetTopAuthors aggregates published-blog engagement per author, ranked by
+// views then likes. A zero since covers all time.
+func (r *BlogRepository) GetTopAuthors(ctx context.Context, since time.Time, limit int) ([]entity.AuthorStat, error) {
+	match := bson.M{"is_deleted": false, "author_hidden": bson.M{"$ne": true}, "status": entity.BlogStatusPublished}
+	if !since.IsZero() {
+		match["created_at"] = bson.M{"$gte": since}
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        "$author_id",
+			"views":      bson.M{"$sum": "$view_count"},
+			"likes":      bson.M{"$sum": "$like_count"},
+			"blog_count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "views", Value: -1}, {Key: "likes", Value: -1}}}},
+		{{Key: "$limit", Value: int64(limit)}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate top authors: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var stats []entity.AuthorStat
+	if err = cursor.All(ctx, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode top authors: %w", err)
+	}
+
+	return stats, nil
+}
+
+// CreatePendingView stores a view awaiting its dwell-time heartbeat. A TTL
+// index on the collection sweeps pending views that never got confirmed.
+func (r *BlogRepository) CreatePendingView(ctx context.Context, view *entity.BlogView) error {
+	if view.ViewedAt.IsZero() {
+		view.ViewedAt = time.Now()
+	}
+	_, err := r.pendingViewsCollection.InsertOne(ctx, view)
+	if err != nil {
+		return fmt.Errorf("failed to create pending view: %w", err)
+	}
+	return nil
+}
+
+// ConsumePendingView atomically removes and returns the viewer's pending
+// view on the blog, so a heartbeat can only ever confirm one view.
+func (r *BlogRepository) ConsumePendingView(ctx context.Context, blogID, userID, ipAddress string) (*entity.BlogView, error) {
+	filter := bson.M{
+		"blog_id": blogID,
+		"$or": []bson.M{
+			{"ip_address": ipAddress},
+		},
+	}
+	if userID != "" {
+		filter["$or"] = append(filter["$or"].([]bson.M), bson.M{"user_id": userID})
+	}
+
+	var view entity.BlogView
+	err := r.pendingViewsCollection.FindOneAndDelete(ctx, filter).Decode(&view)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no pending view")
+		}
+		return nil, fmt.Errorf("failed to consume pending view: %w", err)
+	}
+	return &view, nil
+}
+
+// RecordViewAnomaly bumps the blog's persisted anomaly score by the signal's
+// weight and tallies which signal fired, upserting the record on first use.
+func (r *BlogRepository) RecordViewAnomaly(ctx context.Context, blogID, signal string, weight int) error {
+	update := bson.M{
+		"$inc": bson.M{"score": weight, "signals." + signal: 1},
+		"$set": bson.M{"last_signal_at": time.Now()},
+	}
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.viewAnomaliesCollection.UpdateOne(ctx, bson.M{"_id": blogID}, update, opts); err != nil {
+		return fmt.Errorf("failed to record view anomaly: %w", err)
+	}
+	return nil
+}
+
+// ListViewAnomalies returns the blogs with the highest anomaly scores.
+func (r *BlogRepository) ListViewAnomalies(ctx context.Context, limit int) ([]entity.ViewAnomaly, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "score", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.viewAnomaliesCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list view anomalies: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var anomalies []entity.ViewAnomaly
+	if err := cursor.All(ctx, &anomalies); err != nil {
+		return nil, fmt.Errorf("failed to decode view anomalies: %w", err)
+	}
+	return anomalies, nil
+}
+
+// RollbackViews subtracts count suspicious views from the blog's view
+// counter, clamped so the counter never goes negative, and clears the
+// blog's anomaly record.
+func (r *BlogRepository) RollbackViews(ctx context.Context, blogID string, count int) error {
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"view_count": bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{"$view_count", count}}}},
+		}}},
+	}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": blogID, "is_deleted": false}, update)
+	if err != nil {
+		return fmt.Errorf("failed to roll back views: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("blog post not found or already deleted")
+	}
+
+	if _, err := r.viewAnomaliesCollection.DeleteOne(ctx, bson.M{"_id": blogID}); err != nil {
+		return fmt.Errorf("failed to clear view anomaly record: %w", err)
+	}
+	return nil
+}
+
 // GetRecentViewsByIP retrieves recent views from a specific IP address.
 func (r *BlogRepository) GetRecentViewsByIP(ctx context.Context, ipAddress string, since time.Time) ([]entity.BlogView, error) {
 	filter := bson.M{
@@ -0,0 +1,76 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestBuildBlogFilterAndSort_AuthorNameSort(t *testing.T) {
+	opts := &contract.BlogFilterOptions{SortBy: "username", SortOrder: "asc"}
+
+	filter, sort := buildBlogFilterAndSort(opts)
+
+	if sort.sortKey != "authorDetails.username" {
+		t.Fatalf("expected sort key authorDetails.username, got %q", sort.sortKey)
+	}
+	if order := sort.sortOrder["authorDetails.username"]; order != 1 {
+		t.Fatalf("expected ascending sort order, got %v", order)
+	}
+	if !needsAuthorLookup(opts, sort.sortKey) {
+		t.Fatal("author-name sorting should require the users lookup")
+	}
+	if filter["is_deleted"] != false {
+		t.Fatal("filter should exclude deleted blogs")
+	}
+}
+
+func TestBuildBlogFilterAndSort_UnknownSortFallsBack(t *testing.T) {
+	_, sort := buildBlogFilterAndSort(&contract.BlogFilterOptions{SortBy: "bogus"})
+
+	if sort.sortKey != "created_at" {
+		t.Fatalf("expected fallback sort key created_at, got %q", sort.sortKey)
+	}
+}
+
+func TestNeedsAuthorLookup_UsernameFilter(t *testing.T) {
+	username := "testuser"
+	opts := &contract.BlogFilterOptions{AuthorUsername: &username}
+
+	if !needsAuthorLookup(opts, "created_at") {
+		t.Fatal("username filtering should require the users lookup")
+	}
+
+	empty := ""
+	opts = &contract.BlogFilterOptions{AuthorUsername: &empty}
+	if needsAuthorLookup(opts, "created_at") {
+		t.Fatal("empty username should not require the users lookup")
+	}
+}
+
+func TestAuthorLookupStages_JoinsAsStrings(t *testing.T) {
+	stages := authorLookupStages()
+	if len(stages) != 2 {
+		t.Fatalf("expected lookup and unwind stages, got %d stages", len(stages))
+	}
+
+	lookup, ok := stages[0][0].Value.(bson.M)
+	if !ok || stages[0][0].Key != "$lookup" {
+		t.Fatal("first stage should be a $lookup")
+	}
+	if lookup["from"] != "users" {
+		t.Fatalf("lookup should join the users collection, got %v", lookup["from"])
+	}
+	if _, hasPipeline := lookup["pipeline"]; !hasPipeline {
+		t.Fatal("lookup should use a pipeline join so both ID types are coerced to strings")
+	}
+
+	unwind, ok := stages[1][0].Value.(bson.M)
+	if !ok || stages[1][0].Key != "$unwind" {
+		t.Fatal("second stage should be an $unwind")
+	}
+	if unwind["preserveNullAndEmptyArrays"] != true {
+		t.Fatal("unwind should preserve blogs whose author record is missing")
+	}
+}
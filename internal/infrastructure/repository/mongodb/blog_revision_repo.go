@@ -0,0 +1,86 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BlogRevisionRepository is the MongoDB implementation of the
+// IBlogRevisionRepository interface.
+type BlogRevisionRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IBlogRevisionRepository = (*BlogRevisionRepository)(nil)
+
+// NewBlogRevisionRepository creates and returns a new BlogRevisionRepository
+// instance.
+func NewBlogRevisionRepository(db *mongo.Database) *BlogRevisionRepository {
+	return &BlogRevisionRepository{
+		collection: db.Collection("blog_revisions"),
+	}
+}
+
+// CreateRevision stores a snapshot, assigning the next revision number for
+// the blog.
+func (r *BlogRevisionRepository) CreateRevision(ctx context.Context, revision *entity.BlogRevision) error {
+	var latest entity.BlogRevision
+	opts := options.FindOne().SetSort(bson.D{{Key: "number", Value: -1}})
+	err := r.collection.FindOne(ctx, bson.M{"blog_id": revision.BlogID}, opts).Decode(&latest)
+	switch {
+	case err == nil:
+		revision.Number = latest.Number + 1
+	case errors.Is(err, mongo.ErrNoDocuments):
+		revision.Number = 1
+	default:
+		return fmt.Errorf("failed to determine next revision number: %w", err)
+	}
+
+	if revision.CreatedAt.IsZero() {
+		revision.CreatedAt = time.Now()
+	}
+	if _, err := r.collection.InsertOne(ctx, revision); err != nil {
+		return fmt.Errorf("failed to create blog revision: %w", err)
+	}
+	return nil
+}
+
+// ListRevisions returns a blog's revision metadata, newest first, with
+// Content omitted to keep the listing light.
+func (r *BlogRevisionRepository) ListRevisions(ctx context.Context, blogID string) ([]*entity.BlogRevision, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "number", Value: -1}}).
+		SetProjection(bson.M{"content": 0})
+	cursor, err := r.collection.Find(ctx, bson.M{"blog_id": blogID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blog revisions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var revisions []*entity.BlogRevision
+	if err := cursor.All(ctx, &revisions); err != nil {
+		return nil, fmt.Errorf("failed to decode blog revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetRevision retrieves one revision of a blog by its number.
+func (r *BlogRevisionRepository) GetRevision(ctx context.Context, blogID string, number int) (*entity.BlogRevision, error) {
+	var revision entity.BlogRevision
+	err := r.collection.FindOne(ctx, bson.M{"blog_id": blogID, "number": number}).Decode(&revision)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("revision %d not found", number)
+		}
+		return nil, fmt.Errorf("failed to retrieve revision %d: %w", number, err)
+	}
+	return &revision, nil
+}
@@ -23,6 +23,8 @@ var (
 	ErrInvalidParentTarget = errors.New("invalid parent/target relationship")
 	ErrCommentAlreadyLiked = errors.New("comment already liked by user")
 	ErrCommentNotLiked     = errors.New("comment not liked by user")
+	ErrAlreadyReacted      = errors.New("user already reacted with this emoji")
+	ErrNotReacted          = errors.New("user has not reacted with this emoji")
 )
 
 type CommentRepository struct {
@@ -126,16 +128,26 @@ func (r *CommentRepository) Delete(ctx context.Context, id string) error {
 }
 
 // Listing Operations
-func (r *CommentRepository) GetTopLevelComments(ctx context.Context, blogID string, pagination contract.Pagination) (comments []*entity.Comment, total int64, err error) {
+func (r *CommentRepository) GetTopLevelComments(ctx context.Context, blogID string, viewerID *string, pagination contract.Pagination) (comments []*entity.Comment, total int64, err error) {
 	if pagination.Page < 1 || pagination.PageSize < 1 {
 		return nil, 0, ErrInvalidPagination
 	}
 
+	// Approved comments are visible to everyone. A viewer also sees their own
+	// hidden comments so shadow-banned users don't notice anything is wrong.
+	statusFilter := []bson.M{
+		{"status": bson.M{"$in": []string{"approved"}}},
+	}
+	if viewerID != nil && *viewerID != "" {
+		statusFilter = append(statusFilter, bson.M{"author_id": *viewerID})
+	}
+
 	filter := bson.M{
-		"blog_id":    blogID,
-		"parent_id":  nil,
-		"is_deleted": false,
-		"status":     bson.M{"$in": []string{"approved"}},
+		"blog_id":       blogID,
+		"parent_id":     nil,
+		"is_deleted":    false,
+		"author_hidden": bson.M{"$ne": true},
+		"$or":           statusFilter,
 	}
 
 	// Get total count
@@ -164,7 +176,7 @@ func (r *CommentRepository) GetTopLevelComments(ctx context.Context, blogID stri
 	return comments, total, nil
 }
 
-func (r *CommentRepository) GetCommentThread(ctx context.Context, parentID string) (*entity.CommentThread, error) {
+func (r *CommentRepository) GetCommentThread(ctx context.Context, parentID string, viewerID *string) (*entity.CommentThread, error) {
 	// Get the parent comment
 	parentComment, err := r.GetByID(ctx, parentID)
 	if err != nil {
@@ -183,7 +195,7 @@ func (r *CommentRepository) GetCommentThread(ctx context.Context, parentID strin
 	}
 
 	// Get all replies for this thread
-	replies, err := r.getRepliesRecursively(ctx, parentID, 1)
+	replies, err := r.getRepliesRecursively(ctx, parentID, viewerID, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -198,8 +210,9 @@ func (r *CommentRepository) GetCommentsByUser(ctx context.Context, userID string
 	}
 
 	filter := bson.M{
-		"author_id":  userID,
-		"is_deleted": false,
+		"author_id":     userID,
+		"is_deleted":    false,
+		"author_hidden": bson.M{"$ne": true},
 	}
 
 	total, err := r.collection.CountDocuments(ctx, filter)
@@ -227,6 +240,35 @@ func (r *CommentRepository) GetCommentsByUser(ctx context.Context, userID string
 	return comments, total, nil
 }
 
+// ListApprovedByBlogIDsSince returns the approved comments created on any of
+// the blogs after the cutoff, oldest first (digest building).
+func (r *CommentRepository) ListApprovedByBlogIDsSince(ctx context.Context, blogIDs []string, since time.Time) ([]*entity.Comment, error) {
+	if len(blogIDs) == 0 {
+		return nil, nil
+	}
+
+	filter := bson.M{
+		"blog_id":       bson.M{"$in": blogIDs},
+		"status":        "approved",
+		"is_deleted":    false,
+		"author_hidden": bson.M{"$ne": true},
+		"created_at":    bson.M{"$gte": since},
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comments for digest: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var comments []*entity.Comment
+	if err := cursor.All(ctx, &comments); err != nil {
+		return nil, fmt.Errorf("failed to decode digest comments: %w", err)
+	}
+	return comments, nil
+}
+
 // Status and Moderation
 func (r *CommentRepository) UpdateStatus(ctx context.Context, id string, status string) error {
 	filter := bson.M{"_id": id, "is_deleted": false}
@@ -249,11 +291,46 @@ func (r *CommentRepository) UpdateStatus(ctx context.Context, id string, status
 	return nil
 }
 
+func (r *CommentRepository) BulkUpdateStatus(ctx context.Context, commentIDs []string, status string) (int64, error) {
+	filter := bson.M{"_id": bson.M{"$in": commentIDs}, "is_deleted": false}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     status,
+			"updated_at": time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed to bulk update comment status: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
+func (r *CommentRepository) BulkDelete(ctx context.Context, commentIDs []string) (int64, error) {
+	filter := bson.M{"_id": bson.M{"$in": commentIDs}, "is_deleted": false}
+	update := bson.M{
+		"$set": bson.M{
+			"is_deleted": true,
+			"updated_at": time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed to bulk delete comments: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
 func (r *CommentRepository) GetCommentCount(ctx context.Context, blogID string) (int64, error) {
 	filter := bson.M{
-		"blog_id":    blogID,
-		"is_deleted": false,
-		"status":     "approved",
+		"blog_id":       blogID,
+		"is_deleted":    false,
+		"status":        "approved",
+		"author_hidden": bson.M{"$ne": true},
 	}
 
 	count, err := r.collection.CountDocuments(ctx, filter)
@@ -264,6 +341,33 @@ func (r *CommentRepository) GetCommentCount(ctx context.Context, blogID string)
 	return count, nil
 }
 
+// User Visibility Cascade
+
+// SetAuthorHidden hides or unhides all of the author's comments in listings,
+// used when the author is deactivated or reactivated.
+func (r *CommentRepository) SetAuthorHidden(ctx context.Context, authorID string, hidden bool) error {
+	update := bson.M{"$set": bson.M{"author_hidden": hidden}}
+	if !hidden {
+		update = bson.M{"$unset": bson.M{"author_hidden": ""}}
+	}
+	_, err := r.collection.UpdateMany(ctx, bson.M{"author_id": authorID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update author visibility: %w", err)
+	}
+	return nil
+}
+
+// AnonymizeByAuthor replaces the denormalized author name on all of the
+// author's comments with the placeholder after their account is deleted.
+func (r *CommentRepository) AnonymizeByAuthor(ctx context.Context, authorID, placeholder string) error {
+	update := bson.M{"$set": bson.M{"author_name": placeholder}}
+	_, err := r.collection.UpdateMany(ctx, bson.M{"author_id": authorID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to anonymize comments: %w", err)
+	}
+	return nil
+}
+
 // Like System
 func (r *CommentRepository) LikeComment(ctx context.Context, commentID string, userID string) error {
 	// Check if already liked
@@ -366,6 +470,107 @@ func (r *CommentRepository) GetCommentLikeCount(ctx context.Context, commentID s
 	return count, nil
 }
 
+// Reaction System. Reactions live in the comment_likes collection with a
+// "reaction" field; plain like records have no such field, so the filters
+// below never mix the two.
+func (r *CommentRepository) ReactToComment(ctx context.Context, commentID, userID, reaction string) error {
+	filter := bson.M{"comment_id": commentID, "user_id": userID, "reaction": reaction}
+	count, err := r.likeCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to check reaction status: %w", err)
+	}
+	if count > 0 {
+		return ErrAlreadyReacted
+	}
+
+	record := &entity.CommentLike{
+		ID:        uuidgen.NewGenerator().NewUUID(),
+		CommentID: commentID,
+		UserID:    userID,
+		Reaction:  reaction,
+		CreatedAt: time.Now(),
+	}
+
+	if _, err := r.likeCollection.InsertOne(ctx, record); err != nil {
+		return fmt.Errorf("failed to react to comment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) RemoveCommentReaction(ctx context.Context, commentID, userID, reaction string) error {
+	filter := bson.M{"comment_id": commentID, "user_id": userID, "reaction": reaction}
+	result, err := r.likeCollection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotReacted
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) GetCommentReactionCounts(ctx context.Context, commentID string) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"comment_id": commentID,
+			"reaction":   bson.M{"$exists": true, "$ne": ""},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$reaction",
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.likeCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate reaction counts: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Reaction string `bson:"_id"`
+		Count    int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode reaction counts: %w", err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Reaction] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *CommentRepository) GetUserCommentReactions(ctx context.Context, commentID, userID string) ([]string, error) {
+	filter := bson.M{
+		"comment_id": commentID,
+		"user_id":    userID,
+		"reaction":   bson.M{"$exists": true, "$ne": ""},
+	}
+
+	cursor, err := r.likeCollection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user reactions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []*entity.CommentLike
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode user reactions: %w", err)
+	}
+
+	reactions := make([]string, 0, len(records))
+	for _, record := range records {
+		reactions = append(reactions, record.Reaction)
+	}
+
+	return reactions, nil
+}
+
 // Reporting System
 func (r *CommentRepository) ReportComment(ctx context.Context, report *entity.CommentReport) error {
 	if report.ID == "" {
@@ -382,6 +587,19 @@ func (r *CommentRepository) ReportComment(ctx context.Context, report *entity.Co
 	return nil
 }
 
+func (r *CommentRepository) GetReportByID(ctx context.Context, reportID string) (*entity.CommentReport, error) {
+	var report entity.CommentReport
+	err := r.reportCollection.FindOne(ctx, bson.M{"_id": reportID}).Decode(&report)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("report not found")
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return &report, nil
+}
+
 func (r *CommentRepository) GetCommentReports(ctx context.Context, pagination contract.Pagination) ([]*entity.CommentReport, int64, error) {
 	if pagination.Page < 1 || pagination.PageSize < 1 {
 		return nil, 0, ErrInvalidPagination
@@ -474,15 +692,25 @@ func (r *CommentRepository) validateParentTargetLogic(ctx context.Context, comme
 	return nil
 }
 
-func (r *CommentRepository) getRepliesRecursively(ctx context.Context, parentID string, depth int) ([]*entity.CommentThread, error) {
+func (r *CommentRepository) getRepliesRecursively(ctx context.Context, parentID string, viewerID *string, depth int) ([]*entity.CommentThread, error) {
 	if depth > contract.MaxCommentDepth { // Prevent excessive nesting
 		return []*entity.CommentThread{}, nil
 	}
 
+	// Approved replies are visible to everyone. A viewer also sees their own
+	// hidden replies so shadow-banned users don't notice anything is wrong.
+	statusFilter := []bson.M{
+		{"status": bson.M{"$in": []string{"approved"}}},
+	}
+	if viewerID != nil && *viewerID != "" {
+		statusFilter = append(statusFilter, bson.M{"author_id": *viewerID})
+	}
+
 	filter := bson.M{
-		"parent_id":  parentID,
-		"is_deleted": false,
-		"status":     "approved",
+		"parent_id":     parentID,
+		"is_deleted":    false,
+		"author_hidden": bson.M{"$ne": true},
+		"$or":           statusFilter,
 	}
 
 	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
@@ -505,7 +733,7 @@ func (r *CommentRepository) getRepliesRecursively(ctx context.Context, parentID
 		}
 
 		// Get nested replies
-		nestedReplies, err := r.getRepliesRecursively(ctx, reply.ID, depth+1)
+		nestedReplies, err := r.getRepliesRecursively(ctx, reply.ID, viewerID, depth+1)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DeviceRepository is the MongoDB implementation of IDeviceRepository.
+type DeviceRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IDeviceRepository = (*DeviceRepository)(nil)
+
+func NewDeviceRepository(db *mongo.Database) *DeviceRepository {
+	return &DeviceRepository{
+		collection: db.Collection("devices"),
+	}
+}
+
+func (r *DeviceRepository) RegisterDevice(ctx context.Context, device *entity.Device) error {
+	filter := bson.M{"token": device.Token}
+	update := bson.M{
+		"$set": bson.M{
+			"user_id":  device.UserID,
+			"platform": device.Platform,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        uuid.New().String(),
+			"created_at": time.Now(),
+		},
+	}
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to register device: %w", err)
+	}
+	return nil
+}
+
+func (r *DeviceRepository) ListDevicesByUserID(ctx context.Context, userID string) ([]*entity.Device, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var devices []*entity.Device
+	if err := cursor.All(ctx, &devices); err != nil {
+		return nil, fmt.Errorf("failed to decode devices: %w", err)
+	}
+	return devices, nil
+}
+
+func (r *DeviceRepository) DeleteDeviceByToken(ctx context.Context, userID, token string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"user_id": userID, "token": token})
+	if err != nil {
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("device not found")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DraftCommentRepository is the MongoDB implementation of the
+// IDraftCommentRepository interface.
+type DraftCommentRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IDraftCommentRepository = (*DraftCommentRepository)(nil)
+
+// NewDraftCommentRepository creates and returns a new DraftCommentRepository
+// instance.
+func NewDraftCommentRepository(db *mongo.Database) *DraftCommentRepository {
+	return &DraftCommentRepository{
+		collection: db.Collection("draft_comments"),
+	}
+}
+
+// CreateComment inserts a new draft comment.
+func (r *DraftCommentRepository) CreateComment(ctx context.Context, comment *entity.DraftComment) error {
+	if comment.CreatedAt.IsZero() {
+		comment.CreatedAt = time.Now()
+	}
+	if _, err := r.collection.InsertOne(ctx, comment); err != nil {
+		return fmt.Errorf("failed to create draft comment: %w", err)
+	}
+	return nil
+}
+
+// ListComments returns a blog's draft comments, oldest first.
+func (r *DraftCommentRepository) ListComments(ctx context.Context, blogID string) ([]*entity.DraftComment, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"blog_id": blogID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft comments: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var comments []*entity.DraftComment
+	if err := cursor.All(ctx, &comments); err != nil {
+		return nil, fmt.Errorf("failed to decode draft comments: %w", err)
+	}
+	return comments, nil
+}
+
+// GetCommentByID retrieves a draft comment by its ID.
+func (r *DraftCommentRepository) GetCommentByID(ctx context.Context, commentID string) (*entity.DraftComment, error) {
+	var comment entity.DraftComment
+	err := r.collection.FindOne(ctx, bson.M{"_id": commentID}).Decode(&comment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("draft comment %s not found", commentID)
+		}
+		return nil, fmt.Errorf("failed to retrieve draft comment %s: %w", commentID, err)
+	}
+	return &comment, nil
+}
+
+// SetResolved updates a comment's resolve state, recording who resolved it;
+// unresolving clears the resolver.
+func (r *DraftCommentRepository) SetResolved(ctx context.Context, commentID string, resolved bool, resolverID string) error {
+	update := bson.M{"resolved": resolved}
+	if resolved {
+		update["resolved_by"] = resolverID
+		update["resolved_at"] = time.Now()
+	}
+	ops := bson.M{"$set": update}
+	if !resolved {
+		ops["$unset"] = bson.M{"resolved_by": "", "resolved_at": ""}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": commentID}, ops)
+	if err != nil {
+		return fmt.Errorf("failed to update draft comment %s: %w", commentID, err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("draft comment %s not found", commentID)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// EmailReplyTokenRepository is the MongoDB implementation of
+// IEmailReplyTokenRepository.
+type EmailReplyTokenRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IEmailReplyTokenRepository = (*EmailReplyTokenRepository)(nil)
+
+func NewEmailReplyTokenRepository(db *mongo.Database) *EmailReplyTokenRepository {
+	return &EmailReplyTokenRepository{
+		collection: db.Collection("email_reply_tokens"),
+	}
+}
+
+func (r *EmailReplyTokenRepository) CreateReplyToken(ctx context.Context, token *entity.EmailReplyToken) error {
+	_, err := r.collection.InsertOne(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to create reply token: %w", err)
+	}
+	return nil
+}
+
+func (r *EmailReplyTokenRepository) GetReplyToken(ctx context.Context, token string) (*entity.EmailReplyToken, error) {
+	var replyToken entity.EmailReplyToken
+	err := r.collection.FindOne(ctx, bson.M{"_id": token}).Decode(&replyToken)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("reply token not found")
+		}
+		return nil, fmt.Errorf("failed to get reply token: %w", err)
+	}
+	return &replyToken, nil
+}
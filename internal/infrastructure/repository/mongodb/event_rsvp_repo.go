@@ -0,0 +1,107 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EventRSVPRepository is the MongoDB implementation of the
+// IEventRSVPRepository interface.
+type EventRSVPRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IEventRSVPRepository = (*EventRSVPRepository)(nil)
+
+// NewEventRSVPRepository creates and returns a new EventRSVPRepository
+// instance.
+func NewEventRSVPRepository(db *mongo.Database) *EventRSVPRepository {
+	return &EventRSVPRepository{
+		collection: db.Collection("event_rsvps"),
+	}
+}
+
+// CreateRSVP inserts a new RSVP.
+func (r *EventRSVPRepository) CreateRSVP(ctx context.Context, rsvp *entity.EventRSVP) error {
+	if rsvp.CreatedAt.IsZero() {
+		rsvp.CreatedAt = time.Now()
+	}
+	if _, err := r.collection.InsertOne(ctx, rsvp); err != nil {
+		return fmt.Errorf("failed to create RSVP: %w", err)
+	}
+	return nil
+}
+
+// GetRSVP returns a user's RSVP to an event, if any.
+func (r *EventRSVPRepository) GetRSVP(ctx context.Context, blogID, userID string) (*entity.EventRSVP, error) {
+	var rsvp entity.EventRSVP
+	err := r.collection.FindOne(ctx, bson.M{"blog_id": blogID, "user_id": userID}).Decode(&rsvp)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("RSVP not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve RSVP: %w", err)
+	}
+	return &rsvp, nil
+}
+
+// DeleteRSVP removes a user's RSVP to an event.
+func (r *EventRSVPRepository) DeleteRSVP(ctx context.Context, blogID, userID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"blog_id": blogID, "user_id": userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete RSVP: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("RSVP not found")
+	}
+	return nil
+}
+
+// CountRSVPs returns the number of RSVPs on an event.
+func (r *EventRSVPRepository) CountRSVPs(ctx context.Context, blogID string) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"blog_id": blogID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count RSVPs: %w", err)
+	}
+	return count, nil
+}
+
+// ListRSVPsByBlog returns an event's RSVPs, oldest first.
+func (r *EventRSVPRepository) ListRSVPsByBlog(ctx context.Context, blogID string) ([]*entity.EventRSVP, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"blog_id": blogID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list RSVPs: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rsvps []*entity.EventRSVP
+	if err := cursor.All(ctx, &rsvps); err != nil {
+		return nil, fmt.Errorf("failed to decode RSVPs: %w", err)
+	}
+	return rsvps, nil
+}
+
+// ListRSVPsByUser returns a user's RSVPs, newest first.
+func (r *EventRSVPRepository) ListRSVPsByUser(ctx context.Context, userID string) ([]*entity.EventRSVP, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list RSVPs: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rsvps []*entity.EventRSVP
+	if err := cursor.All(ctx, &rsvps); err != nil {
+		return nil, fmt.Errorf("failed to decode RSVPs: %w", err)
+	}
+	return rsvps, nil
+}
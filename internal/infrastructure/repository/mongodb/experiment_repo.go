@@ -0,0 +1,120 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ExperimentRepository represents the MongoDB implementation of the
+// IExperimentRepository interface.
+type ExperimentRepository struct {
+	collection         *mongo.Collection
+	exposureCollection *mongo.Collection
+}
+
+// NewExperimentRepository creates and returns a new ExperimentRepository instance.
+func NewExperimentRepository(db *mongo.Database) *ExperimentRepository {
+	return &ExperimentRepository{
+		collection:         db.Collection("experiments"),
+		exposureCollection: db.Collection("experiment_exposures"),
+	}
+}
+
+// UpsertExperiment creates or updates an experiment by name.
+func (r *ExperimentRepository) UpsertExperiment(ctx context.Context, experiment *entity.Experiment) error {
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"variants":   experiment.Variants,
+			"active":     experiment.Active,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        experiment.ID,
+			"name":       experiment.Name,
+			"created_at": now,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"name": experiment.Name}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to upsert experiment: %w", err)
+	}
+
+	return nil
+}
+
+// GetExperiment returns an experiment by name.
+func (r *ExperimentRepository) GetExperiment(ctx context.Context, name string) (*entity.Experiment, error) {
+	var experiment entity.Experiment
+	err := r.collection.FindOne(ctx, bson.M{"name": name}).Decode(&experiment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("experiment not found")
+		}
+		return nil, fmt.Errorf("failed to get experiment: %w", err)
+	}
+
+	return &experiment, nil
+}
+
+// ListExperiments returns all experiments sorted by name.
+func (r *ExperimentRepository) ListExperiments(ctx context.Context) ([]*entity.Experiment, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list experiments: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var experiments []*entity.Experiment
+	if err := cursor.All(ctx, &experiments); err != nil {
+		return nil, fmt.Errorf("failed to decode experiments: %w", err)
+	}
+
+	return experiments, nil
+}
+
+// DeleteExperiment removes an experiment by name.
+func (r *ExperimentRepository) DeleteExperiment(ctx context.Context, name string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"name": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete experiment: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return errors.New("experiment not found")
+	}
+
+	return nil
+}
+
+// RecordExposure upserts the subject's exposure record for an experiment.
+func (r *ExperimentRepository) RecordExposure(ctx context.Context, exposure *entity.ExperimentExposure) error {
+	now := time.Now()
+	filter := bson.M{"experiment": exposure.Experiment, "subject_id": exposure.SubjectID}
+	update := bson.M{
+		"$set": bson.M{
+			"variant":   exposure.Variant,
+			"last_seen": now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        exposure.ID,
+			"experiment": exposure.Experiment,
+			"subject_id": exposure.SubjectID,
+			"first_seen": now,
+		},
+	}
+
+	_, err := r.exposureCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to record experiment exposure: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FeatureFlagRepository represents the MongoDB implementation of the
+// IFeatureFlagRepository interface.
+type FeatureFlagRepository struct {
+	collection *mongo.Collection
+}
+
+// NewFeatureFlagRepository creates and returns a new FeatureFlagRepository instance.
+func NewFeatureFlagRepository(db *mongo.Database) *FeatureFlagRepository {
+	return &FeatureFlagRepository{
+		collection: db.Collection("feature_flags"),
+	}
+}
+
+// UpsertFlag creates or updates a flag by name.
+func (r *FeatureFlagRepository) UpsertFlag(ctx context.Context, flag *entity.FeatureFlag) error {
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"enabled":         flag.Enabled,
+			"rollout_percent": flag.RolloutPercent,
+			"updated_at":      now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        flag.ID,
+			"name":       flag.Name,
+			"created_at": now,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"name": flag.Name}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to upsert feature flag: %w", err)
+	}
+
+	return nil
+}
+
+// GetFlag returns a flag by name.
+func (r *FeatureFlagRepository) GetFlag(ctx context.Context, name string) (*entity.FeatureFlag, error) {
+	var flag entity.FeatureFlag
+	err := r.collection.FindOne(ctx, bson.M{"name": name}).Decode(&flag)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("feature flag not found")
+		}
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+
+	return &flag, nil
+}
+
+// ListFlags returns all flags sorted by name.
+func (r *FeatureFlagRepository) ListFlags(ctx context.Context) ([]*entity.FeatureFlag, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var flags []*entity.FeatureFlag
+	if err := cursor.All(ctx, &flags); err != nil {
+		return nil, fmt.Errorf("failed to decode feature flags: %w", err)
+	}
+
+	return flags, nil
+}
+
+// DeleteFlag removes a flag by name.
+func (r *FeatureFlagRepository) DeleteFlag(ctx context.Context, name string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"name": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete feature flag: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return errors.New("feature flag not found")
+	}
+
+	return nil
+}
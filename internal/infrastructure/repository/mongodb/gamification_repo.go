@@ -0,0 +1,75 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// GamificationRepository is the MongoDB implementation of the
+// IGamificationRepository interface. Action tallies live in one counter
+// document per user; badges are one document each.
+type GamificationRepository struct {
+	counters *mongo.Collection
+	badges   *mongo.Collection
+}
+
+var _ contract.IGamificationRepository = (*GamificationRepository)(nil)
+
+// NewGamificationRepository creates and returns a new GamificationRepository
+// instance.
+func NewGamificationRepository(db *mongo.Database) *GamificationRepository {
+	return &GamificationRepository{
+		counters: db.Collection("reputation_counters"),
+		badges:   db.Collection("badges"),
+	}
+}
+
+// IncrementActionCount bumps the user's tally for an action and returns the
+// new count.
+func (r *GamificationRepository) IncrementActionCount(ctx context.Context, userID string, action entity.ReputationAction) (int, error) {
+	filter := bson.M{"_id": userID}
+	update := bson.M{"$inc": bson.M{"counts." + string(action): 1}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var doc struct {
+		Counts map[string]int `bson:"counts"`
+	}
+	if err := r.counters.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc); err != nil {
+		return 0, fmt.Errorf("failed to increment action count: %w", err)
+	}
+	return doc.Counts[string(action)], nil
+}
+
+// AwardBadge stores a badge unless the user already holds it and reports
+// whether it was newly awarded.
+func (r *GamificationRepository) AwardBadge(ctx context.Context, badge *entity.Badge) (bool, error) {
+	filter := bson.M{"user_id": badge.UserID, "slug": badge.Slug}
+	update := bson.M{"$setOnInsert": badge}
+	result, err := r.badges.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, fmt.Errorf("failed to award badge: %w", err)
+	}
+	return result.UpsertedCount > 0, nil
+}
+
+// ListBadgesByUser retrieves a user's badges, oldest first.
+func (r *GamificationRepository) ListBadgesByUser(ctx context.Context, userID string) ([]*entity.Badge, error) {
+	opts := options.Find().SetSort(bson.M{"awarded_at": 1})
+	cursor, err := r.badges.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list badges: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var badges []*entity.Badge
+	if err := cursor.All(ctx, &badges); err != nil {
+		return nil, fmt.Errorf("failed to decode badges: %w", err)
+	}
+	return badges, nil
+}
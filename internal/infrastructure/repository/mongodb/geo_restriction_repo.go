@@ -0,0 +1,106 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// GeoRestrictionRepository is the MongoDB implementation of the
+// IGeoRestrictionRepository interface.
+type GeoRestrictionRepository struct {
+	restrictions *mongo.Collection
+	audit        *mongo.Collection
+}
+
+// NewGeoRestrictionRepository creates and returns a new
+// GeoRestrictionRepository instance.
+func NewGeoRestrictionRepository(db *mongo.Database) *GeoRestrictionRepository {
+	return &GeoRestrictionRepository{
+		restrictions: db.Collection("geo_restrictions"),
+		audit:        db.Collection("geo_restriction_audit"),
+	}
+}
+
+// UpsertRestriction stores (or replaces) a blog's restriction.
+func (r *GeoRestrictionRepository) UpsertRestriction(ctx context.Context, restriction *entity.GeoRestriction) error {
+	restriction.UpdatedAt = time.Now()
+	filter := bson.M{"_id": restriction.BlogID}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.restrictions.ReplaceOne(ctx, filter, restriction, opts); err != nil {
+		return fmt.Errorf("failed to upsert geo restriction for blog %s: %w", restriction.BlogID, err)
+	}
+	return nil
+}
+
+// GetRestrictionByBlogID returns a blog's restriction.
+func (r *GeoRestrictionRepository) GetRestrictionByBlogID(ctx context.Context, blogID string) (*entity.GeoRestriction, error) {
+	var restriction entity.GeoRestriction
+	err := r.restrictions.FindOne(ctx, bson.M{"_id": blogID}).Decode(&restriction)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("no geo restriction for blog %s", blogID)
+		}
+		return nil, fmt.Errorf("failed to retrieve geo restriction for blog %s: %w", blogID, err)
+	}
+	return &restriction, nil
+}
+
+// DeleteRestriction removes a blog's restriction.
+func (r *GeoRestrictionRepository) DeleteRestriction(ctx context.Context, blogID string) error {
+	res, err := r.restrictions.DeleteOne(ctx, bson.M{"_id": blogID})
+	if err != nil {
+		return fmt.Errorf("failed to delete geo restriction for blog %s: %w", blogID, err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("no geo restriction for blog %s", blogID)
+	}
+	return nil
+}
+
+// ListRestrictions returns every restricted blog.
+func (r *GeoRestrictionRepository) ListRestrictions(ctx context.Context) ([]*entity.GeoRestriction, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+	cursor, err := r.restrictions.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list geo restrictions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var restrictions []*entity.GeoRestriction
+	if err := cursor.All(ctx, &restrictions); err != nil {
+		return nil, fmt.Errorf("failed to decode geo restrictions: %w", err)
+	}
+	return restrictions, nil
+}
+
+// AddAuditEntry appends to the restriction audit trail.
+func (r *GeoRestrictionRepository) AddAuditEntry(ctx context.Context, entry *entity.GeoRestrictionAudit) error {
+	entry.CreatedAt = time.Now()
+	if _, err := r.audit.InsertOne(ctx, entry); err != nil {
+		return fmt.Errorf("failed to record geo restriction audit entry: %w", err)
+	}
+	return nil
+}
+
+// ListAuditEntries returns a blog's audit trail, newest first.
+func (r *GeoRestrictionRepository) ListAuditEntries(ctx context.Context, blogID string) ([]*entity.GeoRestrictionAudit, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.audit.Find(ctx, bson.M{"blog_id": blogID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list geo restriction audit entries: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var entries []*entity.GeoRestrictionAudit
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode geo restriction audit entries: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,108 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// HighlightRepository is the MongoDB implementation of the
+// IHighlightRepository interface.
+type HighlightRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IHighlightRepository = (*HighlightRepository)(nil)
+
+// NewHighlightRepository creates and returns a new HighlightRepository
+// instance.
+func NewHighlightRepository(db *mongo.Database) *HighlightRepository {
+	return &HighlightRepository{
+		collection: db.Collection("highlights"),
+	}
+}
+
+// CreateHighlight inserts a new highlight.
+func (r *HighlightRepository) CreateHighlight(ctx context.Context, highlight *entity.Highlight) error {
+	if highlight.CreatedAt.IsZero() {
+		highlight.CreatedAt = time.Now()
+	}
+	if _, err := r.collection.InsertOne(ctx, highlight); err != nil {
+		return fmt.Errorf("failed to create highlight: %w", err)
+	}
+	return nil
+}
+
+// GetHighlightByID retrieves a highlight by its ID.
+func (r *HighlightRepository) GetHighlightByID(ctx context.Context, highlightID string) (*entity.Highlight, error) {
+	var highlight entity.Highlight
+	err := r.collection.FindOne(ctx, bson.M{"_id": highlightID}).Decode(&highlight)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("highlight %s not found", highlightID)
+		}
+		return nil, fmt.Errorf("failed to retrieve highlight %s: %w", highlightID, err)
+	}
+	return &highlight, nil
+}
+
+// DeleteHighlight removes a highlight.
+func (r *HighlightRepository) DeleteHighlight(ctx context.Context, highlightID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": highlightID})
+	if err != nil {
+		return fmt.Errorf("failed to delete highlight %s: %w", highlightID, err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("highlight %s not found", highlightID)
+	}
+	return nil
+}
+
+// ListHighlightsByUser returns a user's highlights, newest first.
+func (r *HighlightRepository) ListHighlightsByUser(ctx context.Context, userID string) ([]*entity.Highlight, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list highlights: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var highlights []*entity.Highlight
+	if err := cursor.All(ctx, &highlights); err != nil {
+		return nil, fmt.Errorf("failed to decode highlights: %w", err)
+	}
+	return highlights, nil
+}
+
+// TopHighlights aggregates a post's most-highlighted passages, grouping by
+// the highlighted text.
+func (r *HighlightRepository) TopHighlights(ctx context.Context, blogID string, limit int) ([]entity.HighlightStat, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"blog_id": blogID}}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id":   "$text",
+			"count": bson.M{"$sum": 1},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}}}},
+		bson.D{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate highlights: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var stats []entity.HighlightStat
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode highlight stats: %w", err)
+	}
+	return stats, nil
+}
@@ -18,13 +18,15 @@ var ErrReactionNotFound = errors.New("reaction not found")
 
 // LikeRepository represents the MongoDB implementation of the ILikeRepository interface.
 type LikeRepository struct {
-	collection *mongo.Collection
+	collection      *mongo.Collection
+	clapsCollection *mongo.Collection
 }
 
 // NewLikeRepository creates and returns a new LikeRepository instance.
 func NewLikeRepository(db *mongo.Database) *LikeRepository {
 	return &LikeRepository{
-		collection: db.Collection("blog_likes"),
+		collection:      db.Collection("blog_likes"),
+		clapsCollection: db.Collection("blog_claps"),
 	}
 }
 
@@ -146,3 +148,75 @@ func (r *LikeRepository) CountDislikesByTargetID(ctx context.Context, targetID s
 	}
 	return count, nil
 }
+
+// AddClaps adds up to count claps from the user on a blog, capped at
+// entity.MaxClapsPerUser, and returns the user's new tally. The cap is
+// clamped inside the update itself ($min over the incremented tally), so
+// concurrent requests serialize on the document and can never overshoot it.
+func (r *LikeRepository) AddClaps(ctx context.Context, userID, blogID string, count int) (int, error) {
+	now := time.Now()
+	filter := bson.M{"user_id": userID, "blog_id": blogID}
+	// Setting _id to its own value is a no-op on existing documents and
+	// assigns the generated ID on upsert, where $setOnInsert is unavailable
+	// in pipeline updates.
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"_id": bson.M{"$ifNull": bson.A{"$_id", uuid.New().String()}},
+			"count": bson.M{"$min": bson.A{
+				entity.MaxClapsPerUser,
+				bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$count", 0}}, count}},
+			}},
+			"created_at": bson.M{"$ifNull": bson.A{"$created_at", now}},
+			"updated_at": now,
+		}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var clap entity.Clap
+	if err := r.clapsCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&clap); err != nil {
+		return 0, fmt.Errorf("failed to add claps: %w", err)
+	}
+	return clap.Count, nil
+}
+
+// GetClapCountByUserIDAndBlogID returns the user's clap tally for a blog.
+func (r *LikeRepository) GetClapCountByUserIDAndBlogID(ctx context.Context, userID, blogID string) (int, error) {
+	filter := bson.M{"user_id": userID, "blog_id": blogID}
+	var clap entity.Clap
+	err := r.clapsCollection.FindOne(ctx, filter).Decode(&clap)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get clap count: %w", err)
+	}
+	return clap.Count, nil
+}
+
+// CountClapsByBlogID sums the claps a blog received across all users.
+func (r *LikeRepository) CountClapsByBlogID(ctx context.Context, blogID string) (int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"blog_id": blogID}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   nil,
+			"total": bson.M{"$sum": "$count"},
+		}}},
+	}
+
+	cursor, err := r.clapsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count claps: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Total int64 `bson:"total"`
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return 0, fmt.Errorf("failed to decode clap count: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+	return results[0].Total, nil
+}
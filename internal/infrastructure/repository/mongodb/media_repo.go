@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -126,6 +127,189 @@ func (r *MediaRepository) UpdateMedia(ctx context.Context, mediaID string, updat
 	return nil
 }
 
+// SetMediaVariants stores the generated size variants on a media record.
+func (r *MediaRepository) SetMediaVariants(ctx context.Context, mediaID string, variants []entity.MediaVariant) error {
+	filter := bson.M{"_id": mediaID, "is_deleted": false}
+	update := bson.M{"$set": bson.M{"variants": variants}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to set variants on media record %s: %w", mediaID, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("media record with ID %s not found", mediaID)
+	}
+	return nil
+}
+
+// ListUserMedia returns a page of a user's media matching the filters, with
+// the total match count.
+func (r *MediaRepository) ListUserMedia(ctx context.Context, filterOpts *contract.MediaFilterOptions) ([]*entity.Media, int64, error) {
+	filter := bson.M{"is_deleted": false}
+	if filterOpts.UploadedByUserID != nil {
+		filter["uploaded_by_user_id"] = *filterOpts.UploadedByUserID
+	}
+	if filterOpts.MimeType != nil && *filterOpts.MimeType != "" {
+		filter["mime_type"] = *filterOpts.MimeType
+	}
+	dateFilter := bson.M{}
+	if filterOpts.DateFrom != nil {
+		dateFilter["$gte"] = *filterOpts.DateFrom
+	}
+	if filterOpts.DateTo != nil {
+		dateFilter["$lte"] = *filterOpts.DateTo
+	}
+	if len(dateFilter) > 0 {
+		filter["created_at"] = dateFilter
+	}
+	if filterOpts.Used != nil {
+		if *filterOpts.Used {
+			filter["blog_id"] = bson.M{"$nin": bson.A{nil, ""}}
+		} else {
+			filter["blog_id"] = bson.M{"$in": bson.A{nil, ""}}
+		}
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count media records: %w", err)
+	}
+
+	sortBy := filterOpts.SortBy
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	sortDir := -1
+	if filterOpts.SortOrder == "asc" {
+		sortDir = 1
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: sortBy, Value: sortDir}}).
+		SetSkip((filterOpts.Page - 1) * filterOpts.Limit).
+		SetLimit(filterOpts.Limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list media records: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var mediaList []*entity.Media
+	if err := cursor.All(ctx, &mediaList); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode media records: %w", err)
+	}
+	return mediaList, total, nil
+}
+
+// BulkSoftDeleteByUser soft-deletes the given media IDs that belong to the
+// user.
+func (r *MediaRepository) BulkSoftDeleteByUser(ctx context.Context, userID string, mediaIDs []string) (int64, error) {
+	filter := bson.M{
+		"_id":                 bson.M{"$in": mediaIDs},
+		"uploaded_by_user_id": userID,
+		"is_deleted":          false,
+	}
+	res, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"is_deleted": true}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to bulk delete media records: %w", err)
+	}
+	return res.ModifiedCount, nil
+}
+
+// GetStorageUsage sums the bytes a user's live uploads occupy, grouped by
+// MIME type.
+func (r *MediaRepository) GetStorageUsage(ctx context.Context, userID string) ([]contract.StorageUsageByType, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"uploaded_by_user_id": userID, "is_deleted": false}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$mime_type",
+			"bytes": bson.M{"$sum": "$file_size"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "bytes", Value: -1}}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate storage usage: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		MimeType string `bson:"_id"`
+		Bytes    int64  `bson:"bytes"`
+		Count    int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode storage usage: %w", err)
+	}
+
+	usage := make([]contract.StorageUsageByType, 0, len(rows))
+	for _, row := range rows {
+		usage = append(usage, contract.StorageUsageByType{
+			MimeType: row.MimeType,
+			Bytes:    row.Bytes,
+			Count:    row.Count,
+		})
+	}
+	return usage, nil
+}
+
+// MarkTranscodeSubmitted records the transcoder job a video upload was
+// handed to.
+func (r *MediaRepository) MarkTranscodeSubmitted(ctx context.Context, mediaID, jobID string) error {
+	filter := bson.M{"_id": mediaID, "is_deleted": false}
+	update := bson.M{"$set": bson.M{
+		"transcode_job_id": jobID,
+		"transcode_status": entity.TranscodeStateProcessing,
+	}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to mark transcode submitted for media %s: %w", mediaID, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("media record with ID %s not found", mediaID)
+	}
+	return nil
+}
+
+// SetTranscodeResult stores a finished (or failed) transcode's outcome.
+func (r *MediaRepository) SetTranscodeResult(ctx context.Context, mediaID string, status *entity.TranscodeJobStatus) error {
+	filter := bson.M{"_id": mediaID, "is_deleted": false}
+	update := bson.M{"$set": bson.M{
+		"transcode_status":  status.State,
+		"transcode_message": status.Message,
+		"hls_playback_url":  status.HLSPlaybackURL,
+		"dash_playback_url": status.DASHPlaybackURL,
+	}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to set transcode result for media %s: %w", mediaID, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("media record with ID %s not found", mediaID)
+	}
+	return nil
+}
+
+// ListProcessingTranscodes returns video media whose transcode job is still
+// in flight.
+func (r *MediaRepository) ListProcessingTranscodes(ctx context.Context, limit int) ([]*entity.Media, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(int64(limit))
+	filter := bson.M{"transcode_status": entity.TranscodeStateProcessing, "is_deleted": false}
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processing transcodes: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var media []*entity.Media
+	if err := cursor.All(ctx, &media); err != nil {
+		return nil, fmt.Errorf("failed to decode media records: %w", err)
+	}
+	return media, nil
+}
+
 // DeleteMedia soft deletes a media record by its ID.
 func (r *MediaRepository) DeleteMedia(ctx context.Context, mediaID string) error {
 	filter := bson.M{"_id": mediaID, "is_deleted": false}
@@ -0,0 +1,73 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MembershipRepository is the MongoDB implementation of the
+// IMembershipRepository interface.
+type MembershipRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IMembershipRepository = (*MembershipRepository)(nil)
+
+// NewMembershipRepository creates and returns a new MembershipRepository
+// instance.
+func NewMembershipRepository(db *mongo.Database) *MembershipRepository {
+	return &MembershipRepository{
+		collection: db.Collection("memberships"),
+	}
+}
+
+// UpsertMembership creates or replaces a user's membership record, keyed by
+// the user so each reader has at most one.
+func (r *MembershipRepository) UpsertMembership(ctx context.Context, membership *entity.Membership) error {
+	now := time.Now()
+	if membership.CreatedAt.IsZero() {
+		membership.CreatedAt = now
+	}
+	membership.UpdatedAt = now
+
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"user_id": membership.UserID}, membership, opts); err != nil {
+		return fmt.Errorf("failed to upsert membership: %w", err)
+	}
+	return nil
+}
+
+// GetMembershipByUserID retrieves a user's membership record.
+func (r *MembershipRepository) GetMembershipByUserID(ctx context.Context, userID string) (*entity.Membership, error) {
+	var membership entity.Membership
+	err := r.collection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&membership)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("membership not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve membership: %w", err)
+	}
+	return &membership, nil
+}
+
+// GetMembershipBySubscriptionID resolves a membership from the payments
+// provider's subscription identifier.
+func (r *MembershipRepository) GetMembershipBySubscriptionID(ctx context.Context, subscriptionID string) (*entity.Membership, error) {
+	var membership entity.Membership
+	err := r.collection.FindOne(ctx, bson.M{"subscription_id": subscriptionID}).Decode(&membership)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("membership not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve membership: %w", err)
+	}
+	return &membership, nil
+}
@@ -0,0 +1,73 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/uuidgen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ModerationActionRepository represents the MongoDB implementation of the
+// IModerationActionRepository interface.
+type ModerationActionRepository struct {
+	collection *mongo.Collection
+}
+
+// NewModerationActionRepository creates and returns a new ModerationActionRepository instance.
+func NewModerationActionRepository(db *mongo.Database) *ModerationActionRepository {
+	return &ModerationActionRepository{
+		collection: db.Collection("moderation_actions"),
+	}
+}
+
+var _ contract.IModerationActionRepository = (*ModerationActionRepository)(nil)
+
+// RecordAction stores a new moderation audit record.
+func (r *ModerationActionRepository) RecordAction(ctx context.Context, action *entity.ModerationAction) error {
+	if action.ID == "" {
+		action.ID = uuidgen.NewGenerator().NewUUID()
+	}
+	if action.CreatedAt.IsZero() {
+		action.CreatedAt = time.Now()
+	}
+
+	_, err := r.collection.InsertOne(ctx, action)
+	if err != nil {
+		return fmt.Errorf("failed to record moderation action: %w", err)
+	}
+	return nil
+}
+
+// GetActionsByComment returns the moderation history of a comment, newest first.
+func (r *ModerationActionRepository) GetActionsByComment(ctx context.Context, commentID string) ([]*entity.ModerationAction, error) {
+	return r.findActions(ctx, bson.M{"comment_id": commentID})
+}
+
+// GetActionsByUser returns every moderation action taken against a user's
+// comments, newest first.
+func (r *ModerationActionRepository) GetActionsByUser(ctx context.Context, userID string) ([]*entity.ModerationAction, error) {
+	return r.findActions(ctx, bson.M{"target_user_id": userID})
+}
+
+func (r *ModerationActionRepository) findActions(ctx context.Context, filter bson.M) ([]*entity.ModerationAction, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve moderation actions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var actions []*entity.ModerationAction
+	if err := cursor.All(ctx, &actions); err != nil {
+		return nil, fmt.Errorf("failed to decode moderation actions: %w", err)
+	}
+
+	return actions, nil
+}
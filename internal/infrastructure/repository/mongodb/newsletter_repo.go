@@ -0,0 +1,144 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewsletterRepository is the MongoDB implementation of INewsletterRepository.
+type NewsletterRepository struct {
+	subscriptions *mongo.Collection
+	broadcasts    *mongo.Collection
+}
+
+var _ contract.INewsletterRepository = (*NewsletterRepository)(nil)
+
+func NewNewsletterRepository(db *mongo.Database) *NewsletterRepository {
+	return &NewsletterRepository{
+		subscriptions: db.Collection("newsletter_subscriptions"),
+		broadcasts:    db.Collection("newsletter_broadcasts"),
+	}
+}
+
+func (r *NewsletterRepository) CreateSubscription(ctx context.Context, subscription *entity.NewsletterSubscription) error {
+	_, err := r.subscriptions.InsertOne(ctx, subscription)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *NewsletterRepository) GetSubscriptionByEmailAndAuthor(ctx context.Context, email, authorID string) (*entity.NewsletterSubscription, error) {
+	filter := bson.M{"email": email}
+	if authorID == "" {
+		filter["author_id"] = bson.M{"$exists": false}
+	} else {
+		filter["author_id"] = authorID
+	}
+
+	var subscription entity.NewsletterSubscription
+	err := r.subscriptions.FindOne(ctx, filter).Decode(&subscription)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("subscription not found")
+		}
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return &subscription, nil
+}
+
+func (r *NewsletterRepository) ConfirmSubscription(ctx context.Context, confirmToken string) (*entity.NewsletterSubscription, error) {
+	now := time.Now()
+	update := bson.M{"$set": bson.M{"confirmed": true, "confirmed_at": now}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var subscription entity.NewsletterSubscription
+	err := r.subscriptions.FindOneAndUpdate(ctx, bson.M{"confirm_token": confirmToken}, update, opts).Decode(&subscription)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("subscription not found")
+		}
+		return nil, fmt.Errorf("failed to confirm subscription: %w", err)
+	}
+	return &subscription, nil
+}
+
+func (r *NewsletterRepository) DeleteSubscriptionByToken(ctx context.Context, unsubscribeToken string) error {
+	res, err := r.subscriptions.DeleteOne(ctx, bson.M{"unsubscribe_token": unsubscribeToken})
+	if err != nil {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("subscription not found")
+	}
+	return nil
+}
+
+// ListConfirmedSubscriptions returns the confirmed subscribers of the
+// author's list plus the site-wide list.
+func (r *NewsletterRepository) ListConfirmedSubscriptions(ctx context.Context, authorID string) ([]*entity.NewsletterSubscription, error) {
+	filter := bson.M{
+		"confirmed": true,
+		"$or": []bson.M{
+			{"author_id": authorID},
+			{"author_id": bson.M{"$exists": false}},
+		},
+	}
+	cursor, err := r.subscriptions.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var subscriptions []*entity.NewsletterSubscription
+	if err = cursor.All(ctx, &subscriptions); err != nil {
+		return nil, fmt.Errorf("failed to decode subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+func (r *NewsletterRepository) CreateBroadcast(ctx context.Context, broadcast *entity.NewsletterBroadcast) error {
+	_, err := r.broadcasts.InsertOne(ctx, broadcast)
+	if err != nil {
+		return fmt.Errorf("failed to create broadcast: %w", err)
+	}
+	return nil
+}
+
+func (r *NewsletterRepository) UpdateBroadcastStats(ctx context.Context, broadcastID string, delivered, failed int, completed bool) error {
+	updates := bson.M{"delivered": delivered, "failed": failed}
+	if completed {
+		updates["status"] = entity.BroadcastStatusSent
+		updates["completed_at"] = time.Now()
+	}
+	res, err := r.broadcasts.UpdateOne(ctx, bson.M{"_id": broadcastID}, bson.M{"$set": updates})
+	if err != nil {
+		return fmt.Errorf("failed to update broadcast stats: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("broadcast not found")
+	}
+	return nil
+}
+
+func (r *NewsletterRepository) ListBroadcastsByAuthorID(ctx context.Context, authorID string) ([]*entity.NewsletterBroadcast, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.broadcasts.Find(ctx, bson.M{"author_id": authorID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list broadcasts: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var broadcasts []*entity.NewsletterBroadcast
+	if err = cursor.All(ctx, &broadcasts); err != nil {
+		return nil, fmt.Errorf("failed to decode broadcasts: %w", err)
+	}
+	return broadcasts, nil
+}
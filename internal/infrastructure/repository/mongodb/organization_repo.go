@@ -0,0 +1,184 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// OrganizationRepository represents the MongoDB implementation of the
+// IOrganizationRepository interface.
+type OrganizationRepository struct {
+	collection        *mongo.Collection
+	membersCollection *mongo.Collection
+}
+
+// NewOrganizationRepository creates and returns a new OrganizationRepository instance.
+func NewOrganizationRepository(db *mongo.Database) *OrganizationRepository {
+	return &OrganizationRepository{
+		collection:        db.Collection("organizations"),
+		membersCollection: db.Collection("org_members"),
+	}
+}
+
+// CreateOrganization inserts a new organization record into the database.
+func (r *OrganizationRepository) CreateOrganization(ctx context.Context, org *entity.Organization) error {
+	now := time.Now()
+	if org.CreatedAt.IsZero() {
+		org.CreatedAt = now
+	}
+	org.UpdatedAt = now
+
+	_, err := r.collection.InsertOne(ctx, org)
+	if err != nil {
+		var writeException mongo.WriteException
+		if errors.As(err, &writeException) {
+			for _, e := range writeException.WriteErrors {
+				if e.Code == 11000 {
+					return errors.New("organization with this slug already exists")
+				}
+			}
+		}
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+	return nil
+}
+
+// GetOrganizationByID retrieves a single organization by its unique ID.
+func (r *OrganizationRepository) GetOrganizationByID(ctx context.Context, orgID string) (*entity.Organization, error) {
+	var org entity.Organization
+	err := r.collection.FindOne(ctx, bson.M{"_id": orgID}).Decode(&org)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("organization not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve organization: %w", err)
+	}
+	return &org, nil
+}
+
+// GetOrganizationBySlug retrieves a single organization by its URL slug.
+func (r *OrganizationRepository) GetOrganizationBySlug(ctx context.Context, slug string) (*entity.Organization, error) {
+	var org entity.Organization
+	err := r.collection.FindOne(ctx, bson.M{"slug": slug}).Decode(&org)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("organization not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve organization by slug: %w", err)
+	}
+	return &org, nil
+}
+
+// GetOrganizationByDomain resolves a custom hostname to its organization.
+func (r *OrganizationRepository) GetOrganizationByDomain(ctx context.Context, domain string) (*entity.Organization, error) {
+	var org entity.Organization
+	err := r.collection.FindOne(ctx, bson.M{"custom_domain": domain}).Decode(&org)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("organization not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve organization by domain: %w", err)
+	}
+	return &org, nil
+}
+
+// UpdateOrganization updates the details of an existing organization by its ID.
+func (r *OrganizationRepository) UpdateOrganization(ctx context.Context, orgID string, updates map[string]interface{}) error {
+	updates["updated_at"] = time.Now()
+	filter := bson.M{"_id": orgID}
+	update := bson.M{"$set": updates}
+
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update organization: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("organization not found")
+	}
+	return nil
+}
+
+// AddMember inserts a membership record for an organization.
+func (r *OrganizationRepository) AddMember(ctx context.Context, member *entity.OrgMember) error {
+	if member.CreatedAt.IsZero() {
+		member.CreatedAt = time.Now()
+	}
+
+	_, err := r.membersCollection.InsertOne(ctx, member)
+	if err != nil {
+		var writeException mongo.WriteException
+		if errors.As(err, &writeException) {
+			for _, e := range writeException.WriteErrors {
+				if e.Code == 11000 {
+					return errors.New("user is already a member of this organization")
+				}
+			}
+		}
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember deletes a membership record from an organization.
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID, userID string) error {
+	filter := bson.M{"org_id": orgID, "user_id": userID}
+	res, err := r.membersCollection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return errors.New("member not found")
+	}
+	return nil
+}
+
+// UpdateMemberRole changes a member's role within an organization.
+func (r *OrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID string, role entity.OrgRole) error {
+	filter := bson.M{"org_id": orgID, "user_id": userID}
+	update := bson.M{"$set": bson.M{"role": role}}
+
+	res, err := r.membersCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update member role: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("member not found")
+	}
+	return nil
+}
+
+// GetMember retrieves a user's membership in an organization.
+func (r *OrganizationRepository) GetMember(ctx context.Context, orgID, userID string) (*entity.OrgMember, error) {
+	var member entity.OrgMember
+	filter := bson.M{"org_id": orgID, "user_id": userID}
+
+	err := r.membersCollection.FindOne(ctx, filter).Decode(&member)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("member not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve organization member: %w", err)
+	}
+	return &member, nil
+}
+
+// GetMembers retrieves every membership record of an organization.
+func (r *OrganizationRepository) GetMembers(ctx context.Context, orgID string) ([]*entity.OrgMember, error) {
+	cursor, err := r.membersCollection.Find(ctx, bson.M{"org_id": orgID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve organization members: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var members []*entity.OrgMember
+	if err = cursor.All(ctx, &members); err != nil {
+		return nil, fmt.Errorf("failed to decode organization members: %w", err)
+	}
+	return members, nil
+}
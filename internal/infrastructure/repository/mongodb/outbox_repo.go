@@ -0,0 +1,88 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// OutboxRepository is the MongoDB implementation of IOutboxRepository.
+type OutboxRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IOutboxRepository = (*OutboxRepository)(nil)
+
+func NewOutboxRepository(db *mongo.Database) *OutboxRepository {
+	return &OutboxRepository{
+		collection: db.Collection("outbox_events"),
+	}
+}
+
+func (r *OutboxRepository) EnqueueOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	_, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue outbox event: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) FetchPendingOutboxEvents(ctx context.Context, limit int) ([]*entity.OutboxEvent, error) {
+	filter := bson.M{
+		"status":          entity.OutboxStatusPending,
+		"next_attempt_at": bson.M{"$lte": time.Now()},
+	}
+	opts := options.Find().SetSort(bson.M{"created_at": 1}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []*entity.OutboxEvent
+	for cursor.Next(ctx) {
+		var event entity.OutboxEvent
+		if err := cursor.Decode(&event); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, cursor.Err()
+}
+
+func (r *OutboxRepository) MarkOutboxEventDelivered(ctx context.Context, eventID string) error {
+	update := bson.M{"$set": bson.M{
+		"status":       entity.OutboxStatusDelivered,
+		"delivered_at": time.Now(),
+		"last_error":   "",
+	}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": eventID}, update)
+	return err
+}
+
+func (r *OutboxRepository) RescheduleOutboxEvent(ctx context.Context, eventID string, nextAttemptAt time.Time, lastError string) error {
+	update := bson.M{
+		"$set": bson.M{
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": eventID}, update)
+	return err
+}
+
+func (r *OutboxRepository) MarkOutboxEventFailed(ctx context.Context, eventID, lastError string) error {
+	update := bson.M{"$set": bson.M{
+		"status":     entity.OutboxStatusFailed,
+		"last_error": lastError,
+	}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": eventID}, update)
+	return err
+}
@@ -0,0 +1,83 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ProfanityWordRepository represents the MongoDB implementation of the
+// IProfanityWordRepository interface.
+type ProfanityWordRepository struct {
+	collection *mongo.Collection
+}
+
+// NewProfanityWordRepository creates and returns a new ProfanityWordRepository instance.
+func NewProfanityWordRepository(db *mongo.Database) *ProfanityWordRepository {
+	return &ProfanityWordRepository{
+		collection: db.Collection("profanity_words"),
+	}
+}
+
+// AddWord inserts an admin-added wordlist entry.
+func (r *ProfanityWordRepository) AddWord(ctx context.Context, word *entity.ProfanityWord) error {
+	if word.CreatedAt.IsZero() {
+		word.CreatedAt = time.Now()
+	}
+
+	_, err := r.collection.InsertOne(ctx, word)
+	if err != nil {
+		var writeException mongo.WriteException
+		if errors.As(err, &writeException) {
+			for _, writeError := range writeException.WriteErrors {
+				if writeError.Code == 11000 {
+					return errors.New("word is already in the list")
+				}
+			}
+		}
+		return fmt.Errorf("failed to add profanity word: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveWord deletes an admin-added wordlist entry.
+func (r *ProfanityWordRepository) RemoveWord(ctx context.Context, word, locale string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"word": word, "locale": locale})
+	if err != nil {
+		return fmt.Errorf("failed to remove profanity word: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return errors.New("word not found in the list")
+	}
+
+	return nil
+}
+
+// ListWords returns the wordlist entries for a locale; an empty locale
+// returns the entries for all locales.
+func (r *ProfanityWordRepository) ListWords(ctx context.Context, locale string) ([]*entity.ProfanityWord, error) {
+	filter := bson.M{}
+	if locale != "" {
+		filter["locale"] = locale
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"word": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profanity words: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var words []*entity.ProfanityWord
+	if err := cursor.All(ctx, &words); err != nil {
+		return nil, fmt.Errorf("failed to decode profanity words: %w", err)
+	}
+
+	return words, nil
+}
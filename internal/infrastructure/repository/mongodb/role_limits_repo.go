@@ -0,0 +1,66 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RoleLimitsRepository is the MongoDB implementation of the
+// IRoleLimitsRepository interface.
+type RoleLimitsRepository struct {
+	collection *mongo.Collection
+}
+
+// NewRoleLimitsRepository creates and returns a new RoleLimitsRepository
+// instance.
+func NewRoleLimitsRepository(db *mongo.Database) *RoleLimitsRepository {
+	return &RoleLimitsRepository{
+		collection: db.Collection("role_limits"),
+	}
+}
+
+// UpsertLimits stores (or replaces) the limits for a role.
+func (r *RoleLimitsRepository) UpsertLimits(ctx context.Context, limits *entity.RoleLimits) error {
+	limits.UpdatedAt = time.Now()
+	filter := bson.M{"_id": limits.Role}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, filter, limits, opts); err != nil {
+		return fmt.Errorf("failed to upsert limits for role %s: %w", limits.Role, err)
+	}
+	return nil
+}
+
+// GetLimits returns the configured limits for a role.
+func (r *RoleLimitsRepository) GetLimits(ctx context.Context, role entity.UserRole) (*entity.RoleLimits, error) {
+	var limits entity.RoleLimits
+	err := r.collection.FindOne(ctx, bson.M{"_id": role}).Decode(&limits)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("no limits configured for role %s", role)
+		}
+		return nil, fmt.Errorf("failed to retrieve limits for role %s: %w", role, err)
+	}
+	return &limits, nil
+}
+
+// ListLimits returns every configured role's limits.
+func (r *RoleLimitsRepository) ListLimits(ctx context.Context) ([]*entity.RoleLimits, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role limits: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var limits []*entity.RoleLimits
+	if err := cursor.All(ctx, &limits); err != nil {
+		return nil, fmt.Errorf("failed to decode role limits: %w", err)
+	}
+	return limits, nil
+}
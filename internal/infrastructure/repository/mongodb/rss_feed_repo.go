@@ -0,0 +1,134 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RSSFeedRepository is the MongoDB implementation of IRSSFeedRepository.
+// Feeds live in "rss_feeds"; imported items are recorded in
+// "rss_imported_items" with a unique (feed_id, guid) index as the dedupe
+// ledger.
+type RSSFeedRepository struct {
+	feeds *mongo.Collection
+	items *mongo.Collection
+}
+
+var _ contract.IRSSFeedRepository = (*RSSFeedRepository)(nil)
+
+func NewRSSFeedRepository(db *mongo.Database) *RSSFeedRepository {
+	return &RSSFeedRepository{
+		feeds: db.Collection("rss_feeds"),
+		items: db.Collection("rss_imported_items"),
+	}
+}
+
+func (r *RSSFeedRepository) CreateFeed(ctx context.Context, feed *entity.RSSFeed) error {
+	_, err := r.feeds.InsertOne(ctx, feed)
+	if err != nil {
+		return fmt.Errorf("failed to create RSS feed: %w", err)
+	}
+	return nil
+}
+
+func (r *RSSFeedRepository) ListFeedsByUserID(ctx context.Context, userID string) ([]*entity.RSSFeed, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.feeds.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list RSS feeds: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var feeds []*entity.RSSFeed
+	if err := cursor.All(ctx, &feeds); err != nil {
+		return nil, fmt.Errorf("failed to decode RSS feeds: %w", err)
+	}
+	return feeds, nil
+}
+
+func (r *RSSFeedRepository) CountFeedsByUserID(ctx context.Context, userID string) (int64, error) {
+	count, err := r.feeds.CountDocuments(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count RSS feeds: %w", err)
+	}
+	return count, nil
+}
+
+func (r *RSSFeedRepository) DeleteFeed(ctx context.Context, userID, feedID string) error {
+	res, err := r.feeds.DeleteOne(ctx, bson.M{"_id": feedID, "user_id": userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete RSS feed: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return errors.New("RSS feed not found")
+	}
+	// The import ledger is kept so re-registering the feed does not
+	// re-import old items as duplicate drafts.
+	return nil
+}
+
+func (r *RSSFeedRepository) ListFeeds(ctx context.Context, offset, limit int) ([]*entity.RSSFeed, error) {
+	opts := options.Find().
+		SetSort(bson.M{"created_at": 1}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := r.feeds.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list RSS feeds: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var feeds []*entity.RSSFeed
+	if err := cursor.All(ctx, &feeds); err != nil {
+		return nil, fmt.Errorf("failed to decode RSS feeds: %w", err)
+	}
+	return feeds, nil
+}
+
+func (r *RSSFeedRepository) UpdateFeedStatus(ctx context.Context, feedID string, fetchedAt time.Time, lastError string, importedDelta int) error {
+	update := bson.M{
+		"$set": bson.M{
+			"last_fetched_at": fetchedAt,
+			"last_error":      lastError,
+		},
+	}
+	if importedDelta > 0 {
+		update["$inc"] = bson.M{"imported_count": importedDelta}
+	}
+	_, err := r.feeds.UpdateOne(ctx, bson.M{"_id": feedID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update RSS feed status: %w", err)
+	}
+	return nil
+}
+
+func (r *RSSFeedRepository) MarkItemImported(ctx context.Context, feedID, guid, blogID string) error {
+	_, err := r.items.InsertOne(ctx, bson.M{
+		"_id":        uuid.New().String(),
+		"feed_id":    feedID,
+		"guid":       guid,
+		"blog_id":    blogID,
+		"created_at": time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark RSS item imported: %w", err)
+	}
+	return nil
+}
+
+func (r *RSSFeedRepository) IsItemImported(ctx context.Context, feedID, guid string) (bool, error) {
+	count, err := r.items.CountDocuments(ctx, bson.M{"feed_id": feedID, "guid": guid})
+	if err != nil {
+		return false, fmt.Errorf("failed to check RSS item import: %w", err)
+	}
+	return count > 0, nil
+}
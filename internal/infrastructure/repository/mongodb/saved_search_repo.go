@@ -0,0 +1,86 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SavedSearchRepository is the MongoDB implementation of
+// ISavedSearchRepository.
+type SavedSearchRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.ISavedSearchRepository = (*SavedSearchRepository)(nil)
+
+func NewSavedSearchRepository(db *mongo.Database) *SavedSearchRepository {
+	return &SavedSearchRepository{
+		collection: db.Collection("saved_searches"),
+	}
+}
+
+func (r *SavedSearchRepository) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) error {
+	_, err := r.collection.InsertOne(ctx, search)
+	if err != nil {
+		return fmt.Errorf("failed to create saved search: %w", err)
+	}
+	return nil
+}
+
+func (r *SavedSearchRepository) ListSavedSearchesByUserID(ctx context.Context, userID string) ([]*entity.SavedSearch, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var searches []*entity.SavedSearch
+	if err := cursor.All(ctx, &searches); err != nil {
+		return nil, fmt.Errorf("failed to decode saved searches: %w", err)
+	}
+	return searches, nil
+}
+
+func (r *SavedSearchRepository) CountSavedSearchesByUserID(ctx context.Context, userID string) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count saved searches: %w", err)
+	}
+	return count, nil
+}
+
+func (r *SavedSearchRepository) DeleteSavedSearch(ctx context.Context, userID, id string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id, "user_id": userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("saved search not found")
+	}
+	return nil
+}
+
+func (r *SavedSearchRepository) ListSavedSearches(ctx context.Context, offset, limit int) ([]*entity.SavedSearch, error) {
+	opts := options.Find().
+		SetSort(bson.M{"created_at": 1}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var searches []*entity.SavedSearch
+	if err := cursor.All(ctx, &searches); err != nil {
+		return nil, fmt.Errorf("failed to decode saved searches: %w", err)
+	}
+	return searches, nil
+}
@@ -0,0 +1,79 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// SearchEventRepository is the MongoDB implementation of
+// ISearchEventRepository.
+type SearchEventRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.ISearchEventRepository = (*SearchEventRepository)(nil)
+
+func NewSearchEventRepository(db *mongo.Database) *SearchEventRepository {
+	return &SearchEventRepository{
+		collection: db.Collection("search_events"),
+	}
+}
+
+func (r *SearchEventRepository) RecordSearchEvent(ctx context.Context, event *entity.SearchEvent) error {
+	_, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to record search event: %w", err)
+	}
+	return nil
+}
+
+// aggregateQueryStats groups search events since the given time by query,
+// counting executions and result clicks, ordered by execution count.
+func (r *SearchEventRepository) aggregateQueryStats(ctx context.Context, match bson.M, limit int) ([]entity.SearchQueryStat, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id": "$query",
+			"count": bson.M{"$sum": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$clicked_blog_id", ""}}, ""}}, 1, 0,
+			}}},
+			"click_count": bson.M{"$sum": bson.M{"$cond": bson.A{
+				bson.M{"$ne": bson.A{bson.M{"$ifNull": bson.A{"$clicked_blog_id", ""}}, ""}}, 1, 0,
+			}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate search events: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var stats []entity.SearchQueryStat
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode search query stats: %w", err)
+	}
+	return stats, nil
+}
+
+func (r *SearchEventRepository) GetTopQueries(ctx context.Context, since time.Time, limit int) ([]entity.SearchQueryStat, error) {
+	return r.aggregateQueryStats(ctx, bson.M{"created_at": bson.M{"$gte": since}}, limit)
+}
+
+func (r *SearchEventRepository) GetZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]entity.SearchQueryStat, error) {
+	// Clicks are excluded via their empty result_count semantics: only search
+	// executions carry result_count, and zero-result ones are the gap signal.
+	return r.aggregateQueryStats(ctx, bson.M{
+		"created_at":      bson.M{"$gte": since},
+		"result_count":    0,
+		"clicked_blog_id": bson.M{"$in": bson.A{nil, ""}},
+	}, limit)
+}
@@ -0,0 +1,81 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SecurityEventRepository represents the MongoDB implementation of the ISecurityEventRepository interface.
+type SecurityEventRepository struct {
+	collection *mongo.Collection
+}
+
+// NewSecurityEventRepository creates and returns a new SecurityEventRepository instance.
+func NewSecurityEventRepository(db *mongo.Database) *SecurityEventRepository {
+	return &SecurityEventRepository{
+		collection: db.Collection("security_events"),
+	}
+}
+
+var _ contract.ISecurityEventRepository = (*SecurityEventRepository)(nil)
+
+// CreateEvent stores a new security event record.
+func (r *SecurityEventRepository) CreateEvent(ctx context.Context, event *entity.SecurityEvent) error {
+	_, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to create security event: %w", err)
+	}
+	return nil
+}
+
+// GetEventsByUserID retrieves a paginated list of security events for a user, newest first.
+func (r *SecurityEventRepository) GetEventsByUserID(ctx context.Context, userID string, pagination contract.Pagination) ([]*entity.SecurityEvent, int64, error) {
+	filter := bson.M{"user_id": userID}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count security events: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.M{"created_at": -1}).
+		SetSkip(int64((pagination.Page - 1) * pagination.PageSize)).
+		SetLimit(int64(pagination.PageSize))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve security events: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []*entity.SecurityEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode security events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// GetKnownCountriesForUser returns the distinct non-empty countries recorded for a user.
+func (r *SecurityEventRepository) GetKnownCountriesForUser(ctx context.Context, userID string) ([]string, error) {
+	filter := bson.M{"user_id": userID, "country": bson.M{"$ne": ""}}
+
+	values, err := r.collection.Distinct(ctx, "country", filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve known countries: %w", err)
+	}
+
+	countries := make([]string, 0, len(values))
+	for _, v := range values {
+		if country, ok := v.(string); ok {
+			countries = append(countries, country)
+		}
+	}
+	return countries, nil
+}
@@ -0,0 +1,78 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ServiceClientRepository is the MongoDB implementation of
+// IServiceClientRepository.
+type ServiceClientRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IServiceClientRepository = (*ServiceClientRepository)(nil)
+
+func NewServiceClientRepository(db *mongo.Database) *ServiceClientRepository {
+	return &ServiceClientRepository{
+		collection: db.Collection("service_clients"),
+	}
+}
+
+func (r *ServiceClientRepository) CreateServiceClient(ctx context.Context, client *entity.ServiceClient) error {
+	_, err := r.collection.InsertOne(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to create service client: %w", err)
+	}
+	return nil
+}
+
+func (r *ServiceClientRepository) GetServiceClientByID(ctx context.Context, clientID string) (*entity.ServiceClient, error) {
+	var client entity.ServiceClient
+	err := r.collection.FindOne(ctx, bson.M{"_id": clientID}).Decode(&client)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("service client not found")
+		}
+		return nil, err
+	}
+	return &client, nil
+}
+
+func (r *ServiceClientRepository) ListServiceClients(ctx context.Context) ([]*entity.ServiceClient, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var clients []*entity.ServiceClient
+	for cursor.Next(ctx) {
+		var client entity.ServiceClient
+		if err := cursor.Decode(&client); err != nil {
+			return nil, err
+		}
+		clients = append(clients, &client)
+	}
+	return clients, cursor.Err()
+}
+
+func (r *ServiceClientRepository) RevokeServiceClient(ctx context.Context, clientID string) error {
+	filter := bson.M{"_id": clientID, "revoked": false}
+	update := bson.M{"$set": bson.M{"revoked": true}}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("service client not found")
+	}
+	return nil
+}
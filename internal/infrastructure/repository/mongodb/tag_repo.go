@@ -4,22 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // TagRepository represents the MongoDB implementation of the ITagRepository interface.
 type TagRepository struct {
-	collection *mongo.Collection
+	collection        *mongo.Collection
+	followsCollection *mongo.Collection
 }
 
 // NewTagRepository creates and returns a new TagRepository instance.
 func NewTagRepository(db *mongo.Database) *TagRepository {
 	return &TagRepository{
-		collection: db.Collection("tags"),
+		collection:        db.Collection("tags"),
+		followsCollection: db.Collection("tag_follows"),
 	}
 }
 
@@ -71,6 +75,73 @@ func (r *TagRepository) GetTagByName(ctx context.Context, name string) (*entity.
 	return &tag, nil
 }
 
+// GetTagBySlug retrieves a single tag by its URL slug. Slugs of tags that
+// were merged away resolve to the surviving tag via its aliases.
+func (r *TagRepository) GetTagBySlug(ctx context.Context, slug string) (*entity.Tag, error) {
+	var tag entity.Tag
+	filter := bson.M{"$or": []bson.M{{"slug": slug}, {"aliases": slug}}}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&tag)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("tag not found")
+		}
+		return nil, fmt.Errorf("failed to retrieve tag by slug: %w", err)
+	}
+	return &tag, nil
+}
+
+// SuggestTags returns tags whose name or slug starts with the given prefix,
+// ordered by how many blogs use them. The regex is anchored so the query can
+// use the tags name and slug indexes.
+func (r *TagRepository) SuggestTags(ctx context.Context, prefix string, limit int) ([]*entity.TagSuggestion, error) {
+	pattern := "^" + regexp.QuoteMeta(prefix)
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"$or": []bson.M{
+			{"name": bson.M{"$regex": pattern, "$options": "i"}},
+			{"slug": bson.M{"$regex": pattern, "$options": "i"}},
+		}}}},
+		// Count how many (non-deleted) blogs carry each candidate tag.
+		bson.D{{Key: "$lookup", Value: bson.M{
+			"from": "blogs",
+			"let":  bson.M{"tagID": "$_id"},
+			"pipeline": mongo.Pipeline{
+				bson.D{{Key: "$match", Value: bson.M{
+					"is_deleted": false,
+					"$expr": bson.M{"$in": bson.A{
+						"$$tagID",
+						bson.M{"$ifNull": bson.A{"$tags", bson.A{}}},
+					}},
+				}}},
+				bson.D{{Key: "$count", Value: "n"}},
+			},
+			"as": "usage",
+		}}},
+		bson.D{{Key: "$addFields", Value: bson.M{
+			"usage_count": bson.M{"$ifNull": bson.A{
+				bson.M{"$arrayElemAt": bson.A{"$usage.n", 0}},
+				0,
+			}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "usage_count", Value: -1}, {Key: "name", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: limit}},
+		bson.D{{Key: "$project", Value: bson.M{"name": 1, "slug": 1, "usage_count": 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest tags: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var suggestions []*entity.TagSuggestion
+	if err = cursor.All(ctx, &suggestions); err != nil {
+		return nil, fmt.Errorf("failed to decode tag suggestions: %w", err)
+	}
+	return suggestions, nil
+}
+
 // GetAllTags retrieves all tag records from the database.
 func (r *TagRepository) GetAllTags(ctx context.Context) ([]*entity.Tag, error) {
 	cursor, err := r.collection.Find(ctx, bson.M{})
@@ -113,3 +184,138 @@ func (r *TagRepository) DeleteTag(ctx context.Context, tagID string) error {
 	}
 	return nil
 }
+
+// AddTagAliases records old slugs on a tag so they keep resolving after a merge.
+func (r *TagRepository) AddTagAliases(ctx context.Context, tagID string, aliases []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"_id": tagID}
+	update := bson.M{"$addToSet": bson.M{"aliases": bson.M{"$each": aliases}}}
+
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to add tag aliases: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("tag not found")
+	}
+	return nil
+}
+
+// ReassignTagFollowers moves followers of one tag to another. Users who
+// already follow the target keep a single follow record; their source follow
+// is dropped first so the unique (user_id, tag_id) index is never violated.
+func (r *TagRepository) ReassignTagFollowers(ctx context.Context, fromTagID, toTagID string) error {
+	// Collect users who already follow the target tag.
+	cursor, err := r.followsCollection.Find(ctx, bson.M{"tag_id": toTagID})
+	if err != nil {
+		return fmt.Errorf("failed to load target tag followers: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var existing []*entity.TagFollow
+	if err = cursor.All(ctx, &existing); err != nil {
+		return fmt.Errorf("failed to decode target tag followers: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(existing))
+	for _, follow := range existing {
+		userIDs = append(userIDs, follow.UserID)
+	}
+
+	if len(userIDs) > 0 {
+		_, err = r.followsCollection.DeleteMany(ctx, bson.M{
+			"tag_id":  fromTagID,
+			"user_id": bson.M{"$in": userIDs},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to drop duplicate tag follows: %w", err)
+		}
+	}
+
+	_, err = r.followsCollection.UpdateMany(ctx,
+		bson.M{"tag_id": fromTagID},
+		bson.M{"$set": bson.M{"tag_id": toTagID}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reassign tag followers: %w", err)
+	}
+	return nil
+}
+
+// FollowTag records that a user follows a tag. Following an already-followed
+// tag is a no-op so the operation stays idempotent.
+func (r *TagRepository) FollowTag(ctx context.Context, follow *entity.TagFollow) error {
+	if follow.CreatedAt.IsZero() {
+		follow.CreatedAt = time.Now()
+	}
+
+	filter := bson.M{"user_id": follow.UserID, "tag_id": follow.TagID}
+	update := bson.M{"$setOnInsert": bson.M{
+		"_id":        follow.ID,
+		"user_id":    follow.UserID,
+		"tag_id":     follow.TagID,
+		"created_at": follow.CreatedAt,
+	}}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.followsCollection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to follow tag: %w", err)
+	}
+	return nil
+}
+
+// UnfollowTag removes a user's follow record for a tag.
+func (r *TagRepository) UnfollowTag(ctx context.Context, userID, tagID string) error {
+	filter := bson.M{"user_id": userID, "tag_id": tagID}
+	res, err := r.followsCollection.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to unfollow tag: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return errors.New("tag not followed")
+	}
+	return nil
+}
+
+// IsFollowingTag checks whether a user currently follows a tag.
+func (r *TagRepository) IsFollowingTag(ctx context.Context, userID, tagID string) (bool, error) {
+	filter := bson.M{"user_id": userID, "tag_id": tagID}
+	count, err := r.followsCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, fmt.Errorf("failed to check tag follow: %w", err)
+	}
+	return count > 0, nil
+}
+
+// CountTagFollowers returns the number of users following a tag.
+func (r *TagRepository) CountTagFollowers(ctx context.Context, tagID string) (int64, error) {
+	count, err := r.followsCollection.CountDocuments(ctx, bson.M{"tag_id": tagID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count tag followers: %w", err)
+	}
+	return count, nil
+}
+
+// GetFollowedTagIDs returns the IDs of every tag the user follows.
+func (r *TagRepository) GetFollowedTagIDs(ctx context.Context, userID string) ([]string, error) {
+	cursor, err := r.followsCollection.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve followed tags: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var follows []*entity.TagFollow
+	if err = cursor.All(ctx, &follows); err != nil {
+		return nil, fmt.Errorf("failed to decode followed tags: %w", err)
+	}
+
+	tagIDs := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		tagIDs = append(tagIDs, follow.TagID)
+	}
+	return tagIDs, nil
+}
@@ -0,0 +1,82 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TakedownRepository is the MongoDB implementation of the ITakedownRepository
+// interface.
+type TakedownRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTakedownRepository creates and returns a new TakedownRepository
+// instance.
+func NewTakedownRepository(db *mongo.Database) *TakedownRepository {
+	return &TakedownRepository{
+		collection: db.Collection("takedown_requests"),
+	}
+}
+
+// CreateRequest inserts a new takedown request.
+func (r *TakedownRepository) CreateRequest(ctx context.Context, request *entity.TakedownRequest) error {
+	request.CreatedAt = time.Now()
+	if _, err := r.collection.InsertOne(ctx, request); err != nil {
+		return fmt.Errorf("failed to create takedown request: %w", err)
+	}
+	return nil
+}
+
+// GetRequestByID retrieves a takedown request by its ID.
+func (r *TakedownRepository) GetRequestByID(ctx context.Context, requestID string) (*entity.TakedownRequest, error) {
+	var request entity.TakedownRequest
+	err := r.collection.FindOne(ctx, bson.M{"_id": requestID}).Decode(&request)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("takedown request %s not found", requestID)
+		}
+		return nil, fmt.Errorf("failed to retrieve takedown request %s: %w", requestID, err)
+	}
+	return &request, nil
+}
+
+// ListRequests returns takedown requests newest first, optionally filtered
+// by status.
+func (r *TakedownRepository) ListRequests(ctx context.Context, status *entity.TakedownStatus) ([]*entity.TakedownRequest, error) {
+	filter := bson.M{}
+	if status != nil && *status != "" {
+		filter["status"] = *status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list takedown requests: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var requests []*entity.TakedownRequest
+	if err := cursor.All(ctx, &requests); err != nil {
+		return nil, fmt.Errorf("failed to decode takedown requests: %w", err)
+	}
+	return requests, nil
+}
+
+// UpdateRequest replaces a takedown request.
+func (r *TakedownRepository) UpdateRequest(ctx context.Context, request *entity.TakedownRequest) error {
+	res, err := r.collection.ReplaceOne(ctx, bson.M{"_id": request.ID}, request)
+	if err != nil {
+		return fmt.Errorf("failed to update takedown request %s: %w", request.ID, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("takedown request %s not found", request.ID)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TipRepository is the MongoDB implementation of ITipRepository.
+type TipRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.ITipRepository = (*TipRepository)(nil)
+
+func NewTipRepository(db *mongo.Database) *TipRepository {
+	return &TipRepository{
+		collection: db.Collection("tips"),
+	}
+}
+
+func (r *TipRepository) CreateTip(ctx context.Context, tip *entity.Tip) error {
+	_, err := r.collection.InsertOne(ctx, tip)
+	if err != nil {
+		return fmt.Errorf("failed to create tip: %w", err)
+	}
+	return nil
+}
+
+// MarkTipCompleted records the provider's payment confirmation. Re-marking a
+// completed tip is a no-op so duplicate webhook deliveries are harmless.
+func (r *TipRepository) MarkTipCompleted(ctx context.Context, tipID string) error {
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"status":       entity.TipStatusCompleted,
+		"completed_at": now,
+	}}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": tipID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to mark tip completed: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("tip not found")
+	}
+	return nil
+}
+
+func (r *TipRepository) GetEarningsByAuthorID(ctx context.Context, authorID string) (*entity.AuthorEarnings, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"author_id": authorID, "status": entity.TipStatusCompleted}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":         nil,
+			"total_cents": bson.M{"$sum": "$amount_cents"},
+			"tip_count":   bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate earnings: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []entity.AuthorEarnings
+	if err = cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("failed to decode earnings: %w", err)
+	}
+	if len(results) == 0 {
+		return &entity.AuthorEarnings{}, nil
+	}
+	return &results[0], nil
+}
+
+func (r *TipRepository) ListTipsByAuthorID(ctx context.Context, authorID string, limit int) ([]*entity.Tip, error) {
+	filter := bson.M{"author_id": authorID, "status": entity.TipStatusCompleted}
+	opts := options.Find().SetSort(bson.M{"completed_at": -1}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tips: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var tips []*entity.Tip
+	if err = cursor.All(ctx, &tips); err != nil {
+		return nil, fmt.Errorf("failed to decode tips: %w", err)
+	}
+	return tips, nil
+}
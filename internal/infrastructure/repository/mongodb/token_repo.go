@@ -9,43 +9,56 @@ import (
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // ---------- DTO layer ------------------
 type tokenDTO struct {
-	ID        string    `bson:"_id"`
-	UserID    string    `bson:"user_id"`
-	TokenType string    `bson:"token_type"`
-	TokenHash string    `bson:"token_hash"`
-	Verifier  string    `bson:"verifier"`
-	CreatedAt time.Time `bson:"created_at"`
-	ExpiresAt time.Time `bson:"expires_at"`
-	Revoke    bool      `bson:"revoke"`
+	ID         string    `bson:"_id"`
+	UserID     string    `bson:"user_id"`
+	TokenType  string    `bson:"token_type"`
+	TokenHash  string    `bson:"token_hash"`
+	Verifier   string    `bson:"verifier"`
+	CreatedAt  time.Time `bson:"created_at"`
+	ExpiresAt  time.Time `bson:"expires_at"`
+	Revoke     bool      `bson:"revoke"`
+	DeviceName string    `bson:"device_name,omitempty"`
+	UserAgent  string    `bson:"user_agent,omitempty"`
+	IPAddress  string    `bson:"ip_address,omitempty"`
+	LastUsedAt time.Time `bson:"last_used_at,omitempty"`
 }
 
 func (t *tokenDTO) ToEntity() *entity.Token {
 	return &entity.Token{
-		ID:        t.ID,
-		UserID:    t.UserID,
-		TokenType: entity.TokenType(t.TokenType),
-		Verifier:  t.Verifier,
-		TokenHash: t.TokenHash,
-		CreatedAt: t.CreatedAt,
-		ExpiresAt: t.ExpiresAt,
-		Revoke:    t.Revoke,
+		ID:         t.ID,
+		UserID:     t.UserID,
+		TokenType:  entity.TokenType(t.TokenType),
+		Verifier:   t.Verifier,
+		TokenHash:  t.TokenHash,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		Revoke:     t.Revoke,
+		DeviceName: t.DeviceName,
+		UserAgent:  t.UserAgent,
+		IPAddress:  t.IPAddress,
+		LastUsedAt: t.LastUsedAt,
 	}
 }
 
 func FromTokenEntityToDTO(t *entity.Token) *tokenDTO {
 	return &tokenDTO{
-		ID:        t.ID,
-		UserID:    t.UserID,
-		TokenType: string(t.TokenType),
-		Verifier:  t.Verifier,
-		TokenHash: t.TokenHash,
-		CreatedAt: t.CreatedAt,
-		ExpiresAt: t.ExpiresAt,
-		Revoke:    t.Revoke,
+		ID:         t.ID,
+		UserID:     t.UserID,
+		TokenType:  string(t.TokenType),
+		Verifier:   t.Verifier,
+		TokenHash:  t.TokenHash,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		Revoke:     t.Revoke,
+		DeviceName: t.DeviceName,
+		UserAgent:  t.UserAgent,
+		IPAddress:  t.IPAddress,
+		LastUsedAt: t.LastUsedAt,
 	}
 }
 
@@ -98,10 +111,61 @@ func (r *TokenRepository) GetTokenByUserID(ctx context.Context, userID string) (
 	return token, nil
 }
 
-// UpdateToken updates the token hash and expiry
+// GetActiveTokenByUserIDAndType returns the newest unrevoked, unexpired token
+// of the given type for a user. A user routinely holds several token kinds at
+// once (refresh, verification, reset), so lookups must filter by type.
+func (r *TokenRepository) GetActiveTokenByUserIDAndType(ctx context.Context, userID string, tokenType entity.TokenType) (*entity.Token, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"token_type": string(tokenType),
+		"revoke":     false,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+	opts := options.FindOne().SetSort(bson.M{"created_at": -1})
+	var dto tokenDTO
+	err := r.Collection.FindOne(ctx, filter, opts).Decode(&dto)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("token not found")
+		}
+		return nil, err
+	}
+
+	return dto.ToEntity(), nil
+}
+
+// ListActiveTokensByUserIDAndType returns every unrevoked, unexpired token of
+// the given type for a user, newest first. Used to list active sessions.
+func (r *TokenRepository) ListActiveTokensByUserIDAndType(ctx context.Context, userID string, tokenType entity.TokenType) ([]*entity.Token, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"token_type": string(tokenType),
+		"revoke":     false,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+	cursor, err := r.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tokens []*entity.Token
+	for cursor.Next(ctx) {
+		var dto tokenDTO
+		if err := cursor.Decode(&dto); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, dto.ToEntity())
+	}
+	return tokens, cursor.Err()
+}
+
+// UpdateToken updates the token hash and expiry. Rotating the hash means the
+// session was just used, so the last-used timestamp advances with it.
 func (r *TokenRepository) UpdateToken(ctx context.Context, tokenID string, tokenHash string, expiry time.Time) error {
 	filter := bson.M{"_id": tokenID}
-	update := bson.M{"$set": bson.M{"token_hash": tokenHash, "expires_at": expiry}}
+	update := bson.M{"$set": bson.M{"token_hash": tokenHash, "expires_at": expiry, "last_used_at": time.Now()}}
 	_, err := r.Collection.UpdateOne(ctx, filter, update)
 	return err
 }
@@ -135,7 +199,24 @@ func (r *TokenRepository) RevokeToken(ctx context.Context, id string) error {
 	return nil
 }
 
-// GetTokenByUserID retrieves a token by user ID (string).
+// ConsumeToken atomically marks a token as revoked, failing if it was already
+// revoked. This makes single-use tokens safe against concurrent requests.
+func (r *TokenRepository) ConsumeToken(ctx context.Context, id string) error {
+	filter := bson.M{"_id": id, "revoke": false}
+	update := bson.M{"$set": bson.M{"revoke": true}}
+	result, err := r.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("token already used or not found: %v", id)
+	}
+
+	return nil
+}
+
+// RevokeAllTokensForUser revokes every active token of the given type for a user.
 func (r *TokenRepository) RevokeAllTokensForUser(ctx context.Context, userID string, tokenType entity.TokenType) error {
 	filter := bson.D{
 		{Key: "user_id", Value: userID},
@@ -146,7 +227,7 @@ func (r *TokenRepository) RevokeAllTokensForUser(ctx context.Context, userID str
 		{Key: "$set", Value: bson.M{"revoke": true}},
 	}
 
-	_, err := r.Collection.UpdateOne(ctx, filter, update)
+	_, err := r.Collection.UpdateMany(ctx, filter, update)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return fmt.Errorf("token not found")
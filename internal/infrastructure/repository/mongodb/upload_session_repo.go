@@ -0,0 +1,88 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UploadSessionRepository is the MongoDB implementation of
+// IUploadSessionRepository, tracking chunked uploads in "upload_sessions".
+type UploadSessionRepository struct {
+	collection *mongo.Collection
+}
+
+var _ contract.IUploadSessionRepository = (*UploadSessionRepository)(nil)
+
+func NewUploadSessionRepository(db *mongo.Database) *UploadSessionRepository {
+	return &UploadSessionRepository{
+		collection: db.Collection("upload_sessions"),
+	}
+}
+
+func (r *UploadSessionRepository) CreateSession(ctx context.Context, session *entity.UploadSession) error {
+	_, err := r.collection.InsertOne(ctx, session)
+	if err != nil {
+		return fmt.Errorf("failed to create upload session: %w", err)
+	}
+	return nil
+}
+
+func (r *UploadSessionRepository) GetSessionByID(ctx context.Context, sessionID string) (*entity.UploadSession, error) {
+	var session entity.UploadSession
+	err := r.collection.FindOne(ctx, bson.M{"_id": sessionID}).Decode(&session)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("upload session not found")
+		}
+		return nil, fmt.Errorf("failed to get upload session: %w", err)
+	}
+	return &session, nil
+}
+
+func (r *UploadSessionRepository) UpdateReceived(ctx context.Context, sessionID string, receivedSize int64) error {
+	update := bson.M{
+		"$set": bson.M{"received_size": receivedSize, "updated_at": time.Now()},
+		"$inc": bson.M{"chunk_count": 1},
+	}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": sessionID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update upload session: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("upload session not found")
+	}
+	return nil
+}
+
+func (r *UploadSessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": sessionID})
+	if err != nil {
+		return fmt.Errorf("failed to delete upload session: %w", err)
+	}
+	return nil
+}
+
+func (r *UploadSessionRepository) ListExpiredSessions(ctx context.Context, now time.Time, limit int) ([]*entity.UploadSession, error) {
+	opts := options.Find().
+		SetSort(bson.M{"expires_at": 1}).
+		SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, bson.M{"expires_at": bson.M{"$lt": now}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired upload sessions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []*entity.UploadSession
+	if err := cursor.All(ctx, &sessions); err != nil {
+		return nil, fmt.Errorf("failed to decode upload sessions: %w", err)
+	}
+	return sessions, nil
+}
@@ -21,8 +21,24 @@ func NewMongoUserRepository(collection *mongo.Collection) *MongoUserRepository {
 	return &MongoUserRepository{collection: collection}
 }
 
+// CreateUser inserts the user. Email and username uniqueness is enforced by
+// the database's unique indexes, so two concurrent registrations that both
+// pass the usecase's existence checks cannot both land; the loser gets a
+// clean conflict error instead of a raw duplicate-key error.
 func (r *MongoUserRepository) CreateUser(ctx context.Context, user *entity.User) error {
 	_, err := r.collection.InsertOne(ctx, user)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New("user with that email or username already exists")
+	}
+	return err
+}
+
+func (r *MongoUserRepository) CreateUsers(ctx context.Context, users []*entity.User) error {
+	docs := make([]interface{}, len(users))
+	for i, user := range users {
+		docs[i] = user
+	}
+	_, err := r.collection.InsertMany(ctx, docs)
 	return err
 }
 
@@ -102,13 +118,115 @@ func (r *MongoUserRepository) UpdateUserPassword(ctx context.Context, id string,
 	return nil
 }
 
+// GetShadowBannedUsers retrieves all users that are currently shadow banned.
+func (r *MongoUserRepository) GetShadowBannedUsers(ctx context.Context) ([]*entity.User, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"is_shadow_banned": true})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*entity.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// ListUsersByCommentDigest retrieves the users who opted into a comment
+// digest at the given frequency.
+func (r *MongoUserRepository) ListUsersByCommentDigest(ctx context.Context, frequency string) ([]*entity.User, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"comment_digest": frequency})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*entity.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// IncrementReputation adds delta to the user's reputation points.
+func (r *MongoUserRepository) IncrementReputation(ctx context.Context, id string, delta int) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$inc": bson.M{"reputation": delta}})
+	return err
+}
+
+// GetUserByReferralCode retrieves the user owning a referral code.
+func (r *MongoUserRepository) GetUserByReferralCode(ctx context.Context, code string) (*entity.User, error) {
+	var user entity.User
+	err := r.collection.FindOne(ctx, bson.M{"referral_code": code}).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("user not found")
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// ListUsersByReferrer retrieves the users who signed up with the given
+// user's referral code.
+func (r *MongoUserRepository) ListUsersByReferrer(ctx context.Context, referrerID string) ([]*entity.User, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"referred_by": referrerID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*entity.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// ListUsersByPerformanceReport retrieves the authors who opted into a
+// performance report at the given frequency.
+func (r *MongoUserRepository) ListUsersByPerformanceReport(ctx context.Context, frequency string) ([]*entity.User, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"performance_report": frequency})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*entity.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// DeleteUser soft-deletes the user and scrubs their PII. The record is kept
+// so content attribution and referential integrity survive.
 func (r *MongoUserRepository) DeleteUser(ctx context.Context, id string) error {
-	filter := bson.M{"_id": id}
-	count, err := r.collection.DeleteOne(ctx, filter)
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"is_deleted": true,
+			"is_active":  false,
+			"deleted_at": now,
+			"updated_at": now,
+		},
+		// Email and username are unset rather than blanked so the sparse
+		// unique indexes skip the record and later deletions don't collide.
+		"$unset": bson.M{
+			"username":      "",
+			"email":         "",
+			"password_hash": "",
+			"firstname":     "",
+			"lastname":      "",
+			"avatar_url":    "",
+		},
+	}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
 	if err != nil {
 		return err
 	}
-	if count.DeletedCount == 0 {
+	if res.MatchedCount == 0 {
 		return fmt.Errorf("failed to fetch user with id:%s", id)
 	}
 	return nil
@@ -0,0 +1,153 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/metrics"
+)
+
+// ErrCircuitOpen is returned without calling the backend while the breaker
+// is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// breaker states, mirrored into the Prometheus gauge.
+const (
+	stateClosed   = 0
+	stateHalfOpen = 1
+	stateOpen     = 2
+)
+
+// Policy wraps calls to one external service with a timeout, retries and a
+// circuit breaker, so a slow or failing backend sheds load quickly instead
+// of tying up handler goroutines.
+type Policy struct {
+	name             string        // service label in metrics
+	timeout          time.Duration // per-attempt deadline
+	maxAttempts      int           // attempts per call, including the first
+	retryDelay       time.Duration // pause between attempts
+	failureThreshold int           // consecutive failures that open the breaker
+	cooldown         time.Duration // how long the breaker stays open
+
+	mu       sync.Mutex
+	state    int
+	failures int
+	openedAt time.Time
+	probing  bool // a half-open probe is in flight
+}
+
+// NewPolicy creates a policy for the named service. The name labels the
+// breaker state metric.
+func NewPolicy(name string, timeout time.Duration, maxAttempts, failureThreshold int, cooldown time.Duration) *Policy {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	if failureThreshold < 1 {
+		failureThreshold = 5
+	}
+	p := &Policy{
+		name:             name,
+		timeout:          timeout,
+		maxAttempts:      maxAttempts,
+		retryDelay:       500 * time.Millisecond,
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+	}
+	metrics.SetBreakerState(name, stateClosed)
+	return p
+}
+
+// Execute runs the call under the policy: each attempt gets its own
+// deadline, failed attempts are retried, and consecutive failures open the
+// breaker for the cooldown period.
+func (p *Policy) Execute(ctx context.Context, call func(ctx context.Context) error) error {
+	if err := p.allow(); err != nil {
+		return fmt.Errorf("%s: %w", p.name, err)
+	}
+
+	var err error
+	for attempt := 1; attempt <= p.maxAttempts; attempt++ {
+		err = p.attempt(ctx, call)
+		if err == nil {
+			p.recordSuccess()
+			return nil
+		}
+		if ctx.Err() != nil {
+			// The caller is gone; further attempts are wasted work.
+			break
+		}
+		if attempt < p.maxAttempts {
+			time.Sleep(p.retryDelay)
+		}
+	}
+	p.recordFailure()
+	return err
+}
+
+// attempt runs one call under the per-attempt deadline.
+func (p *Policy) attempt(ctx context.Context, call func(ctx context.Context) error) error {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	return call(ctx)
+}
+
+// allow gates a call on the breaker state: open rejects immediately, and
+// after the cooldown a single probe is let through half-open.
+func (p *Policy) allow() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch p.state {
+	case stateOpen:
+		if time.Since(p.openedAt) < p.cooldown {
+			return ErrCircuitOpen
+		}
+		p.setState(stateHalfOpen)
+		p.probing = true
+		return nil
+	case stateHalfOpen:
+		if p.probing {
+			return ErrCircuitOpen
+		}
+		p.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// recordSuccess closes the breaker and resets the failure streak.
+func (p *Policy) recordSuccess() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failures = 0
+	p.probing = false
+	if p.state != stateClosed {
+		p.setState(stateClosed)
+	}
+}
+
+// recordFailure counts the failure and opens the breaker once the streak
+// reaches the threshold. A failed half-open probe reopens it immediately.
+func (p *Policy) recordFailure() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failures++
+	p.probing = false
+	if p.state == stateHalfOpen || p.failures >= p.failureThreshold {
+		p.openedAt = time.Now()
+		p.setState(stateOpen)
+	}
+}
+
+// setState transitions the breaker and mirrors the state into metrics. The
+// caller must hold the mutex.
+func (p *Policy) setState(state int) {
+	p.state = state
+	metrics.SetBreakerState(p.name, state)
+}
@@ -0,0 +1,59 @@
+package resilience
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// AIService wraps an IAIService with a resilience policy.
+type AIService struct {
+	inner  usecasecontract.IAIService
+	policy *Policy
+}
+
+var _ usecasecontract.IAIService = (*AIService)(nil)
+
+// WrapAIService puts the policy in front of every AI backend call.
+func WrapAIService(inner usecasecontract.IAIService, policy *Policy) *AIService {
+	return &AIService{inner: inner, policy: policy}
+}
+
+func (s *AIService) GenerateContent(ctx context.Context, prompt string) (string, error) {
+	var result string
+	err := s.policy.Execute(ctx, func(ctx context.Context) error {
+		var callErr error
+		result, callErr = s.inner.GenerateContent(ctx, prompt)
+		return callErr
+	})
+	if err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// EmailService wraps an IEmailService with a resilience policy.
+type EmailService struct {
+	inner  contract.IEmailService
+	policy *Policy
+}
+
+var _ contract.IEmailService = (*EmailService)(nil)
+
+// WrapEmailService puts the policy in front of every SMTP delivery.
+func WrapEmailService(inner contract.IEmailService, policy *Policy) *EmailService {
+	return &EmailService{inner: inner, policy: policy}
+}
+
+func (s *EmailService) SendEmail(ctx context.Context, to, subject, body string) error {
+	return s.policy.Execute(ctx, func(ctx context.Context) error {
+		return s.inner.SendEmail(ctx, to, subject, body)
+	})
+}
+
+func (s *EmailService) SendEmailWithReplyTo(ctx context.Context, to, subject, body, replyTo string) error {
+	return s.policy.Execute(ctx, func(ctx context.Context) error {
+		return s.inner.SendEmailWithReplyTo(ctx, to, subject, body, replyTo)
+	})
+}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// LocalFileStorage implements IFileStorage on the local filesystem. Files
+// are written under a single directory and served from the /uploads static
+// route.
+type LocalFileStorage struct {
+	dir     string
+	baseURL string
+}
+
+var _ contract.IFileStorage = (*LocalFileStorage)(nil)
+
+// NewLocalFileStorage creates a store writing into dir; served URLs are
+// rooted at baseURL.
+func NewLocalFileStorage(dir, baseURL string) *LocalFileStorage {
+	return &LocalFileStorage{dir: dir, baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// Save writes the bytes under fileName and returns the URL the file is
+// served from. The name must not contain path separators.
+func (s *LocalFileStorage) Save(ctx context.Context, fileName string, data []byte) (string, error) {
+	if fileName == "" || fileName != filepath.Base(fileName) {
+		return "", fmt.Errorf("invalid file name %q", fileName)
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create upload directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(s.dir, fileName), data, 0o644); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	return s.baseURL + "/uploads/" + fileName, nil
+}
+
+// Append adds bytes to the end of a stored file, creating it when missing.
+func (s *LocalFileStorage) Append(ctx context.Context, fileName string, data []byte) error {
+	if fileName == "" || fileName != filepath.Base(fileName) {
+		return fmt.Errorf("invalid file name %q", fileName)
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create upload directory: %w", err)
+	}
+	f, err := os.OpenFile(filepath.Join(s.dir, fileName), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("failed to append to file: %w", err)
+	}
+	return nil
+}
+
+// Load reads a stored file back into memory.
+func (s *LocalFileStorage) Load(ctx context.Context, fileName string) ([]byte, error) {
+	if fileName == "" || fileName != filepath.Base(fileName) {
+		return nil, fmt.Errorf("invalid file name %q", fileName)
+	}
+	data, err := os.ReadFile(filepath.Join(s.dir, fileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes a stored file; deleting a file that is already gone is not
+// an error.
+func (s *LocalFileStorage) Delete(ctx context.Context, fileName string) error {
+	if fileName == "" || fileName != filepath.Base(fileName) {
+		return fmt.Errorf("invalid file name %q", fileName)
+	}
+	err := os.Remove(filepath.Join(s.dir, fileName))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
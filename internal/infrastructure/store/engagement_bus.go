@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// engagementBufferSize is the per-subscriber channel buffer. Updates beyond
+// it are dropped for that subscriber; snapshots make the next update a full
+// catch-up.
+const engagementBufferSize = 8
+
+// EngagementBus is an in-process implementation of IEngagementBus backed by
+// per-blog subscriber sets.
+type EngagementBus struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan entity.EngagementUpdate]struct{}
+	presence    contract.IPresenceTracker // optional, enriches updates with the reader count
+}
+
+var _ contract.IEngagementBus = (*EngagementBus)(nil)
+
+func NewEngagementBus() *EngagementBus {
+	return &EngagementBus{
+		subscribers: make(map[string]map[chan entity.EngagementUpdate]struct{}),
+	}
+}
+
+// SetPresenceTracker enriches every published update with the current
+// active-reader count.
+func (b *EngagementBus) SetPresenceTracker(presence contract.IPresenceTracker) {
+	b.presence = presence
+}
+
+func (b *EngagementBus) Publish(update entity.EngagementUpdate) {
+	if b.presence != nil {
+		if readers, err := b.presence.CountActiveReaders(context.Background(), update.BlogID); err == nil {
+			update.Readers = readers
+		}
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subscribers[update.BlogID] {
+		select {
+		case ch <- update:
+		default:
+			// Subscriber is not keeping up; drop the update rather than
+			// blocking the publisher.
+		}
+	}
+}
+
+func (b *EngagementBus) Subscribe(blogID string) (<-chan entity.EngagementUpdate, func()) {
+	ch := make(chan entity.EngagementUpdate, engagementBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[blogID] == nil {
+		b.subscribers[blogID] = make(map[chan entity.EngagementUpdate]struct{})
+	}
+	b.subscribers[blogID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	cancel := func() {
+		b.mu.Lock()
+		delete(b.subscribers[blogID], ch)
+		if len(b.subscribers[blogID]) == 0 {
+			delete(b.subscribers, blogID)
+		}
+		b.mu.Unlock()
+	}
+	return ch, cancel
+}
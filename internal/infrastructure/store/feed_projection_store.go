@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+const (
+	// maxFeedEntries bounds each sorted set; older entries fall off the end.
+	maxFeedEntries = 1000
+	// feedUnionTTL is how long a merged per-tag result set is kept; repeat
+	// page requests within the window reuse it.
+	feedUnionTTL = time.Minute
+)
+
+// FeedProjectionStore keeps the feed projection in Redis sorted sets:
+// feed:latest and feed:popular for the site-wide segments and feed:tag:<id>
+// per tag, with a reverse index per blog so removal can clean up every list.
+type FeedProjectionStore struct {
+	rdb *redis.Client
+}
+
+var _ contract.IFeedProjection = (*FeedProjectionStore)(nil)
+
+func NewFeedProjectionStore(rdb *redis.Client) *FeedProjectionStore {
+	return &FeedProjectionStore{rdb: rdb}
+}
+
+func feedTagKey(tagID string) string   { return fmt.Sprintf("feed:tag:%s", tagID) }
+func feedBlogKey(blogID string) string { return fmt.Sprintf("feed:blog:%s", blogID) }
+
+func (s *FeedProjectionStore) UpsertBlog(ctx context.Context, blogID string, tagIDs []string, publishedAt time.Time, popularity float64) error {
+	// Re-tagging can drop tags, so clean up the old entries first.
+	if err := s.RemoveBlog(ctx, blogID); err != nil {
+		return err
+	}
+
+	recency := redis.Z{Score: float64(publishedAt.Unix()), Member: blogID}
+	pipe := s.rdb.Pipeline()
+	pipe.ZAdd(ctx, "feed:latest", recency)
+	pipe.ZRemRangeByRank(ctx, "feed:latest", 0, -(maxFeedEntries + 1))
+	pipe.ZAdd(ctx, "feed:popular", redis.Z{Score: popularity, Member: blogID})
+	pipe.ZRemRangeByRank(ctx, "feed:popular", 0, -(maxFeedEntries + 1))
+	for _, tagID := range tagIDs {
+		pipe.ZAdd(ctx, feedTagKey(tagID), recency)
+		pipe.ZRemRangeByRank(ctx, feedTagKey(tagID), 0, -(maxFeedEntries + 1))
+	}
+	if len(tagIDs) > 0 {
+		pipe.SAdd(ctx, feedBlogKey(blogID), tagIDs)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *FeedProjectionStore) RemoveBlog(ctx context.Context, blogID string) error {
+	tagIDs, err := s.rdb.SMembers(ctx, feedBlogKey(blogID)).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	pipe := s.rdb.Pipeline()
+	pipe.ZRem(ctx, "feed:latest", blogID)
+	pipe.ZRem(ctx, "feed:popular", blogID)
+	for _, tagID := range tagIDs {
+		pipe.ZRem(ctx, feedTagKey(tagID), blogID)
+	}
+	pipe.Del(ctx, feedBlogKey(blogID))
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (s *FeedProjectionStore) LatestBlogIDs(ctx context.Context, offset, limit int) ([]string, int64, error) {
+	return s.pageSet(ctx, "feed:latest", offset, limit)
+}
+
+func (s *FeedProjectionStore) PopularBlogIDs(ctx context.Context, offset, limit int) ([]string, int64, error) {
+	return s.pageSet(ctx, "feed:popular", offset, limit)
+}
+
+func (s *FeedProjectionStore) BlogIDsForTags(ctx context.Context, tagIDs []string, offset, limit int) ([]string, int64, error) {
+	if len(tagIDs) == 0 {
+		return []string{}, 0, nil
+	}
+	if len(tagIDs) == 1 {
+		return s.pageSet(ctx, feedTagKey(tagIDs[0]), offset, limit)
+	}
+
+	// Merge into a short-lived union key so pagination pages through a
+	// stable, cheap-to-reuse set.
+	sum := sha256.Sum256([]byte(strings.Join(tagIDs, ",")))
+	unionKey := "feed:union:" + hex.EncodeToString(sum[:16])
+
+	keys := make([]string, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		keys = append(keys, feedTagKey(tagID))
+	}
+	if err := s.rdb.ZUnionStore(ctx, unionKey, &redis.ZStore{Keys: keys, Aggregate: "MAX"}).Err(); err != nil {
+		return nil, 0, err
+	}
+	s.rdb.Expire(ctx, unionKey, feedUnionTTL)
+
+	return s.pageSet(ctx, unionKey, offset, limit)
+}
+
+// pageSet returns one page of a sorted set, highest score first, plus the
+// set's total size.
+func (s *FeedProjectionStore) pageSet(ctx context.Context, key string, offset, limit int) ([]string, int64, error) {
+	ids, err := s.rdb.ZRevRange(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, 0, err
+	}
+	total, err := s.rdb.ZCard(ctx, key).Result()
+	if err != nil && err != redis.Nil {
+		return nil, 0, err
+	}
+	return ids, total, nil
+}
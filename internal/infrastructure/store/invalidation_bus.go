@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// invalidationChannel is the Redis pub/sub channel cache invalidations are
+// broadcast on; every API instance subscribes to it.
+const invalidationChannel = "cache:invalidate"
+
+// InvalidationBus implements ICacheInvalidationBus on Redis pub/sub. Each
+// broadcast carries the invalidated topic as its payload; the publishing
+// instance receives its own broadcasts too, which is harmless (it re-reads
+// state it just wrote).
+type InvalidationBus struct {
+	rdb *redis.Client
+
+	mu       sync.RWMutex
+	handlers []func(topic string)
+}
+
+var _ contract.ICacheInvalidationBus = (*InvalidationBus)(nil)
+
+func NewInvalidationBus(rdb *redis.Client) *InvalidationBus {
+	b := &InvalidationBus{rdb: rdb}
+	go b.listen()
+	return b
+}
+
+// PublishInvalidation broadcasts that cached state under topic is stale.
+// Failures are logged and swallowed: subscribers still converge via their
+// periodic refresh.
+func (b *InvalidationBus) PublishInvalidation(ctx context.Context, topic string) {
+	if err := b.rdb.Publish(ctx, invalidationChannel, topic).Err(); err != nil {
+		log.Printf("failed to publish cache invalidation for %s: %v", topic, err)
+	}
+}
+
+// SubscribeInvalidations registers a handler for every broadcast topic.
+func (b *InvalidationBus) SubscribeInvalidations(handler func(topic string)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// listen delivers broadcasts to the registered handlers for the life of the
+// process; go-redis reconnects the subscription after connection loss.
+func (b *InvalidationBus) listen() {
+	pubsub := b.rdb.Subscribe(context.Background(), invalidationChannel)
+	for msg := range pubsub.Channel() {
+		b.mu.RLock()
+		handlers := b.handlers
+		b.mu.RUnlock()
+		for _, handler := range handlers {
+			handler(msg.Payload)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+)
+
+// presenceTTL is how long a heartbeat keeps a reader in the active count;
+// clients are expected to heartbeat well within it.
+const presenceTTL = 45 * time.Second
+
+// PresenceStore tracks active readers per blog in a Redis sorted set keyed
+// by heartbeat time, so stale readers decay out of the count.
+type PresenceStore struct {
+	rdb *redis.Client
+}
+
+var _ contract.IPresenceTracker = (*PresenceStore)(nil)
+
+func NewPresenceStore(rdb *redis.Client) *PresenceStore {
+	return &PresenceStore{rdb: rdb}
+}
+
+func presenceKey(blogID string) string { return fmt.Sprintf("presence:blog:%s", blogID) }
+
+func (s *PresenceStore) Heartbeat(ctx context.Context, blogID, readerID string) error {
+	key := presenceKey(blogID)
+	now := time.Now().Unix()
+
+	pipe := s.rdb.Pipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now-int64(presenceTTL.Seconds()), 10))
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now), Member: readerID})
+	pipe.Expire(ctx, key, 2*presenceTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *PresenceStore) CountActiveReaders(ctx context.Context, blogID string) (int64, error) {
+	cutoff := time.Now().Add(-presenceTTL).Unix()
+	return s.rdb.ZCount(ctx, presenceKey(blogID), strconv.FormatInt(cutoff, 10), "+inf").Result()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -13,16 +14,18 @@ import (
 )
 
 type BlogCacheStore struct {
-	rdb       *redis.Client
-	detailTTL time.Duration
-	listTTL   time.Duration
+	rdb        *redis.Client
+	detailTTL  time.Duration
+	listTTL    time.Duration
+	suggestTTL time.Duration
 }
 
 func NewBlogCacheStore(rdb *redis.Client) *BlogCacheStore {
 	return &BlogCacheStore{
-		rdb:       rdb,
-		detailTTL: 60 * time.Minute, // 60 minutes
-		listTTL:   30 * time.Minute, // 30 minutes
+		rdb:        rdb,
+		detailTTL:  60 * time.Minute, // 60 minutes
+		listTTL:    30 * time.Minute, // 30 minutes
+		suggestTTL: 5 * time.Minute,  // type-ahead results go stale quickly
 	}
 }
 
@@ -132,3 +135,159 @@ func (c *BlogCacheStore) AddRecentViewByUser(ctx context.Context, userID, ip str
 func (c *BlogCacheStore) GetRecentIPCountByUser(ctx context.Context, userID string) (int64, error) {
 	return c.rdb.SCard(ctx, recentViewsByUserKey(userID)).Result()
 }
+
+// --- Search Type-Ahead Caching ---
+// Title suggestions are cached per prefix; executed search queries are
+// tallied in a sorted set so the most popular ones can be suggested.
+
+const searchQueriesKey = "blog:search:queries"
+
+func (c *BlogCacheStore) GetBlogSuggestions(ctx context.Context, key string) ([]*entity.BlogSuggestion, bool, error) {
+	b, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	var suggestions []*entity.BlogSuggestion
+	if err := json.Unmarshal(b, &suggestions); err != nil {
+		return nil, false, nil
+	}
+	return suggestions, true, nil
+}
+
+func (c *BlogCacheStore) SetBlogSuggestions(ctx context.Context, key string, suggestions []*entity.BlogSuggestion) error {
+	data, err := json.Marshal(suggestions)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Set(ctx, key, data, c.suggestTTL).Err()
+}
+
+// RecordSearchQuery bumps the query's score in the popularity tally.
+func (c *BlogCacheStore) RecordSearchQuery(ctx context.Context, query string) error {
+	return c.rdb.ZIncrBy(ctx, searchQueriesKey, 1, query).Err()
+}
+
+// GetPopularSearchQueries returns the most-executed search queries, most
+// popular first.
+func (c *BlogCacheStore) GetPopularSearchQueries(ctx context.Context, limit int) ([]string, error) {
+	return c.rdb.ZRevRange(ctx, searchQueriesKey, 0, int64(limit-1)).Result()
+}
+
+// --- Tag Suggestion Caching ---
+
+// TagCacheStore caches tag auto-complete suggestions in Redis.
+type TagCacheStore struct {
+	rdb        *redis.Client
+	suggestTTL time.Duration
+}
+
+func NewTagCacheStore(rdb *redis.Client) *TagCacheStore {
+	return &TagCacheStore{
+		rdb:        rdb,
+		suggestTTL: 5 * time.Minute, // suggestions go stale quickly as usage shifts
+	}
+}
+
+func (c *TagCacheStore) GetTagSuggestions(ctx context.Context, key string) ([]*entity.TagSuggestion, bool, error) {
+	b, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	var suggestions []*entity.TagSuggestion
+	if err := json.Unmarshal(b, &suggestions); err != nil {
+		return nil, false, nil
+	}
+	return suggestions, true, nil
+}
+
+func (c *TagCacheStore) SetTagSuggestions(ctx context.Context, key string, suggestions []*entity.TagSuggestion) error {
+	data, err := json.Marshal(suggestions)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Set(ctx, key, data, c.suggestTTL).Err()
+}
+
+type RateLimitCounterStore struct {
+	rdb *redis.Client
+}
+
+var _ contract.IRateLimitCounter = (*RateLimitCounterStore)(nil)
+
+func NewRateLimitCounterStore(rdb *redis.Client) *RateLimitCounterStore {
+	return &RateLimitCounterStore{rdb: rdb}
+}
+
+func (c *RateLimitCounterStore) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	count, err := c.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	// Only the first increment starts the window; later ones must not extend it.
+	if count == 1 {
+		if err := c.rdb.Expire(ctx, key, window).Err(); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+func (c *RateLimitCounterStore) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return c.rdb.TTL(ctx, key).Result()
+}
+
+// AIResponseCacheStore caches AI responses in Redis keyed by prompt type and
+// content hash, so unchanged content skips the AI backend.
+type AIResponseCacheStore struct {
+	rdb *redis.Client
+	mu  sync.RWMutex
+	ttl time.Duration
+}
+
+var _ contract.IAIResponseCache = (*AIResponseCacheStore)(nil)
+
+func NewAIResponseCacheStore(rdb *redis.Client, ttl time.Duration) *AIResponseCacheStore {
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	return &AIResponseCacheStore{rdb: rdb, ttl: ttl}
+}
+
+// SetTTL changes the lifetime applied to newly cached responses; existing
+// entries keep the TTL they were stored with. Used by hot config reload.
+func (c *AIResponseCacheStore) SetTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+}
+
+func aiResponseKey(promptType, contentHash string) string {
+	return fmt.Sprintf("ai:resp:%s:%s", promptType, contentHash)
+}
+
+func (c *AIResponseCacheStore) GetResponse(ctx context.Context, promptType, contentHash string) (string, bool, error) {
+	response, err := c.rdb.Get(ctx, aiResponseKey(promptType, contentHash)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return response, true, nil
+}
+
+func (c *AIResponseCacheStore) SetResponse(ctx context.Context, promptType, contentHash, response string) error {
+	c.mu.RLock()
+	ttl := c.ttl
+	c.mu.RUnlock()
+	return c.rdb.Set(ctx, aiResponseKey(promptType, contentHash), response, ttl).Err()
+}
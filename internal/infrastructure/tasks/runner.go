@@ -0,0 +1,110 @@
+package tasks
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// Runner is a bounded worker pool for fire-and-forget side effects (metrics,
+// popularity recomputes, cache invalidations, notification fan-out). It caps
+// the number of concurrent background goroutines, recovers panics so one bad
+// job cannot take the process down, and drains outstanding work on shutdown.
+type Runner struct {
+	jobs    chan func()
+	workers sync.WaitGroup
+	pending sync.WaitGroup
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewRunner starts a pool with the given number of workers and queue slots.
+func NewRunner(workers, queueSize int) *Runner {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+	r := &Runner{jobs: make(chan func(), queueSize)}
+	r.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer r.workers.Done()
+			for job := range r.jobs {
+				runSafely(job)
+				r.pending.Done()
+			}
+		}()
+	}
+	return r
+}
+
+// Go schedules a job on the pool. When the queue is full (or the pool is
+// draining) the job runs on the caller's goroutine instead of being dropped,
+// so a burst slows callers down rather than losing work.
+func (r *Runner) Go(job func()) {
+	if job == nil {
+		return
+	}
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		runSafely(job)
+		return
+	}
+	r.pending.Add(1)
+	select {
+	case r.jobs <- job:
+		r.mu.Unlock()
+	default:
+		r.mu.Unlock()
+		defer r.pending.Done()
+		runSafely(job)
+	}
+}
+
+// Drain stops accepting new jobs and waits up to the timeout for queued and
+// running ones to finish. It reports whether everything completed in time.
+func (r *Runner) Drain(timeout time.Duration) bool {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.jobs)
+	}
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.pending.Wait()
+		r.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// runSafely executes a job and turns a panic into a log line.
+func runSafely(job func()) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Printf("tasks: recovered panic in background job: %v", rec)
+		}
+	}()
+	job()
+}
+
+// defaultRunner serves the package-level Go/Drain helpers, sized for light
+// per-request side effects.
+var defaultRunner = NewRunner(8, 256)
+
+// Go schedules a job on the default pool.
+func Go(job func()) { defaultRunner.Go(job) }
+
+// Drain drains the default pool; call it during graceful shutdown.
+func Drain(timeout time.Duration) bool { return defaultRunner.Drain(timeout) }
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"unicode"
@@ -47,9 +48,41 @@ func (av *AppValidator) ValidatePasswordStrength(password string) error {
 	return nil
 }
 
+// ValidateUsername checks that a username sticks to the safe identifier
+// alphabet: lowercase ASCII letters, digits, '.', '_' and '-'. Usernames
+// double as login identifiers and public handles, so anything outside that
+// set is rejected — in particular unicode homoglyphs (e.g. Cyrillic 'а' for
+// Latin 'a'), which would allow confusable impersonation handles. Callers
+// normalize to lowercase before validating.
+func (av *AppValidator) ValidateUsername(username string) error {
+	if len(username) < 3 || len(username) > 32 {
+		return fmt.Errorf("username must be between 3 and 32 characters long")
+	}
+	for _, ch := range username {
+		if (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '.' || ch == '_' || ch == '-' {
+			continue
+		}
+		return fmt.Errorf("username may only contain letters, digits, '.', '_' and '-'")
+	}
+	return nil
+}
+
 // RegisterCustomValidators registers custom validation functions with the Gin validator.
 func RegisterCustomValidators() {
+	// Reject request bodies carrying fields the DTO does not declare, so
+	// typos and stale clients fail loudly instead of being silently ignored
+	binding.EnableDecoderDisallowUnknownFields = true
+
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		// Report fields under their JSON wire names so validation error
+		// details match what the client actually sent
+		v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+			name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+			if name == "" || name == "-" {
+				return fld.Name
+			}
+			return name
+		})
 		v.RegisterValidation("containsuppercase", containsUppercaseFL)
 		v.RegisterValidation("containslowercase", containsLowercaseFL)
 		v.RegisterValidation("containsdigit", containsNumberFL)
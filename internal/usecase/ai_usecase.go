@@ -2,14 +2,32 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/metrics"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
+// assistantTones are the tones the rewrite endpoint accepts.
+var assistantTones = []string{"professional", "casual", "friendly", "persuasive", "humorous", "academic"}
+
 type AIUseCase struct {
 	aiService usecasecontract.IAIService
+	// usageRepo tracks per-user consumption; dailyQuota and monthlyQuota cap
+	// requests per user (zero = not enforced). Without a repository no usage
+	// is tracked and no quota is enforced.
+	usageRepo    contract.IAIUsageRepository
+	dailyQuota   int
+	monthlyQuota int
+	// responseCache deduplicates AI calls for unchanged content; without it
+	// every request goes to the backend.
+	responseCache contract.IAIResponseCache
 }
 
 // check if AIUseCase implement IAIUseCase
@@ -21,10 +39,104 @@ func NewAIUseCase(aiServ usecasecontract.IAIService) *AIUseCase {
 	}
 }
 
-func (uc *AIUseCase) GenerateBlogContent(ctx context.Context, keywords string) (string, error) {
+// SetUsageTracking enables per-user consumption tracking for billing and the
+// daily/monthly request quotas.
+func (uc *AIUseCase) SetUsageTracking(usageRepo contract.IAIUsageRepository, dailyQuota, monthlyQuota int) {
+	uc.usageRepo = usageRepo
+	uc.dailyQuota = dailyQuota
+	uc.monthlyQuota = monthlyQuota
+}
+
+// SetResponseCache enables AI response caching and deduplication: responses
+// are keyed by content hash and prompt type, so unchanged content is served
+// from cache instead of being re-sent to the backend.
+func (uc *AIUseCase) SetResponseCache(cache contract.IAIResponseCache) {
+	uc.responseCache = cache
+}
+
+// contentHash fingerprints content for the response cache.
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// estimateTokens approximates the tokens a request consumed from its prompt
+// and completion text, at the usual four characters per token.
+func estimateTokens(texts ...string) int64 {
+	chars := 0
+	for _, t := range texts {
+		chars += len(t)
+	}
+	return int64(chars+3) / 4
+}
+
+// checkUsageQuota rejects the request when the user is over the daily or
+// monthly allowance. Lookup errors fail open so an outage does not disable
+// the assistant.
+func (uc *AIUseCase) checkUsageQuota(ctx context.Context, userID string) error {
+	// Every assistant entry point runs through here, so bail out before the
+	// expensive backend call if the request deadline has already passed.
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("request cancelled: %w", err)
+	}
+	if uc.usageRepo == nil || userID == "" {
+		return nil
+	}
+	if uc.dailyQuota > 0 {
+		daily, err := uc.usageRepo.SummarizeUsage(ctx, userID, time.Now().Add(-24*time.Hour))
+		if err == nil && daily.Requests >= int64(uc.dailyQuota) {
+			return fmt.Errorf("daily AI quota exceeded: at most %d assistant requests are allowed per day", uc.dailyQuota)
+		}
+	}
+	if uc.monthlyQuota > 0 {
+		monthly, err := uc.usageRepo.SummarizeUsage(ctx, userID, time.Now().Add(-30*24*time.Hour))
+		if err == nil && monthly.Requests >= int64(uc.monthlyQuota) {
+			return fmt.Errorf("monthly AI quota exceeded: at most %d assistant requests are allowed per month", uc.monthlyQuota)
+		}
+	}
+	return nil
+}
+
+// recordUsage charges a finished request to the user and emits the cost
+// monitoring metrics.
+func (uc *AIUseCase) recordUsage(ctx context.Context, userID, operation string, tokens int64) {
+	metrics.IncAIRequest(operation)
+	metrics.AddAITokens(operation, tokens)
+	if uc.usageRepo == nil || userID == "" {
+		return
+	}
+	_ = uc.usageRepo.RecordUsage(ctx, &entity.AIUsageRecord{
+		UserID:          userID,
+		Operation:       operation,
+		EstimatedTokens: tokens,
+		CreatedAt:       time.Now(),
+	})
+}
+
+// GetMyUsage returns the user's AI consumption over the last day and the
+// last 30 days, alongside the configured quotas (zero = unlimited).
+func (uc *AIUseCase) GetMyUsage(ctx context.Context, userID string) (daily, monthly *entity.AIUsageSummary, dailyQuota, monthlyQuota int, err error) {
+	if uc.usageRepo == nil {
+		return nil, nil, 0, 0, fmt.Errorf("AI usage tracking is not enabled")
+	}
+	daily, err = uc.usageRepo.SummarizeUsage(ctx, userID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, nil, 0, 0, fmt.Errorf("failed to summarize AI usage: %w", err)
+	}
+	monthly, err = uc.usageRepo.SummarizeUsage(ctx, userID, time.Now().Add(-30*24*time.Hour))
+	if err != nil {
+		return nil, nil, 0, 0, fmt.Errorf("failed to summarize AI usage: %w", err)
+	}
+	return daily, monthly, uc.dailyQuota, uc.monthlyQuota, nil
+}
+
+func (uc *AIUseCase) GenerateBlogContent(ctx context.Context, userID, keywords string) (string, error) {
 	if strings.TrimSpace(keywords) == "" {
 		return "", fmt.Errorf("failed to generate content: empty keyword provided")
 	}
+	if err := uc.checkUsageQuota(ctx, userID); err != nil {
+		return "", err
+	}
 	prompt := fmt.Sprintf("Generate a blog post of at least 300 words with a compelling title based on the following keywords: %s. The post should be well-structured and engaging.", keywords)
 
 	// call the ai service to generate content
@@ -32,17 +144,21 @@ func (uc *AIUseCase) GenerateBlogContent(ctx context.Context, keywords string) (
 	if err != nil {
 		return "", fmt.Errorf("failed to generate content: %w", err)
 	}
+	uc.recordUsage(ctx, userID, "generate", estimateTokens(prompt, generateContent))
 	return generateContent, nil
 
 }
 
-func (uc *AIUseCase) SuggestAndModifyContent(ctx context.Context, keywords string, blog string) (string, error) {
+func (uc *AIUseCase) SuggestAndModifyContent(ctx context.Context, userID, keywords string, blog string) (string, error) {
 	if strings.TrimSpace(keywords) == "" {
 		return "", fmt.Errorf("failed to generate content: empty keyword provided")
 	}
 	if strings.TrimSpace(blog) == "" {
 		return "", fmt.Errorf("failed to modify content: original blog content is empty")
 	}
+	if err := uc.checkUsageQuota(ctx, userID); err != nil {
+		return "", err
+	}
 	prompt := fmt.Sprintf(
 		`You are a professional editor. 
 Improve the following blog post using the keywords: "%s".
@@ -65,14 +181,127 @@ Return only the revised blog content.`,
 	if err != nil {
 		return "", fmt.Errorf("failed to generate content: %w", err)
 	}
+	uc.recordUsage(ctx, userID, "modify", estimateTokens(prompt, modifiedContent))
 	return modifiedContent, nil
 
 }
 
+// OutlineBlog drafts a structured outline for a post about the topic.
+func (uc *AIUseCase) OutlineBlog(ctx context.Context, userID, topic string) (string, error) {
+	if strings.TrimSpace(topic) == "" {
+		return "", fmt.Errorf("failed to outline post: empty topic provided")
+	}
+	if err := uc.checkUsageQuota(ctx, userID); err != nil {
+		return "", err
+	}
+	prompt := fmt.Sprintf(
+		`You are a writing assistant.
+Draft an outline for a blog post about: "%s".
+Your tasks:
+- Propose a working title
+- List 4 to 7 section headings in reading order
+- Under each heading add one or two bullet points of what to cover
+- Keep the outline in markdown
+
+Return only the outline.`,
+		topic,
+	)
+	outline, err := uc.aiService.GenerateContent(ctx, prompt)
+	if err != nil {
+		return "", fmt.Errorf("failed to outline post: %w", err)
+	}
+	uc.recordUsage(ctx, userID, "outline", estimateTokens(prompt, outline))
+	return outline, nil
+}
+
+// ExpandSection writes out one section of a post from its heading, using the
+// draft so far as context.
+func (uc *AIUseCase) ExpandSection(ctx context.Context, userID, section, draft string) (string, error) {
+	if strings.TrimSpace(section) == "" {
+		return "", fmt.Errorf("failed to expand section: empty section provided")
+	}
+	if err := uc.checkUsageQuota(ctx, userID); err != nil {
+		return "", err
+	}
+	prompt := fmt.Sprintf(
+		`You are a writing assistant.
+Write out the section "%s" of a blog post.
+Your tasks:
+- Write 2 to 4 paragraphs for this section only
+- Match the voice and subject of the draft below
+- Do not repeat content the draft already covers
+- Do not write other sections
+
+Here is the draft so far:
+%s
+
+Return only the new section's prose.`,
+		section,
+		draft,
+	)
+	expanded, err := uc.aiService.GenerateContent(ctx, prompt)
+	if err != nil {
+		return "", fmt.Errorf("failed to expand section: %w", err)
+	}
+	uc.recordUsage(ctx, userID, "expand", estimateTokens(prompt, expanded))
+	return expanded, nil
+}
+
+// RewriteTone rewrites the content in the requested tone, preserving its
+// meaning and structure.
+func (uc *AIUseCase) RewriteTone(ctx context.Context, userID, content, tone string) (string, error) {
+	if strings.TrimSpace(content) == "" {
+		return "", fmt.Errorf("failed to rewrite content: empty content provided")
+	}
+	tone = strings.ToLower(strings.TrimSpace(tone))
+	supported := false
+	for _, t := range assistantTones {
+		if t == tone {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return "", fmt.Errorf("unsupported tone %q, expected one of: %s", tone, strings.Join(assistantTones, ", "))
+	}
+	if err := uc.checkUsageQuota(ctx, userID); err != nil {
+		return "", err
+	}
+	prompt := fmt.Sprintf(
+		`You are a professional editor.
+Rewrite the following blog content in a %s tone.
+Your tasks:
+- Keep the meaning, facts and structure unchanged
+- Only adjust wording, rhythm and register to match the tone
+- Keep any markdown formatting intact
+
+Here is the content:
+%s
+
+Return only the rewritten content.`,
+		tone,
+		content,
+	)
+	rewritten, err := uc.aiService.GenerateContent(ctx, prompt)
+	if err != nil {
+		return "", fmt.Errorf("failed to rewrite content: %w", err)
+	}
+	uc.recordUsage(ctx, userID, "rewrite", estimateTokens(prompt, rewritten))
+	return rewritten, nil
+}
+
 func (uc *AIUseCase) CensorAndCheckBlog(ctx context.Context, blog string) (string, error) {
 	if strings.TrimSpace(blog) == "" {
 		return "", fmt.Errorf("failed to check content: empty blog provided")
 	}
+	// Unchanged content keeps its last moderation verdict; only new or
+	// edited content is sent to the backend.
+	hash := contentHash(blog)
+	if uc.responseCache != nil {
+		if cached, found, err := uc.responseCache.GetResponse(ctx, "moderate", hash); err == nil && found {
+			return cached, nil
+		}
+	}
 	prompt := fmt.Sprintf(
 		`You are a content moderator.
 Review the following blog post and respond with "yes" if it is appropriate and follows community guidelines, or "no" if it contains inappropriate content or violates guidelines.
@@ -88,6 +317,9 @@ Respond only with "yes" or "no".`,
 	if err != nil {
 		return "", fmt.Errorf("failed to generate content: %w", err)
 	}
+	if uc.responseCache != nil {
+		_ = uc.responseCache.SetResponse(ctx, "moderate", hash, feedback)
+	}
 	return feedback, nil
 
 }
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// apiKeyPrefixLen is how many characters of the key are kept in plaintext so
+// users can tell their keys apart in listings.
+const apiKeyPrefixLen = 11
+
+// APIKeyUseCaseImpl manages personal access tokens. Keys are hashed at rest;
+// the plaintext is returned once at creation and never stored.
+type APIKeyUseCaseImpl struct {
+	apiKeyRepo      contract.IAPIKeyRepository
+	userRepo        contract.IUserRepository
+	hasher          contract.IHasher
+	uuidGenerator   contract.IUUIDGenerator
+	randomGenerator contract.IRandomGenerator
+}
+
+var _ usecasecontract.IAPIKeyUseCase = (*APIKeyUseCaseImpl)(nil)
+
+func NewAPIKeyUseCase(apiKeyRepo contract.IAPIKeyRepository, userRepo contract.IUserRepository, hasher contract.IHasher, uuidGenerator contract.IUUIDGenerator, randomGenerator contract.IRandomGenerator) *APIKeyUseCaseImpl {
+	return &APIKeyUseCaseImpl{
+		apiKeyRepo:      apiKeyRepo,
+		userRepo:        userRepo,
+		hasher:          hasher,
+		uuidGenerator:   uuidGenerator,
+		randomGenerator: randomGenerator,
+	}
+}
+
+// CreateAPIKey issues a new key for the user. Scopes default to read-only
+// when omitted.
+func (uc *APIKeyUseCaseImpl) CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*entity.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("invalid name: must not be empty")
+	}
+	if len(name) > 100 {
+		return nil, "", errors.New("invalid name: must be at most 100 characters")
+	}
+
+	if len(scopes) == 0 {
+		scopes = []string{entity.APIKeyScopeRead}
+	}
+	for _, scope := range scopes {
+		if !entity.IsValidAPIKeyScope(scope) {
+			return nil, "", fmt.Errorf("invalid scope: %s", scope)
+		}
+	}
+
+	secret, err := uc.randomGenerator.GenerateRandomToken(32)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	rawKey := "ak_" + secret
+
+	key := &entity.APIKey{
+		ID:        uc.uuidGenerator.NewUUID(),
+		UserID:    userID,
+		Name:      name,
+		KeyHash:   uc.hasher.HashString(rawKey),
+		Prefix:    rawKey[:apiKeyPrefixLen],
+		Scopes:    scopes,
+		CreatedAt: time.Now(),
+	}
+	if err := uc.apiKeyRepo.CreateAPIKey(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	return key, rawKey, nil
+}
+
+// ListAPIKeys returns the user's keys, newest first.
+func (uc *APIKeyUseCaseImpl) ListAPIKeys(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	return uc.apiKeyRepo.ListAPIKeysByUserID(ctx, userID)
+}
+
+// RevokeAPIKey revokes one of the user's keys.
+func (uc *APIKeyUseCaseImpl) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	return uc.apiKeyRepo.RevokeAPIKey(ctx, keyID, userID)
+}
+
+// Authenticate resolves a presented key to its record and owning user. The
+// lookup goes through the deterministic hash, so the plaintext never touches
+// the database.
+func (uc *APIKeyUseCaseImpl) Authenticate(ctx context.Context, rawKey string) (*entity.APIKey, *entity.User, error) {
+	rawKey = strings.TrimSpace(rawKey)
+	if rawKey == "" {
+		return nil, nil, errors.New("invalid api key")
+	}
+
+	key, err := uc.apiKeyRepo.GetAPIKeyByHash(ctx, uc.hasher.HashString(rawKey))
+	if err != nil {
+		return nil, nil, errors.New("invalid api key")
+	}
+	if key.Revoked {
+		return nil, nil, errors.New("api key revoked")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, key.UserID)
+	if err != nil {
+		return nil, nil, errors.New("invalid api key")
+	}
+	if !user.IsActive {
+		return nil, nil, errors.New("account not active")
+	}
+
+	// Best-effort usage tracking; a failure here must not block the request.
+	_ = uc.apiKeyRepo.TouchAPIKey(ctx, key.ID)
+
+	return key, user, nil
+}
@@ -4,52 +4,102 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
-	"sync/atomic"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"github.com/mikiasgoitom/Articulate/internal/infrastructure/metrics"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/tasks"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 	"github.com/mikiasgoitom/Articulate/internal/utils"
 )
 
 // IBlogUseCase defines blog-related business logic
 type IBlogUseCase interface {
-	CreateBlog(ctx context.Context, title, content string, authorID string, slug string, status entity.BlogStatus, featuredImageID *string, tags []string) (*entity.Blog, error)
-	GetBlogs(ctx context.Context, page, pageSize int, sortBy string, sortOrder string, dateFrom *time.Time, dateTo *time.Time) (blogs []entity.Blog, totalCount int, currentPage int, totalPages int, err error)
-	GetBlogDetail(cnt context.Context, slug string) (blog entity.Blog, err error)
-	UpdateBlog(ctx context.Context, blogID, authorID string, title *string, content *string, status *entity.BlogStatus, featuredImageID *string) (*entity.Blog, error)
+	CreateBlog(ctx context.Context, title, content string, authorID string, slug string, status entity.BlogStatus, featuredImageID *string, tags []string, orgID *string, language string, canonicalURL string, originalSource string, contentRating string, postType string, accessLevel string) (*entity.Blog, error)
+	GetBlogs(ctx context.Context, page, pageSize int, sortBy string, sortOrder string, dateFrom *time.Time, dateTo *time.Time, orgID *string, language *string, viewerID *string) (blogs []entity.Blog, totalCount int, currentPage int, totalPages int, err error)
+	GetBlogDetail(cnt context.Context, slug string, viewerID *string) (blog entity.Blog, err error)
+	GetBlogMeta(ctx context.Context, slug string) (*entity.Blog, string, error)
+	UpdateBlog(ctx context.Context, blogID, authorID string, title *string, content *string, status *entity.BlogStatus, featuredImageID *string, language *string, canonicalURL *string, originalSource *string, contentRating *string, accessLevel *string) (*entity.Blog, error)
 	DeleteBlog(ctx context.Context, blogID, userID string, isAdmin bool) (bool, error)
-	SearchAndFilterBlogs(ctx context.Context, query string, tags []string, dateFrom *time.Time, dateTo *time.Time, minViews *int, maxViews *int, minLikes *int, maxLikes *int, authorID *string, page int, pageSize int) ([]entity.Blog, int, int, int, error)
-	TrackBlogView(ctx context.Context, blogID, userID, ipAddress, userAgent string) error
-	GetPopularBlogs(ctx context.Context, page, pageSize int) ([]entity.Blog, int, int, int, error)
+	CanViewMatureContent(ctx context.Context, userID string) bool
+	SuggestBlogs(ctx context.Context, query string) ([]*entity.BlogSuggestion, []string, error)
+	RecordSearchClick(ctx context.Context, query, blogID, userID string) error
+	GetSearchAnalytics(ctx context.Context, since time.Time) (topQueries, zeroResultQueries []entity.SearchQueryStat, err error)
+	SearchAndFilterBlogs(ctx context.Context, query string, tags []string, tagsAll []string, dateFrom *time.Time, dateTo *time.Time, minViews *int, maxViews *int, minLikes *int, maxLikes *int, minReadingTime *int, maxReadingTime *int, authorID *string, authorUsername *string, orgID *string, language *string, status *string, sortBy string, sortOrder string, page int, pageSize int, viewerID *string) ([]entity.Blog, int, int, int, error)
+	TrackBlogView(ctx context.Context, blogID, userID, ipAddress, userAgent, fingerprint string) error
+	ConfirmBlogView(ctx context.Context, blogID, userID, ipAddress string) error
+	ListViewAnomalies(ctx context.Context, limit int) ([]entity.ViewAnomaly, error)
+	RollbackBlogViews(ctx context.Context, blogID string, count int) error
+	GetPopularBlogs(ctx context.Context, page, pageSize int, viewerID *string) ([]entity.Blog, int, int, int, error)
+	GetBlogArchive(ctx context.Context, orgID *string) ([]entity.ArchivePeriod, error)
+	GetArchivePeriodBlogs(ctx context.Context, year, month, page, pageSize int, orgID *string, viewerID *string) ([]entity.Blog, int, int, int, error)
+	RenderShareImage(ctx context.Context, slug string) ([]byte, error)
+	GetBlogViewGeoBreakdown(ctx context.Context, blogID, requesterID string, isAdmin bool) ([]entity.ViewGeoStat, error)
+	SubmitBlogForReview(ctx context.Context, blogID, authorID string) (*entity.Blog, error)
+	ReviewBlog(ctx context.Context, blogID, reviewerID string, approve bool, note string) (*entity.Blog, error)
+	GetReviewNotes(ctx context.Context, blogID, requesterID string, isAdmin bool) ([]*entity.ReviewNote, error)
+	GetTopAuthors(ctx context.Context, window string, limit int) ([]entity.AuthorStat, error)
+	ListBlogRevisions(ctx context.Context, blogID, userID string) ([]*entity.BlogRevision, error)
+	GetBlogRevisionDiff(ctx context.Context, blogID, userID string, from, to int) (*BlogRevisionDiff, error)
+	SetAcceptedAnswer(ctx context.Context, blogID, authorID, commentID string) (*entity.Blog, error)
 }
 
 // BlogStatus is defined in entity.BlogStatus
 
 // BlogUseCaseImpl implements the BlogUseCase interface
 type BlogUseCaseImpl struct {
-	blogRepo  contract.IBlogRepository
-	uuidgen   contract.IUUIDGenerator
-	logger    usecasecontract.IAppLogger
-	aiUC      usecasecontract.IAIUseCase
-	blogCache contract.IBlogCache
-	// simple metrics
-	detailHits uint64
-	detailMiss uint64
-	listHits   uint64
-	listMiss   uint64
+	blogRepo            contract.IBlogRepository
+	uuidgen             contract.IUUIDGenerator
+	logger              usecasecontract.IAppLogger
+	aiUC                usecasecontract.IAIUseCase
+	blogCache           contract.IBlogCache
+	geoResolver         contract.IGeoIPResolver
+	orgRepo             contract.IOrganizationRepository // optional, enables org-owned blogs
+	probation           *ProbationPolicy                 // optional, restricts new accounts
+	limits              *LimitsPolicy                    // optional, enforces per-role posting quotas
+	linkPreviews        contract.ILinkPreviewService     // optional, unfurls URLs in content
+	oembed              contract.IOEmbedService          // optional, resolves whitelisted media embeds
+	imageProxy          contract.IImageProxyService      // optional, proxies external images
+	featureFlags        contract.IFeatureFlags           // optional, runtime feature toggles
+	userRepo            contract.IUserRepository         // optional, enables the top-authors leaderboard
+	engagementBus       contract.IEngagementBus          // optional, feeds live engagement streams
+	presence            contract.IPresenceTracker        // optional, counts active readers per post
+	savedSearchNotifier SavedSearchNotifier              // optional, alerts saved searches on publish
+	searchEvents        contract.ISearchEventRepository  // optional, records search analytics events
+	ogImageRenderer     contract.IOGImageRenderer        // optional, renders social share images
+	mediaStore          contract.IMediaStore             // stores generated share images, set with ogImageRenderer
+	shareImageBaseURL   string                           // base URL of the share image endpoint
+	tts                 contract.ITTSSynthesizer         // optional, renders audio versions of posts
+	audioStorage        contract.IFileStorage            // persists generated audio, set with tts
+	revisionRepo        contract.IBlogRevisionRepository // optional, snapshots edits for revision history
+	commentRepo         contract.ICommentRepository      // optional, enables accepted answers on question posts
+	accessPolicy        BlogAccessPolicy                 // optional, enforces the paywall on gated posts
+	reputation          ReputationRecorder               // optional, records point-earning actions
+	// popularity debouncing: hot posts defer their recompute to the refresher
+	popularityCounter contract.IRateLimitCounter // optional, marks hot posts per window
+	popularityWindow  time.Duration
+	dirtyPopularity   sync.Map // blog IDs awaiting a deferred recompute
+	// popularity formula weights, tunable at runtime via the admin API
+	popularityWeights   utils.PopularityWeights
+	popularityWeightsMu sync.RWMutex
+	// top-authors leaderboard, cached per window
+	leaderboardMu    sync.Mutex
+	leaderboardCache map[string]cachedLeaderboard
 }
 
 // NewBlogUseCase creates a new instance of BlogUseCase
 func NewBlogUseCase(blogRepo contract.IBlogRepository, uuidgenrator contract.IUUIDGenerator, logger usecasecontract.IAppLogger, aiUC usecasecontract.IAIUseCase) *BlogUseCaseImpl {
 	return &BlogUseCaseImpl{
-		blogRepo: blogRepo,
-		logger:   logger,
-		uuidgen:  uuidgenrator,
-		aiUC:     aiUC,
+		blogRepo:          blogRepo,
+		logger:            logger,
+		uuidgen:           uuidgenrator,
+		aiUC:              aiUC,
+		popularityWeights: utils.DefaultPopularityWeights(),
 	}
 }
 
@@ -61,21 +111,230 @@ func (uc *BlogUseCaseImpl) SetBlogCache(cache contract.IBlogCache) {
 	uc.blogCache = cache
 }
 
-// buildBlogsListCacheKey builds a stable key for list endpoint caching
-func buildBlogsListCacheKey(page, pageSize int, sortBy string, sortOrder string, dateFrom, dateTo *time.Time) string {
+// SetUserRepository enables author enrichment and the privacy opt-out on the
+// top-authors leaderboard. When unset, stats are served without usernames.
+func (uc *BlogUseCaseImpl) SetUserRepository(userRepo contract.IUserRepository) {
+	uc.userRepo = userRepo
+}
+
+// SetEngagementBus enables live engagement streaming: counter changes are
+// published to the bus and served to post pages over SSE.
+func (uc *BlogUseCaseImpl) SetEngagementBus(bus contract.IEngagementBus) {
+	uc.engagementBus = bus
+}
+
+// SetPresenceTracker enables the "N people reading this post" indicator,
+// fed by reader heartbeats and surfaced on the live engagement stream.
+func (uc *BlogUseCaseImpl) SetPresenceTracker(presence contract.IPresenceTracker) {
+	uc.presence = presence
+}
+
+// SetSavedSearchNotifier alerts users whose saved searches match a blog the
+// moment it is published.
+func (uc *BlogUseCaseImpl) SetSavedSearchNotifier(notifier SavedSearchNotifier) {
+	uc.savedSearchNotifier = notifier
+}
+
+// SetSearchEventRepository enables search analytics: executed queries, their
+// result counts and result clicks are recorded for the admin analytics API.
+func (uc *BlogUseCaseImpl) SetSearchEventRepository(searchEvents contract.ISearchEventRepository) {
+	uc.searchEvents = searchEvents
+}
+
+// SetOGImageService enables social share images: a rendered title-and-author
+// image is generated when a post is published, recorded in the media layer
+// and exposed as the post's og_image_url.
+func (uc *BlogUseCaseImpl) SetOGImageService(renderer contract.IOGImageRenderer, mediaStore contract.IMediaStore, baseURL string) {
+	uc.ogImageRenderer = renderer
+	uc.mediaStore = mediaStore
+	uc.shareImageBaseURL = baseURL
+}
+
+// SetTTSService enables spoken audio renditions: published posts are
+// synthesized asynchronously, stored through the media layer and exposed via
+// the post's audio_url.
+func (uc *BlogUseCaseImpl) SetTTSService(tts contract.ITTSSynthesizer, mediaStore contract.IMediaStore, audioStorage contract.IFileStorage) {
+	uc.tts = tts
+	uc.mediaStore = mediaStore
+	uc.audioStorage = audioStorage
+}
+
+// SetRevisionHistory enables snapshotting the pre-edit state of a post on
+// every title or content change, powering the revision list and diff
+// endpoints. When unset, edits are not recorded.
+func (uc *BlogUseCaseImpl) SetRevisionHistory(revisionRepo contract.IBlogRevisionRepository) {
+	uc.revisionRepo = revisionRepo
+}
+
+// SetCommentRepository enables accepted answers on question posts: the
+// chosen comment is validated against the comment store. When unset,
+// accepting answers is disabled.
+func (uc *BlogUseCaseImpl) SetCommentRepository(commentRepo contract.ICommentRepository) {
+	uc.commentRepo = commentRepo
+}
+
+// SetAccessPolicy enables the paywall: gated posts are truncated to a
+// teaser for viewers without the required membership. When unset, every
+// post is served in full.
+func (uc *BlogUseCaseImpl) SetAccessPolicy(policy BlogAccessPolicy) {
+	uc.accessPolicy = policy
+}
+
+// SetReputationRecorder enables gamification: publishing and accepted
+// answers earn their authors reputation points and badges.
+func (uc *BlogUseCaseImpl) SetReputationRecorder(recorder ReputationRecorder) {
+	uc.reputation = recorder
+}
+
+// SetGeoIPResolver enables geo enrichment of recorded blog views. When unset,
+// views are stored without country/region data.
+func (uc *BlogUseCaseImpl) SetGeoIPResolver(resolver contract.IGeoIPResolver) {
+	uc.geoResolver = resolver
+}
+
+// SetOrganizationRepo enables org-owned blogs: membership checks on creation
+// and editor/owner management rights on org posts.
+func (uc *BlogUseCaseImpl) SetOrganizationRepo(orgRepo contract.IOrganizationRepository) {
+	uc.orgRepo = orgRepo
+}
+
+// SetProbationPolicy enables new-account restrictions on blog content.
+func (uc *BlogUseCaseImpl) SetProbationPolicy(policy *ProbationPolicy) {
+	uc.probation = policy
+}
+
+// SetLimitsPolicy enables the per-role posting quotas (posts per day, tags
+// and images per post) on blog creation.
+func (uc *BlogUseCaseImpl) SetLimitsPolicy(policy *LimitsPolicy) {
+	uc.limits = policy
+}
+
+// SetLinkPreviewService enables unfurling of URLs in blog content into
+// stored link previews.
+func (uc *BlogUseCaseImpl) SetLinkPreviewService(service contract.ILinkPreviewService) {
+	uc.linkPreviews = service
+}
+
+// SetOEmbedService enables media embeds from whitelisted providers: content
+// is sanitized of unapproved iframes and media URLs are resolved into stored
+// oEmbed metadata.
+func (uc *BlogUseCaseImpl) SetOEmbedService(service contract.IOEmbedService) {
+	uc.oembed = service
+}
+
+// SetImageProxy enables rewriting of external image references in blog
+// content to signed proxy URLs.
+func (uc *BlogUseCaseImpl) SetImageProxy(proxy contract.IImageProxyService) {
+	uc.imageProxy = proxy
+}
+
+// SetFeatureFlags enables runtime feature toggles, e.g. turning AI
+// moderation off without a redeploy.
+func (uc *BlogUseCaseImpl) SetFeatureFlags(flags contract.IFeatureFlags) {
+	uc.featureFlags = flags
+}
+
+// aiModerationEnabled consults the "ai_moderation" feature flag; AI
+// moderation stays on while the flag is undefined.
+func (uc *BlogUseCaseImpl) aiModerationEnabled(ctx context.Context) bool {
+	if uc.featureFlags == nil {
+		return true
+	}
+	return uc.featureFlags.IsEnabled(ctx, "ai_moderation", true)
+}
+
+// canManageOrgBlog reports whether the user may manage the blog through an
+// organization role (owner or editor of the owning org).
+func (uc *BlogUseCaseImpl) canManageOrgBlog(ctx context.Context, blog *entity.Blog, userID string) bool {
+	if blog.OrgID == nil || *blog.OrgID == "" || uc.orgRepo == nil {
+		return false
+	}
+	member, err := uc.orgRepo.GetMember(ctx, *blog.OrgID, userID)
+	if err != nil {
+		return false
+	}
+	return member.CanManageOrgPosts()
+}
+
+// buildBlogsListCacheKey builds a stable key for list endpoint caching. The
+// tenant org is part of the key so custom-domain publications never share
+// cached pages with the main site or each other.
+func buildBlogsListCacheKey(page, pageSize int, sortBy string, sortOrder string, dateFrom, dateTo *time.Time, orgID *string, language *string) string {
 	df := ""
 	dt := ""
+	org := ""
+	lang := ""
 	if dateFrom != nil {
 		df = dateFrom.UTC().Format(time.RFC3339)
 	}
 	if dateTo != nil {
 		dt = dateTo.UTC().Format(time.RFC3339)
 	}
-	return fmt.Sprintf("blogs:list:p=%d:s=%d:sb=%s:so=%s:df=%s:dt=%s", page, pageSize, sortBy, sortOrder, df, dt)
+	if orgID != nil {
+		org = *orgID
+	}
+	if language != nil {
+		lang = *language
+	}
+	return fmt.Sprintf("blogs:list:p=%d:s=%d:sb=%s:so=%s:df=%s:dt=%s:org=%s:lang=%s", page, pageSize, sortBy, sortOrder, df, dt, org, lang)
 }
 
 // CreateBlog creates a new blog post
-func (uc *BlogUseCaseImpl) CreateBlog(ctx context.Context, title, content string, authorID string, slug string, status entity.BlogStatus, featuredImageID *string, tags []string) (*entity.Blog, error) {
+// validateCanonicalURL rejects canonical URLs that are not absolute http(s)
+// URLs; an empty value is allowed and means the post is original content.
+func validateCanonicalURL(canonicalURL string) error {
+	if canonicalURL == "" {
+		return nil
+	}
+	parsed, err := url.Parse(canonicalURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("canonical URL must be a valid http(s) URL")
+	}
+	return nil
+}
+
+// parseAccessLevel validates an author-supplied access level; empty
+// defaults to public.
+func parseAccessLevel(accessLevel string) (entity.BlogAccessLevel, error) {
+	switch entity.BlogAccessLevel(accessLevel) {
+	case "":
+		return entity.BlogAccessPublic, nil
+	case entity.BlogAccessPublic, entity.BlogAccessMembers, entity.BlogAccessPaid:
+		return entity.BlogAccessLevel(accessLevel), nil
+	default:
+		return "", fmt.Errorf("invalid access level %q", accessLevel)
+	}
+}
+
+// parsePostType validates an author-supplied post type; empty defaults to
+// article.
+func parsePostType(postType string) (entity.BlogType, error) {
+	switch entity.BlogType(postType) {
+	case "":
+		return entity.BlogTypeArticle, nil
+	case entity.BlogTypeArticle, entity.BlogTypeQuestion, entity.BlogTypeDiscussion:
+		return entity.BlogType(postType), nil
+	default:
+		return "", fmt.Errorf("invalid post type %q", postType)
+	}
+}
+
+// parseContentRating validates an author-supplied content rating; empty
+// defaults to general.
+func parseContentRating(contentRating string) (entity.ContentRating, error) {
+	switch entity.ContentRating(contentRating) {
+	case "":
+		return entity.ContentRatingGeneral, nil
+	case entity.ContentRatingGeneral:
+		return entity.ContentRatingGeneral, nil
+	case entity.ContentRatingMature:
+		return entity.ContentRatingMature, nil
+	default:
+		return "", fmt.Errorf("invalid content rating %q", contentRating)
+	}
+}
+
+func (uc *BlogUseCaseImpl) CreateBlog(ctx context.Context, title, content string, authorID string, slug string, status entity.BlogStatus, featuredImageID *string, tags []string, orgID *string, language string, canonicalURL string, originalSource string, contentRating string, postType string, accessLevel string) (*entity.Blog, error) {
 	if title == "" {
 		return nil, errors.New("title is required")
 	}
@@ -85,37 +344,102 @@ func (uc *BlogUseCaseImpl) CreateBlog(ctx context.Context, title, content string
 	if authorID == "" {
 		return nil, errors.New("author ID is required")
 	}
+	// New posts cannot skip the editorial workflow: publishing requires an
+	// approved review first.
+	if status == entity.BlogStatusPublished {
+		return nil, errors.New("blog must be approved before publishing")
+	}
+	// Accounts on probation may not post external links.
+	if uc.probation != nil && uc.probation.IsOnProbation(ctx, authorID) {
+		if uc.probation.ContainsExternalLink(content) {
+			return nil, errors.New("external links are not allowed for new accounts")
+		}
+	}
+	// Per-role posting quotas: posts per day, tags and images per post.
+	if uc.limits != nil {
+		if err := uc.limits.CheckBlogLimits(ctx, authorID, content, tags); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateCanonicalURL(canonicalURL); err != nil {
+		return nil, err
+	}
+	rating, err := parseContentRating(contentRating)
+	if err != nil {
+		return nil, err
+	}
+	kind, err := parsePostType(postType)
+	if err != nil {
+		return nil, err
+	}
+	access, err := parseAccessLevel(accessLevel)
+	if err != nil {
+		return nil, err
+	}
+	// Posting under an organization requires membership (any role).
+	if orgID != nil && *orgID != "" {
+		if uc.orgRepo == nil {
+			return nil, errors.New("organizations are not enabled")
+		}
+		if _, err := uc.orgRepo.GetMember(ctx, *orgID, authorID); err != nil {
+			return nil, errors.New("unauthorized: only organization members can post under the organization")
+		}
+	}
 
 	// If slug is not provided, generate it from the title
 	if slug == "" {
 		slug = strings.ReplaceAll(strings.ToLower(title), " ", "-")
 	}
 
-	blog := &entity.Blog{
-		ID:              uc.uuidgen.NewUUID(),
-		Title:           title,
-		Content:         content,
-		AuthorID:        authorID,
-		Slug:            slug + "-" + uc.uuidgen.NewUUID(), // A UUID is always appended to ensure the final slug is unique
-		Status:          entity.BlogStatus(status),
-		Tags:            tags,
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
-		ViewCount:       0,
-		LikeCount:       0,
-		DislikeCount:    0,
-		CommentCount:    0,
-		Popularity:      utils.CalculatePopularity(0, 0, 0, 0),
-		FeaturedImageID: featuredImageID,
-		IsDeleted:       false,
+	// Strip iframes from unapproved sources before storing the content.
+	if uc.oembed != nil {
+		content = uc.oembed.SanitizeContent(content)
+	}
+	// Serve external images through the signed proxy.
+	if uc.imageProxy != nil {
+		content = uc.imageProxy.RewriteContent(content)
 	}
 
-	if status == entity.BlogStatusPublished {
-		now := time.Now()
-		blog.PublishedAt = &now
+	// Detect the post's language when the author did not set one.
+	if language == "" {
+		language = utils.DetectLanguage(title + " " + content)
+	}
+
+	blog := &entity.Blog{
+		ID:                 uc.uuidgen.NewUUID(),
+		Title:              title,
+		Content:            content,
+		AuthorID:           authorID,
+		OrgID:              orgID,
+		Slug:               slug + "-" + uc.uuidgen.NewUUID(), // A UUID is always appended to ensure the final slug is unique
+		Status:             entity.BlogStatus(status),
+		Tags:               tags,
+		Language:           language,
+		CanonicalURL:       canonicalURL,
+		OriginalSource:     originalSource,
+		ContentRating:      rating,
+		Type:               kind,
+		AccessLevel:        access,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+		ViewCount:          0,
+		LikeCount:          0,
+		DislikeCount:       0,
+		CommentCount:       0,
+		Popularity:         utils.CalculatePopularity(0, 0, 0, 0),
+		FeaturedImageID:    featuredImageID,
+		ReadingTimeMinutes: utils.EstimateReadingTime(content),
+		IsDeleted:          false,
+	}
+	if uc.linkPreviews != nil {
+		blog.LinkPreviews = uc.linkPreviews.ExtractPreviews(ctx, content)
+	}
+	if uc.oembed != nil {
+		blog.EmbeddedMedia = uc.oembed.ResolveEmbeds(ctx, content)
 	}
+
 	// Check for profanity in the content using AI. If AI check fails (e.g., not configured or service error), proceed but log a warning.
-	if uc.aiUC != nil {
+	if uc.aiUC != nil && uc.aiModerationEnabled(ctx) {
 		feedback, err := uc.aiUC.CensorAndCheckBlog(ctx, content)
 		if err != nil {
 			if uc.logger != nil {
@@ -127,6 +451,11 @@ func (uc *BlogUseCaseImpl) CreateBlog(ctx context.Context, title, content string
 			if norm == "no" {
 				return nil, errors.New("content contains inappropriate material")
 			}
+			// AI moderation may rate content the author did not flag as
+			// mature; the rating is only ever escalated, never lowered.
+			if norm == "mature" {
+				blog.ContentRating = entity.ContentRatingMature
+			}
 		}
 	}
 
@@ -151,18 +480,21 @@ func (uc *BlogUseCaseImpl) CreateBlog(ctx context.Context, title, content string
 }
 
 // GetBlogs retrieves paginated list of blogs
-func (uc *BlogUseCaseImpl) GetBlogs(ctx context.Context, page, pageSize int, sortBy string, sortOrder string, dateFrom *time.Time, dateTo *time.Time) ([]entity.Blog, int, int, int, error) {
+func (uc *BlogUseCaseImpl) GetBlogs(ctx context.Context, page, pageSize int, sortBy string, sortOrder string, dateFrom *time.Time, dateTo *time.Time, orgID *string, language *string, viewerID *string) ([]entity.Blog, int, int, int, error) {
+	// Don't start a listing query on behalf of a caller that already gave up
+	if err := ctx.Err(); err != nil {
+		return nil, 0, 0, 0, fmt.Errorf("request cancelled: %w", err)
+	}
 
 	// Try cache first
 	if uc.blogCache != nil {
-		key := buildBlogsListCacheKey(page, pageSize, sortBy, sortOrder, dateFrom, dateTo)
+		key := buildBlogsListCacheKey(page, pageSize, sortBy, sortOrder, dateFrom, dateTo, orgID, language)
 		t0 := time.Now()
 		cached, found, err := uc.blogCache.GetBlogsPage(ctx, key)
 		elapsed := time.Since(t0)
 		if err == nil && found && cached != nil {
-			atomic.AddUint64(&uc.listHits, 1)
-			go metrics.IncListHit()
-			go metrics.AddHitDuration(elapsed.Seconds())
+			tasks.Go(metrics.IncListHit)
+			tasks.Go(func() { metrics.AddHitDuration(elapsed.Seconds()) })
 			if uc.logger != nil {
 				uc.logger.Infof("cache hit: blogs list key=%s took=%s", key, elapsed)
 			}
@@ -171,11 +503,13 @@ func (uc *BlogUseCaseImpl) GetBlogs(ctx context.Context, page, pageSize int, sor
 			if pageSize > 0 {
 				totalPages = (total + pageSize - 1) / pageSize
 			}
+			for i := range cached.Blogs {
+				uc.applyBlogAccess(ctx, viewerID, &cached.Blogs[i])
+			}
 			return cached.Blogs, total, page, totalPages, nil
 		} else if err == nil && !found {
-			atomic.AddUint64(&uc.listMiss, 1)
-			go metrics.IncListMiss()
-			go metrics.AddMissDuration(elapsed.Seconds())
+			tasks.Go(metrics.IncListMiss)
+			tasks.Go(func() { metrics.AddMissDuration(elapsed.Seconds()) })
 			if uc.logger != nil {
 				uc.logger.Infof("cache miss: blogs list key=%s took=%s", key, elapsed)
 			}
@@ -197,6 +531,10 @@ func (uc *BlogUseCaseImpl) GetBlogs(ctx context.Context, page, pageSize int, sor
 		SortOrder: string(sortOrder),
 		DateFrom:  dateFrom,
 		DateTo:    dateTo,
+		OrgID:     orgID,
+		Language:  language,
+		// The public feed never lists mature-rated posts.
+		ExcludeMature: true,
 	}
 
 	// Only return published or archived blogs (not drafts)
@@ -224,18 +562,89 @@ func (uc *BlogUseCaseImpl) GetBlogs(ctx context.Context, page, pageSize int, sor
 
 	// If there is a cache miss before retuning save the results to the cache
 	if uc.blogCache != nil {
-		key := buildBlogsListCacheKey(page, pageSize, sortBy, sortOrder, dateFrom, dateTo)
+		key := buildBlogsListCacheKey(page, pageSize, sortBy, sortOrder, dateFrom, dateTo, orgID, language)
 		_ = uc.blogCache.SetBlogsPage(ctx, key, &contract.CachedBlogsPage{Blogs: filteredBlogs, Total: int(totalCount)})
 		if uc.logger != nil {
 			uc.logger.Infof("cache set: blogs list key=%s size=%d ttl=%s", key, len(filteredBlogs), 5*time.Minute)
 		}
 	}
 
+	// Gated posts are cached in full and truncated per viewer on the way
+	// out.
+	for i := range filteredBlogs {
+		uc.applyBlogAccess(ctx, viewerID, &filteredBlogs[i])
+	}
+
 	return filteredBlogs, int(totalCount), page, totalPages, nil
 }
 
+// blogTeaserLength is how much of a gated post's content viewers without
+// the required membership see.
+const blogTeaserLength = 280
+
+// BlogAccessPolicy answers whether a viewer may read posts gated at an
+// access level; implemented by the membership usecase.
+type BlogAccessPolicy interface {
+	HasAccess(ctx context.Context, viewerID *string, level entity.BlogAccessLevel) bool
+}
+
+// applyBlogAccess truncates a gated post's content to a teaser when the
+// viewer lacks the required membership. The author and admins always see
+// the full post. Posts are cached in full; this runs on a per-viewer copy.
+func (uc *BlogUseCaseImpl) applyBlogAccess(ctx context.Context, viewerID *string, blog *entity.Blog) {
+	if uc.accessPolicy == nil || blog.AccessLevel == "" || blog.AccessLevel == entity.BlogAccessPublic {
+		return
+	}
+	if viewerID != nil && *viewerID != "" {
+		if *viewerID == blog.AuthorID {
+			return
+		}
+		if uc.userRepo != nil {
+			if user, err := uc.userRepo.GetUserByID(ctx, *viewerID); err == nil && user.Role == entity.UserRoleAdmin {
+				return
+			}
+		}
+	}
+	if uc.accessPolicy.HasAccess(ctx, viewerID, blog.AccessLevel) {
+		return
+	}
+	if len(blog.Content) > blogTeaserLength {
+		// Back off to a rune boundary so the teaser never ends in a split
+		// UTF-8 sequence.
+		cut := blogTeaserLength
+		for cut > 0 && !utf8.RuneStart(blog.Content[cut]) {
+			cut--
+		}
+		blog.Content = blog.Content[:cut]
+	}
+	blog.IsLocked = true
+	// Don't hand out the full post through side channels either.
+	blog.AudioURL = ""
+	blog.AudioStatus = ""
+}
+
+// GateBlogAccess applies the membership paywall to a blog loaded outside
+// this usecase (feed reads); it implements BlogAccessGater.
+func (uc *BlogUseCaseImpl) GateBlogAccess(ctx context.Context, viewerID *string, blog *entity.Blog) {
+	uc.applyBlogAccess(ctx, viewerID, blog)
+}
+
+// CanViewMatureContent reports whether the user may see mature-rated posts:
+// a logged-in account that confirmed its age preference, or an admin.
+// Anonymous visitors never can.
+func (uc *BlogUseCaseImpl) CanViewMatureContent(ctx context.Context, userID string) bool {
+	if userID == "" || uc.userRepo == nil {
+		return false
+	}
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return false
+	}
+	return user.MatureContentOptIn || user.Role == entity.UserRoleAdmin
+}
+
 // GetBlogDetail retrieves a blog by its slug
-func (uc *BlogUseCaseImpl) GetBlogDetail(ctx context.Context, slug string) (entity.Blog, error) {
+func (uc *BlogUseCaseImpl) GetBlogDetail(ctx context.Context, slug string, viewerID *string) (entity.Blog, error) {
 	if slug == "" {
 		return entity.Blog{}, errors.New("slug is required")
 	}
@@ -246,19 +655,19 @@ func (uc *BlogUseCaseImpl) GetBlogDetail(ctx context.Context, slug string) (enti
 		cached, found, err := uc.blogCache.GetBlogBySlug(ctx, slug)
 		elapsed := time.Since(t0)
 		if err == nil && found && cached != nil {
-			atomic.AddUint64(&uc.detailHits, 1)
-			go metrics.IncDetailHit()
-			go metrics.AddHitDuration(elapsed.Seconds())
+			tasks.Go(metrics.IncDetailHit)
+			tasks.Go(func() { metrics.AddHitDuration(elapsed.Seconds()) })
 			if uc.logger != nil {
 				uc.logger.Infof("cache hit: blog detail slug=%s took=%s", slug, elapsed)
 			}
 			if cached.Status == entity.BlogStatusPublished || cached.Status == entity.BlogStatusArchived {
-				return *cached, nil
+				result := *cached
+				uc.applyBlogAccess(ctx, viewerID, &result)
+				return result, nil
 			}
 		} else if err == nil && !found {
-			atomic.AddUint64(&uc.detailMiss, 1)
-			go metrics.IncDetailMiss()
-			go metrics.AddMissDuration(elapsed.Seconds())
+			tasks.Go(metrics.IncDetailMiss)
+			tasks.Go(func() { metrics.AddMissDuration(elapsed.Seconds()) })
 			if uc.logger != nil {
 				uc.logger.Infof("cache miss: blog detail slug=%s took=%s", slug, elapsed)
 			}
@@ -279,6 +688,11 @@ func (uc *BlogUseCaseImpl) GetBlogDetail(ctx context.Context, slug string) (enti
 	if blog == nil || blog.IsDeleted {
 		return entity.Blog{}, errors.New("blog not found")
 	}
+	// Taken-down posts surface the reason so the handler can render a
+	// tombstone page instead of a plain 404.
+	if blog.Status == entity.BlogStatusTakenDown {
+		return entity.Blog{}, fmt.Errorf("blog taken down: %s", blog.TakedownReason)
+	}
 	// Only allow published or archived blogs to be fetched by slug
 	if blog.Status != entity.BlogStatusPublished && blog.Status != entity.BlogStatusArchived {
 		return entity.Blog{}, errors.New("blog not found")
@@ -288,11 +702,24 @@ func (uc *BlogUseCaseImpl) GetBlogDetail(ctx context.Context, slug string) (enti
 	if uc.blogCache != nil {
 		_ = uc.blogCache.SetBlogBySlug(ctx, slug, blog)
 	}
-	return *blog, nil
+	result := *blog
+	uc.applyBlogAccess(ctx, viewerID, &result)
+	return result, nil
+}
+
+// GetBlogMeta returns the blog and its author's display name for the meta
+// endpoint, so SSR clients and link unfurlers get SEO fields without the
+// full content payload.
+func (uc *BlogUseCaseImpl) GetBlogMeta(ctx context.Context, slug string) (*entity.Blog, string, error) {
+	blog, err := uc.GetBlogDetail(ctx, slug, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	return &blog, uc.shareImageAuthorName(ctx, blog.AuthorID), nil
 }
 
 // UpdateBlog updates an existing blog post
-func (uc *BlogUseCaseImpl) UpdateBlog(ctx context.Context, blogID, authorID string, title *string, content *string, status *entity.BlogStatus, featuredImageID *string) (*entity.Blog, error) {
+func (uc *BlogUseCaseImpl) UpdateBlog(ctx context.Context, blogID, authorID string, title *string, content *string, status *entity.BlogStatus, featuredImageID *string, language *string, canonicalURL *string, originalSource *string, contentRating *string, accessLevel *string) (*entity.Blog, error) {
 	if blogID == "" {
 		return nil, errors.New("blog ID is required")
 	}
@@ -310,8 +737,8 @@ func (uc *BlogUseCaseImpl) UpdateBlog(ctx context.Context, blogID, authorID stri
 		return nil, errors.New("blog not found")
 	}
 
-	// Check if user is the author
-	if blog.AuthorID != authorID {
+	// Check if user is the author or an editor/owner of the owning org
+	if blog.AuthorID != authorID && !uc.canManageOrgBlog(ctx, blog, authorID) {
 		return nil, errors.New("unauthorized: only the author can update this blog")
 	}
 
@@ -325,18 +752,49 @@ func (uc *BlogUseCaseImpl) UpdateBlog(ctx context.Context, blogID, authorID stri
 		updates["slug"] = newSlug + "-" + uc.uuidgen.NewUUID()
 	}
 	if content != nil {
+		// Accounts on probation may not post external links.
+		if uc.probation != nil && uc.probation.IsOnProbation(ctx, authorID) {
+			if uc.probation.ContainsExternalLink(*content) {
+				return nil, errors.New("external links are not allowed for new accounts")
+			}
+		}
+		// Strip iframes from unapproved sources before storing the content.
+		if uc.oembed != nil {
+			sanitized := uc.oembed.SanitizeContent(*content)
+			content = &sanitized
+		}
+		// Serve external images through the signed proxy.
+		if uc.imageProxy != nil {
+			rewritten := uc.imageProxy.RewriteContent(*content)
+			content = &rewritten
+		}
 		updates["content"] = *content
-		// if content is edited check for profanity
-		feedback, err := uc.aiUC.CensorAndCheckBlog(ctx, *content)
-		if err != nil {
-			return nil, fmt.Errorf("failed to check content: %w", err)
+		updates["reading_time_minutes"] = utils.EstimateReadingTime(*content)
+		if uc.linkPreviews != nil {
+			updates["link_previews"] = uc.linkPreviews.ExtractPreviews(ctx, *content)
+		}
+		if uc.oembed != nil {
+			updates["embedded_media"] = uc.oembed.ResolveEmbeds(ctx, *content)
 		}
-		if feedback == "no" {
-			return nil, errors.New("content contains inappropriate material")
+		// if content is edited check for profanity
+		if uc.aiModerationEnabled(ctx) {
+			feedback, err := uc.aiUC.CensorAndCheckBlog(ctx, *content)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check content: %w", err)
+			}
+			if feedback == "no" {
+				return nil, errors.New("content contains inappropriate material")
+			}
 		}
 	}
 
 	if status != nil {
+		// Only approved (or already published) posts may be published.
+		if *status == entity.BlogStatusPublished &&
+			blog.Status != entity.BlogStatusPublished &&
+			blog.Status != entity.BlogStatusApproved {
+			return nil, errors.New("blog must be approved before publishing")
+		}
 		updates["status"] = *status
 		if *status == entity.BlogStatusPublished && blog.PublishedAt == nil {
 			now := time.Now()
@@ -348,6 +806,63 @@ func (uc *BlogUseCaseImpl) UpdateBlog(ctx context.Context, blogID, authorID stri
 		updates["featured_image_id"] = *featuredImageID
 	}
 
+	if language != nil {
+		updates["language"] = *language
+	} else if content != nil && blog.Language == "" {
+		// Backfill the detected language for posts that never had one set,
+		// without overriding a language the author chose.
+		newTitle := blog.Title
+		if title != nil {
+			newTitle = *title
+		}
+		if detected := utils.DetectLanguage(newTitle + " " + *content); detected != "" {
+			updates["language"] = detected
+		}
+	}
+
+	if canonicalURL != nil {
+		// An empty value clears the attribution.
+		if err := validateCanonicalURL(*canonicalURL); err != nil {
+			return nil, err
+		}
+		updates["canonical_url"] = *canonicalURL
+	}
+
+	if originalSource != nil {
+		updates["original_source"] = *originalSource
+	}
+
+	if contentRating != nil {
+		rating, err := parseContentRating(*contentRating)
+		if err != nil {
+			return nil, err
+		}
+		updates["content_rating"] = rating
+	}
+
+	if accessLevel != nil {
+		access, err := parseAccessLevel(*accessLevel)
+		if err != nil {
+			return nil, err
+		}
+		updates["access_level"] = access
+	}
+
+	// Snapshot the pre-edit state so the change can be reviewed and diffed
+	// later. A failed snapshot is logged but never blocks the edit.
+	if uc.revisionRepo != nil && (title != nil || content != nil) {
+		revision := &entity.BlogRevision{
+			ID:       uc.uuidgen.NewUUID(),
+			BlogID:   blog.ID,
+			Title:    blog.Title,
+			Content:  blog.Content,
+			EditorID: authorID,
+		}
+		if err := uc.revisionRepo.CreateRevision(ctx, revision); err != nil {
+			uc.logger.Errorf("failed to snapshot blog revision: %v", err)
+		}
+	}
+
 	if len(updates) > 0 {
 		updates["updated_at"] = time.Now()
 		if err := uc.blogRepo.UpdateBlog(ctx, blogID, updates); err != nil {
@@ -363,6 +878,32 @@ func (uc *BlogUseCaseImpl) UpdateBlog(ctx context.Context, blogID, authorID stri
 		return nil, fmt.Errorf("failed to get updated blog: %w", err)
 	}
 
+	// Alert matching saved searches the first time the post goes live.
+	if uc.savedSearchNotifier != nil && updatedBlog != nil &&
+		blog.Status != entity.BlogStatusPublished && updatedBlog.Status == entity.BlogStatusPublished {
+		tasks.Go(func() { uc.savedSearchNotifier.NotifyPublished(context.Background(), updatedBlog) })
+	}
+
+	// Credit publish reputation the first time the post goes live.
+	if uc.reputation != nil && updatedBlog != nil &&
+		blog.Status != entity.BlogStatusPublished && updatedBlog.Status == entity.BlogStatusPublished {
+		publisherID := updatedBlog.AuthorID
+		tasks.Go(func() { uc.reputation.RecordAction(context.Background(), publisherID, entity.ReputationActionPublish) })
+	}
+
+	// Generate the social share image the first time the post goes live.
+	if uc.ogImageRenderer != nil && updatedBlog != nil && updatedBlog.OGImageURL == "" &&
+		updatedBlog.Status == entity.BlogStatusPublished {
+		tasks.Go(func() { uc.generateShareImage(context.Background(), updatedBlog) })
+	}
+
+	// Generate (or refresh after a content edit) the audio rendition of a
+	// published post.
+	if uc.tts != nil && updatedBlog != nil && updatedBlog.Status == entity.BlogStatusPublished &&
+		(updatedBlog.AudioStatus == "" || (content != nil && updatedBlog.AudioStatus != entity.AudioStatusPending)) {
+		tasks.Go(func() { uc.generateAudioRendition(context.Background(), updatedBlog) })
+	}
+
 	// Invalidate caches after update
 	if uc.blogCache != nil {
 		_ = uc.blogCache.InvalidateBlogLists(ctx)
@@ -378,90 +919,494 @@ func (uc *BlogUseCaseImpl) UpdateBlog(ctx context.Context, blogID, authorID stri
 	return updatedBlog, nil
 }
 
-// DeleteBlog deletes a blog post
-func (uc *BlogUseCaseImpl) DeleteBlog(ctx context.Context, blogID, userID string, isAdmin bool) (bool, error) {
-	if blogID == "" {
-		return false, errors.New("blog ID is required")
+// shareImageAuthorName resolves the author's display name for the share
+// image byline, falling back to an empty byline when the user repository is
+// unset or the lookup fails.
+func (uc *BlogUseCaseImpl) shareImageAuthorName(ctx context.Context, authorID string) string {
+	if uc.userRepo == nil {
+		return ""
+	}
+	author, err := uc.userRepo.GetUserByID(ctx, authorID)
+	if err != nil || author == nil {
+		return ""
+	}
+	return author.Username
+}
+
+// RenderShareImage renders the social share image for a published post,
+// identified by slug. The image is rendered on demand so it always reflects
+// the current title.
+func (uc *BlogUseCaseImpl) RenderShareImage(ctx context.Context, slug string) ([]byte, error) {
+	if uc.ogImageRenderer == nil {
+		return nil, errors.New("share images are not enabled")
 	}
-	if userID == "" {
-		return false, errors.New("user ID is required")
+	if slug == "" {
+		return nil, errors.New("slug is required")
 	}
 
-	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	blog, err := uc.blogRepo.GetBlogBySlug(ctx, slug)
 	if err != nil {
-		uc.logger.Errorf("failed to get blog: %v", err)
-		return false, fmt.Errorf("failed to get blog: %w", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
 	}
-	if blog == nil {
-		return false, errors.New("blog not found")
+	if blog == nil || blog.IsDeleted || blog.Status != entity.BlogStatusPublished {
+		return nil, errors.New("blog not found")
 	}
 
-	// Check authorization
-	if !isAdmin && blog.AuthorID != userID {
-		return false, errors.New("unauthorized: only the author or admin can delete this blog")
+	return uc.ogImageRenderer.RenderShareImage(blog.Title, uc.shareImageAuthorName(ctx, blog.AuthorID))
+}
+
+// generateShareImage renders the share image for a freshly published post,
+// records it in the media layer and stores its URL on the blog. Failures are
+// logged and never block publication.
+func (uc *BlogUseCaseImpl) generateShareImage(ctx context.Context, blog *entity.Blog) {
+	data, err := uc.ogImageRenderer.RenderShareImage(blog.Title, uc.shareImageAuthorName(ctx, blog.AuthorID))
+	if err != nil {
+		uc.logger.Warningf("failed to render share image for blog %s: %v", blog.ID, err)
+		return
 	}
 
-	if err := uc.blogRepo.DeleteBlog(ctx, blogID); err != nil {
-		uc.logger.Errorf("failed to delete blog: %v", err)
-		return false, fmt.Errorf("failed to delete blog: %w", err)
+	imageURL := fmt.Sprintf("%s/api/v1/blogs/slug/%s/og-image.png", uc.shareImageBaseURL, blog.Slug)
+	media := &entity.Media{
+		ID:               uc.uuidgen.NewUUID(),
+		FileName:         "og-" + blog.Slug + ".png",
+		URL:              imageURL,
+		MimeType:         "image/png",
+		FileSize:         int64(len(data)),
+		UploadedByUserID: blog.AuthorID,
+		CreatedAt:        time.Now(),
+	}
+	if err := uc.mediaStore.CreateMedia(ctx, media); err != nil {
+		uc.logger.Warningf("failed to store share image media for blog %s: %v", blog.ID, err)
+		return
+	}
+	if err := uc.mediaStore.AssociateMediaWithBlog(ctx, media.ID, blog.ID); err != nil {
+		uc.logger.Warningf("failed to associate share image with blog %s: %v", blog.ID, err)
 	}
 
-	// Invalidate caches after delete
-	if uc.blogCache != nil {
-		_ = uc.blogCache.InvalidateBlogLists(ctx)
-		if blog.Slug != "" {
+	if err := uc.blogRepo.UpdateBlog(ctx, blog.ID, map[string]interface{}{"og_image_url": imageURL}); err != nil {
+		uc.logger.Warningf("failed to set og_image_url on blog %s: %v", blog.ID, err)
+		return
+	}
+	if uc.blogCache != nil && blog.Slug != "" {
+		_ = uc.blogCache.InvalidateBlogBySlug(ctx, blog.Slug)
+	}
+}
+
+// generateAudioRendition synthesizes the spoken audio version of a published
+// post, persists it, records it in the media layer and stores its URL on the
+// blog. The status moves pending -> ready/failed; failures are logged and
+// never block publication.
+func (uc *BlogUseCaseImpl) generateAudioRendition(ctx context.Context, blog *entity.Blog) {
+	setStatus := func(updates map[string]interface{}) {
+		if err := uc.blogRepo.UpdateBlog(ctx, blog.ID, updates); err != nil {
+			uc.logger.Warningf("failed to update audio status on blog %s: %v", blog.ID, err)
+			return
+		}
+		if uc.blogCache != nil && blog.Slug != "" {
 			_ = uc.blogCache.InvalidateBlogBySlug(ctx, blog.Slug)
 		}
 	}
+	setStatus(map[string]interface{}{"audio_status": entity.AudioStatusPending})
 
-	return true, nil
-}
+	data, err := uc.tts.Synthesize(ctx, blog.Title+".\n\n"+blog.Content, blog.Language)
+	if err != nil {
+		uc.logger.Warningf("failed to synthesize audio for blog %s: %v", blog.ID, err)
+		setStatus(map[string]interface{}{"audio_status": entity.AudioStatusFailed})
+		return
+	}
 
-// TrackBlogView tracks a view on a blog post, ensuring it's authentic by checking user ID, IP address, and User-Agent.
+	fileName := "audio-" + blog.ID + ".mp3"
+	audioURL, err := uc.audioStorage.Save(ctx, fileName, data)
+	if err != nil {
+		uc.logger.Warningf("failed to store audio for blog %s: %v", blog.ID, err)
+		setStatus(map[string]interface{}{"audio_status": entity.AudioStatusFailed})
+		return
+	}
 
-// isBot returns true if the User-Agent string matches common bot patterns.
-func isBot(userAgent string) bool {
-	ua := strings.ToLower(userAgent)
-	botSignatures := []string{"bot", "spider", "crawl", "slurp", "curl", "wget", "python-requests", "httpclient", "feedfetcher", "mediapartners-google"}
-	for _, sig := range botSignatures {
-		if strings.Contains(ua, sig) {
-			return true
-		}
+	media := &entity.Media{
+		ID:               uc.uuidgen.NewUUID(),
+		FileName:         fileName,
+		URL:              audioURL,
+		MimeType:         "audio/mpeg",
+		FileSize:         int64(len(data)),
+		UploadedByUserID: blog.AuthorID,
+		CreatedAt:        time.Now(),
+	}
+	if err := uc.mediaStore.CreateMedia(ctx, media); err != nil {
+		uc.logger.Warningf("failed to store audio media for blog %s: %v", blog.ID, err)
+	} else if err := uc.mediaStore.AssociateMediaWithBlog(ctx, media.ID, blog.ID); err != nil {
+		uc.logger.Warningf("failed to associate audio with blog %s: %v", blog.ID, err)
 	}
-	return false
+
+	setStatus(map[string]interface{}{
+		"audio_url":    audioURL,
+		"audio_status": entity.AudioStatusReady,
+	})
 }
-func (uc *BlogUseCaseImpl) TrackBlogView(ctx context.Context, blogID, userID, ipAddress, userAgent string) error {
+
+// SubmitBlogForReview moves a writer's draft into the editorial review queue.
+// Only the author can submit, and only drafts or posts sent back with change
+// requests are eligible.
+func (uc *BlogUseCaseImpl) SubmitBlogForReview(ctx context.Context, blogID, authorID string) (*entity.Blog, error) {
 	if blogID == "" {
-		return errors.New("blog ID is required")
+		return nil, errors.New("blog ID is required")
+	}
+	if authorID == "" {
+		return nil, errors.New("author ID is required")
 	}
 
-	// For a view to be considered unique, either the userID (if logged in) or the IP address must be provided.
-	if userID == "" && ipAddress == "" {
-		return errors.New("unable to track view without user ID or IP address")
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.AuthorID != authorID {
+		return nil, errors.New("unauthorized: only the author can submit this blog for review")
+	}
+	if blog.Status != entity.BlogStatusDraft && blog.Status != entity.BlogStatusChangesRequested {
+		return nil, fmt.Errorf("only drafts can be submitted for review (current status: %s)", blog.Status)
 	}
 
-	// 1. Basic Bot Detection
-	if isBot(userAgent) {
-		uc.logger.Infof("Bot detected, view not counted for blog %s. User-Agent: %s", blogID, userAgent)
-		return nil
+	updates := map[string]interface{}{
+		"status":     entity.BlogStatusInReview,
+		"updated_at": time.Now(),
+	}
+	if err := uc.blogRepo.UpdateBlog(ctx, blogID, updates); err != nil {
+		uc.logger.Errorf("failed to submit blog for review: %v", err)
+		return nil, fmt.Errorf("failed to submit blog for review: %w", err)
 	}
 
-	// 2. Check for recent view from this user/IP for this specific blog post
-	hasViewed, err := uc.blogRepo.HasViewedRecently(ctx, blogID, userID, ipAddress)
+	return uc.blogRepo.GetBlogByID(ctx, blogID)
+}
+
+// ReviewBlog records an editor's verdict on a submitted blog: approval makes
+// the post publishable, otherwise it is sent back to the author with a note.
+// A note is required when requesting changes and optional on approval.
+func (uc *BlogUseCaseImpl) ReviewBlog(ctx context.Context, blogID, reviewerID string, approve bool, note string) (*entity.Blog, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	if reviewerID == "" {
+		return nil, errors.New("reviewer ID is required")
+	}
+	note = strings.TrimSpace(note)
+	if !approve && note == "" {
+		return nil, errors.New("a note is required when requesting changes")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
 	if err != nil {
-		uc.logger.Errorf("failed to check for recent blog view: %v", err)
-		return fmt.Errorf("failed to check for recent blog view: %w", err)
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
 	}
-	if hasViewed {
-		// Already viewed recently: return sentinel error for handler
-		uc.logger.Infof("User %s or IP %s already viewed blog %s recently", userID, ipAddress, blogID)
-		return errors.New("already viewed recently")
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.Status != entity.BlogStatusInReview {
+		return nil, fmt.Errorf("blog is not in review (current status: %s)", blog.Status)
 	}
 
-	// 3. Advanced Velocity & Rotation Checks (using Redis cache)
-	const (
-		maxIpVelocity     = 10      // max 10 views from one IP in 5 mins
-		ipVelocityTTL     = 5 * 60  // 5 minutes in seconds
+	newStatus := entity.BlogStatusChangesRequested
+	if approve {
+		newStatus = entity.BlogStatusApproved
+	}
+	updates := map[string]interface{}{
+		"status":     newStatus,
+		"updated_at": time.Now(),
+	}
+	if err := uc.blogRepo.UpdateBlog(ctx, blogID, updates); err != nil {
+		uc.logger.Errorf("failed to review blog: %v", err)
+		return nil, fmt.Errorf("failed to review blog: %w", err)
+	}
+
+	if note != "" {
+		reviewNote := &entity.ReviewNote{
+			ID:         uc.uuidgen.NewUUID(),
+			BlogID:     blogID,
+			ReviewerID: reviewerID,
+			Note:       note,
+			CreatedAt:  time.Now(),
+		}
+		if err := uc.blogRepo.AddReviewNote(ctx, reviewNote); err != nil {
+			// The verdict is already recorded; losing the note is not fatal.
+			uc.logger.Warnf("failed to store review note: %v", err)
+		}
+	}
+
+	return uc.blogRepo.GetBlogByID(ctx, blogID)
+}
+
+// GetReviewNotes returns the review notes for a blog. Only the author and
+// admins may read them.
+func (uc *BlogUseCaseImpl) GetReviewNotes(ctx context.Context, blogID, requesterID string, isAdmin bool) ([]*entity.ReviewNote, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if !isAdmin && blog.AuthorID != requesterID {
+		return nil, errors.New("unauthorized: only the author or an admin can read review notes")
+	}
+
+	return uc.blogRepo.GetReviewNotes(ctx, blogID)
+}
+
+// BlogRevisionDiff describes what changed between two revisions of a post.
+// ContentDiff is a unified text diff of the revision contents.
+type BlogRevisionDiff struct {
+	BlogID       string `json:"blog_id"`
+	From         int    `json:"from"`
+	To           int    `json:"to"`
+	FromTitle    string `json:"from_title"`
+	ToTitle      string `json:"to_title"`
+	TitleChanged bool   `json:"title_changed"`
+	ContentDiff  string `json:"content_diff"`
+}
+
+// revisionAccessBlog checks that the requester may read the blog's revision
+// history (the author or an org editor/owner) and returns the blog.
+func (uc *BlogUseCaseImpl) revisionAccessBlog(ctx context.Context, blogID, userID string) (*entity.Blog, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	if uc.revisionRepo == nil {
+		return nil, errors.New("revision history is not enabled")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.AuthorID != userID && !uc.canManageOrgBlog(ctx, blog, userID) {
+		return nil, errors.New("unauthorized: only the author can view revisions")
+	}
+	return blog, nil
+}
+
+// ListBlogRevisions returns a blog's revision metadata, newest first.
+func (uc *BlogUseCaseImpl) ListBlogRevisions(ctx context.Context, blogID, userID string) ([]*entity.BlogRevision, error) {
+	if _, err := uc.revisionAccessBlog(ctx, blogID, userID); err != nil {
+		return nil, err
+	}
+	return uc.revisionRepo.ListRevisions(ctx, blogID)
+}
+
+// GetBlogRevisionDiff compares two revisions of a blog so authors and
+// editors can review exactly what changed between them.
+func (uc *BlogUseCaseImpl) GetBlogRevisionDiff(ctx context.Context, blogID, userID string, from, to int) (*BlogRevisionDiff, error) {
+	if from < 1 || to < 1 {
+		return nil, errors.New("revision numbers must be positive")
+	}
+	if _, err := uc.revisionAccessBlog(ctx, blogID, userID); err != nil {
+		return nil, err
+	}
+
+	fromRev, err := uc.revisionRepo.GetRevision(ctx, blogID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := uc.revisionRepo.GetRevision(ctx, blogID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlogRevisionDiff{
+		BlogID:       blogID,
+		From:         from,
+		To:           to,
+		FromTitle:    fromRev.Title,
+		ToTitle:      toRev.Title,
+		TitleChanged: fromRev.Title != toRev.Title,
+		ContentDiff: utils.UnifiedDiff(
+			fmt.Sprintf("revision %d", from),
+			fmt.Sprintf("revision %d", to),
+			fromRev.Content,
+			toRev.Content,
+		),
+	}, nil
+}
+
+// SetAcceptedAnswer records the author's accepted answer on a question
+// post, or clears it when commentID is empty. The accepted comment is
+// surfaced at the top of the post's comment list.
+func (uc *BlogUseCaseImpl) SetAcceptedAnswer(ctx context.Context, blogID, authorID, commentID string) (*entity.Blog, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	if uc.commentRepo == nil {
+		return nil, errors.New("accepted answers are not enabled")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.AuthorID != authorID && !uc.canManageOrgBlog(ctx, blog, authorID) {
+		return nil, errors.New("unauthorized: only the author can accept an answer")
+	}
+	if blog.Type != entity.BlogTypeQuestion {
+		return nil, errors.New("only question posts can have an accepted answer")
+	}
+
+	var answer *entity.Comment
+	if commentID != "" {
+		comment, err := uc.commentRepo.GetByID(ctx, commentID)
+		if err != nil || comment == nil {
+			return nil, errors.New("comment not found")
+		}
+		if comment.BlogID != blogID {
+			return nil, errors.New("comment not found")
+		}
+		answer = comment
+	}
+
+	updates := map[string]interface{}{
+		"accepted_answer_id": commentID,
+		"updated_at":         time.Now(),
+	}
+	if err := uc.blogRepo.UpdateBlog(ctx, blogID, updates); err != nil {
+		uc.logger.Errorf("failed to update blog: %v", err)
+		return nil, fmt.Errorf("failed to update blog: %w", err)
+	}
+
+	if uc.blogCache != nil && blog.Slug != "" {
+		_ = uc.blogCache.InvalidateBlogBySlug(ctx, blog.Slug)
+	}
+
+	// Accepting an answer credits its author, except when the question's
+	// author answered themselves or the same answer is re-accepted.
+	if uc.reputation != nil && answer != nil && answer.AuthorID != "" &&
+		answer.AuthorID != blog.AuthorID && blog.AcceptedAnswerID != commentID {
+		answerAuthorID := answer.AuthorID
+		tasks.Go(func() {
+			uc.reputation.RecordAction(context.Background(), answerAuthorID, entity.ReputationActionHelpfulComment)
+		})
+	}
+
+	return uc.blogRepo.GetBlogByID(ctx, blogID)
+}
+
+// DeleteBlog deletes a blog post
+func (uc *BlogUseCaseImpl) DeleteBlog(ctx context.Context, blogID, userID string, isAdmin bool) (bool, error) {
+	if blogID == "" {
+		return false, errors.New("blog ID is required")
+	}
+	if userID == "" {
+		return false, errors.New("user ID is required")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return false, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return false, errors.New("blog not found")
+	}
+
+	// Check authorization (author, admin, or editor/owner of the owning org)
+	if !isAdmin && blog.AuthorID != userID && !uc.canManageOrgBlog(ctx, blog, userID) {
+		return false, errors.New("unauthorized: only the author or admin can delete this blog")
+	}
+
+	if err := uc.blogRepo.DeleteBlog(ctx, blogID); err != nil {
+		uc.logger.Errorf("failed to delete blog: %v", err)
+		return false, fmt.Errorf("failed to delete blog: %w", err)
+	}
+
+	// Invalidate caches after delete
+	if uc.blogCache != nil {
+		_ = uc.blogCache.InvalidateBlogLists(ctx)
+		if blog.Slug != "" {
+			_ = uc.blogCache.InvalidateBlogBySlug(ctx, blog.Slug)
+		}
+	}
+
+	return true, nil
+}
+
+// TrackBlogView tracks a view on a blog post, ensuring it's authentic by checking user ID, IP address, and User-Agent.
+
+// isBot returns true if the User-Agent string matches common bot patterns.
+func isBot(userAgent string) bool {
+	ua := strings.ToLower(userAgent)
+	botSignatures := []string{"bot", "spider", "crawl", "slurp", "curl", "wget", "python-requests", "httpclient", "feedfetcher", "mediapartners-google"}
+	for _, sig := range botSignatures {
+		if strings.Contains(ua, sig) {
+			return true
+		}
+	}
+	return false
+}
+
+// anomalyWeights maps each view-fraud signal to how much it bumps the
+// targeted blog's anomaly score: the harder a signal is to trip by accident,
+// the more it weighs.
+var anomalyWeights = map[string]int{
+	entity.ViewSignalShortDwell:          1,
+	entity.ViewSignalIPVelocity:          2,
+	entity.ViewSignalIPRotation:          3,
+	entity.ViewSignalFingerprintRotation: 3,
+}
+
+// recordViewAnomaly persists a fraud signal against the blog's anomaly
+// score. Failures are logged; scoring must never block view handling.
+func (uc *BlogUseCaseImpl) recordViewAnomaly(ctx context.Context, blogID, signal string) {
+	if err := uc.blogRepo.RecordViewAnomaly(ctx, blogID, signal, anomalyWeights[signal]); err != nil {
+		uc.logger.Errorf("failed to record view anomaly %s for blog %s: %v", signal, blogID, err)
+	}
+}
+
+func (uc *BlogUseCaseImpl) TrackBlogView(ctx context.Context, blogID, userID, ipAddress, userAgent, fingerprint string) error {
+	if blogID == "" {
+		return errors.New("blog ID is required")
+	}
+
+	// For a view to be considered unique, either the userID (if logged in) or the IP address must be provided.
+	if userID == "" && ipAddress == "" {
+		return errors.New("unable to track view without user ID or IP address")
+	}
+
+	// 1. Basic Bot Detection
+	if isBot(userAgent) {
+		uc.logger.Infof("Bot detected, view not counted for blog %s. User-Agent: %s", blogID, userAgent)
+		return nil
+	}
+
+	// 2. Check for recent view from this user/IP for this specific blog post
+	hasViewed, err := uc.blogRepo.HasViewedRecently(ctx, blogID, userID, ipAddress)
+	if err != nil {
+		uc.logger.Errorf("failed to check for recent blog view: %v", err)
+		return fmt.Errorf("failed to check for recent blog view: %w", err)
+	}
+	if hasViewed {
+		// Already viewed recently: return sentinel error for handler
+		uc.logger.Infof("User %s or IP %s already viewed blog %s recently", userID, ipAddress, blogID)
+		return errors.New("already viewed recently")
+	}
+
+	// 3. Advanced Velocity & Rotation Checks (using Redis cache)
+	const (
+		maxIpVelocity     = 10      // max 10 views from one IP in 5 mins
+		ipVelocityTTL     = 5 * 60  // 5 minutes in seconds
 		maxUserIPs        = 5       // max 5 different IPs for one user in 1 hour
 		userIPRotationTTL = 60 * 60 // 60 minutes in seconds
 	)
@@ -473,6 +1418,7 @@ func (uc *BlogUseCaseImpl) TrackBlogView(ctx context.Context, blogID, userID, ip
 		if err == nil {
 			if ipViewCount > int64(maxIpVelocity) {
 				uc.logger.Warningf("High IP velocity detected for %s. Views: %d", ipAddress, ipViewCount)
+				uc.recordViewAnomaly(ctx, blogID, entity.ViewSignalIPVelocity)
 				return fmt.Errorf("exceeded view velocity limit: too many views from this IP recently")
 			}
 		} else {
@@ -481,6 +1427,7 @@ func (uc *BlogUseCaseImpl) TrackBlogView(ctx context.Context, blogID, userID, ip
 			ipViews, dbErr := uc.blogRepo.GetRecentViewsByIP(ctx, ipAddress, shortWindow)
 			if dbErr == nil && len(ipViews) > maxIpVelocity {
 				uc.logger.Warningf("[DB Fallback] High IP velocity detected for %s. Views: %d", ipAddress, len(ipViews))
+				uc.recordViewAnomaly(ctx, blogID, entity.ViewSignalIPVelocity)
 				return fmt.Errorf("exceeded view velocity limit: too many views from this IP recently")
 			}
 		}
@@ -493,6 +1440,7 @@ func (uc *BlogUseCaseImpl) TrackBlogView(ctx context.Context, blogID, userID, ip
 			if err == nil {
 				if userIPCount > int64(maxUserIPs) {
 					uc.logger.Warningf("High IP rotation detected for user %s. IPs used: %d", userID, userIPCount)
+					uc.recordViewAnomaly(ctx, blogID, entity.ViewSignalIPRotation)
 					return fmt.Errorf("exceeded IP rotation limit: too many IPs used by this user recently")
 				}
 			} else {
@@ -506,6 +1454,7 @@ func (uc *BlogUseCaseImpl) TrackBlogView(ctx context.Context, blogID, userID, ip
 					}
 					if len(ipSet) > maxUserIPs {
 						uc.logger.Warningf("[DB Fallback] High IP rotation detected for user %s. IPs used: %d", userID, len(ipSet))
+						uc.recordViewAnomaly(ctx, blogID, entity.ViewSignalIPRotation)
 						return fmt.Errorf("exceeded IP rotation limit: too many IPs used by this user recently")
 					}
 				}
@@ -513,26 +1462,232 @@ func (uc *BlogUseCaseImpl) TrackBlogView(ctx context.Context, blogID, userID, ip
 		}
 	}
 
-	// If all checks pass, increment the view count and record the view on the DB
+	// 4. Device-fingerprint rotation check: one account viewing through many
+	// distinct devices in a short window is a bot-farm tell.
+	const maxUserFingerprints = 3
+	if userID != "" && fingerprint != "" {
+		mediumWindow := time.Now().Add(-60 * time.Minute)
+		if userViews, dbErr := uc.blogRepo.GetRecentViewsByUser(ctx, userID, mediumWindow); dbErr == nil {
+			fingerprintSet := map[string]struct{}{fingerprint: {}}
+			for _, recent := range userViews {
+				if recent.Fingerprint != "" {
+					fingerprintSet[recent.Fingerprint] = struct{}{}
+				}
+			}
+			if len(fingerprintSet) > maxUserFingerprints {
+				uc.logger.Warningf("High device rotation detected for user %s. Fingerprints: %d", userID, len(fingerprintSet))
+				uc.recordViewAnomaly(ctx, blogID, entity.ViewSignalFingerprintRotation)
+				return fmt.Errorf("exceeded device rotation limit: too many devices used by this user recently")
+			}
+		}
+	}
+
+	// All checks passed, but the view does not count yet: it is stored as
+	// pending and only counts once the client's dwell-time heartbeat
+	// confirms the reader actually stayed on the page (ConfirmBlogView).
+	view := &entity.BlogView{
+		BlogID:      blogID,
+		UserID:      userID,
+		IPAddress:   ipAddress,
+		UserAgent:   userAgent,
+		Fingerprint: fingerprint,
+		ViewedAt:    time.Now(),
+	}
+	// Enrich the view with geo data when a resolver is configured. Resolution
+	// failures are logged and the view is recorded without geo data.
+	if uc.geoResolver != nil && ipAddress != "" {
+		if loc, err := uc.geoResolver.ResolveIP(ctx, ipAddress); err != nil {
+			uc.logger.Warningf("failed to resolve GeoIP for %s: %v", ipAddress, err)
+		} else if loc != nil {
+			view.Country = loc.Country
+			view.Region = loc.Region
+		}
+	}
+
+	if err := uc.blogRepo.CreatePendingView(ctx, view); err != nil {
+		uc.logger.Errorf("failed to create pending view: %v", err)
+		return fmt.Errorf("failed to record user view: %w", err)
+	}
+	return nil
+}
+
+// minViewDwellTime is how long the client must stay on the page before its
+// heartbeat turns a pending view into a counted one.
+const minViewDwellTime = 10 * time.Second
+
+// ConfirmBlogView handles the client's dwell-time heartbeat: it consumes the
+// viewer's pending view and, provided the minimum dwell time has passed,
+// counts it. A heartbeat that arrives too early is a scripted client and is
+// scored against the blog instead of counted.
+func (uc *BlogUseCaseImpl) ConfirmBlogView(ctx context.Context, blogID, userID, ipAddress string) error {
+	if blogID == "" {
+		return errors.New("blog ID is required")
+	}
+	if userID == "" && ipAddress == "" {
+		return errors.New("unable to confirm view without user ID or IP address")
+	}
+
+	view, err := uc.blogRepo.ConsumePendingView(ctx, blogID, userID, ipAddress)
+	if err != nil {
+		if err.Error() == "no pending view" {
+			return err
+		}
+		uc.logger.Errorf("failed to consume pending view: %v", err)
+		return fmt.Errorf("failed to confirm blog view: %w", err)
+	}
+
+	if time.Since(view.ViewedAt) < minViewDwellTime {
+		uc.logger.Infof("Heartbeat for blog %s arrived before the minimum dwell time, view not counted", blogID)
+		uc.recordViewAnomaly(ctx, blogID, entity.ViewSignalShortDwell)
+		return errors.New("view heartbeat arrived too early")
+	}
+
 	if err := uc.blogRepo.IncrementViewCount(ctx, blogID); err != nil {
 		uc.logger.Errorf("failed to increment view count: %v", err)
 		return fmt.Errorf("failed to increment view count: %w", err)
 	}
 
-	if err := uc.blogRepo.RecordView(ctx, blogID, userID, ipAddress, userAgent); err != nil {
+	if err := uc.blogRepo.RecordView(ctx, view); err != nil {
 		uc.logger.Errorf("failed to record user view: %v", err)
 		return fmt.Errorf("failed to record user view: %w", err)
 	}
 
-	// Update popularity after view
-	if err := uc.UpdateBlogPopularity(ctx, blogID); err != nil {
+	// Update popularity after view, debounced for hot posts
+	if err := uc.RefreshBlogPopularity(ctx, blogID); err != nil {
 		uc.logger.Errorf("failed to update blog popularity after view: %v", err)
 	}
+
+	publishEngagement(ctx, uc.blogRepo, uc.engagementBus, blogID)
 	return nil
 }
 
+// ListViewAnomalies returns the blogs with the highest persisted view-fraud
+// scores, for admin review.
+func (uc *BlogUseCaseImpl) ListViewAnomalies(ctx context.Context, limit int) ([]entity.ViewAnomaly, error) {
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	anomalies, err := uc.blogRepo.ListViewAnomalies(ctx, limit)
+	if err != nil {
+		uc.logger.Errorf("failed to list view anomalies: %v", err)
+		return nil, errors.New("failed to list view anomalies")
+	}
+	return anomalies, nil
+}
+
+// RollbackBlogViews removes a number of suspicious views from a blog after
+// an admin reviewed its anomaly record, and clears the record.
+func (uc *BlogUseCaseImpl) RollbackBlogViews(ctx context.Context, blogID string, count int) error {
+	if blogID == "" {
+		return errors.New("blog ID is required")
+	}
+	if count < 1 {
+		return errors.New("rollback count must be positive")
+	}
+
+	if err := uc.blogRepo.RollbackViews(ctx, blogID, count); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return errors.New("blog not found")
+		}
+		uc.logger.Errorf("failed to roll back views for blog %s: %v", blogID, err)
+		return errors.New("failed to roll back views")
+	}
+
+	uc.logger.Infof("rolled back %d suspicious views on blog %s", count, blogID)
+	return nil
+}
+
+// publishEngagement snapshots the blog's counters and publishes them to the
+// engagement bus. Shared by the view, reaction and comment paths; a nil bus
+// or a failed lookup is a no-op.
+func publishEngagement(ctx context.Context, blogRepo contract.IBlogRepository, bus contract.IEngagementBus, blogID string) {
+	if bus == nil || blogRepo == nil {
+		return
+	}
+	blog, err := blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog == nil {
+		return
+	}
+	bus.Publish(entity.EngagementUpdate{
+		BlogID:   blog.ID,
+		Views:    blog.ViewCount,
+		Likes:    blog.LikeCount,
+		Dislikes: blog.DislikeCount,
+		Comments: blog.CommentCount,
+		Claps:    blog.ClapCount,
+	})
+}
+
+// StreamEngagement subscribes to the blog's live engagement updates. It
+// returns the current snapshot, the update channel and a cancel function the
+// caller must invoke on disconnect.
+func (uc *BlogUseCaseImpl) StreamEngagement(ctx context.Context, blogID string) (entity.EngagementUpdate, <-chan entity.EngagementUpdate, func(), error) {
+	if uc.engagementBus == nil {
+		return entity.EngagementUpdate{}, nil, nil, errors.New("live engagement is not enabled")
+	}
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog == nil {
+		return entity.EngagementUpdate{}, nil, nil, errors.New("blog not found")
+	}
+
+	initial := entity.EngagementUpdate{
+		BlogID:   blog.ID,
+		Views:    blog.ViewCount,
+		Likes:    blog.LikeCount,
+		Dislikes: blog.DislikeCount,
+		Comments: blog.CommentCount,
+		Claps:    blog.ClapCount,
+	}
+	if uc.presence != nil {
+		if readers, err := uc.presence.CountActiveReaders(ctx, blogID); err == nil {
+			initial.Readers = readers
+		}
+	}
+	updates, cancel := uc.engagementBus.Subscribe(blogID)
+	return initial, updates, cancel, nil
+}
+
+// HeartbeatPresence marks the reader as currently viewing the blog and
+// publishes the refreshed counters so open streams pick up the new reader
+// count.
+func (uc *BlogUseCaseImpl) HeartbeatPresence(ctx context.Context, blogID, readerID string) error {
+	if uc.presence == nil {
+		return errors.New("presence tracking is not enabled")
+	}
+	if err := uc.presence.Heartbeat(ctx, blogID, readerID); err != nil {
+		return fmt.Errorf("failed to record presence: %w", err)
+	}
+	publishEngagement(ctx, uc.blogRepo, uc.engagementBus, blogID)
+	return nil
+}
+
+// GetBlogViewGeoBreakdown returns the per-country view counts for a blog.
+// Only the blog's author or an admin may access the breakdown.
+func (uc *BlogUseCaseImpl) GetBlogViewGeoBreakdown(ctx context.Context, blogID, requesterID string, isAdmin bool) ([]entity.ViewGeoStat, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+
+	if blog.AuthorID != requesterID && !isAdmin {
+		return nil, errors.New("unauthorized: only the author can view blog analytics")
+	}
+
+	stats, err := uc.blogRepo.GetViewGeoBreakdown(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get view geo breakdown for blog %s: %v", blogID, err)
+		return nil, fmt.Errorf("failed to get view geo breakdown: %w", err)
+	}
+
+	return stats, nil
+}
+
 // GetPopularBlogs returns blogs sorted by view count (descending), paginated.
-func (uc *BlogUseCaseImpl) GetPopularBlogs(ctx context.Context, page, pageSize int) ([]entity.Blog, int, int, int, error) {
+func (uc *BlogUseCaseImpl) GetPopularBlogs(ctx context.Context, page, pageSize int, viewerID *string) ([]entity.Blog, int, int, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -541,10 +1696,11 @@ func (uc *BlogUseCaseImpl) GetPopularBlogs(ctx context.Context, page, pageSize i
 	}
 
 	filterOptions := &contract.BlogFilterOptions{
-		Page:      page,
-		PageSize:  pageSize,
-		SortBy:    "popularity",
-		SortOrder: "desc",
+		Page:          page,
+		PageSize:      pageSize,
+		SortBy:        "popularity",
+		SortOrder:     "desc",
+		ExcludeMature: true,
 	}
 
 	blogs, totalCount, err := uc.blogRepo.GetBlogs(ctx, filterOptions)
@@ -563,35 +1719,308 @@ func (uc *BlogUseCaseImpl) GetPopularBlogs(ctx context.Context, page, pageSize i
 		blogEntities = append(blogEntities, *blog)
 	}
 
+	// Gated posts leave as a per-viewer teaser.
+	for i := range blogEntities {
+		uc.applyBlogAccess(ctx, viewerID, &blogEntities[i])
+	}
+
+	return blogEntities, int(totalCount), page, totalPages, nil
+}
+
+// leaderboardCacheTTL bounds how stale the top-authors leaderboard can get;
+// the aggregation behind it is too heavy to run per request.
+const leaderboardCacheTTL = 5 * time.Minute
+
+// cachedLeaderboard is a computed leaderboard with its expiry.
+type cachedLeaderboard struct {
+	stats     []entity.AuthorStat
+	expiresAt time.Time
+}
+
+// GetTopAuthors returns the authors with the most published-blog engagement
+// in the window ("week", "month" or "all"), ranked by views then likes.
+// Authors who opted out of the leaderboard are skipped. Results are cached
+// per window.
+func (uc *BlogUseCaseImpl) GetTopAuthors(ctx context.Context, window string, limit int) ([]entity.AuthorStat, error) {
+	var since time.Time
+	switch window {
+	case "week":
+		since = time.Now().AddDate(0, 0, -7)
+	case "month":
+		since = time.Now().AddDate(0, -1, 0)
+	case "", "all":
+	default:
+		return nil, fmt.Errorf("invalid window: must be week, month or all")
+	}
+	if limit <= 0 {
+		limit = 10
+	} else if limit > 50 {
+		limit = 50
+	}
+
+	cacheKey := fmt.Sprintf("%s:%d", window, limit)
+	uc.leaderboardMu.Lock()
+	if cached, ok := uc.leaderboardCache[cacheKey]; ok && time.Now().Before(cached.expiresAt) {
+		uc.leaderboardMu.Unlock()
+		return cached.stats, nil
+	}
+	uc.leaderboardMu.Unlock()
+
+	// Fetch extra rows so opted-out authors do not shrink the leaderboard.
+	stats, err := uc.blogRepo.GetTopAuthors(ctx, since, limit*2)
+	if err != nil {
+		uc.logger.Errorf("failed to get top authors: %v", err)
+		return nil, fmt.Errorf("failed to get top authors: %w", err)
+	}
+
+	top := make([]entity.AuthorStat, 0, limit)
+	for _, stat := range stats {
+		if len(top) == limit {
+			break
+		}
+		if uc.userRepo != nil {
+			user, err := uc.userRepo.GetUserByID(ctx, stat.AuthorID)
+			if err != nil || user == nil {
+				continue
+			}
+			if user.LeaderboardOptOut {
+				continue
+			}
+			stat.Username = user.Username
+			stat.AvatarURL = user.AvatarURL
+		}
+		top = append(top, stat)
+	}
+
+	uc.leaderboardMu.Lock()
+	if uc.leaderboardCache == nil {
+		uc.leaderboardCache = make(map[string]cachedLeaderboard)
+	}
+	uc.leaderboardCache[cacheKey] = cachedLeaderboard{stats: top, expiresAt: time.Now().Add(leaderboardCacheTTL)}
+	uc.leaderboardMu.Unlock()
+
+	return top, nil
+}
+
+// GetBlogArchive returns published-post counts grouped by publication year
+// and month for the archive navigation, scoped to an organization when orgID
+// is set.
+func (uc *BlogUseCaseImpl) GetBlogArchive(ctx context.Context, orgID *string) ([]entity.ArchivePeriod, error) {
+	periods, err := uc.blogRepo.GetArchiveCounts(ctx, orgID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog archive: %v", err)
+		return nil, fmt.Errorf("failed to get blog archive: %w", err)
+	}
+	if periods == nil {
+		periods = []entity.ArchivePeriod{}
+	}
+	return periods, nil
+}
+
+// GetArchivePeriodBlogs pages over the posts published in the given year and
+// month, newest first.
+func (uc *BlogUseCaseImpl) GetArchivePeriodBlogs(ctx context.Context, year, month, page, pageSize int, orgID *string, viewerID *string) ([]entity.Blog, int, int, int, error) {
+	if year < 1 {
+		return nil, 0, 0, 0, errors.New("invalid year")
+	}
+	if month < 1 || month > 12 {
+		return nil, 0, 0, 0, errors.New("invalid month")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	blogs, totalCount, err := uc.blogRepo.GetBlogsByArchivePeriod(ctx, year, month, orgID, page, pageSize)
+	if err != nil {
+		uc.logger.Errorf("failed to get archive period blogs: %v", err)
+		return nil, 0, 0, 0, fmt.Errorf("failed to get archive period blogs: %w", err)
+	}
+
+	totalPages := int(totalCount) / pageSize
+	if int(totalCount)%pageSize != 0 {
+		totalPages++
+	}
+
+	var blogEntities []entity.Blog
+	for _, blog := range blogs {
+		blogEntities = append(blogEntities, *blog)
+	}
+
+	// Gated posts leave as a per-viewer teaser.
+	for i := range blogEntities {
+		uc.applyBlogAccess(ctx, viewerID, &blogEntities[i])
+	}
+
 	return blogEntities, int(totalCount), page, totalPages, nil
 }
 
+// suggestBlogsLimit is the number of title matches returned by the search
+// type-ahead endpoint.
+const suggestBlogsLimit = 8
+
+// popularQueriesLimit is the number of popular search queries returned
+// alongside title matches.
+const popularQueriesLimit = 5
+
+// SuggestBlogs powers the search type-ahead: it returns published blogs whose
+// title starts with the typed prefix plus the most popular search queries.
+// Title matches are served from the cache when one is configured.
+func (uc *BlogUseCaseImpl) SuggestBlogs(ctx context.Context, query string) ([]*entity.BlogSuggestion, []string, error) {
+	prefix := strings.ToLower(strings.TrimSpace(query))
+
+	var popular []string
+	if uc.blogCache != nil {
+		if queries, err := uc.blogCache.GetPopularSearchQueries(ctx, popularQueriesLimit); err == nil {
+			popular = queries
+		}
+	}
+	if popular == nil {
+		popular = []string{}
+	}
+
+	// An empty prefix has nothing to match titles against; popular queries
+	// alone still give the search box something to show.
+	if prefix == "" {
+		return []*entity.BlogSuggestion{}, popular, nil
+	}
+
+	cacheKey := "blogs:suggest:" + prefix
+	if uc.blogCache != nil {
+		if cached, ok, err := uc.blogCache.GetBlogSuggestions(ctx, cacheKey); err == nil && ok {
+			return cached, popular, nil
+		}
+	}
+
+	suggestions, err := uc.blogRepo.SuggestBlogTitles(ctx, prefix, suggestBlogsLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if suggestions == nil {
+		suggestions = []*entity.BlogSuggestion{}
+	}
+
+	if uc.blogCache != nil {
+		_ = uc.blogCache.SetBlogSuggestions(ctx, cacheKey, suggestions)
+	}
+
+	return suggestions, popular, nil
+}
+
+// searchAnalyticsLimit is the number of queries returned per section of the
+// admin search analytics.
+const searchAnalyticsLimit = 20
+
+// RecordSearchClick records that a search result was clicked, tying the
+// clicked blog back to the query that surfaced it.
+func (uc *BlogUseCaseImpl) RecordSearchClick(ctx context.Context, query, blogID, userID string) error {
+	if uc.searchEvents == nil {
+		return errors.New("search analytics is not enabled")
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return errors.New("query is required")
+	}
+	if blogID == "" {
+		return errors.New("blog ID is required")
+	}
+	event := &entity.SearchEvent{
+		ID:            uc.uuidgen.NewUUID(),
+		Query:         query,
+		ClickedBlogID: blogID,
+		UserID:        userID,
+		CreatedAt:     time.Now(),
+	}
+	return uc.searchEvents.RecordSearchEvent(ctx, event)
+}
+
+// GetSearchAnalytics returns the most-executed queries and the most-executed
+// zero-result queries since the given time, so content gaps can be spotted.
+func (uc *BlogUseCaseImpl) GetSearchAnalytics(ctx context.Context, since time.Time) ([]entity.SearchQueryStat, []entity.SearchQueryStat, error) {
+	if uc.searchEvents == nil {
+		return nil, nil, errors.New("search analytics is not enabled")
+	}
+	topQueries, err := uc.searchEvents.GetTopQueries(ctx, since, searchAnalyticsLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	zeroResultQueries, err := uc.searchEvents.GetZeroResultQueries(ctx, since, searchAnalyticsLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if topQueries == nil {
+		topQueries = []entity.SearchQueryStat{}
+	}
+	if zeroResultQueries == nil {
+		zeroResultQueries = []entity.SearchQueryStat{}
+	}
+	return topQueries, zeroResultQueries, nil
+}
+
 // SearchAndFilterBlogs implements advanced search and filtering for blogs.
 func (uc *BlogUseCaseImpl) SearchAndFilterBlogs(
 	ctx context.Context,
 	query string,
 	tags []string,
+	tagsAll []string,
 	dateFrom *time.Time,
 	dateTo *time.Time,
 	minViews *int,
 	maxViews *int,
 	minLikes *int,
 	maxLikes *int,
+	minReadingTime *int,
+	maxReadingTime *int,
 	authorID *string,
+	authorUsername *string,
+	orgID *string,
+	language *string,
+	status *string,
+	sortBy string,
+	sortOrder string,
 	page int,
 	pageSize int,
+	viewerID *string,
 ) ([]entity.Blog, int, int, int, error) {
+	// The status filter comes straight off a public route, so only the two
+	// public statuses may be requested — otherwise ?status=draft would list
+	// other users' drafts. Published-only is the default.
+	searchStatus := string(entity.BlogStatusPublished)
+	if status != nil && *status != "" {
+		switch entity.BlogStatus(*status) {
+		case entity.BlogStatusPublished, entity.BlogStatusArchived:
+			searchStatus = *status
+		default:
+			return nil, 0, 0, 0, errors.New("status filter must be published or archived")
+		}
+	}
+	status = &searchStatus
+
 	filterOptions := &contract.BlogFilterOptions{
-		Page:     page,
-		PageSize: pageSize,
-		DateFrom: dateFrom,
-		DateTo:   dateTo,
-		MinViews: minViews,
-		MaxViews: maxViews,
-		MinLikes: minLikes,
-		MaxLikes: maxLikes,
-		AuthorID: authorID,
-		TagIDs:   tags,
+		Page:           page,
+		PageSize:       pageSize,
+		SortBy:         sortBy,
+		SortOrder:      sortOrder,
+		DateFrom:       dateFrom,
+		DateTo:         dateTo,
+		MinViews:       minViews,
+		MaxViews:       maxViews,
+		MinLikes:       minLikes,
+		MaxLikes:       maxLikes,
+		MinReadingTime: minReadingTime,
+		MaxReadingTime: maxReadingTime,
+		AuthorID:       authorID,
+		AuthorUsername: authorUsername,
+		OrgID:          orgID,
+		Language:       language,
+		Status:         status,
+		TagIDs:         tags,
+		TagsAll:        tagsAll,
+		// Search is a public surface; mature-rated posts stay out of it just
+		// as they do in the listing feed.
+		ExcludeMature: true,
 	}
 	var blogs []*entity.Blog
 	var totalCount int64
@@ -605,6 +2034,22 @@ func (uc *BlogUseCaseImpl) SearchAndFilterBlogs(
 		uc.logger.Errorf("failed to search/filter blogs: %v", err)
 		return nil, 0, 0, 0, fmt.Errorf("failed to search/filter blogs: %w", err)
 	}
+	// Tally executed queries so the type-ahead can suggest popular ones.
+	if query != "" && uc.blogCache != nil {
+		_ = uc.blogCache.RecordSearchQuery(ctx, strings.ToLower(strings.TrimSpace(query)))
+	}
+	// Record the execution for the admin search analytics.
+	if query != "" && uc.searchEvents != nil {
+		event := &entity.SearchEvent{
+			ID:          uc.uuidgen.NewUUID(),
+			Query:       strings.ToLower(strings.TrimSpace(query)),
+			ResultCount: int(totalCount),
+			CreatedAt:   time.Now(),
+		}
+		if err := uc.searchEvents.RecordSearchEvent(ctx, event); err != nil && uc.logger != nil {
+			uc.logger.Warningf("failed to record search event: %v", err)
+		}
+	}
 	totalPages := int(totalCount) / pageSize
 	if int(totalCount)%pageSize != 0 {
 		totalPages++
@@ -613,6 +2058,12 @@ func (uc *BlogUseCaseImpl) SearchAndFilterBlogs(
 	for _, blog := range blogs {
 		blogEntities = append(blogEntities, *blog)
 	}
+
+	// Gated posts leave as a per-viewer teaser.
+	for i := range blogEntities {
+		uc.applyBlogAccess(ctx, viewerID, &blogEntities[i])
+	}
+
 	return blogEntities, int(totalCount), page, totalPages, nil
 }
 
@@ -622,7 +2073,135 @@ func (uc *BlogUseCaseImpl) UpdateBlogPopularity(ctx context.Context, blogID stri
 	if err != nil {
 		return err
 	}
-	popularity := utils.CalculatePopularity(views, likes, dislikes, comments)
+	weights := uc.PopularityWeights()
+	var age time.Duration
+	if weights.RecencyHalfLifeHours > 0 {
+		// Recency decay needs the publish time; fall back to no decay when
+		// the blog cannot be loaded.
+		if blog, err := uc.blogRepo.GetBlogByID(ctx, blogID); err == nil && blog != nil {
+			publishedAt := blog.CreatedAt
+			if blog.PublishedAt != nil {
+				publishedAt = *blog.PublishedAt
+			}
+			age = time.Since(publishedAt)
+		}
+	}
+	popularity := weights.Score(views, likes, dislikes, comments, age)
 	updates := map[string]interface{}{"popularity": popularity}
 	return uc.blogRepo.UpdateBlog(ctx, blogID, updates)
 }
+
+// PopularityWeights returns the weights currently applied by popularity
+// recomputes.
+func (uc *BlogUseCaseImpl) PopularityWeights() utils.PopularityWeights {
+	uc.popularityWeightsMu.RLock()
+	defer uc.popularityWeightsMu.RUnlock()
+	return uc.popularityWeights
+}
+
+// SetPopularityWeights swaps the popularity formula weights and kicks off a
+// background recalculation of every blog so stored scores stay comparable.
+func (uc *BlogUseCaseImpl) SetPopularityWeights(ctx context.Context, weights utils.PopularityWeights) error {
+	if weights.RecencyHalfLifeHours < 0 {
+		return fmt.Errorf("recency half-life must not be negative")
+	}
+
+	uc.popularityWeightsMu.Lock()
+	changed := weights != uc.popularityWeights
+	uc.popularityWeights = weights
+	uc.popularityWeightsMu.Unlock()
+
+	if changed {
+		tasks.Go(func() { uc.recalculateAllPopularity(context.Background()) })
+	}
+	return nil
+}
+
+// recalculateAllPopularity recomputes the stored popularity of every blog in
+// batches. It runs in the background after a weights change.
+func (uc *BlogUseCaseImpl) recalculateAllPopularity(ctx context.Context) {
+	const batchSize = 100
+
+	updated := 0
+	for offset := 0; ; offset += batchSize {
+		blogIDs, err := uc.blogRepo.ListBlogIDs(ctx, offset, batchSize)
+		if err != nil {
+			uc.logger.Errorf("popularity recalculation aborted: %v", err)
+			return
+		}
+		if len(blogIDs) == 0 {
+			break
+		}
+		for _, blogID := range blogIDs {
+			if err := uc.UpdateBlogPopularity(ctx, blogID); err != nil {
+				uc.logger.Warningf("failed to recalculate popularity for blog %s: %v", blogID, err)
+				continue
+			}
+			updated++
+		}
+	}
+	uc.logger.Infof("popularity recalculation finished: %d blogs updated", updated)
+}
+
+// SetPopularityDebounce bounds popularity recomputes to once per window per
+// blog: the counter marks hot posts, and the refresher started with
+// StartPopularityRefresher catches up the deferred ones. Without it every
+// view, like and comment recomputes synchronously.
+func (uc *BlogUseCaseImpl) SetPopularityDebounce(counter contract.IRateLimitCounter, window time.Duration) {
+	if window <= 0 {
+		window = 30 * time.Second
+	}
+	uc.popularityCounter = counter
+	uc.popularityWindow = window
+}
+
+// RefreshBlogPopularity recomputes a blog's popularity, debounced per blog.
+// The first update in a window goes through immediately so cold posts stay
+// fresh; further updates within the window only mark the blog dirty for the
+// next refresher pass.
+func (uc *BlogUseCaseImpl) RefreshBlogPopularity(ctx context.Context, blogID string) error {
+	if uc.popularityCounter == nil {
+		return uc.UpdateBlogPopularity(ctx, blogID)
+	}
+
+	count, err := uc.popularityCounter.Increment(ctx, "popularity:debounce:"+blogID, uc.popularityWindow)
+	if err != nil || count == 1 {
+		// Cold post (or Redis unavailable): update immediately.
+		return uc.UpdateBlogPopularity(ctx, blogID)
+	}
+
+	uc.dirtyPopularity.Store(blogID, struct{}{})
+	return nil
+}
+
+// StartPopularityRefresher runs the deferred popularity recomputes in the
+// background until the context is cancelled. One pass per debounce window
+// keeps hot posts at a single write per window.
+func (uc *BlogUseCaseImpl) StartPopularityRefresher(ctx context.Context) {
+	if uc.popularityCounter == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(uc.popularityWindow)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				uc.flushDirtyPopularity(ctx)
+			}
+		}
+	}()
+}
+
+func (uc *BlogUseCaseImpl) flushDirtyPopularity(ctx context.Context) {
+	uc.dirtyPopularity.Range(func(key, _ any) bool {
+		blogID := key.(string)
+		uc.dirtyPopularity.Delete(blogID)
+		if err := uc.UpdateBlogPopularity(ctx, blogID); err != nil {
+			uc.logger.Errorf("failed to refresh popularity for blog %s: %v", blogID, err)
+		}
+		return true
+	})
+}
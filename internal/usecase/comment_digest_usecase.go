@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// digestDailyHour is the local hour at which daily digests go out.
+const digestDailyHour = 8
+
+// digestTemplate renders the digest email body: one section per post with
+// its new comments.
+var digestTemplate = template.Must(template.New("commentDigest").Parse(
+	`Hi {{.Username}},
+
+Here is what happened on your posts since the last digest:
+{{range .Posts}}
+{{.Title}} ({{len .Comments}} new {{if eq (len .Comments) 1}}comment{{else}}comments{{end}}):
+{{- range .Comments}}
+  - {{.AuthorName}}: {{.Content}}
+{{- end}}
+{{end}}
+You receive this digest because comment notifications on your account are
+batched. Change the frequency in your profile settings.
+
+Thanks,
+The Team
+`))
+
+type digestPost struct {
+	Title    string
+	Comments []*entity.Comment
+}
+
+type digestData struct {
+	Username string
+	Posts    []digestPost
+}
+
+// CommentDigestUseCaseImpl batches comment notifications into periodic
+// digest emails, one per author summarizing new comments per post.
+type CommentDigestUseCaseImpl struct {
+	commentRepo contract.ICommentRepository
+	blogRepo    contract.IBlogRepository
+	userRepo    contract.IUserRepository
+	mailService contract.IEmailService
+	logger      usecasecontract.IAppLogger
+}
+
+func NewCommentDigestUseCase(commentRepo contract.ICommentRepository, blogRepo contract.IBlogRepository, userRepo contract.IUserRepository, mailService contract.IEmailService, logger usecasecontract.IAppLogger) *CommentDigestUseCaseImpl {
+	return &CommentDigestUseCaseImpl{
+		commentRepo: commentRepo,
+		blogRepo:    blogRepo,
+		userRepo:    userRepo,
+		mailService: mailService,
+		logger:      logger,
+	}
+}
+
+// StartDigestScheduler runs the digest passes in the background until the
+// context is cancelled: hourly digests every hour, daily digests once a day.
+func (uc *CommentDigestUseCaseImpl) StartDigestScheduler(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				uc.RunDigests(ctx, "hourly")
+				if now.Hour() == digestDailyHour {
+					uc.RunDigests(ctx, "daily")
+				}
+			}
+		}
+	}()
+}
+
+// RunDigests sends one digest email to every author subscribed at the given
+// frequency, covering the comments of the elapsed period. Per-author
+// failures are logged and do not stop the pass.
+func (uc *CommentDigestUseCaseImpl) RunDigests(ctx context.Context, frequency string) {
+	window := time.Hour
+	if frequency == "daily" {
+		window = 24 * time.Hour
+	}
+	since := time.Now().Add(-window)
+
+	users, err := uc.userRepo.ListUsersByCommentDigest(ctx, frequency)
+	if err != nil {
+		uc.logger.Errorf("failed to list %s digest subscribers: %v", frequency, err)
+		return
+	}
+	for _, user := range users {
+		if err := uc.sendDigest(ctx, user, since); err != nil {
+			uc.logger.Errorf("failed to send comment digest to user %s: %v", user.ID, err)
+		}
+	}
+}
+
+// sendDigest builds and sends one author's digest; authors with no new
+// comments in the window get no email.
+func (uc *CommentDigestUseCaseImpl) sendDigest(ctx context.Context, user *entity.User, since time.Time) error {
+	if user.Email == "" {
+		return nil
+	}
+
+	blogs, err := uc.blogRepo.ListBlogsByAuthorID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	titles := make(map[string]string, len(blogs))
+	blogIDs := make([]string, 0, len(blogs))
+	for _, blog := range blogs {
+		titles[blog.ID] = blog.Title
+		blogIDs = append(blogIDs, blog.ID)
+	}
+
+	comments, err := uc.commentRepo.ListApprovedByBlogIDsSince(ctx, blogIDs, since)
+	if err != nil {
+		return err
+	}
+
+	// Group per post, keeping the blogs in their listing order and skipping
+	// the author's own comments.
+	byBlog := make(map[string][]*entity.Comment)
+	for _, comment := range comments {
+		if comment.AuthorID == user.ID {
+			continue
+		}
+		byBlog[comment.BlogID] = append(byBlog[comment.BlogID], comment)
+	}
+	if len(byBlog) == 0 {
+		return nil
+	}
+
+	data := digestData{Username: user.Username}
+	total := 0
+	for _, blogID := range blogIDs {
+		if group := byBlog[blogID]; len(group) > 0 {
+			data.Posts = append(data.Posts, digestPost{Title: titles[blogID], Comments: group})
+			total += len(group)
+		}
+	}
+
+	var body strings.Builder
+	if err := digestTemplate.Execute(&body, data); err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("%d new comments on your posts", total)
+	if total == 1 {
+		subject = "1 new comment on your posts"
+	}
+	return uc.mailService.SendEmail(ctx, user.Email, subject, body.String())
+}
@@ -6,8 +6,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
-
-	// "time"
+	"time"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
@@ -15,26 +14,144 @@ import (
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
 )
 
-type commentUseCase struct {
+// Ensure CommentUseCaseImpl implements ICommentUseCase
+var _ usecasecontract.ICommentUseCase = (*CommentUseCaseImpl)(nil)
+
+type CommentUseCaseImpl struct {
 	commentRepo contract.ICommentRepository
 	blogRepo    contract.IBlogRepository
 	userRepo    contract.IUserRepository
+	config      usecasecontract.IConfigProvider
+	rateCounter contract.IRateLimitCounter
+	probation   *ProbationPolicy
+	// limits is optional; when set, comment length is capped per role
+	// instead of by the built-in default.
+	limits *LimitsPolicy
+	// moderationRepo is optional; when set, moderation steps are recorded
+	// for the audit history endpoints.
+	moderationRepo contract.IModerationActionRepository
+	// profanityFilter is optional; without it profanity is not screened.
+	profanityFilter contract.IProfanityFilter
+	// linkPreviews is optional; without it comments carry no link previews.
+	linkPreviews contract.ILinkPreviewService
+	// popularityRefresher is optional; when set, comment activity refreshes
+	// the blog's popularity score (debounced for hot posts).
+	popularityRefresher PopularityRefresher
+	// Reply-by-email is optional; when configured, comment replies trigger
+	// a notification email whose reply address posts the response.
+	mailService     contract.IEmailService
+	replyTokenRepo  contract.IEmailReplyTokenRepository
+	randomGenerator contract.IRandomGenerator
+	replyDomain     string
+	// pushNotifier is optional; when set, new comments and replies push an
+	// alert to the affected user's devices.
+	pushNotifier PushNotifier
+	// engagementBus is optional; when set, comment count changes feed the
+	// live engagement streams.
+	engagementBus contract.IEngagementBus
 }
 
 func NewCommentUseCase(
 	commentRepo contract.ICommentRepository,
 	blogRepo contract.IBlogRepository,
 	userRepo contract.IUserRepository,
-) usecasecontract.ICommentUseCase {
-	return &commentUseCase{
+	config usecasecontract.IConfigProvider,
+) *CommentUseCaseImpl {
+	return &CommentUseCaseImpl{
 		commentRepo: commentRepo,
 		blogRepo:    blogRepo,
 		userRepo:    userRepo,
+		config:      config,
+	}
+}
+
+// SetRateLimiter enables per-user comment rate limiting backed by Redis
+// counters. Without a counter the limits are not enforced.
+func (uc *CommentUseCaseImpl) SetRateLimiter(counter contract.IRateLimitCounter) {
+	uc.rateCounter = counter
+}
+
+// SetProbationPolicy enables new-account restrictions on commenting.
+func (uc *CommentUseCaseImpl) SetProbationPolicy(policy *ProbationPolicy) {
+	uc.probation = policy
+}
+
+// SetLimitsPolicy enables the per-role comment length cap.
+func (uc *CommentUseCaseImpl) SetLimitsPolicy(policy *LimitsPolicy) {
+	uc.limits = policy
+}
+
+// SetModerationLog enables recording of moderation actions for the audit
+// history endpoints.
+func (uc *CommentUseCaseImpl) SetModerationLog(repo contract.IModerationActionRepository) {
+	uc.moderationRepo = repo
+}
+
+// SetProfanityFilter enables profanity screening of comment content against
+// the admin-managed word lists.
+func (uc *CommentUseCaseImpl) SetProfanityFilter(filter contract.IProfanityFilter) {
+	uc.profanityFilter = filter
+}
+
+// SetLinkPreviewService enables unfurling of URLs in comment content into
+// stored link previews.
+func (uc *CommentUseCaseImpl) SetLinkPreviewService(service contract.ILinkPreviewService) {
+	uc.linkPreviews = service
+}
+
+// SetReplyByEmail enables comment reply notification emails carrying a
+// unique reply address, so replying to the email posts the response.
+func (uc *CommentUseCaseImpl) SetReplyByEmail(mailService contract.IEmailService, replyTokenRepo contract.IEmailReplyTokenRepository, randomGenerator contract.IRandomGenerator, replyDomain string) {
+	uc.mailService = mailService
+	uc.replyTokenRepo = replyTokenRepo
+	uc.randomGenerator = randomGenerator
+	uc.replyDomain = replyDomain
+}
+
+// SetPushNotifier enables push alerts for new comments and replies.
+func (uc *CommentUseCaseImpl) SetPushNotifier(notifier PushNotifier) {
+	uc.pushNotifier = notifier
+}
+
+// SetEngagementBus publishes comment count changes to the live engagement
+// streams.
+func (uc *CommentUseCaseImpl) SetEngagementBus(bus contract.IEngagementBus) {
+	uc.engagementBus = bus
+}
+
+// SetPopularityRefresher wires the blog popularity recompute so comment
+// activity feeds into the popularity score.
+func (uc *CommentUseCaseImpl) SetPopularityRefresher(refresher PopularityRefresher) {
+	uc.popularityRefresher = refresher
+}
+
+// recordModerationAction stores an audit record; failures are swallowed so a
+// logging problem never blocks the moderation step itself.
+func (uc *CommentUseCaseImpl) recordModerationAction(ctx context.Context, commentID, targetUserID, moderatorID string, action entity.ModerationActionType, detail string) {
+	if uc.moderationRepo == nil {
+		return
 	}
+	_ = uc.moderationRepo.RecordAction(ctx, &entity.ModerationAction{
+		CommentID:    commentID,
+		TargetUserID: targetUserID,
+		ModeratorID:  moderatorID,
+		Action:       action,
+		Detail:       detail,
+	})
 }
 
 // Core Operations
-func (uc *commentUseCase) CreateComment(ctx context.Context, req dto.CreateCommentRequest, userID, blogID string) (*dto.CommentResponse, error) {
+func (uc *CommentUseCaseImpl) CreateComment(ctx context.Context, req dto.CreateCommentRequest, userID, blogID string) (*dto.CommentResponse, error) {
+	// Enforce per-user rate limits before doing any work; accounts on
+	// probation get a tighter cap.
+	onProbation := uc.probation != nil && uc.probation.IsOnProbation(ctx, userID)
+	if err := uc.enforceCommentRateLimit(ctx, userID, onProbation); err != nil {
+		return nil, err
+	}
+	if onProbation && uc.probation.ContainsExternalLink(req.Content) {
+		return nil, errors.New("external links are not allowed for new accounts")
+	}
+
 	// Validate blog exists
 	_, err := uc.blogRepo.GetBlogByID(ctx, blogID)
 	if err != nil {
@@ -42,7 +159,7 @@ func (uc *commentUseCase) CreateComment(ctx context.Context, req dto.CreateComme
 	}
 
 	// Validate content
-	if err := uc.validateContent(req.Content); err != nil {
+	if err := uc.validateContent(ctx, userID, req.Content); err != nil {
 		return nil, err
 	}
 
@@ -81,12 +198,26 @@ func (uc *commentUseCase) CreateComment(ctx context.Context, req dto.CreateComme
 
 	// Fetch author name from userRepo
 	authorName := ""
+	status := "approved"
 	if uc.userRepo != nil {
 		user, err := uc.userRepo.GetUserByID(ctx, userID)
 		if err == nil {
 			authorName = user.Username
+			// Shadow-banned authors get their comments hidden from everyone else,
+			// while the comment still looks normal to themselves.
+			if user.IsShadowBanned {
+				status = "hidden"
+			}
 		}
 	}
+	// Comments from accounts on probation are held for moderation.
+	if status == "approved" && onProbation {
+		status = "pending"
+	}
+	// Mild profanity does not block the comment but holds it for moderation.
+	if status == "approved" && uc.profanitySeverity(ctx, req.Content) == entity.ProfanitySeverityMild {
+		status = "pending"
+	}
 
 	comment := &entity.Comment{
 		BlogID:         blogID,
@@ -97,29 +228,218 @@ func (uc *commentUseCase) CreateComment(ctx context.Context, req dto.CreateComme
 		TargetID:       req.TargetID,
 		Type:           commentType,
 		TargetUserName: targetUserName,
-		Status:         "approved",
+		Status:         status,
 		ReplyCount:     0,
 	}
+	if uc.linkPreviews != nil {
+		comment.LinkPreviews = uc.linkPreviews.ExtractPreviews(ctx, comment.Content)
+	}
 
 	// Create comment
 	if err := uc.commentRepo.Create(ctx, comment); err != nil {
 		return nil, fmt.Errorf("failed to create comment: %w", err)
 	}
 
-	// Update blog popularity after comment creation
-	if blogID != "" && uc.blogRepo != nil {
-		if updater, ok := uc.blogRepo.(interface {
-			UpdateBlogPopularity(context.Context, string) error
-		}); ok {
-			_ = updater.UpdateBlogPopularity(ctx, blogID)
+	// Update blog popularity after comment creation, debounced for hot posts
+	if blogID != "" && uc.popularityRefresher != nil {
+		_ = uc.popularityRefresher.RefreshBlogPopularity(ctx, blogID)
+	}
+	publishEngagement(ctx, uc.blogRepo, uc.engagementBus, blogID)
+
+	// Notify the parent comment's author of the reply; failures must never
+	// block the comment itself.
+	if status == "approved" {
+		if comment.ParentID != nil && *comment.ParentID != "" {
+			uc.notifyCommentReply(ctx, comment)
 		}
+		uc.notifyCommentPush(ctx, comment)
 	}
 
 	// Return response
 	return uc.toCommentResponse(ctx, comment, &userID)
 }
 
-func (uc *commentUseCase) GetComment(ctx context.Context, commentID string, userID *string) (*dto.CommentResponse, error) {
+// replyTokenTTL is how long a reply-by-email address stays usable.
+const replyTokenTTL = 30 * 24 * time.Hour
+
+// notifyCommentReply emails the parent comment's author about the reply,
+// with a unique reply address so answering the email posts a comment.
+func (uc *CommentUseCaseImpl) notifyCommentReply(ctx context.Context, comment *entity.Comment) {
+	if uc.mailService == nil || uc.replyTokenRepo == nil || uc.randomGenerator == nil || uc.userRepo == nil {
+		return
+	}
+
+	parent, err := uc.commentRepo.GetByID(ctx, *comment.ParentID)
+	if err != nil || parent.AuthorID == "" || parent.AuthorID == comment.AuthorID {
+		return
+	}
+	recipient, err := uc.userRepo.GetUserByID(ctx, parent.AuthorID)
+	if err != nil || recipient == nil || recipient.Email == "" {
+		return
+	}
+
+	token, err := uc.randomGenerator.GenerateRandomToken(32)
+	if err != nil {
+		return
+	}
+	replyToken := &entity.EmailReplyToken{
+		Token:           token,
+		UserID:          parent.AuthorID,
+		BlogID:          comment.BlogID,
+		ParentCommentID: comment.ID,
+		CreatedAt:       time.Now(),
+		ExpiresAt:       time.Now().Add(replyTokenTTL),
+	}
+	if err := uc.replyTokenRepo.CreateReplyToken(ctx, replyToken); err != nil {
+		return
+	}
+
+	replyAddress := fmt.Sprintf("reply+%s@%s", token, uc.replyDomain)
+	subject := fmt.Sprintf("%s replied to your comment", comment.AuthorName)
+	body := fmt.Sprintf("Hi %s,\n\n%s replied to your comment:\n\n%s\n\nReply to this email (%s) to respond directly.\n\nThanks,\nThe Team", recipient.Username, comment.AuthorName, comment.Content, replyAddress)
+	_ = uc.mailService.SendEmailWithReplyTo(ctx, recipient.Email, subject, body, replyAddress)
+}
+
+// notifyCommentPush pushes an alert about the new comment: the parent
+// comment's author for replies, the blog's author otherwise. Self-comments
+// are skipped.
+func (uc *CommentUseCaseImpl) notifyCommentPush(ctx context.Context, comment *entity.Comment) {
+	if uc.pushNotifier == nil {
+		return
+	}
+
+	var recipientID, title string
+	if comment.ParentID != nil && *comment.ParentID != "" {
+		parent, err := uc.commentRepo.GetByID(ctx, *comment.ParentID)
+		if err != nil {
+			return
+		}
+		recipientID = parent.AuthorID
+		title = fmt.Sprintf("%s replied to your comment", comment.AuthorName)
+	} else {
+		blog, err := uc.blogRepo.GetBlogByID(ctx, comment.BlogID)
+		if err != nil || blog == nil {
+			return
+		}
+		recipientID = blog.AuthorID
+		title = fmt.Sprintf("%s commented on %q", comment.AuthorName, blog.Title)
+	}
+	if recipientID == "" || recipientID == comment.AuthorID {
+		return
+	}
+	uc.pushNotifier.NotifyUser(ctx, recipientID, title, comment.Content)
+}
+
+// ReplyByEmail posts a comment on behalf of the user an inbound email's
+// reply token belongs to. The raw email body is stripped of quoted text
+// before it becomes the comment content.
+func (uc *CommentUseCaseImpl) ReplyByEmail(ctx context.Context, token, body string) (*dto.CommentResponse, error) {
+	if uc.replyTokenRepo == nil {
+		return nil, errors.New("reply-by-email is not enabled")
+	}
+	replyToken, err := uc.replyTokenRepo.GetReplyToken(ctx, token)
+	if err != nil {
+		return nil, errors.New("invalid reply token")
+	}
+	if time.Now().After(replyToken.ExpiresAt) {
+		return nil, errors.New("reply token expired")
+	}
+
+	content := stripQuotedReply(body)
+	if content == "" {
+		return nil, errors.New("reply body is empty")
+	}
+
+	parentID := replyToken.ParentCommentID
+	req := dto.CreateCommentRequest{
+		Content:  content,
+		ParentID: &parentID,
+	}
+	return uc.CreateComment(ctx, req, replyToken.UserID, replyToken.BlogID)
+}
+
+// stripQuotedReply keeps only the text the sender actually wrote, dropping
+// quoted lines (">") and the "On ... wrote:" attribution mail clients add
+// above the quote.
+func stripQuotedReply(body string) string {
+	var kept []string
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "On ") && strings.HasSuffix(trimmed, "wrote:") {
+			break
+		}
+		kept = append(kept, line)
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+// CreateGuestComment creates a comment from an unauthenticated visitor,
+// identified by display name + email. Guest comments always start in
+// "pending" status so a moderator approves them before they become visible.
+func (uc *CommentUseCaseImpl) CreateGuestComment(ctx context.Context, req dto.CreateGuestCommentRequest, blogID string) (*dto.CommentResponse, error) {
+	// Validate blog exists
+	_, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		return nil, fmt.Errorf("blog not found: %w", err)
+	}
+
+	// Validate content
+	if err := uc.validateContent(ctx, "", req.Content); err != nil {
+		return nil, err
+	}
+
+	displayName := strings.TrimSpace(req.DisplayName)
+	if displayName == "" {
+		return nil, errors.New("display name is required")
+	}
+
+	commentType := "comment"
+	var targetUserName string
+	if req.ParentID != nil && *req.ParentID != "" {
+		commentType = "reply"
+		parent, err := uc.commentRepo.GetByID(ctx, *req.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parent/target relationship: parent comment not found: %w", err)
+		}
+		parent.ReplyCount = parent.ReplyCount + 1
+		_ = uc.commentRepo.Update(ctx, parent)
+		targetUserName = parent.AuthorName
+	}
+
+	comment := &entity.Comment{
+		BlogID:         blogID,
+		AuthorID:       "",
+		AuthorName:     displayName,
+		GuestName:      displayName,
+		GuestEmail:     strings.TrimSpace(req.Email),
+		Content:        strings.TrimSpace(req.Content),
+		ParentID:       req.ParentID,
+		Type:           commentType,
+		TargetUserName: targetUserName,
+		Status:         "pending",
+		ReplyCount:     0,
+	}
+	if uc.linkPreviews != nil {
+		comment.LinkPreviews = uc.linkPreviews.ExtractPreviews(ctx, comment.Content)
+	}
+
+	if err := uc.commentRepo.Create(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	// Update blog popularity after comment creation, debounced for hot posts
+	if blogID != "" && uc.popularityRefresher != nil {
+		_ = uc.popularityRefresher.RefreshBlogPopularity(ctx, blogID)
+	}
+	publishEngagement(ctx, uc.blogRepo, uc.engagementBus, blogID)
+
+	return uc.toCommentResponse(ctx, comment, nil)
+}
+
+func (uc *CommentUseCaseImpl) GetComment(ctx context.Context, commentID string, userID *string) (*dto.CommentResponse, error) {
 	comment, err := uc.commentRepo.GetByID(ctx, commentID)
 	if err != nil {
 		return nil, err
@@ -128,7 +448,7 @@ func (uc *commentUseCase) GetComment(ctx context.Context, commentID string, user
 	return uc.toCommentResponse(ctx, comment, userID)
 }
 
-func (uc *commentUseCase) UpdateComment(ctx context.Context, commentID, userID string, req dto.UpdateCommentRequest) (*dto.CommentResponse, error) {
+func (uc *CommentUseCaseImpl) UpdateComment(ctx context.Context, commentID, userID string, req dto.UpdateCommentRequest) (*dto.CommentResponse, error) {
 	// Get existing comment
 	comment, err := uc.commentRepo.GetByID(ctx, commentID)
 	if err != nil {
@@ -141,12 +461,15 @@ func (uc *commentUseCase) UpdateComment(ctx context.Context, commentID, userID s
 	}
 
 	// Validate content
-	if err := uc.validateContent(req.Content); err != nil {
+	if err := uc.validateContent(ctx, userID, req.Content); err != nil {
 		return nil, err
 	}
 
 	// Update comment
 	comment.Content = strings.TrimSpace(req.Content)
+	if uc.linkPreviews != nil {
+		comment.LinkPreviews = uc.linkPreviews.ExtractPreviews(ctx, comment.Content)
+	}
 	if err := uc.commentRepo.Update(ctx, comment); err != nil {
 		return nil, fmt.Errorf("failed to update comment: %w", err)
 	}
@@ -154,7 +477,7 @@ func (uc *commentUseCase) UpdateComment(ctx context.Context, commentID, userID s
 	return uc.toCommentResponse(ctx, comment, &userID)
 }
 
-func (uc *commentUseCase) DeleteComment(ctx context.Context, commentID, userID string) error {
+func (uc *CommentUseCaseImpl) DeleteComment(ctx context.Context, commentID, userID string) error {
 	// Get existing comment
 	comment, err := uc.commentRepo.GetByID(ctx, commentID)
 	if err != nil {
@@ -171,19 +494,15 @@ func (uc *commentUseCase) DeleteComment(ctx context.Context, commentID, userID s
 		return err
 	}
 
-	// Update blog popularity after comment deletion
-	if comment.BlogID != "" && uc.blogRepo != nil {
-		if updater, ok := uc.blogRepo.(interface {
-			UpdateBlogPopularity(context.Context, string) error
-		}); ok {
-			_ = updater.UpdateBlogPopularity(ctx, comment.BlogID)
-		}
+	// Update blog popularity after comment deletion, debounced for hot posts
+	if comment.BlogID != "" && uc.popularityRefresher != nil {
+		_ = uc.popularityRefresher.RefreshBlogPopularity(ctx, comment.BlogID)
 	}
 	return nil
 }
 
 // Listing Operations
-func (uc *commentUseCase) GetBlogComments(ctx context.Context, blogID string, page, pageSize int, userID *string) (*dto.CommentsResponse, error) {
+func (uc *CommentUseCaseImpl) GetBlogComments(ctx context.Context, blogID string, page, pageSize int, userID *string) (*dto.CommentsResponse, error) {
 	// Validate pagination
 	if page < 1 {
 		page = 1
@@ -197,7 +516,7 @@ func (uc *commentUseCase) GetBlogComments(ctx context.Context, blogID string, pa
 		PageSize: pageSize,
 	}
 
-	comments, total, err := uc.commentRepo.GetTopLevelComments(ctx, blogID, pagination)
+	comments, total, err := uc.commentRepo.GetTopLevelComments(ctx, blogID, userID, pagination)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get blog comments: %w", err)
 	}
@@ -211,6 +530,10 @@ func (uc *commentUseCase) GetBlogComments(ctx context.Context, blogID string, pa
 		}
 	}
 
+	// Surface the author's accepted answer at the top of the first page of a
+	// question post.
+	commentResponses = uc.surfaceAcceptedAnswer(ctx, blogID, page, commentResponses, userID)
+
 	// Create pagination meta
 	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
 	paginationMeta := dto.PaginationMeta{
@@ -228,8 +551,46 @@ func (uc *commentUseCase) GetBlogComments(ctx context.Context, blogID string, pa
 	}, nil
 }
 
-func (uc *commentUseCase) GetCommentThread(ctx context.Context, commentID string, userID *string) (*dto.CommentThreadResponse, error) {
-	thread, err := uc.commentRepo.GetCommentThread(ctx, commentID)
+// surfaceAcceptedAnswer moves the accepted answer of a question post to the
+// front of the comment list. On the first page the answer is fetched and
+// prepended when it fell outside the page; failures leave the list as-is.
+func (uc *CommentUseCaseImpl) surfaceAcceptedAnswer(ctx context.Context, blogID string, page int, responses []*dto.CommentResponse, userID *string) []*dto.CommentResponse {
+	if uc.blogRepo == nil {
+		return responses
+	}
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog.Type != entity.BlogTypeQuestion || blog.AcceptedAnswerID == "" {
+		return responses
+	}
+
+	for i, resp := range responses {
+		if resp.ID == blog.AcceptedAnswerID {
+			resp.IsAcceptedAnswer = true
+			copy(responses[1:i+1], responses[:i])
+			responses[0] = resp
+			return responses
+		}
+	}
+
+	// The accepted answer is not on this page; prepend it on page one so
+	// readers always see it first.
+	if page != 1 {
+		return responses
+	}
+	answer, err := uc.commentRepo.GetByID(ctx, blog.AcceptedAnswerID)
+	if err != nil || answer.BlogID != blogID {
+		return responses
+	}
+	resp, err := uc.toCommentResponse(ctx, answer, userID)
+	if err != nil {
+		return responses
+	}
+	resp.IsAcceptedAnswer = true
+	return append([]*dto.CommentResponse{resp}, responses...)
+}
+
+func (uc *CommentUseCaseImpl) GetCommentThread(ctx context.Context, commentID string, userID *string) (*dto.CommentThreadResponse, error) {
+	thread, err := uc.commentRepo.GetCommentThread(ctx, commentID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get comment thread: %w", err)
 	}
@@ -237,7 +598,7 @@ func (uc *commentUseCase) GetCommentThread(ctx context.Context, commentID string
 	return uc.toCommentThreadResponse(ctx, thread, userID)
 }
 
-func (uc *commentUseCase) GetUserComments(ctx context.Context, userID string, page, pageSize int) (*dto.CommentsResponse, error) {
+func (uc *CommentUseCaseImpl) GetUserComments(ctx context.Context, userID string, page, pageSize int) (*dto.CommentsResponse, error) {
 	// Validate pagination
 	if page < 1 {
 		page = 1
@@ -283,15 +644,66 @@ func (uc *commentUseCase) GetUserComments(ctx context.Context, userID string, pa
 }
 
 // Moderation
-func (uc *commentUseCase) UpdateCommentStatus(ctx context.Context, commentID, moderatorID string, req dto.UpdateCommentStatusRequest) error {
+func (uc *CommentUseCaseImpl) UpdateCommentStatus(ctx context.Context, commentID, moderatorID string, req dto.UpdateCommentStatusRequest) error {
 	// Here you would check if moderatorID has admin/moderator role
 	// For now, we'll assume they do
 
-	return uc.commentRepo.UpdateStatus(ctx, commentID, req.Status)
+	comment, err := uc.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.commentRepo.UpdateStatus(ctx, commentID, req.Status); err != nil {
+		return err
+	}
+
+	uc.recordModerationAction(ctx, commentID, comment.AuthorID, moderatorID, entity.ModerationActionStatusChange, req.Status)
+	return nil
+}
+
+// BulkModerateComments applies a moderation action to many comments with one
+// batched repository update and records a single audit entry for the batch.
+func (uc *CommentUseCaseImpl) BulkModerateComments(ctx context.Context, moderatorID string, isAdmin bool, commentIDs []string, action, reason string) (int64, error) {
+	if !isAdmin {
+		return 0, errors.New("unauthorized: admin access required")
+	}
+	if len(commentIDs) == 0 {
+		return 0, errors.New("no comment IDs provided")
+	}
+
+	var (
+		modified   int64
+		err        error
+		actionType entity.ModerationActionType
+	)
+	switch action {
+	case "delete":
+		modified, err = uc.commentRepo.BulkDelete(ctx, commentIDs)
+		actionType = entity.ModerationActionDeletion
+	case "approve":
+		modified, err = uc.commentRepo.BulkUpdateStatus(ctx, commentIDs, "approved")
+		actionType = entity.ModerationActionStatusChange
+	case "hide":
+		modified, err = uc.commentRepo.BulkUpdateStatus(ctx, commentIDs, "hidden")
+		actionType = entity.ModerationActionStatusChange
+	default:
+		return 0, fmt.Errorf("invalid bulk action: %s", action)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	detail := fmt.Sprintf("bulk %s of %d comments", action, modified)
+	if reason != "" {
+		detail += ": " + reason
+	}
+	uc.recordModerationAction(ctx, "", "", moderatorID, actionType, detail)
+
+	return modified, nil
 }
 
 // Engagement
-func (uc *commentUseCase) LikeComment(ctx context.Context, commentID, userID string) error {
+func (uc *CommentUseCaseImpl) LikeComment(ctx context.Context, commentID, userID string) error {
 	// Check if comment exists
 	_, err := uc.commentRepo.GetByID(ctx, commentID)
 	if err != nil {
@@ -301,7 +713,7 @@ func (uc *commentUseCase) LikeComment(ctx context.Context, commentID, userID str
 	return uc.commentRepo.LikeComment(ctx, commentID, userID)
 }
 
-func (uc *commentUseCase) UnlikeComment(ctx context.Context, commentID, userID string) error {
+func (uc *CommentUseCaseImpl) UnlikeComment(ctx context.Context, commentID, userID string) error {
 	// Check if comment exists
 	_, err := uc.commentRepo.GetByID(ctx, commentID)
 	if err != nil {
@@ -311,8 +723,39 @@ func (uc *commentUseCase) UnlikeComment(ctx context.Context, commentID, userID s
 	return uc.commentRepo.UnlikeComment(ctx, commentID, userID)
 }
 
+// ReactToComment records an emoji reaction; each user can use each emoji at
+// most once per comment.
+func (uc *CommentUseCaseImpl) ReactToComment(ctx context.Context, commentID, userID, reaction string) error {
+	if !entity.IsValidCommentReaction(reaction) {
+		return fmt.Errorf("invalid reaction: allowed reactions are %s", strings.Join(entity.CommentReactions, " "))
+	}
+
+	// Check if comment exists
+	_, err := uc.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	return uc.commentRepo.ReactToComment(ctx, commentID, userID, reaction)
+}
+
+// RemoveCommentReaction removes a previously recorded emoji reaction.
+func (uc *CommentUseCaseImpl) RemoveCommentReaction(ctx context.Context, commentID, userID, reaction string) error {
+	if !entity.IsValidCommentReaction(reaction) {
+		return fmt.Errorf("invalid reaction: allowed reactions are %s", strings.Join(entity.CommentReactions, " "))
+	}
+
+	// Check if comment exists
+	_, err := uc.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	return uc.commentRepo.RemoveCommentReaction(ctx, commentID, userID, reaction)
+}
+
 // Reporting
-func (uc *commentUseCase) ReportComment(ctx context.Context, commentID, userID string, req dto.ReportCommentRequest) error {
+func (uc *CommentUseCaseImpl) ReportComment(ctx context.Context, commentID, userID string, req dto.ReportCommentRequest) error {
 	// Check if comment exists
 	_, err := uc.commentRepo.GetByID(ctx, commentID)
 	if err != nil {
@@ -329,7 +772,7 @@ func (uc *commentUseCase) ReportComment(ctx context.Context, commentID, userID s
 	return uc.commentRepo.ReportComment(ctx, report)
 }
 
-func (uc *commentUseCase) GetCommentReports(ctx context.Context, page, pageSize int) (*dto.ReportsResponse, error) {
+func (uc *CommentUseCaseImpl) GetCommentReports(ctx context.Context, page, pageSize int) (*dto.ReportsResponse, error) {
 	// Validate pagination
 	if page < 1 {
 		page = 1
@@ -381,44 +824,167 @@ func (uc *commentUseCase) GetCommentReports(ctx context.Context, page, pageSize
 	}, nil
 }
 
-func (uc *commentUseCase) UpdateReportStatus(ctx context.Context, reportID, reviewerID string, status string) error {
-	return uc.commentRepo.UpdateReportStatus(ctx, reportID, status, reviewerID)
+// UpdateReportStatus resolves a pending report. Reports only move forward
+// from "pending"; when a comment action is given (or the status is
+// "actioned") the reported comment is hidden or deleted in the same step.
+func (uc *CommentUseCaseImpl) UpdateReportStatus(ctx context.Context, reportID, reviewerID string, status string, commentAction string) error {
+	switch status {
+	case "reviewed", "dismissed", "actioned":
+	default:
+		return fmt.Errorf("invalid report status: %s", status)
+	}
+
+	report, err := uc.commentRepo.GetReportByID(ctx, reportID)
+	if err != nil {
+		return err
+	}
+	if report.Status != "pending" {
+		return errors.New("report has already been resolved")
+	}
+
+	if status == "actioned" && commentAction == "" {
+		return errors.New("comment action is required when actioning a report")
+	}
+
+	// The reported comment may already be gone; the audit record is still
+	// written with whatever is known.
+	targetUserID := ""
+	if comment, err := uc.commentRepo.GetByID(ctx, report.CommentID); err == nil {
+		targetUserID = comment.AuthorID
+	}
+
+	switch commentAction {
+	case "":
+	case "hide":
+		if err := uc.commentRepo.UpdateStatus(ctx, report.CommentID, "hidden"); err != nil {
+			return fmt.Errorf("failed to hide reported comment: %w", err)
+		}
+		uc.recordModerationAction(ctx, report.CommentID, targetUserID, reviewerID, entity.ModerationActionStatusChange, "hidden")
+	case "delete":
+		if err := uc.commentRepo.Delete(ctx, report.CommentID); err != nil {
+			return fmt.Errorf("failed to delete reported comment: %w", err)
+		}
+		uc.recordModerationAction(ctx, report.CommentID, targetUserID, reviewerID, entity.ModerationActionDeletion, "deleted via report")
+	default:
+		return fmt.Errorf("invalid comment action: %s", commentAction)
+	}
+
+	if err := uc.commentRepo.UpdateReportStatus(ctx, reportID, status, reviewerID); err != nil {
+		return err
+	}
+
+	uc.recordModerationAction(ctx, report.CommentID, targetUserID, reviewerID, entity.ModerationActionReportResolution, status)
+	return nil
+}
+
+// enforceCommentRateLimit applies the per-user comment limits: fresh accounts
+// sit out a cooldown before their first comment, and everyone is capped at a
+// configurable number of comments per minute (tighter while on probation). A
+// Redis outage fails open so an
+// infrastructure problem never blocks commenting.
+func (uc *CommentUseCaseImpl) enforceCommentRateLimit(ctx context.Context, userID string, onProbation bool) error {
+	if uc.rateCounter == nil || uc.config == nil {
+		return nil
+	}
+
+	if cooldown := uc.config.GetNewAccountCommentCooldown(); cooldown > 0 && uc.userRepo != nil {
+		if user, err := uc.userRepo.GetUserByID(ctx, userID); err == nil {
+			if age := time.Since(user.CreatedAt); age < cooldown {
+				return &usecasecontract.CommentRateLimitError{RetryAfter: cooldown - age}
+			}
+		}
+	}
+
+	limit := uc.config.GetCommentRateLimitPerMinute()
+	if onProbation {
+		if probationLimit := uc.config.GetProbationCommentRateLimitPerMinute(); probationLimit > 0 {
+			limit = probationLimit
+		}
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	key := "ratelimit:comments:" + userID
+	count, err := uc.rateCounter.Increment(ctx, key, time.Minute)
+	if err != nil {
+		return nil
+	}
+	if count > int64(limit) {
+		retryAfter := time.Minute
+		if ttl, err := uc.rateCounter.TTL(ctx, key); err == nil && ttl > 0 {
+			retryAfter = ttl
+		}
+		return &usecasecontract.CommentRateLimitError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// GetCommentModerationHistory returns the moderation audit trail of a comment.
+func (uc *CommentUseCaseImpl) GetCommentModerationHistory(ctx context.Context, commentID string) ([]*entity.ModerationAction, error) {
+	if uc.moderationRepo == nil {
+		return []*entity.ModerationAction{}, nil
+	}
+	return uc.moderationRepo.GetActionsByComment(ctx, commentID)
+}
+
+// GetUserModerationHistory returns every moderation action taken against a
+// user's comments.
+func (uc *CommentUseCaseImpl) GetUserModerationHistory(ctx context.Context, userID string) ([]*entity.ModerationAction, error) {
+	if uc.moderationRepo == nil {
+		return []*entity.ModerationAction{}, nil
+	}
+	return uc.moderationRepo.GetActionsByUser(ctx, userID)
 }
 
 // Helper Methods
-func (uc *commentUseCase) validateContent(content string) error {
+func (uc *CommentUseCaseImpl) validateContent(ctx context.Context, authorID, content string) error {
 	content = strings.TrimSpace(content)
 
 	if len(content) == 0 {
 		return errors.New("comment content cannot be empty")
 	}
 
-	if len(content) > 1000 {
-		return errors.New("comment content too long (max 1000 characters)")
+	// The length cap is role-based when the limits policy is configured;
+	// zero means the role has no cap.
+	maxLength := 1000
+	if uc.limits != nil {
+		maxLength = uc.limits.MaxCommentLength(ctx, authorID)
+	}
+	if maxLength > 0 && len(content) > maxLength {
+		return fmt.Errorf("comment content too long (max %d characters)", maxLength)
 	}
 
-	// Add profanity filter, spam detection, etc.
-	if uc.containsProfanity(content) {
+	// Severe profanity blocks the comment outright; mild matches are handled
+	// by holding the comment for moderation instead.
+	if uc.profanitySeverity(ctx, content) == entity.ProfanitySeveritySevere {
 		return errors.New("comment contains inappropriate language")
 	}
 
 	return nil
 }
 
-func (uc *commentUseCase) containsProfanity(content string) bool {
-	// Implement profanity detection logic
-	// For now, return false
-	if strings.Contains(strings.ToLower(content), "badword") {
-		return true
+// profanitySeverity returns the worst profanity severity found in content,
+// or an empty string when no filter is configured or the content is clean.
+func (uc *CommentUseCaseImpl) profanitySeverity(ctx context.Context, content string) string {
+	if uc.profanityFilter == nil {
+		return ""
 	}
-	return false
+	return uc.profanityFilter.Check(ctx, content, "")
 }
 
-func (uc *commentUseCase) toCommentResponse(ctx context.Context, comment *entity.Comment, userID *string) (*dto.CommentResponse, error) {
-	// Get author name
-	author, err := uc.userRepo.GetUserByID(ctx, comment.AuthorID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get comment author: %w", err)
+func (uc *CommentUseCaseImpl) toCommentResponse(ctx context.Context, comment *entity.Comment, userID *string) (*dto.CommentResponse, error) {
+	// Get author name. Guest comments carry no author ID; their display name
+	// is stored on the comment itself.
+	authorName := comment.GuestName
+	isGuest := comment.AuthorID == ""
+	if !isGuest {
+		author, err := uc.userRepo.GetUserByID(ctx, comment.AuthorID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get comment author: %w", err)
+		}
+		authorName = author.Username
 	}
 
 	// Check if liked by current user
@@ -427,9 +993,36 @@ func (uc *commentUseCase) toCommentResponse(ctx context.Context, comment *entity
 		isLiked, _ = uc.commentRepo.IsCommentLikedByUser(ctx, comment.ID, *userID)
 	}
 
+	// Emoji reactions: per-emoji counts plus the viewer's own reactions
+	reactions, _ := uc.commentRepo.GetCommentReactionCounts(ctx, comment.ID)
+	if reactions == nil {
+		reactions = map[string]int64{}
+	}
+	var userReactions []string
+	if userID != nil {
+		userReactions, _ = uc.commentRepo.GetUserCommentReactions(ctx, comment.ID, *userID)
+	}
+
 	// Use stored reply count for now (could be recalculated if needed)
 	replyCount := comment.ReplyCount
 
+	var linkPreviews []dto.LinkPreviewResponse
+	for _, preview := range comment.LinkPreviews {
+		linkPreviews = append(linkPreviews, dto.LinkPreviewResponse{
+			URL:         preview.URL,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImageURL:    preview.ImageURL,
+			SiteName:    preview.SiteName,
+		})
+	}
+
+	// A shadow-banned author should see their own hidden comments as normal.
+	status := comment.Status
+	if status == "hidden" && userID != nil && !isGuest && *userID == comment.AuthorID {
+		status = "approved"
+	}
+
 	return &dto.CommentResponse{
 		ID:             comment.ID,
 		BlogID:         comment.BlogID,
@@ -437,19 +1030,23 @@ func (uc *commentUseCase) toCommentResponse(ctx context.Context, comment *entity
 		ParentID:       comment.ParentID,
 		TargetID:       comment.TargetID,
 		AuthorID:       comment.AuthorID,
-		AuthorName:     author.Username,
+		AuthorName:     authorName,
+		IsGuest:        isGuest,
 		TargetUserName: comment.TargetUserName,
 		Content:        comment.Content,
-		Status:         comment.Status,
+		Status:         status,
 		LikeCount:      comment.LikeCount,
 		IsLiked:        isLiked,
+		Reactions:      reactions,
+		UserReactions:  userReactions,
+		LinkPreviews:   linkPreviews,
 		CreatedAt:      comment.CreatedAt,
 		UpdatedAt:      comment.UpdatedAt,
 		ReplyCount:     replyCount,
 	}, nil
 }
 
-func (uc *commentUseCase) toCommentThreadResponse(ctx context.Context, thread *entity.CommentThread, userID *string) (*dto.CommentThreadResponse, error) {
+func (uc *CommentUseCaseImpl) toCommentThreadResponse(ctx context.Context, thread *entity.CommentThread, userID *string) (*dto.CommentThreadResponse, error) {
 	commentResponse, err := uc.toCommentResponse(ctx, thread.Comment, userID)
 	if err != nil {
 		return nil, err
@@ -471,7 +1068,7 @@ func (uc *commentUseCase) toCommentThreadResponse(ctx context.Context, thread *e
 	return response, nil
 }
 
-func (uc *commentUseCase) GetBlogCommentsCount(ctx context.Context, blogID string) (int64, error) {
+func (uc *CommentUseCaseImpl) GetBlogCommentsCount(ctx context.Context, blogID string) (int64, error) {
 	count, err := uc.commentRepo.GetCommentCount(ctx, blogID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get blog comments count: %w", err)
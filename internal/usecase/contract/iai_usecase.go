@@ -1,9 +1,20 @@
 package usecasecontract
 
-import "context"
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
 
 type IAIUseCase interface {
-	GenerateBlogContent(ctx context.Context, keywords string) (string, error)
-	SuggestAndModifyContent(ctx context.Context, keywords, blog string) (string, error)
+	GenerateBlogContent(ctx context.Context, userID, keywords string) (string, error)
+	SuggestAndModifyContent(ctx context.Context, userID, keywords, blog string) (string, error)
 	CensorAndCheckBlog(ctx context.Context, blog string) (string, error)
+	// Granular assistant endpoints, quota-limited per user per day.
+	OutlineBlog(ctx context.Context, userID, topic string) (string, error)
+	ExpandSection(ctx context.Context, userID, section, draft string) (string, error)
+	RewriteTone(ctx context.Context, userID, content, tone string) (string, error)
+	// GetMyUsage reports the user's consumption over the last day and last
+	// 30 days with the configured quotas (zero = unlimited).
+	GetMyUsage(ctx context.Context, userID string) (daily, monthly *entity.AIUsageSummary, dailyQuota, monthlyQuota int, err error)
 }
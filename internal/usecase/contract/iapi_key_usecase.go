@@ -0,0 +1,19 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IAPIKeyUseCase manages personal access tokens for programmatic API access.
+type IAPIKeyUseCase interface {
+	// CreateAPIKey issues a new key for the user and returns it with the
+	// plaintext key, which is shown once and never stored.
+	CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]*entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID string) error
+	// Authenticate resolves a presented key to its record and owning user,
+	// rejecting revoked keys and inactive accounts.
+	Authenticate(ctx context.Context, rawKey string) (*entity.APIKey, *entity.User, error)
+}
@@ -2,13 +2,30 @@ package usecasecontract
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	"github.com/mikiasgoitom/Articulate/internal/dto"
 )
 
+// CommentRateLimitError reports how long the caller must wait before
+// commenting again; handlers translate it into a 429 response with a
+// Retry-After header.
+type CommentRateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *CommentRateLimitError) Error() string {
+	return fmt.Sprintf("comment rate limit exceeded, retry after %d seconds", int(e.RetryAfter.Seconds()))
+}
+
 type ICommentUseCase interface {
 	// Core operations
 	CreateComment(ctx context.Context, req dto.CreateCommentRequest, userID, blogID string) (*dto.CommentResponse, error)
+	// CreateGuestComment creates a comment from an unauthenticated visitor;
+	// guest comments always start in "pending" status.
+	CreateGuestComment(ctx context.Context, req dto.CreateGuestCommentRequest, blogID string) (*dto.CommentResponse, error)
 	GetComment(ctx context.Context, commentID string, userID *string) (*dto.CommentResponse, error)
 	UpdateComment(ctx context.Context, commentID, userID string, req dto.UpdateCommentRequest) (*dto.CommentResponse, error)
 	DeleteComment(ctx context.Context, commentID, userID string) error
@@ -21,12 +38,26 @@ type ICommentUseCase interface {
 
 	// Moderation
 	UpdateCommentStatus(ctx context.Context, commentID, moderatorID string, req dto.UpdateCommentStatusRequest) error
+	// BulkModerateComments applies one action ("delete", "approve" or "hide")
+	// to many comments in a single batched update; admin only.
+	BulkModerateComments(ctx context.Context, moderatorID string, isAdmin bool, commentIDs []string, action, reason string) (int64, error)
 	// Engagement
 	LikeComment(ctx context.Context, commentID, userID string) error
 	UnlikeComment(ctx context.Context, commentID, userID string) error
+	// ReactToComment and RemoveCommentReaction manage emoji reactions from the
+	// entity.CommentReactions set.
+	ReactToComment(ctx context.Context, commentID, userID, reaction string) error
+	RemoveCommentReaction(ctx context.Context, commentID, userID, reaction string) error
 
 	// Reporting
 	ReportComment(ctx context.Context, commentID, userID string, req dto.ReportCommentRequest) error
 	GetCommentReports(ctx context.Context, page, pageSize int) (*dto.ReportsResponse, error)
-	UpdateReportStatus(ctx context.Context, reportID, reviewerID string, status string) error
+	// UpdateReportStatus resolves a pending report (reviewed, dismissed or
+	// actioned), optionally applying a moderation action ("hide" or "delete")
+	// to the reported comment in the same step.
+	UpdateReportStatus(ctx context.Context, reportID, reviewerID string, status string, commentAction string) error
+
+	// Moderation audit history
+	GetCommentModerationHistory(ctx context.Context, commentID string) ([]*entity.ModerationAction, error)
+	GetUserModerationHistory(ctx context.Context, userID string) ([]*entity.ModerationAction, error)
 }
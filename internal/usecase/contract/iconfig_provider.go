@@ -1,12 +1,32 @@
 package usecasecontract
 
-import "time"
+import (
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
 
 type IConfigProvider interface {
 	GetSendActivationEmail() bool
 	GetAppBaseURL() string
 	GetRefreshTokenExpiry() time.Duration
+	GetAccessTokenExpiryFor(clientType entity.ClientType) time.Duration
+	GetRefreshTokenExpiryFor(clientType entity.ClientType, rememberMe bool) time.Duration
 	GetPasswordResetTokenExpiry() time.Duration
 	GetEmailVerificationTokenExpiry() time.Duration
 	GetAIServiceAPIKey() string
+	GetCaptchaEnabled() bool
+	GetCaptchaProvider() string
+	GetCaptchaSecret() string
+	GetAllowAnonymousComments() bool
+	GetMaintenanceMode() bool
+	GetEmailVerifySuccessURL() string
+	GetEmailVerifyFailureURL() string
+	GetPasswordHashAlgorithm() string
+	GetBcryptCost() int
+	GetCommentRateLimitPerMinute() int
+	GetNewAccountCommentCooldown() time.Duration
+	GetNewAccountProbationPeriod() time.Duration
+	GetProbationCommentRateLimitPerMinute() int
+	GetProfanityWords() []string
 }
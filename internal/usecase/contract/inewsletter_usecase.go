@@ -0,0 +1,24 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// INewsletterUseCase defines the business logic for newsletter
+// subscriptions and broadcasts.
+type INewsletterUseCase interface {
+	// Subscribe starts a double opt-in subscription to an author's
+	// newsletter, or to the site newsletter when authorID is empty.
+	Subscribe(ctx context.Context, email, authorID string) error
+	// Confirm completes the double opt-in for the emailed token.
+	Confirm(ctx context.Context, token string) error
+	// Unsubscribe removes the subscription behind an unsubscribe link.
+	Unsubscribe(ctx context.Context, token string) error
+	// Broadcast sends a published post to the author's confirmed
+	// subscribers; delivery runs in the background.
+	Broadcast(ctx context.Context, blogID, authorID string) (*entity.NewsletterBroadcast, error)
+	// ListBroadcasts returns the author's broadcasts with delivery stats.
+	ListBroadcasts(ctx context.Context, authorID string) ([]*entity.NewsletterBroadcast, error)
+}
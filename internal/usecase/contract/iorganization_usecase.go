@@ -0,0 +1,24 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IOrganizationUseCase defines the business operations for multi-author
+// publications (organizations) and their memberships.
+type IOrganizationUseCase interface {
+	// CreateOrganization creates an organization with the creator as owner.
+	CreateOrganization(ctx context.Context, name, slug, description, ownerID string) (*entity.Organization, error)
+	// GetOrganizationProfile returns the public profile page: the
+	// organization, its members and its recent posts.
+	GetOrganizationProfile(ctx context.Context, slug string) (*entity.OrganizationProfile, error)
+	// UpdateOrganization updates profile and tenant settings (description,
+	// custom domain, theme); only owners may change them.
+	UpdateOrganization(ctx context.Context, slug, actorID string, description, customDomain *string, theme map[string]string) (*entity.Organization, error)
+	// AddMember adds a user to the organization; only owners manage members.
+	AddMember(ctx context.Context, slug, actorID, userID string, role entity.OrgRole) error
+	UpdateMemberRole(ctx context.Context, slug, actorID, userID string, role entity.OrgRole) error
+	RemoveMember(ctx context.Context, slug, actorID, userID string) error
+}
@@ -0,0 +1,19 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IPushUseCase defines push notification operations: device registration and
+// delivering alerts to all of a user's registered devices.
+type IPushUseCase interface {
+	RegisterDevice(ctx context.Context, userID, token, platform string) error
+	UnregisterDevice(ctx context.Context, userID, token string) error
+	ListDevices(ctx context.Context, userID string) ([]*entity.Device, error)
+	// NotifyUser pushes the alert to every device the user has registered,
+	// unless they opted out of push notifications. Best-effort: failures are
+	// logged and never surfaced to the triggering operation.
+	NotifyUser(ctx context.Context, userID, title, body string)
+}
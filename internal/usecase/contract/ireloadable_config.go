@@ -0,0 +1,36 @@
+package usecasecontract
+
+import "time"
+
+// ConfigChange records one audited runtime configuration change: which key
+// changed, the before/after values and who or what triggered it.
+type ConfigChange struct {
+	Key    string    `json:"key"`
+	Old    string    `json:"old"`
+	New    string    `json:"new"`
+	Source string    `json:"source"`
+	At     time.Time `json:"at"`
+}
+
+// IReloadableConfig is the runtime-tunable slice of the configuration.
+// Selected values can be overridden while the server runs, or reset back to
+// the booted environment via SIGHUP, without a restart. Every change is
+// validated before apply and recorded for auditing.
+type IReloadableConfig interface {
+	// ReloadableValues returns the current effective value of every
+	// runtime-tunable key.
+	ReloadableValues() map[string]string
+	// ApplyOverrides validates and applies runtime overrides. If any key is
+	// unknown or any value invalid, nothing is applied. It returns the
+	// changes that resulted.
+	ApplyOverrides(source string, values map[string]string) ([]ConfigChange, error)
+	// Reload discards runtime overrides and re-resolves the tunable keys
+	// from the process environment.
+	Reload(source string) []ConfigChange
+	// Changes returns the audited change history, newest first.
+	Changes() []ConfigChange
+	// OnReload registers a hook invoked after changes are applied, so
+	// components holding derived values (cache TTLs, limiter state) can pick
+	// them up.
+	OnReload(fn func(changes []ConfigChange))
+}
@@ -0,0 +1,17 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IRSSImportUseCase manages author-registered RSS feeds whose new items are
+// periodically imported as draft posts.
+type IRSSImportUseCase interface {
+	RegisterFeed(ctx context.Context, userID, url string) (*entity.RSSFeed, error)
+	// ListFeeds returns the user's feeds with their per-feed import status
+	// and last poll error.
+	ListFeeds(ctx context.Context, userID string) ([]*entity.RSSFeed, error)
+	DeleteFeed(ctx context.Context, userID, feedID string) error
+}
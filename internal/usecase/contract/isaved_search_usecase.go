@@ -0,0 +1,15 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ISavedSearchUseCase defines saved search operations: managing a user's
+// saved search/filter combinations and alerting them about matching posts.
+type ISavedSearchUseCase interface {
+	CreateSavedSearch(ctx context.Context, userID, name, query string, tags []string) (*entity.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID string) ([]*entity.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, id string) error
+}
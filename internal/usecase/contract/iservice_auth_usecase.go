@@ -0,0 +1,25 @@
+package usecasecontract
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// IServiceAuthUseCase implements the client-credentials flow for internal
+// services: a registry of clients and scoped machine tokens.
+type IServiceAuthUseCase interface {
+	// RegisterClient creates a service client and returns it with the
+	// plaintext secret, which is shown once and never stored.
+	RegisterClient(ctx context.Context, name string, scopes []string) (*entity.ServiceClient, string, error)
+	ListClients(ctx context.Context) ([]*entity.ServiceClient, error)
+	RevokeClient(ctx context.Context, clientID string) error
+	// IssueToken exchanges client credentials for a machine token. Requested
+	// scopes must be a subset of the client's grants; when empty, all of the
+	// client's scopes are granted.
+	IssueToken(ctx context.Context, clientID, clientSecret string, requestedScopes []string) (string, []string, time.Duration, error)
+	// ParseToken validates a machine token and returns its claims.
+	ParseToken(token string) (*contract.ServiceClaims, error)
+}
@@ -0,0 +1,30 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ITagUseCase defines the business operations for the public tag pages and
+// tag follows.
+type ITagUseCase interface {
+	// GetTagDetail returns a tag's metadata together with its blog count,
+	// follower count and trending posts. When userID is set, the result also
+	// reports whether that user follows the tag.
+	GetTagDetail(ctx context.Context, slug string, userID *string) (*entity.TagDetail, error)
+	// SuggestTags returns prefix-matched tags for the editor's tag input,
+	// ordered by usage count.
+	SuggestTags(ctx context.Context, query string) ([]*entity.TagSuggestion, error)
+	FollowTag(ctx context.Context, slug, userID string) error
+	UnfollowTag(ctx context.Context, slug, userID string) error
+	// GetFollowedFeed returns the personalized feed: recent blogs under the
+	// tags the user follows.
+	GetFollowedFeed(ctx context.Context, userID string, page, pageSize int) ([]*entity.Blog, int64, error)
+	// GetProjectedFeed serves the home feed from the precomputed projection,
+	// falling back to GetFollowedFeed when no projection is wired.
+	GetProjectedFeed(ctx context.Context, userID string, page, pageSize int) ([]*entity.Blog, int64, error)
+	// MergeTags folds the source tag into the target: blogs and followers are
+	// reassigned and the source slug becomes an alias of the target.
+	MergeTags(ctx context.Context, sourceTagID, targetTagID string) (*entity.Tag, error)
+}
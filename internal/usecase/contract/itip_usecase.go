@@ -0,0 +1,20 @@
+package usecasecontract
+
+import (
+	"context"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+)
+
+// ITipUseCase defines the business logic for tipping authors on blog posts.
+type ITipUseCase interface {
+	// CreateTip opens a checkout session for a tip on the blog and returns
+	// the URL the reader is redirected to.
+	CreateTip(ctx context.Context, blogID, supporterID string, amountCents int64, currency string) (string, error)
+	// HandleWebhook processes a payment provider webhook delivery and
+	// records the tip as completed when the checkout succeeded.
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+	// GetEarnings returns the author's completed-tip totals and most recent
+	// tips for the dashboard.
+	GetEarnings(ctx context.Context, authorID string) (*entity.AuthorEarnings, []*entity.Tip, error)
+}
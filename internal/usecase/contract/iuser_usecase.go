@@ -8,8 +8,8 @@ import (
 
 // UserUseCase defines the interface for user-related operations.
 type IUserUseCase interface {
-	Register(ctx context.Context, username, email, password, firstName, lastName string) (*entity.User, error)
-	Login(ctx context.Context, email, password string) (*entity.User, string, string, error)
+	Register(ctx context.Context, username, email, password, firstName, lastName, referralCode, ipAddress, userAgent string) (*entity.User, error)
+	Login(ctx context.Context, email, password, ipAddress, userAgent string, clientType entity.ClientType, rememberMe bool) (*entity.User, string, string, error)
 	Authenticate(ctx context.Context, accessToken string) (*entity.User, error)
 	RefreshToken(ctx context.Context, refreshToken string) (string, string, error)
 	ForgotPassword(ctx context.Context, email string) error
@@ -17,7 +17,21 @@ type IUserUseCase interface {
 	Logout(ctx context.Context, refreshToken string) error
 	PromoteUser(ctx context.Context, userID string) (*entity.User, error)
 	DemoteUser(ctx context.Context, userID string) (*entity.User, error)
+	UpdateUserRole(ctx context.Context, userID, actorID string, role entity.UserRole) (*entity.User, error)
 	UpdateProfile(ctx context.Context, userID string, updates map[string]interface{}) (*entity.User, error)
 	LoginWithOAuth(ctx context.Context, firstName, lastName, email string) (string, string, error)
 	GetUserByID(ctx context.Context, userID string) (*entity.User, error)
+	SetShadowBan(ctx context.Context, userID string, banned bool) (*entity.User, error)
+	// SetActive activates or deactivates a user; deactivation hides their
+	// content from listings until reactivation.
+	SetActive(ctx context.Context, userID string, active bool) (*entity.User, error)
+	// DeleteUser soft-deletes a user, scrubbing their PII and anonymizing
+	// their comments.
+	DeleteUser(ctx context.Context, userID string) error
+	ListShadowBannedUsers(ctx context.Context) ([]*entity.User, error)
+	GetSecurityEvents(ctx context.Context, userID string, page, pageSize int) ([]*entity.SecurityEvent, int64, error)
+	GetActiveSessions(ctx context.Context, userID string) ([]*entity.Token, error)
+	// GetMyReferrals returns the user's referral code and the accounts that
+	// signed up with it.
+	GetMyReferrals(ctx context.Context, userID string) (string, []*entity.User, error)
 }
@@ -4,4 +4,7 @@ package usecasecontract
 type IValidator interface {
 	ValidateEmail(email string) error
 	ValidatePasswordStrength(password string) error
+	// ValidateUsername checks that a (normalized, lowercase) username sticks
+	// to the safe identifier alphabet.
+	ValidateUsername(username string) error
 }
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// IDraftCommentUseCase manages editorial comments anchored to content
+// ranges of a draft, separate from public reader comments.
+type IDraftCommentUseCase interface {
+	AddComment(ctx context.Context, blogID, reviewerID, body string, startOffset, endOffset int, quote string) (*entity.DraftComment, error)
+	ListComments(ctx context.Context, blogID, requesterID string) ([]*entity.DraftComment, error)
+	SetResolved(ctx context.Context, blogID, commentID, requesterID string, resolved bool) (*entity.DraftComment, error)
+}
+
+// DraftCommentUseCaseImpl implements IDraftCommentUseCase.
+type DraftCommentUseCaseImpl struct {
+	draftCommentRepo contract.IDraftCommentRepository
+	blogRepo         contract.IBlogRepository
+	userRepo         contract.IUserRepository
+	uuidgen          contract.IUUIDGenerator
+	logger           usecasecontract.IAppLogger
+}
+
+var _ IDraftCommentUseCase = (*DraftCommentUseCaseImpl)(nil)
+
+// NewDraftCommentUseCase creates a new DraftCommentUseCaseImpl.
+func NewDraftCommentUseCase(
+	draftCommentRepo contract.IDraftCommentRepository,
+	blogRepo contract.IBlogRepository,
+	userRepo contract.IUserRepository,
+	uuidgen contract.IUUIDGenerator,
+	logger usecasecontract.IAppLogger,
+) *DraftCommentUseCaseImpl {
+	return &DraftCommentUseCaseImpl{
+		draftCommentRepo: draftCommentRepo,
+		blogRepo:         blogRepo,
+		userRepo:         userRepo,
+		uuidgen:          uuidgen,
+		logger:           logger,
+	}
+}
+
+// accessBlog checks that the requester may participate in the blog's
+// editorial review (the author or an admin) and returns the blog.
+func (uc *DraftCommentUseCaseImpl) accessBlog(ctx context.Context, blogID, requesterID string) (*entity.Blog, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	if requesterID == "" {
+		return nil, errors.New("requester ID is required")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.AuthorID == requesterID {
+		return blog, nil
+	}
+	if user, err := uc.userRepo.GetUserByID(ctx, requesterID); err == nil && user.Role == entity.UserRoleAdmin {
+		return blog, nil
+	}
+	return nil, errors.New("unauthorized: only the author or a reviewer can access draft comments")
+}
+
+// AddComment attaches an editorial comment to a content range of the draft.
+func (uc *DraftCommentUseCaseImpl) AddComment(ctx context.Context, blogID, reviewerID, body string, startOffset, endOffset int, quote string) (*entity.DraftComment, error) {
+	if body == "" {
+		return nil, errors.New("comment body is required")
+	}
+	if startOffset < 0 || endOffset < startOffset {
+		return nil, errors.New("invalid content range")
+	}
+
+	if _, err := uc.accessBlog(ctx, blogID, reviewerID); err != nil {
+		return nil, err
+	}
+
+	comment := &entity.DraftComment{
+		ID:          uc.uuidgen.NewUUID(),
+		BlogID:      blogID,
+		AuthorID:    reviewerID,
+		Body:        body,
+		StartOffset: startOffset,
+		EndOffset:   endOffset,
+		Quote:       quote,
+	}
+	if err := uc.draftCommentRepo.CreateComment(ctx, comment); err != nil {
+		uc.logger.Errorf("failed to create draft comment: %v", err)
+		return nil, fmt.Errorf("failed to create draft comment")
+	}
+	return comment, nil
+}
+
+// ListComments returns a blog's draft comments, oldest first.
+func (uc *DraftCommentUseCaseImpl) ListComments(ctx context.Context, blogID, requesterID string) ([]*entity.DraftComment, error) {
+	if _, err := uc.accessBlog(ctx, blogID, requesterID); err != nil {
+		return nil, err
+	}
+	return uc.draftCommentRepo.ListComments(ctx, blogID)
+}
+
+// SetResolved marks a draft comment resolved or reopens it.
+func (uc *DraftCommentUseCaseImpl) SetResolved(ctx context.Context, blogID, commentID, requesterID string, resolved bool) (*entity.DraftComment, error) {
+	if commentID == "" {
+		return nil, errors.New("comment ID is required")
+	}
+	if _, err := uc.accessBlog(ctx, blogID, requesterID); err != nil {
+		return nil, err
+	}
+
+	comment, err := uc.draftCommentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.BlogID != blogID {
+		return nil, fmt.Errorf("draft comment %s not found", commentID)
+	}
+
+	if err := uc.draftCommentRepo.SetResolved(ctx, commentID, resolved, requesterID); err != nil {
+		uc.logger.Errorf("failed to update draft comment: %v", err)
+		return nil, fmt.Errorf("failed to update draft comment")
+	}
+	return uc.draftCommentRepo.GetCommentByID(ctx, commentID)
+}
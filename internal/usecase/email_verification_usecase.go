@@ -17,6 +17,10 @@ type EmailVerificationUseCase struct {
 	RandomGenerator contract.IRandomGenerator
 	UUIDGenerator   contract.IUUIDGenerator
 	baseURL         string // Add baseURL for config
+
+	// outboxRepo is optional; when set, verifying a referred user enqueues a
+	// referral conversion event for reward fulfillment. Injected via SetOutbox.
+	outboxRepo contract.IOutboxRepository
 }
 
 func NewEmailVerificationUseCase(tr contract.ITokenRepository, ur contract.IUserRepository, es contract.IEmailService, rg contract.IRandomGenerator, uuidgen contract.IUUIDGenerator, baseURL string) *EmailVerificationUseCase {
@@ -30,6 +34,12 @@ func NewEmailVerificationUseCase(tr contract.ITokenRepository, ur contract.IUser
 	}
 }
 
+// SetOutbox enables referral conversion events: when a referred user
+// verifies their email, an outbox event is enqueued for reward fulfillment.
+func (eu *EmailVerificationUseCase) SetOutbox(outbox contract.IOutboxRepository) {
+	eu.outboxRepo = outbox
+}
+
 func (eu *EmailVerificationUseCase) RequestVerificationEmail(ctx context.Context, user *entity.User) error {
 	if err := eu.tokenRepository.RevokeAllTokensForUser(ctx, user.ID, entity.TokenTypeEmailVerification); err != nil {
 		return fmt.Errorf("failed to revoke old tokens: %w", err)
@@ -112,5 +122,22 @@ func (eu *EmailVerificationUseCase) VerifyEmailToken(ctx context.Context, verifi
 	if err = eu.tokenRepository.RevokeToken(ctx, token.ID); err != nil {
 		return nil, fmt.Errorf("failed to revoke token after user is verified: %w", err)
 	}
+	// A referred user verifying their email is the conversion the referral
+	// program rewards. Enqueue failures are ignored; rewards must never
+	// block verification.
+	if user.ReferredBy != "" && eu.outboxRepo != nil {
+		now := time.Now()
+		_ = eu.outboxRepo.EnqueueOutboxEvent(ctx, &entity.OutboxEvent{
+			ID:        eu.UUIDGenerator.NewUUID(),
+			EventType: entity.OutboxEventReferralConverted,
+			Payload: map[string]string{
+				"referrer_id":      user.ReferredBy,
+				"referred_user_id": user.ID,
+			},
+			Status:        entity.OutboxStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+	}
 	return user, nil
 }
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// IEventUseCase manages event metadata on posts and reader RSVPs.
+type IEventUseCase interface {
+	// SetEventInfo attaches or updates the event block on a post; a nil
+	// info removes it.
+	SetEventInfo(ctx context.Context, blogID, requesterID string, info *entity.EventInfo) (*entity.Blog, error)
+	RSVP(ctx context.Context, blogID, userID string) (*entity.EventRSVP, error)
+	CancelRSVP(ctx context.Context, blogID, userID string) error
+	ListAttendees(ctx context.Context, blogID string) ([]*entity.EventAttendee, error)
+	// ExportCalendar renders the user's RSVPed events as an iCalendar
+	// document.
+	ExportCalendar(ctx context.Context, userID string) (string, error)
+}
+
+// EventUseCaseImpl implements IEventUseCase.
+type EventUseCaseImpl struct {
+	rsvpRepo  contract.IEventRSVPRepository
+	blogRepo  contract.IBlogRepository
+	userRepo  contract.IUserRepository
+	uuidgen   contract.IUUIDGenerator
+	logger    usecasecontract.IAppLogger
+	blogCache contract.IBlogCache // optional, evicts the post after event edits
+}
+
+var _ IEventUseCase = (*EventUseCaseImpl)(nil)
+
+// NewEventUseCase creates a new EventUseCaseImpl.
+func NewEventUseCase(
+	rsvpRepo contract.IEventRSVPRepository,
+	blogRepo contract.IBlogRepository,
+	userRepo contract.IUserRepository,
+	uuidgen contract.IUUIDGenerator,
+	logger usecasecontract.IAppLogger,
+) *EventUseCaseImpl {
+	return &EventUseCaseImpl{
+		rsvpRepo: rsvpRepo,
+		blogRepo: blogRepo,
+		userRepo: userRepo,
+		uuidgen:  uuidgen,
+		logger:   logger,
+	}
+}
+
+// SetBlogCache evicts edited posts from the cache so event changes show up
+// immediately. Optional.
+func (uc *EventUseCaseImpl) SetBlogCache(cache contract.IBlogCache) {
+	uc.blogCache = cache
+}
+
+// eventBlog loads a post and verifies it carries an event block.
+func (uc *EventUseCaseImpl) eventBlog(ctx context.Context, blogID string) (*entity.Blog, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.Event == nil {
+		return nil, errors.New("post is not an event")
+	}
+	return blog, nil
+}
+
+// SetEventInfo attaches, updates or removes the event block on a post. Only
+// the author or an admin may edit it.
+func (uc *EventUseCaseImpl) SetEventInfo(ctx context.Context, blogID, requesterID string, info *entity.EventInfo) (*entity.Blog, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	if info != nil {
+		if info.StartTime.IsZero() {
+			return nil, errors.New("event start time is required")
+		}
+		if info.EndTime != nil && !info.EndTime.After(info.StartTime) {
+			return nil, errors.New("event end time must be after the start time")
+		}
+		if info.Capacity < 0 {
+			return nil, errors.New("event capacity cannot be negative")
+		}
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.AuthorID != requesterID {
+		user, err := uc.userRepo.GetUserByID(ctx, requesterID)
+		if err != nil || user.Role != entity.UserRoleAdmin {
+			return nil, errors.New("unauthorized: only the author can edit event details")
+		}
+	}
+
+	updates := map[string]interface{}{
+		"event":      info,
+		"updated_at": time.Now(),
+	}
+	if err := uc.blogRepo.UpdateBlog(ctx, blogID, updates); err != nil {
+		uc.logger.Errorf("failed to update event details: %v", err)
+		return nil, fmt.Errorf("failed to update event details")
+	}
+	if uc.blogCache != nil {
+		_ = uc.blogCache.InvalidateBlogBySlug(ctx, blog.Slug)
+	}
+	return uc.blogRepo.GetBlogByID(ctx, blogID)
+}
+
+// RSVP records the user's attendance, enforcing the event's capacity.
+func (uc *EventUseCaseImpl) RSVP(ctx context.Context, blogID, userID string) (*entity.EventRSVP, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	blog, err := uc.eventBlog(ctx, blogID)
+	if err != nil {
+		return nil, err
+	}
+	if blog.Status != entity.BlogStatusPublished {
+		return nil, errors.New("only published events accept RSVPs")
+	}
+	if !blog.Event.StartTime.After(time.Now()) {
+		return nil, errors.New("the event has already started")
+	}
+
+	if existing, err := uc.rsvpRepo.GetRSVP(ctx, blogID, userID); err == nil && existing != nil {
+		return existing, nil
+	}
+
+	if blog.Event.Capacity > 0 {
+		count, err := uc.rsvpRepo.CountRSVPs(ctx, blogID)
+		if err != nil {
+			uc.logger.Errorf("failed to count RSVPs: %v", err)
+			return nil, fmt.Errorf("failed to record RSVP")
+		}
+		if count >= int64(blog.Event.Capacity) {
+			return nil, errors.New("the event is at capacity")
+		}
+	}
+
+	rsvp := &entity.EventRSVP{
+		ID:     uc.uuidgen.NewUUID(),
+		BlogID: blogID,
+		UserID: userID,
+	}
+	if err := uc.rsvpRepo.CreateRSVP(ctx, rsvp); err != nil {
+		uc.logger.Errorf("failed to create RSVP: %v", err)
+		return nil, fmt.Errorf("failed to record RSVP")
+	}
+	return rsvp, nil
+}
+
+// CancelRSVP withdraws the user's RSVP.
+func (uc *EventUseCaseImpl) CancelRSVP(ctx context.Context, blogID, userID string) error {
+	if _, err := uc.eventBlog(ctx, blogID); err != nil {
+		return err
+	}
+	return uc.rsvpRepo.DeleteRSVP(ctx, blogID, userID)
+}
+
+// ListAttendees returns an event's attendees in RSVP order.
+func (uc *EventUseCaseImpl) ListAttendees(ctx context.Context, blogID string) ([]*entity.EventAttendee, error) {
+	if _, err := uc.eventBlog(ctx, blogID); err != nil {
+		return nil, err
+	}
+	rsvps, err := uc.rsvpRepo.ListRSVPsByBlog(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to list RSVPs: %v", err)
+		return nil, fmt.Errorf("failed to list attendees")
+	}
+
+	attendees := make([]*entity.EventAttendee, 0, len(rsvps))
+	for _, rsvp := range rsvps {
+		attendee := &entity.EventAttendee{
+			UserID:   rsvp.UserID,
+			RSVPedAt: rsvp.CreatedAt,
+		}
+		if user, err := uc.userRepo.GetUserByID(ctx, rsvp.UserID); err == nil {
+			attendee.Username = user.Username
+		}
+		attendees = append(attendees, attendee)
+	}
+	return attendees, nil
+}
+
+// ExportCalendar renders the user's RSVPed events as an iCalendar document.
+func (uc *EventUseCaseImpl) ExportCalendar(ctx context.Context, userID string) (string, error) {
+	if userID == "" {
+		return "", errors.New("user ID is required")
+	}
+	rsvps, err := uc.rsvpRepo.ListRSVPsByUser(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf("failed to list RSVPs: %v", err)
+		return "", fmt.Errorf("failed to export calendar")
+	}
+
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//Articulate//Events//EN\r\n")
+	for _, rsvp := range rsvps {
+		blog, err := uc.blogRepo.GetBlogByID(ctx, rsvp.BlogID)
+		if err != nil || blog == nil || blog.Event == nil {
+			continue
+		}
+		b.WriteString("BEGIN:VEVENT\r\n")
+		b.WriteString("UID:" + rsvp.ID + "\r\n")
+		b.WriteString("DTSTAMP:" + formatICalTime(rsvp.CreatedAt) + "\r\n")
+		b.WriteString("DTSTART:" + formatICalTime(blog.Event.StartTime) + "\r\n")
+		if blog.Event.EndTime != nil {
+			b.WriteString("DTEND:" + formatICalTime(*blog.Event.EndTime) + "\r\n")
+		}
+		b.WriteString("SUMMARY:" + escapeICalText(blog.Title) + "\r\n")
+		if blog.Event.Location != "" {
+			b.WriteString("LOCATION:" + escapeICalText(blog.Event.Location) + "\r\n")
+		}
+		b.WriteString("END:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String(), nil
+}
+
+// formatICalTime renders a time in the iCalendar UTC format.
+func formatICalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeICalText escapes the characters RFC 5545 reserves in text values.
+func escapeICalText(s string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\n", "\\n",
+		"\r", "",
+	)
+	return replacer.Replace(s)
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// ReputationRecorder is the hook other usecases call when a point-earning
+// action happens. Recording is best-effort: failures are logged and never
+// surfaced to the triggering operation.
+type ReputationRecorder interface {
+	RecordAction(ctx context.Context, userID string, action entity.ReputationAction)
+}
+
+// IGamificationUseCase awards reputation points for actions and badges for
+// their milestones.
+type IGamificationUseCase interface {
+	ReputationRecorder
+	GetUserBadges(ctx context.Context, userID string) ([]*entity.Badge, error)
+}
+
+// reputationPoints is the rules table mapping each action to the points it
+// earns.
+var reputationPoints = map[entity.ReputationAction]int{
+	entity.ReputationActionPublish:        10,
+	entity.ReputationActionLikeReceived:   2,
+	entity.ReputationActionHelpfulComment: 15,
+}
+
+// badgeRule awards a badge when the user's tally for an action reaches the
+// threshold.
+type badgeRule struct {
+	Slug      string
+	Name      string
+	Action    entity.ReputationAction
+	Threshold int
+}
+
+// badgeRules is the milestone table, evaluated whenever the matching action
+// is recorded.
+var badgeRules = []badgeRule{
+	{Slug: "first-post", Name: "First Post", Action: entity.ReputationActionPublish, Threshold: 1},
+	{Slug: "prolific-writer", Name: "Prolific Writer", Action: entity.ReputationActionPublish, Threshold: 10},
+	{Slug: "crowd-favorite", Name: "Crowd Favorite", Action: entity.ReputationActionLikeReceived, Threshold: 100},
+	{Slug: "problem-solver", Name: "Problem Solver", Action: entity.ReputationActionHelpfulComment, Threshold: 1},
+	{Slug: "community-pillar", Name: "Community Pillar", Action: entity.ReputationActionHelpfulComment, Threshold: 25},
+}
+
+// GamificationUseCaseImpl implements IGamificationUseCase.
+type GamificationUseCaseImpl struct {
+	gamificationRepo contract.IGamificationRepository
+	userRepo         contract.IUserRepository
+	uuidgen          contract.IUUIDGenerator
+	logger           usecasecontract.IAppLogger
+}
+
+var _ IGamificationUseCase = (*GamificationUseCaseImpl)(nil)
+
+// NewGamificationUseCase creates a new GamificationUseCaseImpl.
+func NewGamificationUseCase(
+	gamificationRepo contract.IGamificationRepository,
+	userRepo contract.IUserRepository,
+	uuidgen contract.IUUIDGenerator,
+	logger usecasecontract.IAppLogger,
+) *GamificationUseCaseImpl {
+	return &GamificationUseCaseImpl{
+		gamificationRepo: gamificationRepo,
+		userRepo:         userRepo,
+		uuidgen:          uuidgen,
+		logger:           logger,
+	}
+}
+
+// RecordAction credits the user with the action's points and awards any
+// badge whose milestone the new tally reaches. Awarding is idempotent per
+// badge, so a tally past the threshold never duplicates it.
+func (uc *GamificationUseCaseImpl) RecordAction(ctx context.Context, userID string, action entity.ReputationAction) {
+	points, ok := reputationPoints[action]
+	if !ok || userID == "" {
+		return
+	}
+
+	if err := uc.userRepo.IncrementReputation(ctx, userID, points); err != nil {
+		uc.logger.Errorf("failed to increment reputation: %v", err)
+	}
+
+	count, err := uc.gamificationRepo.IncrementActionCount(ctx, userID, action)
+	if err != nil {
+		uc.logger.Errorf("failed to increment action count: %v", err)
+		return
+	}
+
+	for _, rule := range badgeRules {
+		if rule.Action != action || count < rule.Threshold {
+			continue
+		}
+		badge := &entity.Badge{
+			ID:        uc.uuidgen.NewUUID(),
+			UserID:    userID,
+			Slug:      rule.Slug,
+			Name:      rule.Name,
+			AwardedAt: time.Now(),
+		}
+		if _, err := uc.gamificationRepo.AwardBadge(ctx, badge); err != nil {
+			uc.logger.Errorf("failed to award badge %s: %v", rule.Slug, err)
+		}
+	}
+}
+
+// GetUserBadges returns the user's badges, oldest first.
+func (uc *GamificationUseCaseImpl) GetUserBadges(ctx context.Context, userID string) ([]*entity.Badge, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	return uc.gamificationRepo.ListBadgesByUser(ctx, userID)
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// IGeoRestrictionUseCase manages per-country content restrictions (legal
+// takedowns) and answers the per-request blocking checks of the enforcement
+// middleware.
+type IGeoRestrictionUseCase interface {
+	RestrictBlog(ctx context.Context, blogID, actorID string, countryCodes []string, reason string) (*entity.GeoRestriction, error)
+	UnrestrictBlog(ctx context.Context, blogID, actorID string) error
+	ListRestrictions(ctx context.Context) ([]*entity.GeoRestriction, error)
+	GetAuditTrail(ctx context.Context, blogID string) ([]*entity.GeoRestrictionAudit, error)
+	// IsSlugBlockedForCountry reports whether the blog behind the slug may
+	// not be served to the given country.
+	IsSlugBlockedForCountry(ctx context.Context, slug, countryCode string) bool
+}
+
+// GeoRestrictionUseCaseImpl implements the geo restriction workflow. Every
+// change is recorded in an append-only audit trail.
+type GeoRestrictionUseCaseImpl struct {
+	restrictionRepo contract.IGeoRestrictionRepository
+	blogRepo        contract.IBlogRepository
+	uuidgen         contract.IUUIDGenerator
+	logger          usecasecontract.IAppLogger
+}
+
+// NewGeoRestrictionUseCase creates a new instance of GeoRestrictionUseCase.
+func NewGeoRestrictionUseCase(restrictionRepo contract.IGeoRestrictionRepository, blogRepo contract.IBlogRepository, uuidgen contract.IUUIDGenerator, logger usecasecontract.IAppLogger) *GeoRestrictionUseCaseImpl {
+	return &GeoRestrictionUseCaseImpl{
+		restrictionRepo: restrictionRepo,
+		blogRepo:        blogRepo,
+		uuidgen:         uuidgen,
+		logger:          logger,
+	}
+}
+
+var _ IGeoRestrictionUseCase = (*GeoRestrictionUseCaseImpl)(nil)
+
+// normalizeCountryCodes validates and uppercases ISO 3166-1 alpha-2 codes.
+func normalizeCountryCodes(countryCodes []string) ([]string, error) {
+	if len(countryCodes) == 0 {
+		return nil, errors.New("at least one country code is required")
+	}
+	normalized := make([]string, 0, len(countryCodes))
+	seen := make(map[string]bool, len(countryCodes))
+	for _, code := range countryCodes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+			return nil, fmt.Errorf("invalid country code %q, expected ISO 3166-1 alpha-2", code)
+		}
+		if !seen[code] {
+			seen[code] = true
+			normalized = append(normalized, code)
+		}
+	}
+	return normalized, nil
+}
+
+// RestrictBlog blocks a blog from being served in the given countries,
+// replacing any previous restriction.
+func (uc *GeoRestrictionUseCaseImpl) RestrictBlog(ctx context.Context, blogID, actorID string, countryCodes []string, reason string) (*entity.GeoRestriction, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	codes, err := normalizeCountryCodes(countryCodes)
+	if err != nil {
+		return nil, err
+	}
+	if blog, err := uc.blogRepo.GetBlogByID(ctx, blogID); err != nil || blog == nil {
+		return nil, errors.New("blog not found")
+	}
+
+	restriction := &entity.GeoRestriction{
+		BlogID:       blogID,
+		CountryCodes: codes,
+		Reason:       strings.TrimSpace(reason),
+		CreatedBy:    actorID,
+		CreatedAt:    time.Now(),
+	}
+	if err := uc.restrictionRepo.UpsertRestriction(ctx, restriction); err != nil {
+		uc.logger.Errorf("failed to store geo restriction for blog %s: %v", blogID, err)
+		return nil, fmt.Errorf("failed to store geo restriction: %w", err)
+	}
+	uc.recordAudit(ctx, blogID, entity.GeoRestrictionActionRestricted, codes, restriction.Reason, actorID)
+	return restriction, nil
+}
+
+// UnrestrictBlog lifts a blog's restriction.
+func (uc *GeoRestrictionUseCaseImpl) UnrestrictBlog(ctx context.Context, blogID, actorID string) error {
+	if blogID == "" {
+		return errors.New("blog ID is required")
+	}
+	if err := uc.restrictionRepo.DeleteRestriction(ctx, blogID); err != nil {
+		return err
+	}
+	uc.recordAudit(ctx, blogID, entity.GeoRestrictionActionUnrestricted, nil, "", actorID)
+	return nil
+}
+
+// ListRestrictions returns every restricted blog.
+func (uc *GeoRestrictionUseCaseImpl) ListRestrictions(ctx context.Context) ([]*entity.GeoRestriction, error) {
+	return uc.restrictionRepo.ListRestrictions(ctx)
+}
+
+// GetAuditTrail returns a blog's restriction history, newest first.
+func (uc *GeoRestrictionUseCaseImpl) GetAuditTrail(ctx context.Context, blogID string) ([]*entity.GeoRestrictionAudit, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	return uc.restrictionRepo.ListAuditEntries(ctx, blogID)
+}
+
+// IsSlugBlockedForCountry reports whether the blog behind the slug may not
+// be served to the given country. Lookup failures fail open so a database
+// hiccup never takes content down globally.
+func (uc *GeoRestrictionUseCaseImpl) IsSlugBlockedForCountry(ctx context.Context, slug, countryCode string) bool {
+	if slug == "" || countryCode == "" {
+		return false
+	}
+	blog, err := uc.blogRepo.GetBlogBySlug(ctx, slug)
+	if err != nil || blog == nil {
+		return false
+	}
+	restriction, err := uc.restrictionRepo.GetRestrictionByBlogID(ctx, blog.ID)
+	if err != nil {
+		return false
+	}
+	countryCode = strings.ToUpper(countryCode)
+	for _, code := range restriction.CountryCodes {
+		if code == countryCode {
+			return true
+		}
+	}
+	return false
+}
+
+// recordAudit appends to the audit trail; failures are logged but never
+// block the restriction change itself.
+func (uc *GeoRestrictionUseCaseImpl) recordAudit(ctx context.Context, blogID, action string, countryCodes []string, reason, actorID string) {
+	entry := &entity.GeoRestrictionAudit{
+		ID:           uc.uuidgen.NewUUID(),
+		BlogID:       blogID,
+		Action:       action,
+		CountryCodes: countryCodes,
+		Reason:       reason,
+		ActorID:      actorID,
+	}
+	if err := uc.restrictionRepo.AddAuditEntry(ctx, entry); err != nil {
+		uc.logger.Warningf("failed to record geo restriction audit entry for blog %s: %v", blogID, err)
+	}
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+const (
+	// maxHighlightLength caps a highlighted passage.
+	maxHighlightLength = 1000
+	// defaultPopularHighlights is how many passages the popular-highlights
+	// endpoint returns by default.
+	defaultPopularHighlights = 5
+	// maxPopularHighlights caps the popular-highlights page size.
+	maxPopularHighlights = 20
+)
+
+// IHighlightUseCase manages reader highlights: anchored passages with
+// optional private notes, plus the per-post popular highlights aggregate.
+type IHighlightUseCase interface {
+	AddHighlight(ctx context.Context, blogID, userID string, startOffset, endOffset int, text, note string) (*entity.Highlight, error)
+	RemoveHighlight(ctx context.Context, highlightID, userID string) error
+	ListMyHighlights(ctx context.Context, userID string) ([]*entity.Highlight, error)
+	GetPopularHighlights(ctx context.Context, blogID string, limit int) ([]entity.HighlightStat, error)
+}
+
+// HighlightUseCaseImpl implements IHighlightUseCase.
+type HighlightUseCaseImpl struct {
+	highlightRepo contract.IHighlightRepository
+	blogRepo      contract.IBlogRepository
+	uuidgen       contract.IUUIDGenerator
+	logger        usecasecontract.IAppLogger
+}
+
+var _ IHighlightUseCase = (*HighlightUseCaseImpl)(nil)
+
+// NewHighlightUseCase creates a new HighlightUseCaseImpl.
+func NewHighlightUseCase(
+	highlightRepo contract.IHighlightRepository,
+	blogRepo contract.IBlogRepository,
+	uuidgen contract.IUUIDGenerator,
+	logger usecasecontract.IAppLogger,
+) *HighlightUseCaseImpl {
+	return &HighlightUseCaseImpl{
+		highlightRepo: highlightRepo,
+		blogRepo:      blogRepo,
+		uuidgen:       uuidgen,
+		logger:        logger,
+	}
+}
+
+// AddHighlight stores a reader's highlighted passage on a published post.
+func (uc *HighlightUseCaseImpl) AddHighlight(ctx context.Context, blogID, userID string, startOffset, endOffset int, text, note string) (*entity.Highlight, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if text == "" {
+		return nil, errors.New("highlighted text is required")
+	}
+	if len(text) > maxHighlightLength {
+		return nil, fmt.Errorf("highlighted passage must not exceed %d characters", maxHighlightLength)
+	}
+	if startOffset < 0 || endOffset < startOffset {
+		return nil, errors.New("invalid content range")
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil {
+		uc.logger.Errorf("failed to get blog: %v", err)
+		return nil, fmt.Errorf("failed to get blog: %w", err)
+	}
+	if blog == nil {
+		return nil, errors.New("blog not found")
+	}
+	if blog.Status != entity.BlogStatusPublished {
+		return nil, errors.New("only published posts can be highlighted")
+	}
+
+	highlight := &entity.Highlight{
+		ID:          uc.uuidgen.NewUUID(),
+		BlogID:      blogID,
+		UserID:      userID,
+		StartOffset: startOffset,
+		EndOffset:   endOffset,
+		Text:        text,
+		Note:        note,
+	}
+	if err := uc.highlightRepo.CreateHighlight(ctx, highlight); err != nil {
+		uc.logger.Errorf("failed to create highlight: %v", err)
+		return nil, fmt.Errorf("failed to create highlight")
+	}
+	return highlight, nil
+}
+
+// RemoveHighlight deletes one of the user's own highlights.
+func (uc *HighlightUseCaseImpl) RemoveHighlight(ctx context.Context, highlightID, userID string) error {
+	if highlightID == "" {
+		return errors.New("highlight ID is required")
+	}
+
+	highlight, err := uc.highlightRepo.GetHighlightByID(ctx, highlightID)
+	if err != nil {
+		return err
+	}
+	if highlight.UserID != userID {
+		return errors.New("unauthorized: only the owner can remove a highlight")
+	}
+	return uc.highlightRepo.DeleteHighlight(ctx, highlightID)
+}
+
+// ListMyHighlights returns the user's highlights across all posts, newest
+// first.
+func (uc *HighlightUseCaseImpl) ListMyHighlights(ctx context.Context, userID string) ([]*entity.Highlight, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	return uc.highlightRepo.ListHighlightsByUser(ctx, userID)
+}
+
+// GetPopularHighlights returns a post's most-highlighted passages.
+func (uc *HighlightUseCaseImpl) GetPopularHighlights(ctx context.Context, blogID string, limit int) ([]entity.HighlightStat, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	if limit <= 0 {
+		limit = defaultPopularHighlights
+	}
+	if limit > maxPopularHighlights {
+		limit = maxPopularHighlights
+	}
+	return uc.highlightRepo.TopHighlights(ctx, blogID, limit)
+}
@@ -1,13 +1,62 @@
 package usecase
 
 import (
+	"context"
+
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/utils"
 )
 
+// PopularityRefresher recomputes a blog's popularity score. Implementations
+// may debounce hot posts, so the write can land after the call returns.
+type PopularityRefresher interface {
+	RefreshBlogPopularity(ctx context.Context, blogID string) error
+}
+
+// PopularityWeightsManager exposes the runtime-tunable weights of the
+// popularity formula. Changing them triggers a background recalculation of
+// every stored score.
+type PopularityWeightsManager interface {
+	PopularityWeights() utils.PopularityWeights
+	SetPopularityWeights(ctx context.Context, weights utils.PopularityWeights) error
+}
+
+// BlogAccessGater applies the membership paywall to a blog on its way out of
+// a read path: gated posts are truncated to a teaser for viewers without the
+// required membership.
+type BlogAccessGater interface {
+	GateBlogAccess(ctx context.Context, viewerID *string, blog *entity.Blog)
+}
+
+// LiveEngagementStreamer subscribes a client to a blog's live engagement
+// counters: the current snapshot plus a channel of subsequent updates.
+type LiveEngagementStreamer interface {
+	StreamEngagement(ctx context.Context, blogID string) (entity.EngagementUpdate, <-chan entity.EngagementUpdate, func(), error)
+}
+
+// PresenceHeartbeater records that a reader currently has a blog open; the
+// reader count decays when heartbeats stop.
+type PresenceHeartbeater interface {
+	HeartbeatPresence(ctx context.Context, blogID, readerID string) error
+}
+
+// SavedSearchNotifier alerts the owners of matching saved searches when a
+// blog is published. Evaluation runs in the caller's goroutine; publish
+// paths invoke it in the background.
+type SavedSearchNotifier interface {
+	NotifyPublished(ctx context.Context, blog *entity.Blog)
+}
+
+// PushNotifier delivers a push alert to all of a user's registered devices.
+// Delivery is best-effort and must never block the triggering operation.
+type PushNotifier interface {
+	NotifyUser(ctx context.Context, userID, title, body string)
+}
+
 // JWTService defines the interface for JWT operations.
 type JWTService interface {
-	GenerateAccessToken(userID string, role entity.UserRole) (string, error)
-	GenerateRefreshToken(userID string, role entity.UserRole) (string, error)
+	GenerateAccessToken(userID string, role entity.UserRole, clientType entity.ClientType) (string, error)
+	GenerateRefreshToken(userID string, role entity.UserRole, clientType entity.ClientType, rememberMe bool) (string, error)
 	ParseAccessToken(token string) (*entity.Claims, error)
 	ParseRefreshToken(token string) (*entity.Claims, error)
 	GeneratePasswordResetToken(userID string) (string, error)
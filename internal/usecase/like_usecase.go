@@ -21,8 +21,12 @@ var ErrReactionNotFound = errors.New("reaction not found")
 
 // LikeUsecase handles the business logic for managing likes and dislikes.
 type LikeUsecase struct {
-	likeRepo contract.ILikeRepository
-	blogRepo contract.IBlogRepository // Add blogRepo for updating popularity
+	likeRepo            contract.ILikeRepository
+	blogRepo            contract.IBlogRepository // Add blogRepo for updating popularity
+	popularityRefresher PopularityRefresher      // optional, debounces popularity recomputes
+	pushNotifier        PushNotifier             // optional, pushes like alerts to the author
+	engagementBus       contract.IEngagementBus  // optional, feeds live engagement streams
+	reputation          ReputationRecorder       // optional, credits authors for received likes
 }
 
 // NewLikeUsecase creates and returns a new LikeUsecase instance.
@@ -33,6 +37,88 @@ func NewLikeUsecase(likeRepo contract.ILikeRepository, blogRepo contract.IBlogRe
 	}
 }
 
+// SetPopularityRefresher routes popularity recomputes through the debounced
+// refresher instead of recomputing inline on every reaction.
+func (u *LikeUsecase) SetPopularityRefresher(refresher PopularityRefresher) {
+	u.popularityRefresher = refresher
+}
+
+// SetPushNotifier enables push alerts to blog authors when their post is
+// liked.
+func (u *LikeUsecase) SetPushNotifier(notifier PushNotifier) {
+	u.pushNotifier = notifier
+}
+
+// SetEngagementBus publishes reaction count changes to the live engagement
+// streams.
+func (u *LikeUsecase) SetEngagementBus(bus contract.IEngagementBus) {
+	u.engagementBus = bus
+}
+
+// SetReputationRecorder enables gamification: received likes earn the blog's
+// author reputation points and badges.
+func (u *LikeUsecase) SetReputationRecorder(recorder ReputationRecorder) {
+	u.reputation = recorder
+}
+
+// recordLikeReputation credits the blog's author for a received like,
+// skipping self-likes.
+func (u *LikeUsecase) recordLikeReputation(ctx context.Context, userID, blogID string) {
+	if u.reputation == nil || u.blogRepo == nil {
+		return
+	}
+	blog, err := u.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog == nil || blog.AuthorID == userID {
+		return
+	}
+	u.reputation.RecordAction(ctx, blog.AuthorID, entity.ReputationActionLikeReceived)
+}
+
+// notifyBlogLiked pushes a like alert to the blog's author, skipping
+// self-likes.
+func (u *LikeUsecase) notifyBlogLiked(ctx context.Context, userID, blogID string) {
+	if u.pushNotifier == nil || u.blogRepo == nil {
+		return
+	}
+	blog, err := u.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog == nil || blog.AuthorID == userID {
+		return
+	}
+	u.pushNotifier.NotifyUser(ctx, blog.AuthorID, "Your post was liked", fmt.Sprintf("Someone liked %q", blog.Title))
+}
+
+// updateBlogReactionCounts writes the fresh like/dislike counts and refreshes
+// popularity, debounced when a refresher is wired.
+func (u *LikeUsecase) updateBlogReactionCounts(ctx context.Context, blogID string) {
+	likes, err1 := u.likeRepo.CountLikesByTargetID(ctx, blogID)
+	dislikes, err2 := u.likeRepo.CountDislikesByTargetID(ctx, blogID)
+	if err1 != nil || err2 != nil {
+		return
+	}
+
+	updates := map[string]interface{}{
+		"like_count":    likes,
+		"dislike_count": dislikes,
+	}
+	if u.popularityRefresher == nil {
+		blog, err := u.blogRepo.GetBlogByID(ctx, blogID)
+		views := 0
+		comments := 0
+		if err == nil && blog != nil {
+			views = blog.ViewCount
+			comments = blog.CommentCount
+		}
+		updates["popularity"] = utils.CalculatePopularity(views, int(likes), int(dislikes), comments)
+	}
+	_ = u.blogRepo.UpdateBlog(ctx, blogID, updates)
+
+	if u.popularityRefresher != nil {
+		_ = u.popularityRefresher.RefreshBlogPopularity(ctx, blogID)
+	}
+
+	publishEngagement(ctx, u.blogRepo, u.engagementBus, blogID)
+}
+
 // ToggleLike handles the logic for liking and unliking a target.
 func (u *LikeUsecase) ToggleLike(ctx context.Context, userID, targetID string, targetType entity.TargetType) error {
 	existingReaction, err := u.likeRepo.GetReactionByUserIDAndTargetID(ctx, userID, targetID)
@@ -63,28 +149,15 @@ func (u *LikeUsecase) ToggleLike(ctx context.Context, userID, targetID string, t
 			Type:       entity.LIKE_TYPE_LIKE,
 		}
 		resultErr = u.likeRepo.CreateReaction(ctx, newLike)
+		if resultErr == nil && targetType == entity.TargetTypeBlog {
+			u.notifyBlogLiked(ctx, userID, targetID)
+			u.recordLikeReputation(ctx, userID, targetID)
+		}
 	}
 
 	// Update blog like_count and popularity if this is a blog like/dislike
 	if targetType == entity.TargetTypeBlog && u.blogRepo != nil {
-		likes, err1 := u.likeRepo.CountLikesByTargetID(ctx, targetID)
-		dislikes, err2 := u.likeRepo.CountDislikesByTargetID(ctx, targetID)
-		if err1 == nil && err2 == nil {
-			blog, err := u.blogRepo.GetBlogByID(ctx, targetID)
-			views := 0
-			comments := 0
-			if err == nil && blog != nil {
-				views = blog.ViewCount
-				comments = blog.CommentCount
-			}
-			popularity := utils.CalculatePopularity(views, int(likes), int(dislikes), comments)
-			updates := map[string]interface{}{
-				"like_count":    likes,
-				"dislike_count": dislikes,
-				"popularity":    popularity,
-			}
-			_ = u.blogRepo.UpdateBlog(ctx, targetID, updates)
-		}
+		u.updateBlogReactionCounts(ctx, targetID)
 	}
 	return resultErr
 }
@@ -129,28 +202,37 @@ func (u *LikeUsecase) ToggleDislike(ctx context.Context, userID, targetID string
 
 	// Update blog dislike_count and popularity if this is a blog like/dislike
 	if targetType == entity.TargetTypeBlog && u.blogRepo != nil {
-		likes, err1 := u.likeRepo.CountLikesByTargetID(ctx, targetID)
-		dislikes, err2 := u.likeRepo.CountDislikesByTargetID(ctx, targetID)
-		if err1 == nil && err2 == nil {
-			blog, err := u.blogRepo.GetBlogByID(ctx, targetID)
-			views := 0
-			comments := 0
-			if err == nil && blog != nil {
-				views = blog.ViewCount
-				comments = blog.CommentCount
-			}
-			popularity := utils.CalculatePopularity(views, int(likes), int(dislikes), comments)
-			updates := map[string]interface{}{
-				"like_count":    likes,
-				"dislike_count": dislikes,
-				"popularity":    popularity,
-			}
-			_ = u.blogRepo.UpdateBlog(ctx, targetID, updates)
-		}
+		u.updateBlogReactionCounts(ctx, targetID)
 	}
 	return nil
 }
 
+// ClapBlog adds claps from a user on a blog, Medium-style: each call adds up
+// to count claps until the user's tally reaches entity.MaxClapsPerUser. It
+// returns the user's tally and the blog's aggregate clap count.
+func (u *LikeUsecase) ClapBlog(ctx context.Context, userID, blogID string, count int) (int, int64, error) {
+	if count < 1 || count > entity.MaxClapsPerUser {
+		return 0, 0, fmt.Errorf("clap count must be between 1 and %d", entity.MaxClapsPerUser)
+	}
+	if !u.ExistsBlog(ctx, blogID) {
+		return 0, 0, fmt.Errorf("blog not found")
+	}
+
+	userTotal, err := u.likeRepo.AddClaps(ctx, userID, blogID, count)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to add claps: %w", err)
+	}
+
+	blogTotal, err := u.likeRepo.CountClapsByBlogID(ctx, blogID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count claps: %w", err)
+	}
+	_ = u.blogRepo.UpdateBlog(ctx, blogID, map[string]interface{}{"clap_count": int(blogTotal)})
+	publishEngagement(ctx, u.blogRepo, u.engagementBus, blogID)
+
+	return userTotal, blogTotal, nil
+}
+
 // GetUserReaction retrieves the active reaction (if any) a user has on a specific target.
 func (u *LikeUsecase) GetUserReaction(ctx context.Context, userID, targetID string) (*entity.Like, error) {
 	like, err := u.likeRepo.GetReactionByUserIDAndTargetID(ctx, userID, targetID)
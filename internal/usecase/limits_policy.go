@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// LimitsPolicy centralizes the per-role content quotas: posts per day,
+// comment length, tags per post and images per post. Admins configure the
+// limits per role; the blog and comment usecases consult the policy instead
+// of carrying their own constants. Roles without configured limits use the
+// entity defaults.
+type LimitsPolicy struct {
+	limitsRepo contract.IRoleLimitsRepository
+	userRepo   contract.IUserRepository
+	blogRepo   contract.IBlogRepository
+	logger     usecasecontract.IAppLogger
+}
+
+func NewLimitsPolicy(limitsRepo contract.IRoleLimitsRepository, userRepo contract.IUserRepository, blogRepo contract.IBlogRepository, logger usecasecontract.IAppLogger) *LimitsPolicy {
+	return &LimitsPolicy{
+		limitsRepo: limitsRepo,
+		userRepo:   userRepo,
+		blogRepo:   blogRepo,
+		logger:     logger,
+	}
+}
+
+// limitedRoles are the roles limits can be configured for.
+var limitedRoles = []entity.UserRole{entity.UserRoleUser, entity.UserRoleAdmin}
+
+// LimitsForRole returns the limits in effect for a role: the configured
+// record when one exists, the role defaults otherwise.
+func (p *LimitsPolicy) LimitsForRole(ctx context.Context, role entity.UserRole) *entity.RoleLimits {
+	limits, err := p.limitsRepo.GetLimits(ctx, role)
+	if err != nil {
+		return entity.DefaultRoleLimits(role)
+	}
+	return limits
+}
+
+// LimitsForUser resolves a user's role and returns the limits in effect for
+// it. Unknown or empty user IDs (e.g. guests) get the default role's limits.
+func (p *LimitsPolicy) LimitsForUser(ctx context.Context, userID string) *entity.RoleLimits {
+	role := entity.DefaultRole()
+	if userID != "" {
+		if user, err := p.userRepo.GetUserByID(ctx, userID); err == nil {
+			role = user.Role
+		}
+	}
+	return p.LimitsForRole(ctx, role)
+}
+
+// ConfigureLimits validates and stores the limits for a role.
+func (p *LimitsPolicy) ConfigureLimits(ctx context.Context, limits *entity.RoleLimits) error {
+	valid := false
+	for _, role := range limitedRoles {
+		if limits.Role == role {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("unknown role %q", limits.Role)
+	}
+	if limits.MaxPostsPerDay < 0 || limits.MaxCommentLength < 0 || limits.MaxTagsPerPost < 0 || limits.MaxImagesPerPost < 0 {
+		return errors.New("limits must not be negative")
+	}
+	if err := p.limitsRepo.UpsertLimits(ctx, limits); err != nil {
+		p.logger.Errorf("failed to store limits for role %s: %v", limits.Role, err)
+		return fmt.Errorf("failed to store role limits: %w", err)
+	}
+	return nil
+}
+
+// ListLimits returns the limits in effect for every role, with defaults
+// filled in for roles an admin has not configured.
+func (p *LimitsPolicy) ListLimits(ctx context.Context) ([]*entity.RoleLimits, error) {
+	configured, err := p.limitsRepo.ListLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byRole := make(map[entity.UserRole]*entity.RoleLimits, len(configured))
+	for _, limits := range configured {
+		byRole[limits.Role] = limits
+	}
+
+	all := make([]*entity.RoleLimits, 0, len(limitedRoles))
+	for _, role := range limitedRoles {
+		if limits, ok := byRole[role]; ok {
+			all = append(all, limits)
+		} else {
+			all = append(all, entity.DefaultRoleLimits(role))
+		}
+	}
+	return all, nil
+}
+
+// MaxCommentLength returns the comment length cap for the user's role; zero
+// means no cap.
+func (p *LimitsPolicy) MaxCommentLength(ctx context.Context, userID string) int {
+	return p.LimitsForUser(ctx, userID).MaxCommentLength
+}
+
+// countContentImages counts the markdown and inline HTML images embedded in
+// post content.
+func countContentImages(content string) int {
+	return strings.Count(content, "![") + strings.Count(content, "<img")
+}
+
+// CheckBlogLimits enforces the author's per-role posting quotas on a new
+// post: tags per post, images per post and posts per day. When the daily
+// post count cannot be determined the check fails open with a warning.
+func (p *LimitsPolicy) CheckBlogLimits(ctx context.Context, authorID, content string, tags []string) error {
+	limits := p.LimitsForUser(ctx, authorID)
+
+	if limits.MaxTagsPerPost > 0 && len(tags) > limits.MaxTagsPerPost {
+		return fmt.Errorf("too many tags: at most %d tags are allowed per post", limits.MaxTagsPerPost)
+	}
+	if limits.MaxImagesPerPost > 0 && countContentImages(content) > limits.MaxImagesPerPost {
+		return fmt.Errorf("too many images: at most %d images are allowed per post", limits.MaxImagesPerPost)
+	}
+	if limits.MaxPostsPerDay > 0 {
+		count, err := p.blogRepo.CountBlogsByAuthorSince(ctx, authorID, time.Now().Add(-24*time.Hour))
+		if err != nil {
+			p.logger.Warningf("failed to count recent posts for user %s, skipping daily limit: %v", authorID, err)
+			return nil
+		}
+		if count >= int64(limits.MaxPostsPerDay) {
+			return fmt.Errorf("daily post limit reached: at most %d posts are allowed per day", limits.MaxPostsPerDay)
+		}
+	}
+	return nil
+}
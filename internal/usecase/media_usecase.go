@@ -1 +1,632 @@
 package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/tasks"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// maxUploadSize caps a single-request media upload; larger files must use
+// the chunked upload session endpoints.
+const maxUploadSize = 10 << 20 // 10 MiB
+
+const (
+	// maxChunkedUploadSize caps the declared size of a chunked upload.
+	maxChunkedUploadSize = 100 << 20 // 100 MiB
+	// maxUploadChunkSize caps a single chunk request.
+	maxUploadChunkSize = 10 << 20 // 10 MiB
+	// uploadSessionTTL is how long an upload session may stay idle before
+	// it counts as abandoned and is cleaned up.
+	uploadSessionTTL = 24 * time.Hour
+
+	uploadSessionCleanupInterval = time.Hour
+	uploadSessionCleanupBatch    = 100
+
+	// transcodePollInterval is how often in-flight transcode jobs are
+	// polled for their result.
+	transcodePollInterval = time.Minute
+	transcodePollBatch    = 50
+)
+
+// mediaFileExtensions maps the allowed upload MIME types to the extension
+// files are stored under; the stored name never trusts the client's.
+var mediaFileExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+}
+
+// IMediaUseCase defines media upload business logic.
+type IMediaUseCase interface {
+	UploadMedia(ctx context.Context, userID, fileName, declaredMIME string, data []byte) (*entity.Media, error)
+	BeginUpload(ctx context.Context, userID, fileName, declaredMIME string, totalSize int64) (*entity.UploadSession, error)
+	AppendChunk(ctx context.Context, userID, sessionID string, offset int64, chunk []byte) (*entity.UploadSession, error)
+	GetUploadSession(ctx context.Context, userID, sessionID string) (*entity.UploadSession, error)
+	CompleteUpload(ctx context.Context, userID, sessionID string) (*entity.Media, error)
+	AbortUpload(ctx context.Context, userID, sessionID string) error
+	ListMedia(ctx context.Context, userID string, mimeType *string, dateFrom, dateTo *time.Time, used *bool, page, pageSize int) ([]*entity.Media, int, int, int, error)
+	BulkDeleteMedia(ctx context.Context, userID string, mediaIDs []string) (int64, error)
+	GetMediaUsage(ctx context.Context, userID, mediaID string) (*entity.Media, []*entity.Blog, error)
+	GetStorageUsage(ctx context.Context, userID string) (int64, int64, []contract.StorageUsageByType, error)
+}
+
+// MediaUseCaseImpl implements the media upload pipeline: every upload is
+// validated and sanitized, optionally virus-scanned, and only then persisted
+// to file storage and the media collection.
+type MediaUseCaseImpl struct {
+	mediaRepo  contract.IMediaStore
+	storage    contract.IFileStorage
+	sanitizer  contract.IMediaSanitizer
+	uuidgen    contract.IUUIDGenerator
+	logger     usecasecontract.IAppLogger
+	scanner    contract.IVirusScanner            // optional, scans uploads for malware
+	sessions   contract.IUploadSessionRepository // tracks chunked uploads
+	resizer    contract.IImageResizer            // optional, generates responsive image variants
+	transcoder contract.IVideoTranscoder         // optional, produces HLS/DASH renditions of videos
+	blogRepo   contract.IBlogRepository          // optional, enables the "where is this used" lookup
+
+	// storageQuota caps the cumulative bytes a user's live uploads may
+	// occupy; zero means unlimited.
+	storageQuota int64
+}
+
+// variantWidths are the responsive rendition widths generated for image
+// uploads; widths at or above the original are skipped.
+var variantWidths = []int{320, 640, 1024}
+
+// NewMediaUseCase creates a new instance of MediaUseCase.
+func NewMediaUseCase(mediaRepo contract.IMediaStore, storage contract.IFileStorage, sanitizer contract.IMediaSanitizer, uuidgen contract.IUUIDGenerator, logger usecasecontract.IAppLogger) *MediaUseCaseImpl {
+	return &MediaUseCaseImpl{
+		mediaRepo: mediaRepo,
+		storage:   storage,
+		sanitizer: sanitizer,
+		uuidgen:   uuidgen,
+		logger:    logger,
+	}
+}
+
+var _ IMediaUseCase = (*MediaUseCaseImpl)(nil)
+
+// SetVirusScanner enables malware scanning of uploads; infected or
+// unscannable files are rejected before anything is persisted.
+func (uc *MediaUseCaseImpl) SetVirusScanner(scanner contract.IVirusScanner) {
+	uc.scanner = scanner
+}
+
+// SetVideoTranscoder enables the video pipeline: accepted video uploads are
+// handed to the transcoder and their HLS/DASH playback URLs recorded once
+// ready.
+func (uc *MediaUseCaseImpl) SetVideoTranscoder(transcoder contract.IVideoTranscoder) {
+	uc.transcoder = transcoder
+}
+
+// SetImageResizer enables asynchronous generation of responsive image
+// variants after an upload is accepted.
+func (uc *MediaUseCaseImpl) SetImageResizer(resizer contract.IImageResizer) {
+	uc.resizer = resizer
+}
+
+// SetBlogRepository enables the media library's "where is this used"
+// lookup of blogs referencing a media record.
+func (uc *MediaUseCaseImpl) SetBlogRepository(blogRepo contract.IBlogRepository) {
+	uc.blogRepo = blogRepo
+}
+
+// SetStorageQuota caps the cumulative bytes each user's live uploads may
+// occupy. Uploads that would push a user over the quota are rejected. A
+// quota of zero disables the check.
+func (uc *MediaUseCaseImpl) SetStorageQuota(quotaBytes int64) {
+	uc.storageQuota = quotaBytes
+}
+
+// SetUploadSessionRepository enables chunked/resumable uploads for large
+// files.
+func (uc *MediaUseCaseImpl) SetUploadSessionRepository(sessions contract.IUploadSessionRepository) {
+	uc.sessions = sessions
+}
+
+// UploadMedia runs the content-security pipeline over an upload and persists
+// it: magic bytes are validated against the declared MIME type, image
+// metadata (EXIF/GPS) is stripped, and the cleaned bytes are virus-scanned
+// when a scanner is configured.
+func (uc *MediaUseCaseImpl) UploadMedia(ctx context.Context, userID, fileName, declaredMIME string, data []byte) (*entity.Media, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if len(data) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if len(data) > maxUploadSize {
+		return nil, fmt.Errorf("file exceeds the %d MB upload limit", maxUploadSize>>20)
+	}
+
+	if _, ok := mediaFileExtensions[declaredMIME]; !ok {
+		return nil, fmt.Errorf("unsupported media type %q", declaredMIME)
+	}
+	if err := uc.checkStorageQuota(ctx, userID, int64(len(data))); err != nil {
+		return nil, err
+	}
+
+	return uc.finalizeUpload(ctx, userID, fileName, declaredMIME, data)
+}
+
+// checkStorageQuota rejects an upload that would push the user's cumulative
+// stored bytes over the configured quota. A zero quota disables the check.
+func (uc *MediaUseCaseImpl) checkStorageQuota(ctx context.Context, userID string, incomingBytes int64) error {
+	if uc.storageQuota <= 0 {
+		return nil
+	}
+	usage, err := uc.mediaRepo.GetStorageUsage(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf("failed to compute storage usage for user %s: %v", userID, err)
+		return fmt.Errorf("failed to check storage quota: %w", err)
+	}
+	var used int64
+	for _, u := range usage {
+		used += u.Bytes
+	}
+	if used+incomingBytes > uc.storageQuota {
+		return fmt.Errorf("storage quota exceeded: %d of %d bytes used, this upload needs %d more; delete unused media to free up space", used, uc.storageQuota, incomingBytes)
+	}
+	return nil
+}
+
+// finalizeUpload runs the content-security pipeline over assembled upload
+// bytes and persists them: sanitize, optional virus scan, file storage and
+// the media record.
+func (uc *MediaUseCaseImpl) finalizeUpload(ctx context.Context, userID, fileName, declaredMIME string, data []byte) (*entity.Media, error) {
+	sanitized, err := uc.sanitizer.SanitizeUpload(fileName, declaredMIME, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.scanner != nil {
+		if err := uc.scanner.Scan(ctx, fileName, sanitized); err != nil {
+			uc.logger.Warningf("rejected upload %q from user %s: %v", fileName, userID, err)
+			return nil, err
+		}
+	}
+
+	storedName := uc.uuidgen.NewUUID() + mediaFileExtensions[declaredMIME]
+	fileURL, err := uc.storage.Save(ctx, storedName, sanitized)
+	if err != nil {
+		uc.logger.Errorf("failed to store upload %q: %v", fileName, err)
+		return nil, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	if fileName == "" {
+		fileName = storedName
+	}
+	media := &entity.Media{
+		ID:               uc.uuidgen.NewUUID(),
+		FileName:         strings.TrimSpace(fileName),
+		URL:              fileURL,
+		MimeType:         declaredMIME,
+		FileSize:         int64(len(sanitized)),
+		UploadedByUserID: userID,
+		CreatedAt:        time.Now(),
+	}
+	if err := uc.mediaRepo.CreateMedia(ctx, media); err != nil {
+		uc.logger.Errorf("failed to create media record for %q: %v", fileName, err)
+		return nil, fmt.Errorf("failed to create media record: %w", err)
+	}
+
+	// Responsive variants are generated off the request path; clients pick
+	// them up from the media record once ready.
+	if uc.resizer != nil && (declaredMIME == "image/jpeg" || declaredMIME == "image/png") {
+		tasks.Go(func() { uc.generateImageVariants(context.Background(), media, sanitized, storedName) })
+	}
+
+	// Videos are handed to the transcoder; playback URLs land on the record
+	// once the job finishes.
+	if uc.transcoder != nil && strings.HasPrefix(declaredMIME, "video/") {
+		tasks.Go(func() { uc.submitTranscode(context.Background(), media) })
+	}
+	return media, nil
+}
+
+// submitTranscode queues a video upload with the transcoder and records the
+// job on the media record. Submission failures are stored so clients see
+// the video will not get playback renditions.
+func (uc *MediaUseCaseImpl) submitTranscode(ctx context.Context, media *entity.Media) {
+	jobID, err := uc.transcoder.SubmitJob(ctx, media.ID, media.URL)
+	if err != nil {
+		uc.logger.Warningf("failed to submit transcode job for media %s: %v", media.ID, err)
+		failed := &entity.TranscodeJobStatus{State: entity.TranscodeStateFailed, Message: "submission failed"}
+		if err := uc.mediaRepo.SetTranscodeResult(ctx, media.ID, failed); err != nil {
+			uc.logger.Warningf("failed to record transcode failure for media %s: %v", media.ID, err)
+		}
+		return
+	}
+	if err := uc.mediaRepo.MarkTranscodeSubmitted(ctx, media.ID, jobID); err != nil {
+		uc.logger.Warningf("failed to record transcode job for media %s: %v", media.ID, err)
+	}
+}
+
+// StartTranscodePoller launches the background loop that polls in-flight
+// transcode jobs and stores their playback URLs (or failure) once the
+// backend reports them done. It runs until the context is cancelled.
+func (uc *MediaUseCaseImpl) StartTranscodePoller(ctx context.Context) {
+	if uc.transcoder == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(transcodePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				processing, err := uc.mediaRepo.ListProcessingTranscodes(ctx, transcodePollBatch)
+				if err != nil {
+					uc.logger.Warningf("failed to list in-flight transcodes: %v", err)
+					continue
+				}
+				for _, media := range processing {
+					if media.TranscodeJobID == "" {
+						continue
+					}
+					status, err := uc.transcoder.GetJobStatus(ctx, media.TranscodeJobID)
+					if err != nil {
+						uc.logger.Warningf("failed to poll transcode job %s: %v", media.TranscodeJobID, err)
+						continue
+					}
+					if status.State != entity.TranscodeStateReady && status.State != entity.TranscodeStateFailed {
+						continue
+					}
+					if err := uc.mediaRepo.SetTranscodeResult(ctx, media.ID, status); err != nil {
+						uc.logger.Warningf("failed to record transcode result for media %s: %v", media.ID, err)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// generateImageVariants renders the responsive widths of an accepted image
+// upload and stores their metadata on the media record. Widths the original
+// cannot fill are skipped.
+func (uc *MediaUseCaseImpl) generateImageVariants(ctx context.Context, media *entity.Media, data []byte, storedName string) {
+	ext := mediaFileExtensions[media.MimeType]
+	base := strings.TrimSuffix(storedName, ext)
+
+	var variants []entity.MediaVariant
+	for _, width := range variantWidths {
+		resized, mimeType, height, err := uc.resizer.ResizeImage(data, media.MimeType, width)
+		if err != nil {
+			if !strings.Contains(err.Error(), "not wider") {
+				uc.logger.Warningf("failed to generate %dpx variant for media %s: %v", width, media.ID, err)
+			}
+			continue
+		}
+		variantURL, err := uc.storage.Save(ctx, fmt.Sprintf("%s-w%d%s", base, width, ext), resized)
+		if err != nil {
+			uc.logger.Warningf("failed to store %dpx variant for media %s: %v", width, media.ID, err)
+			continue
+		}
+		variants = append(variants, entity.MediaVariant{
+			Width:    width,
+			Height:   height,
+			URL:      variantURL,
+			MimeType: mimeType,
+			FileSize: int64(len(resized)),
+		})
+	}
+	if len(variants) == 0 {
+		return
+	}
+	if err := uc.mediaRepo.SetMediaVariants(ctx, media.ID, variants); err != nil {
+		uc.logger.Warningf("failed to record variants for media %s: %v", media.ID, err)
+	}
+}
+
+// uploadPartName is the temporary file chunked uploads are assembled in.
+func uploadPartName(sessionID string) string {
+	return sessionID + ".part"
+}
+
+// getOwnedSession loads a session and checks ownership and expiry.
+func (uc *MediaUseCaseImpl) getOwnedSession(ctx context.Context, userID, sessionID string) (*entity.UploadSession, error) {
+	if uc.sessions == nil {
+		return nil, errors.New("resumable uploads are not enabled")
+	}
+	if sessionID == "" {
+		return nil, errors.New("session ID is required")
+	}
+	session, err := uc.sessions.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != userID {
+		return nil, errors.New("unauthorized: not your upload session")
+	}
+	if time.Now().After(session.ExpiresAt) {
+		return nil, errors.New("upload session expired")
+	}
+	return session, nil
+}
+
+// BeginUpload opens a chunked upload session for a large file. Chunks are
+// appended with AppendChunk and the assembled file is persisted by
+// CompleteUpload.
+func (uc *MediaUseCaseImpl) BeginUpload(ctx context.Context, userID, fileName, declaredMIME string, totalSize int64) (*entity.UploadSession, error) {
+	if uc.sessions == nil {
+		return nil, errors.New("resumable uploads are not enabled")
+	}
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if totalSize <= 0 {
+		return nil, errors.New("total size must be positive")
+	}
+	if totalSize > maxChunkedUploadSize {
+		return nil, fmt.Errorf("file exceeds the %d MB upload limit", maxChunkedUploadSize>>20)
+	}
+	if _, ok := mediaFileExtensions[declaredMIME]; !ok {
+		return nil, fmt.Errorf("unsupported media type %q", declaredMIME)
+	}
+	if err := uc.checkStorageQuota(ctx, userID, totalSize); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &entity.UploadSession{
+		ID:        uc.uuidgen.NewUUID(),
+		UserID:    userID,
+		FileName:  strings.TrimSpace(fileName),
+		MimeType:  declaredMIME,
+		TotalSize: totalSize,
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(uploadSessionTTL),
+	}
+	if err := uc.sessions.CreateSession(ctx, session); err != nil {
+		uc.logger.Errorf("failed to create upload session: %v", err)
+		return nil, fmt.Errorf("failed to create upload session: %w", err)
+	}
+	return session, nil
+}
+
+// AppendChunk appends the next chunk of a session. The offset must equal the
+// bytes received so far, which lets an interrupted client query the session
+// and resume from the right position.
+func (uc *MediaUseCaseImpl) AppendChunk(ctx context.Context, userID, sessionID string, offset int64, chunk []byte) (*entity.UploadSession, error) {
+	session, err := uc.getOwnedSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(chunk) == 0 {
+		return nil, errors.New("chunk is empty")
+	}
+	if len(chunk) > maxUploadChunkSize {
+		return nil, fmt.Errorf("chunk exceeds the %d MB limit", maxUploadChunkSize>>20)
+	}
+	if offset != session.ReceivedSize {
+		return nil, fmt.Errorf("offset mismatch: expected %d", session.ReceivedSize)
+	}
+	if session.ReceivedSize+int64(len(chunk)) > session.TotalSize {
+		return nil, errors.New("chunk exceeds the declared total size")
+	}
+
+	if err := uc.storage.Append(ctx, uploadPartName(session.ID), chunk); err != nil {
+		uc.logger.Errorf("failed to append upload chunk: %v", err)
+		return nil, fmt.Errorf("failed to store chunk: %w", err)
+	}
+	session.ReceivedSize += int64(len(chunk))
+	session.ChunkCount++
+	session.UpdatedAt = time.Now()
+	if err := uc.sessions.UpdateReceived(ctx, session.ID, session.ReceivedSize); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// GetUploadSession returns a session's progress so clients can resume.
+func (uc *MediaUseCaseImpl) GetUploadSession(ctx context.Context, userID, sessionID string) (*entity.UploadSession, error) {
+	return uc.getOwnedSession(ctx, userID, sessionID)
+}
+
+// CompleteUpload assembles a fully received session into a media object by
+// running the regular upload pipeline over the collected bytes. The session
+// and its temporary file are removed whether the pipeline accepts the file
+// or rejects it.
+func (uc *MediaUseCaseImpl) CompleteUpload(ctx context.Context, userID, sessionID string) (*entity.Media, error) {
+	session, err := uc.getOwnedSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.ReceivedSize != session.TotalSize {
+		return nil, fmt.Errorf("upload is incomplete: %d of %d bytes received", session.ReceivedSize, session.TotalSize)
+	}
+	// Re-check the quota at completion: other uploads may have landed since
+	// the session was opened.
+	if err := uc.checkStorageQuota(ctx, userID, session.TotalSize); err != nil {
+		return nil, err
+	}
+
+	data, err := uc.storage.Load(ctx, uploadPartName(session.ID))
+	if err != nil {
+		uc.logger.Errorf("failed to load assembled upload %s: %v", session.ID, err)
+		return nil, fmt.Errorf("failed to load assembled upload: %w", err)
+	}
+
+	media, err := uc.finalizeUpload(ctx, userID, session.FileName, session.MimeType, data)
+	uc.cleanupSession(ctx, session.ID)
+	return media, err
+}
+
+// AbortUpload discards a session and its partial data.
+func (uc *MediaUseCaseImpl) AbortUpload(ctx context.Context, userID, sessionID string) error {
+	session, err := uc.getOwnedSession(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	uc.cleanupSession(ctx, session.ID)
+	return nil
+}
+
+// cleanupSession removes a session's temporary file and record.
+func (uc *MediaUseCaseImpl) cleanupSession(ctx context.Context, sessionID string) {
+	if err := uc.storage.Delete(ctx, uploadPartName(sessionID)); err != nil {
+		uc.logger.Warningf("failed to delete upload part for session %s: %v", sessionID, err)
+	}
+	if err := uc.sessions.DeleteSession(ctx, sessionID); err != nil {
+		uc.logger.Warningf("failed to delete upload session %s: %v", sessionID, err)
+	}
+}
+
+// StartSessionCleanup launches the background sweep that discards abandoned
+// upload sessions after their expiry. It runs until the context is
+// cancelled.
+func (uc *MediaUseCaseImpl) StartSessionCleanup(ctx context.Context) {
+	if uc.sessions == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(uploadSessionCleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				expired, err := uc.sessions.ListExpiredSessions(ctx, time.Now(), uploadSessionCleanupBatch)
+				if err != nil {
+					uc.logger.Warningf("failed to list expired upload sessions: %v", err)
+					continue
+				}
+				for _, session := range expired {
+					uc.cleanupSession(ctx, session.ID)
+				}
+			}
+		}
+	}()
+}
+
+// ListMedia returns a page of the user's media library, filtered by MIME
+// type, upload date range and whether the media is referenced by a blog.
+func (uc *MediaUseCaseImpl) ListMedia(ctx context.Context, userID string, mimeType *string, dateFrom, dateTo *time.Time, used *bool, page, pageSize int) ([]*entity.Media, int, int, int, error) {
+	if userID == "" {
+		return nil, 0, 0, 0, errors.New("user ID is required")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	opts := &contract.MediaFilterOptions{
+		UploadedByUserID: &userID,
+		MimeType:         mimeType,
+		DateFrom:         dateFrom,
+		DateTo:           dateTo,
+		Used:             used,
+		Page:             int64(page),
+		Limit:            int64(pageSize),
+	}
+	media, total, err := uc.mediaRepo.ListUserMedia(ctx, opts)
+	if err != nil {
+		uc.logger.Errorf("failed to list media for user %s: %v", userID, err)
+		return nil, 0, 0, 0, fmt.Errorf("failed to list media: %w", err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize != 0 {
+		totalPages++
+	}
+	return media, int(total), page, totalPages, nil
+}
+
+// BulkDeleteMedia soft-deletes the given media records; only records owned
+// by the user are touched.
+func (uc *MediaUseCaseImpl) BulkDeleteMedia(ctx context.Context, userID string, mediaIDs []string) (int64, error) {
+	if userID == "" {
+		return 0, errors.New("user ID is required")
+	}
+	if len(mediaIDs) == 0 {
+		return 0, errors.New("at least one media ID is required")
+	}
+	if len(mediaIDs) > 100 {
+		return 0, errors.New("at most 100 media IDs can be deleted at once")
+	}
+
+	deleted, err := uc.mediaRepo.BulkSoftDeleteByUser(ctx, userID, mediaIDs)
+	if err != nil {
+		uc.logger.Errorf("failed to bulk delete media for user %s: %v", userID, err)
+		return 0, fmt.Errorf("failed to delete media: %w", err)
+	}
+	return deleted, nil
+}
+
+// GetMediaUsage returns the media record together with the blogs that
+// reference it, either through the attachment association or as their
+// featured image.
+func (uc *MediaUseCaseImpl) GetMediaUsage(ctx context.Context, userID, mediaID string) (*entity.Media, []*entity.Blog, error) {
+	if mediaID == "" {
+		return nil, nil, errors.New("media ID is required")
+	}
+	media, err := uc.mediaRepo.GetMediaByID(ctx, mediaID)
+	if err != nil {
+		return nil, nil, errors.New("media not found")
+	}
+	if media.UploadedByUserID != userID {
+		return nil, nil, errors.New("unauthorized: not your media")
+	}
+
+	var blogs []*entity.Blog
+	if uc.blogRepo != nil {
+		blogs, err = uc.blogRepo.GetBlogsByFeaturedImageID(ctx, mediaID)
+		if err != nil {
+			uc.logger.Errorf("failed to list blogs using media %s: %v", mediaID, err)
+			return nil, nil, fmt.Errorf("failed to look up media usage: %w", err)
+		}
+		// The attachment association is tracked on the media record itself;
+		// include that blog when it is not already in the featured-image set.
+		if media.BlogID != "" {
+			seen := false
+			for _, blog := range blogs {
+				if blog.ID == media.BlogID {
+					seen = true
+					break
+				}
+			}
+			if !seen {
+				if blog, err := uc.blogRepo.GetBlogByID(ctx, media.BlogID); err == nil && blog != nil && !blog.IsDeleted {
+					blogs = append(blogs, blog)
+				}
+			}
+		}
+	}
+	return media, blogs, nil
+}
+
+// GetStorageUsage returns the user's total stored bytes, the configured
+// quota (zero when unlimited) and the usage breakdown by MIME type.
+func (uc *MediaUseCaseImpl) GetStorageUsage(ctx context.Context, userID string) (int64, int64, []contract.StorageUsageByType, error) {
+	if userID == "" {
+		return 0, 0, nil, errors.New("user ID is required")
+	}
+	usage, err := uc.mediaRepo.GetStorageUsage(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf("failed to compute storage usage for user %s: %v", userID, err)
+		return 0, 0, nil, fmt.Errorf("failed to compute storage usage: %w", err)
+	}
+	var total int64
+	for _, u := range usage {
+		total += u.Bytes
+	}
+	return total, uc.storageQuota, usage, nil
+}
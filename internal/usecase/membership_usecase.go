@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// IMembershipUseCase manages reader membership subscriptions and answers
+// access checks for gated posts.
+type IMembershipUseCase interface {
+	GetMembership(ctx context.Context, userID string) (*entity.Membership, error)
+	// Subscribe starts a checkout with the payments provider and returns
+	// the URL the reader completes payment on.
+	Subscribe(ctx context.Context, userID, tier string) (string, error)
+	// HandleWebhook applies a payments provider notification to the
+	// membership records.
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+	HasAccess(ctx context.Context, viewerID *string, level entity.BlogAccessLevel) bool
+}
+
+// MembershipUseCaseImpl implements IMembershipUseCase.
+type MembershipUseCaseImpl struct {
+	membershipRepo contract.IMembershipRepository
+	userRepo       contract.IUserRepository
+	uuidgen        contract.IUUIDGenerator
+	logger         usecasecontract.IAppLogger
+	baseURL        string
+	provider       contract.ISubscriptionProvider // optional, set when payments are configured
+}
+
+var _ IMembershipUseCase = (*MembershipUseCaseImpl)(nil)
+
+// NewMembershipUseCase creates a new MembershipUseCaseImpl.
+func NewMembershipUseCase(
+	membershipRepo contract.IMembershipRepository,
+	userRepo contract.IUserRepository,
+	uuidgen contract.IUUIDGenerator,
+	logger usecasecontract.IAppLogger,
+	baseURL string,
+) *MembershipUseCaseImpl {
+	return &MembershipUseCaseImpl{
+		membershipRepo: membershipRepo,
+		userRepo:       userRepo,
+		uuidgen:        uuidgen,
+		logger:         logger,
+		baseURL:        baseURL,
+	}
+}
+
+// SetSubscriptionProvider enables subscription checkouts and webhook
+// handling. Without it, memberships can only be granted out of band.
+func (uc *MembershipUseCaseImpl) SetSubscriptionProvider(provider contract.ISubscriptionProvider) {
+	uc.provider = provider
+}
+
+// GetMembership returns the user's membership record.
+func (uc *MembershipUseCaseImpl) GetMembership(ctx context.Context, userID string) (*entity.Membership, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	return uc.membershipRepo.GetMembershipByUserID(ctx, userID)
+}
+
+// Subscribe starts a checkout with the payments provider.
+func (uc *MembershipUseCaseImpl) Subscribe(ctx context.Context, userID, tier string) (string, error) {
+	if uc.provider == nil {
+		return "", errors.New("payments are not configured")
+	}
+	if userID == "" {
+		return "", errors.New("user ID is required")
+	}
+	membershipTier := entity.MembershipTier(tier)
+	if membershipTier != entity.MembershipTierMember && membershipTier != entity.MembershipTierPaid {
+		return "", fmt.Errorf("invalid membership tier %q", tier)
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf("failed to get user: %v", err)
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+
+	successURL := fmt.Sprintf("%s/membership?checkout=success", uc.baseURL)
+	cancelURL := fmt.Sprintf("%s/membership?checkout=cancelled", uc.baseURL)
+	session, err := uc.provider.CreateSubscriptionCheckout(ctx, userID, user.Email, membershipTier, successURL, cancelURL)
+	if err != nil {
+		uc.logger.Errorf("failed to create subscription checkout: %v", err)
+		return "", fmt.Errorf("failed to start checkout")
+	}
+	return session.URL, nil
+}
+
+// HandleWebhook applies a payments provider notification to the membership
+// records.
+func (uc *MembershipUseCaseImpl) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	if uc.provider == nil {
+		return errors.New("payments are not configured")
+	}
+	event, ok, err := uc.provider.ParseSubscriptionEvent(payload, signature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// Unrelated event types are acknowledged and ignored.
+		return nil
+	}
+
+	switch event.Type {
+	case entity.PaymentEventSubscriptionActivated, entity.PaymentEventSubscriptionRenewed:
+		if event.UserID == "" {
+			return errors.New("webhook event has no user ID")
+		}
+		membership := &entity.Membership{
+			ID:             uc.uuidgen.NewUUID(),
+			UserID:         event.UserID,
+			Tier:           event.Tier,
+			Status:         entity.MembershipStatusActive,
+			SubscriptionID: event.SubscriptionID,
+			ExpiresAt:      event.ExpiresAt,
+		}
+		if existing, err := uc.membershipRepo.GetMembershipByUserID(ctx, event.UserID); err == nil {
+			membership.ID = existing.ID
+			membership.CreatedAt = existing.CreatedAt
+		}
+		if err := uc.membershipRepo.UpsertMembership(ctx, membership); err != nil {
+			uc.logger.Errorf("failed to upsert membership: %v", err)
+			return fmt.Errorf("failed to record membership")
+		}
+	case entity.PaymentEventSubscriptionCancelled:
+		membership, err := uc.membershipRepo.GetMembershipBySubscriptionID(ctx, event.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		membership.Status = entity.MembershipStatusCancelled
+		if err := uc.membershipRepo.UpsertMembership(ctx, membership); err != nil {
+			uc.logger.Errorf("failed to cancel membership: %v", err)
+			return fmt.Errorf("failed to record cancellation")
+		}
+	default:
+		return fmt.Errorf("unknown webhook event type %q", event.Type)
+	}
+	return nil
+}
+
+// HasAccess reports whether the viewer may read posts gated at the given
+// access level. Authors and admins are handled by the caller.
+func (uc *MembershipUseCaseImpl) HasAccess(ctx context.Context, viewerID *string, level entity.BlogAccessLevel) bool {
+	if level == "" || level == entity.BlogAccessPublic {
+		return true
+	}
+	if viewerID == nil || *viewerID == "" {
+		return false
+	}
+	membership, err := uc.membershipRepo.GetMembershipByUserID(ctx, *viewerID)
+	if err != nil {
+		return false
+	}
+	if membership.Status != entity.MembershipStatusActive {
+		return false
+	}
+	if membership.ExpiresAt != nil && membership.ExpiresAt.Before(time.Now()) {
+		return false
+	}
+	if level == entity.BlogAccessPaid {
+		return membership.Tier == entity.MembershipTierPaid
+	}
+	return true
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	"github.com/mikiasgoitom/Articulate/internal/infrastructure/tasks"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// broadcastStatsFlushEvery controls how often delivery stats are persisted
+// while a broadcast is being sent, so the dashboard shows progress.
+const broadcastStatsFlushEvery = 25
+
+// NewsletterUseCaseImpl manages newsletter subscriptions (double opt-in)
+// and sends published posts to confirmed subscribers in the background.
+type NewsletterUseCaseImpl struct {
+	newsletterRepo  contract.INewsletterRepository
+	blogRepo        contract.IBlogRepository
+	userRepo        contract.IUserRepository
+	mailService     contract.IEmailService
+	uuidGenerator   contract.IUUIDGenerator
+	randomGenerator contract.IRandomGenerator
+	logger          usecasecontract.IAppLogger
+	baseURL         string
+}
+
+var _ usecasecontract.INewsletterUseCase = (*NewsletterUseCaseImpl)(nil)
+
+func NewNewsletterUseCase(newsletterRepo contract.INewsletterRepository, blogRepo contract.IBlogRepository, userRepo contract.IUserRepository, mailService contract.IEmailService, uuidGenerator contract.IUUIDGenerator, randomGenerator contract.IRandomGenerator, logger usecasecontract.IAppLogger, baseURL string) *NewsletterUseCaseImpl {
+	return &NewsletterUseCaseImpl{
+		newsletterRepo:  newsletterRepo,
+		blogRepo:        blogRepo,
+		userRepo:        userRepo,
+		mailService:     mailService,
+		uuidGenerator:   uuidGenerator,
+		randomGenerator: randomGenerator,
+		logger:          logger,
+		baseURL:         baseURL,
+	}
+}
+
+// Subscribe starts a double opt-in subscription and sends the confirmation
+// email. A pending subscription gets its confirmation email resent instead
+// of a duplicate record.
+func (uc *NewsletterUseCaseImpl) Subscribe(ctx context.Context, email, authorID string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+	listName := "the site newsletter"
+	if authorID != "" {
+		author, err := uc.userRepo.GetUserByID(ctx, authorID)
+		if err != nil || author == nil {
+			return fmt.Errorf("author not found")
+		}
+		listName = fmt.Sprintf("%s's newsletter", author.Username)
+	}
+
+	existing, err := uc.newsletterRepo.GetSubscriptionByEmailAndAuthor(ctx, email, authorID)
+	if err == nil && existing != nil {
+		if existing.Confirmed {
+			return fmt.Errorf("already subscribed")
+		}
+		// Pending double opt-in: resend the confirmation email.
+		uc.sendConfirmationEmail(ctx, existing, listName)
+		return nil
+	}
+
+	confirmToken, err := uc.randomGenerator.GenerateRandomToken(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate confirm token: %w", err)
+	}
+	unsubscribeToken, err := uc.randomGenerator.GenerateRandomToken(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate unsubscribe token: %w", err)
+	}
+
+	subscription := &entity.NewsletterSubscription{
+		ID:               uc.uuidGenerator.NewUUID(),
+		Email:            email,
+		AuthorID:         authorID,
+		ConfirmToken:     confirmToken,
+		UnsubscribeToken: unsubscribeToken,
+		CreatedAt:        time.Now(),
+	}
+	if err := uc.newsletterRepo.CreateSubscription(ctx, subscription); err != nil {
+		uc.logger.Errorf("failed to create subscription for %s: %v", email, err)
+		return fmt.Errorf("failed to create subscription")
+	}
+
+	uc.sendConfirmationEmail(ctx, subscription, listName)
+	return nil
+}
+
+func (uc *NewsletterUseCaseImpl) sendConfirmationEmail(ctx context.Context, subscription *entity.NewsletterSubscription, listName string) {
+	confirmLink := fmt.Sprintf("%s/api/v1/newsletter/confirm?token=%s", uc.baseURL, subscription.ConfirmToken)
+	body := fmt.Sprintf("Hi,\n\nPlease confirm your subscription to %s by clicking the following link: %s\n\nIf you did not request this, please ignore this email.\n\nThanks,\nThe Team", listName, confirmLink)
+	if err := uc.mailService.SendEmail(ctx, subscription.Email, "Confirm your newsletter subscription", body); err != nil {
+		uc.logger.Errorf("failed to send confirmation email to %s: %v", subscription.Email, err)
+	}
+}
+
+// Confirm completes the double opt-in for the emailed token.
+func (uc *NewsletterUseCaseImpl) Confirm(ctx context.Context, token string) error {
+	if _, err := uc.newsletterRepo.ConfirmSubscription(ctx, token); err != nil {
+		return fmt.Errorf("invalid confirmation token")
+	}
+	return nil
+}
+
+// Unsubscribe removes the subscription behind an unsubscribe link.
+func (uc *NewsletterUseCaseImpl) Unsubscribe(ctx context.Context, token string) error {
+	if err := uc.newsletterRepo.DeleteSubscriptionByToken(ctx, token); err != nil {
+		return fmt.Errorf("invalid unsubscribe token")
+	}
+	return nil
+}
+
+// Broadcast sends a published post to the author's confirmed subscribers
+// (including site-wide subscribers). Delivery runs in the background; the
+// returned broadcast carries the recipient count and tracks stats as
+// sending progresses.
+func (uc *NewsletterUseCaseImpl) Broadcast(ctx context.Context, blogID, authorID string) (*entity.NewsletterBroadcast, error) {
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog == nil {
+		return nil, fmt.Errorf("blog not found")
+	}
+	if blog.AuthorID != authorID {
+		return nil, fmt.Errorf("only the author can broadcast a blog")
+	}
+	if blog.Status != entity.BlogStatusPublished {
+		return nil, fmt.Errorf("only published blogs can be broadcast")
+	}
+
+	subscriptions, err := uc.newsletterRepo.ListConfirmedSubscriptions(ctx, authorID)
+	if err != nil {
+		uc.logger.Errorf("failed to list subscribers for author %s: %v", authorID, err)
+		return nil, fmt.Errorf("failed to list subscribers")
+	}
+	if len(subscriptions) == 0 {
+		return nil, fmt.Errorf("no confirmed subscribers")
+	}
+
+	broadcast := &entity.NewsletterBroadcast{
+		ID:         uc.uuidGenerator.NewUUID(),
+		BlogID:     blog.ID,
+		AuthorID:   authorID,
+		Subject:    blog.Title,
+		Status:     entity.BroadcastStatusSending,
+		Recipients: len(subscriptions),
+		CreatedAt:  time.Now(),
+	}
+	if err := uc.newsletterRepo.CreateBroadcast(ctx, broadcast); err != nil {
+		uc.logger.Errorf("failed to create broadcast for blog %s: %v", blogID, err)
+		return nil, fmt.Errorf("failed to create broadcast")
+	}
+
+	tasks.Go(func() { uc.deliverBroadcast(context.Background(), broadcast, blog, subscriptions) })
+	return broadcast, nil
+}
+
+// deliverBroadcast sends the newsletter to each subscriber and persists
+// delivery stats along the way.
+func (uc *NewsletterUseCaseImpl) deliverBroadcast(ctx context.Context, broadcast *entity.NewsletterBroadcast, blog *entity.Blog, subscriptions []*entity.NewsletterSubscription) {
+	blogLink := fmt.Sprintf("%s/blogs/slug/%s", uc.baseURL, blog.Slug)
+	delivered, failed := 0, 0
+	for i, subscription := range subscriptions {
+		unsubscribeLink := fmt.Sprintf("%s/api/v1/newsletter/unsubscribe?token=%s", uc.baseURL, subscription.UnsubscribeToken)
+		body := fmt.Sprintf("%s\n\nRead the full post: %s\n\n---\nYou are receiving this because you subscribed to this newsletter. Unsubscribe: %s", blog.Content, blogLink, unsubscribeLink)
+		if err := uc.mailService.SendEmail(ctx, subscription.Email, blog.Title, body); err != nil {
+			uc.logger.Warningf("failed to deliver broadcast %s to %s: %v", broadcast.ID, subscription.Email, err)
+			failed++
+		} else {
+			delivered++
+		}
+		if (i+1)%broadcastStatsFlushEvery == 0 {
+			if err := uc.newsletterRepo.UpdateBroadcastStats(ctx, broadcast.ID, delivered, failed, false); err != nil {
+				uc.logger.Errorf("failed to update broadcast stats for %s: %v", broadcast.ID, err)
+			}
+		}
+	}
+	if err := uc.newsletterRepo.UpdateBroadcastStats(ctx, broadcast.ID, delivered, failed, true); err != nil {
+		uc.logger.Errorf("failed to finalize broadcast stats for %s: %v", broadcast.ID, err)
+	}
+	uc.logger.Infof("broadcast %s finished: %d delivered, %d failed", broadcast.ID, delivered, failed)
+}
+
+// ListBroadcasts returns the author's broadcasts with delivery stats.
+func (uc *NewsletterUseCaseImpl) ListBroadcasts(ctx context.Context, authorID string) ([]*entity.NewsletterBroadcast, error) {
+	broadcasts, err := uc.newsletterRepo.ListBroadcastsByAuthorID(ctx, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list broadcasts: %w", err)
+	}
+	return broadcasts, nil
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// orgProfileBlogsLimit is the number of recent posts shown on an org profile.
+const orgProfileBlogsLimit = 10
+
+// Ensure OrganizationUseCaseImpl implements IOrganizationUseCase
+var _ usecasecontract.IOrganizationUseCase = (*OrganizationUseCaseImpl)(nil)
+
+type OrganizationUseCaseImpl struct {
+	orgRepo  contract.IOrganizationRepository
+	blogRepo contract.IBlogRepository
+	uuidGen  contract.IUUIDGenerator
+}
+
+func NewOrganizationUseCase(
+	orgRepo contract.IOrganizationRepository,
+	blogRepo contract.IBlogRepository,
+	uuidGen contract.IUUIDGenerator,
+) *OrganizationUseCaseImpl {
+	return &OrganizationUseCaseImpl{
+		orgRepo:  orgRepo,
+		blogRepo: blogRepo,
+		uuidGen:  uuidGen,
+	}
+}
+
+// CreateOrganization creates an organization and records the creator as its
+// owner.
+func (uc *OrganizationUseCaseImpl) CreateOrganization(ctx context.Context, name, slug, description, ownerID string) (*entity.Organization, error) {
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if ownerID == "" {
+		return nil, errors.New("owner ID is required")
+	}
+	if slug == "" {
+		slug = strings.ReplaceAll(strings.ToLower(name), " ", "-")
+	}
+
+	org := &entity.Organization{
+		ID:          uc.uuidGen.NewUUID(),
+		Name:        name,
+		Slug:        slug,
+		Description: description,
+	}
+	if err := uc.orgRepo.CreateOrganization(ctx, org); err != nil {
+		return nil, err
+	}
+
+	owner := &entity.OrgMember{
+		ID:     uc.uuidGen.NewUUID(),
+		OrgID:  org.ID,
+		UserID: ownerID,
+		Role:   entity.OrgRoleOwner,
+	}
+	if err := uc.orgRepo.AddMember(ctx, owner); err != nil {
+		return nil, fmt.Errorf("failed to record organization owner: %w", err)
+	}
+
+	return org, nil
+}
+
+// GetOrganizationProfile returns the public profile page: the organization,
+// its members and its recent posts.
+func (uc *OrganizationUseCaseImpl) GetOrganizationProfile(ctx context.Context, slug string) (*entity.OrganizationProfile, error) {
+	org, err := uc.orgRepo.GetOrganizationBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := uc.orgRepo.GetMembers(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	blogs, blogCount, err := uc.blogRepo.GetBlogs(ctx, &contract.BlogFilterOptions{
+		Page:      1,
+		PageSize:  orgProfileBlogsLimit,
+		SortBy:    "created_at",
+		SortOrder: "desc",
+		OrgID:     &org.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organization blogs: %w", err)
+	}
+
+	return &entity.OrganizationProfile{
+		Organization: *org,
+		Members:      members,
+		RecentBlogs:  blogs,
+		BlogCount:    blogCount,
+	}, nil
+}
+
+// UpdateOrganization updates profile and tenant settings. Only owners may
+// change them; the custom domain is normalized to a bare lowercase hostname.
+func (uc *OrganizationUseCaseImpl) UpdateOrganization(ctx context.Context, slug, actorID string, description, customDomain *string, theme map[string]string) (*entity.Organization, error) {
+	org, err := uc.requireOwner(ctx, slug, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if description != nil {
+		updates["description"] = *description
+	}
+	if customDomain != nil {
+		domain := strings.ToLower(strings.TrimSpace(*customDomain))
+		domain = strings.TrimPrefix(strings.TrimPrefix(domain, "https://"), "http://")
+		domain = strings.TrimSuffix(domain, "/")
+		updates["custom_domain"] = domain
+	}
+	if theme != nil {
+		updates["theme"] = theme
+	}
+
+	if len(updates) > 0 {
+		if err := uc.orgRepo.UpdateOrganization(ctx, org.ID, updates); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.orgRepo.GetOrganizationByID(ctx, org.ID)
+}
+
+// requireOwner loads the organization by slug and verifies the actor owns it.
+func (uc *OrganizationUseCaseImpl) requireOwner(ctx context.Context, slug, actorID string) (*entity.Organization, error) {
+	org, err := uc.orgRepo.GetOrganizationBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := uc.orgRepo.GetMember(ctx, org.ID, actorID)
+	if err != nil || actor.Role != entity.OrgRoleOwner {
+		return nil, errors.New("unauthorized: only organization owners can manage members")
+	}
+	return org, nil
+}
+
+// AddMember adds a user to the organization; only owners manage members.
+func (uc *OrganizationUseCaseImpl) AddMember(ctx context.Context, slug, actorID, userID string, role entity.OrgRole) error {
+	if role != entity.OrgRoleOwner && role != entity.OrgRoleEditor && role != entity.OrgRoleWriter {
+		return fmt.Errorf("invalid organization role: %s", role)
+	}
+
+	org, err := uc.requireOwner(ctx, slug, actorID)
+	if err != nil {
+		return err
+	}
+
+	return uc.orgRepo.AddMember(ctx, &entity.OrgMember{
+		ID:     uc.uuidGen.NewUUID(),
+		OrgID:  org.ID,
+		UserID: userID,
+		Role:   role,
+	})
+}
+
+// UpdateMemberRole changes a member's role; only owners manage members, and
+// an owner cannot demote themselves so the organization always has one.
+func (uc *OrganizationUseCaseImpl) UpdateMemberRole(ctx context.Context, slug, actorID, userID string, role entity.OrgRole) error {
+	if role != entity.OrgRoleOwner && role != entity.OrgRoleEditor && role != entity.OrgRoleWriter {
+		return fmt.Errorf("invalid organization role: %s", role)
+	}
+	if actorID == userID && role != entity.OrgRoleOwner {
+		return errors.New("owners cannot demote themselves")
+	}
+
+	org, err := uc.requireOwner(ctx, slug, actorID)
+	if err != nil {
+		return err
+	}
+
+	return uc.orgRepo.UpdateMemberRole(ctx, org.ID, userID, role)
+}
+
+// RemoveMember removes a user from the organization; only owners manage
+// members, and an owner cannot remove themselves.
+func (uc *OrganizationUseCaseImpl) RemoveMember(ctx context.Context, slug, actorID, userID string) error {
+	if actorID == userID {
+		return errors.New("owners cannot remove themselves")
+	}
+
+	org, err := uc.requireOwner(ctx, slug, actorID)
+	if err != nil {
+		return err
+	}
+
+	return uc.orgRepo.RemoveMember(ctx, org.ID, userID)
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// reportHour is the local hour at which performance reports go out, weekly
+// reports on Monday and monthly reports on the first of the month.
+const reportHour = 8
+
+// reportTopPosts is how many of the author's posts the report ranks.
+const reportTopPosts = 3
+
+// reportCommentHighlights is how many new comments the report quotes.
+const reportCommentHighlights = 3
+
+// reportTemplate renders the performance report email body.
+var reportTemplate = template.Must(template.New("performanceReport").Parse(
+	`Hi {{.Username}},
+
+Here is your {{.Period}} performance report:
+
+Views: {{.TotalViews}} across your posts
+New subscribers: {{.NewFollowers}}
+{{if .TopPosts}}
+Top posts:
+{{- range .TopPosts}}
+  - {{.Title}}: {{.Views}} views
+{{- end}}
+{{end}}{{if .Highlights}}
+Comment highlights:
+{{- range .Highlights}}
+  - {{.AuthorName}} on {{.BlogTitle}}: {{.Content}}
+{{- end}}
+{{end}}
+You receive this report because you opted into {{.Period}} performance
+summaries. Change the frequency in your profile settings.
+
+Thanks,
+The Team
+`))
+
+type reportPost struct {
+	Title string
+	Views int
+}
+
+type reportHighlight struct {
+	AuthorName string
+	BlogTitle  string
+	Content    string
+}
+
+type reportData struct {
+	Username     string
+	Period       string
+	TotalViews   int
+	NewFollowers int
+	TopPosts     []reportPost
+	Highlights   []reportHighlight
+}
+
+// PerformanceReportUseCaseImpl sends opted-in authors a periodic summary of
+// their views, top posts, new newsletter subscribers and comment highlights.
+type PerformanceReportUseCaseImpl struct {
+	blogRepo       contract.IBlogRepository
+	commentRepo    contract.ICommentRepository
+	userRepo       contract.IUserRepository
+	newsletterRepo contract.INewsletterRepository
+	mailService    contract.IEmailService
+	logger         usecasecontract.IAppLogger
+}
+
+func NewPerformanceReportUseCase(blogRepo contract.IBlogRepository, commentRepo contract.ICommentRepository, userRepo contract.IUserRepository, newsletterRepo contract.INewsletterRepository, mailService contract.IEmailService, logger usecasecontract.IAppLogger) *PerformanceReportUseCaseImpl {
+	return &PerformanceReportUseCaseImpl{
+		blogRepo:       blogRepo,
+		commentRepo:    commentRepo,
+		userRepo:       userRepo,
+		newsletterRepo: newsletterRepo,
+		mailService:    mailService,
+		logger:         logger,
+	}
+}
+
+// StartReportScheduler runs the report passes in the background until the
+// context is cancelled: weekly reports on Monday morning, monthly reports on
+// the first of the month.
+func (uc *PerformanceReportUseCaseImpl) StartReportScheduler(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if now.Hour() != reportHour {
+					continue
+				}
+				if now.Weekday() == time.Monday {
+					uc.RunReports(ctx, "weekly")
+				}
+				if now.Day() == 1 {
+					uc.RunReports(ctx, "monthly")
+				}
+			}
+		}
+	}()
+}
+
+// RunReports sends one report email to every author subscribed at the given
+// frequency, covering the elapsed period. Per-author failures are logged and
+// do not stop the pass.
+func (uc *PerformanceReportUseCaseImpl) RunReports(ctx context.Context, frequency string) {
+	window := 7 * 24 * time.Hour
+	if frequency == "monthly" {
+		window = 30 * 24 * time.Hour
+	}
+	since := time.Now().Add(-window)
+
+	users, err := uc.userRepo.ListUsersByPerformanceReport(ctx, frequency)
+	if err != nil {
+		uc.logger.Errorf("failed to list %s report subscribers: %v", frequency, err)
+		return
+	}
+	for _, user := range users {
+		if err := uc.sendReport(ctx, user, frequency, since); err != nil {
+			uc.logger.Errorf("failed to send performance report to user %s: %v", user.ID, err)
+		}
+	}
+}
+
+// sendReport builds and sends one author's report; authors with no activity
+// in the window get no email.
+func (uc *PerformanceReportUseCaseImpl) sendReport(ctx context.Context, user *entity.User, frequency string, since time.Time) error {
+	if user.Email == "" {
+		return nil
+	}
+
+	blogs, err := uc.blogRepo.ListBlogsByAuthorID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	titles := make(map[string]string, len(blogs))
+	blogIDs := make([]string, 0, len(blogs))
+	for _, blog := range blogs {
+		titles[blog.ID] = blog.Title
+		blogIDs = append(blogIDs, blog.ID)
+	}
+
+	views, err := uc.blogRepo.CountViewsSince(ctx, blogIDs, since)
+	if err != nil {
+		return err
+	}
+	data := reportData{Username: user.Username, Period: frequency}
+	for blogID, count := range views {
+		data.TotalViews += count
+		data.TopPosts = append(data.TopPosts, reportPost{Title: titles[blogID], Views: count})
+	}
+	sort.Slice(data.TopPosts, func(i, j int) bool { return data.TopPosts[i].Views > data.TopPosts[j].Views })
+	if len(data.TopPosts) > reportTopPosts {
+		data.TopPosts = data.TopPosts[:reportTopPosts]
+	}
+
+	// New followers are the confirmed subscribers the author's newsletter
+	// gained in the window; the site-wide list does not count.
+	subscriptions, err := uc.newsletterRepo.ListConfirmedSubscriptions(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subscriptions {
+		if sub.AuthorID == user.ID && sub.CreatedAt.After(since) {
+			data.NewFollowers++
+		}
+	}
+
+	// Quote the newest comments from other people as highlights.
+	comments, err := uc.commentRepo.ListApprovedByBlogIDsSince(ctx, blogIDs, since)
+	if err != nil {
+		return err
+	}
+	for _, comment := range comments {
+		if comment.AuthorID == user.ID {
+			continue
+		}
+		data.Highlights = append(data.Highlights, reportHighlight{
+			AuthorName: comment.AuthorName,
+			BlogTitle:  titles[comment.BlogID],
+			Content:    comment.Content,
+		})
+		if len(data.Highlights) == reportCommentHighlights {
+			break
+		}
+	}
+
+	if data.TotalViews == 0 && data.NewFollowers == 0 && len(data.Highlights) == 0 {
+		return nil
+	}
+
+	var body strings.Builder
+	if err := reportTemplate.Execute(&body, data); err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("Your %s performance report", frequency)
+	return uc.mailService.SendEmail(ctx, user.Email, subject, body.String())
+}
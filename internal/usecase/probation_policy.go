@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// linkPattern matches absolute http(s) URLs embedded in user content.
+var linkPattern = regexp.MustCompile(`https?://[^\s<>"')]+`)
+
+// ProbationPolicy applies restrictions to freshly registered accounts:
+// their comments are held for moderation, they get tighter rate limits and
+// they may not post external links. The comment and blog usecases consult it
+// before accepting content.
+type ProbationPolicy struct {
+	userRepo contract.IUserRepository
+	config   usecasecontract.IConfigProvider
+}
+
+func NewProbationPolicy(userRepo contract.IUserRepository, config usecasecontract.IConfigProvider) *ProbationPolicy {
+	return &ProbationPolicy{
+		userRepo: userRepo,
+		config:   config,
+	}
+}
+
+// IsOnProbation reports whether the account is younger than the configured
+// probation period. Unknown users are not treated as on probation.
+func (p *ProbationPolicy) IsOnProbation(ctx context.Context, userID string) bool {
+	period := p.config.GetNewAccountProbationPeriod()
+	if period <= 0 || userID == "" {
+		return false
+	}
+
+	user, err := p.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(user.CreatedAt) < period
+}
+
+// ContainsExternalLink reports whether content links anywhere outside the
+// application's own domain. Unparseable links count as external.
+func (p *ProbationPolicy) ContainsExternalLink(content string) bool {
+	appHost := ""
+	if base, err := url.Parse(p.config.GetAppBaseURL()); err == nil {
+		appHost = strings.ToLower(base.Hostname())
+	}
+
+	for _, match := range linkPattern.FindAllString(content, -1) {
+		link, err := url.Parse(match)
+		if err != nil {
+			return true
+		}
+		if appHost == "" || !strings.EqualFold(link.Hostname(), appHost) {
+			return true
+		}
+	}
+
+	return false
+}
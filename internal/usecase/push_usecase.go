@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// PushUseCaseImpl registers device tokens and fans push notifications out to
+// all of a user's devices through the configured provider.
+type PushUseCaseImpl struct {
+	deviceRepo contract.IDeviceRepository
+	userRepo   contract.IUserRepository
+	provider   contract.IPushProvider
+	logger     usecasecontract.IAppLogger
+}
+
+var _ usecasecontract.IPushUseCase = (*PushUseCaseImpl)(nil)
+
+func NewPushUseCase(deviceRepo contract.IDeviceRepository, userRepo contract.IUserRepository, provider contract.IPushProvider, logger usecasecontract.IAppLogger) *PushUseCaseImpl {
+	return &PushUseCaseImpl{
+		deviceRepo: deviceRepo,
+		userRepo:   userRepo,
+		provider:   provider,
+		logger:     logger,
+	}
+}
+
+// RegisterDevice stores the device token for the user, taking it over from a
+// previous owner when the token was already registered.
+func (uc *PushUseCaseImpl) RegisterDevice(ctx context.Context, userID, token, platform string) error {
+	switch platform {
+	case entity.DevicePlatformWeb, entity.DevicePlatformAndroid, entity.DevicePlatformIOS:
+	default:
+		return fmt.Errorf("invalid platform: must be web, android or ios")
+	}
+
+	device := &entity.Device{
+		UserID:    userID,
+		Token:     token,
+		Platform:  platform,
+		CreatedAt: time.Now(),
+	}
+	if err := uc.deviceRepo.RegisterDevice(ctx, device); err != nil {
+		return fmt.Errorf("failed to register device: %w", err)
+	}
+	return nil
+}
+
+// UnregisterDevice removes the user's device token.
+func (uc *PushUseCaseImpl) UnregisterDevice(ctx context.Context, userID, token string) error {
+	return uc.deviceRepo.DeleteDeviceByToken(ctx, userID, token)
+}
+
+// ListDevices returns the user's registered devices.
+func (uc *PushUseCaseImpl) ListDevices(ctx context.Context, userID string) ([]*entity.Device, error) {
+	return uc.deviceRepo.ListDevicesByUserID(ctx, userID)
+}
+
+// NotifyUser pushes the alert to every device the user has registered,
+// unless they opted out of push notifications. Best-effort: failures are
+// logged and never surfaced to the triggering operation.
+func (uc *PushUseCaseImpl) NotifyUser(ctx context.Context, userID, title, body string) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil || user == nil || user.PushNotificationsOptOut {
+		return
+	}
+
+	devices, err := uc.deviceRepo.ListDevicesByUserID(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf("failed to list devices for user %s: %v", userID, err)
+		return
+	}
+	for _, device := range devices {
+		if err := uc.provider.SendPush(ctx, device.Token, title, body); err != nil {
+			uc.logger.Warningf("failed to push to device %s of user %s: %v", device.ID, userID, err)
+		}
+	}
+}
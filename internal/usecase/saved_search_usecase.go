@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// maxSavedSearchesPerUser caps how many searches one user may save.
+const maxSavedSearchesPerUser = 20
+
+// savedSearchBatchSize is how many saved searches one evaluation page loads.
+const savedSearchBatchSize = 100
+
+// SavedSearchUseCaseImpl manages saved searches and alerts their owners when
+// a newly published post matches.
+type SavedSearchUseCaseImpl struct {
+	savedSearchRepo contract.ISavedSearchRepository
+	userRepo        contract.IUserRepository
+	mailService     contract.IEmailService
+	uuidGenerator   contract.IUUIDGenerator
+	logger          usecasecontract.IAppLogger
+	baseURL         string
+}
+
+var _ usecasecontract.ISavedSearchUseCase = (*SavedSearchUseCaseImpl)(nil)
+
+func NewSavedSearchUseCase(savedSearchRepo contract.ISavedSearchRepository, userRepo contract.IUserRepository, mailService contract.IEmailService, uuidGenerator contract.IUUIDGenerator, logger usecasecontract.IAppLogger, baseURL string) *SavedSearchUseCaseImpl {
+	return &SavedSearchUseCaseImpl{
+		savedSearchRepo: savedSearchRepo,
+		userRepo:        userRepo,
+		mailService:     mailService,
+		uuidGenerator:   uuidGenerator,
+		logger:          logger,
+		baseURL:         baseURL,
+	}
+}
+
+// CreateSavedSearch stores the search/filter combination for the user.
+func (uc *SavedSearchUseCaseImpl) CreateSavedSearch(ctx context.Context, userID, name, query string, tags []string) (*entity.SavedSearch, error) {
+	query = strings.TrimSpace(query)
+	if query == "" && len(tags) == 0 {
+		return nil, errors.New("saved search needs a query or at least one tag")
+	}
+	if strings.TrimSpace(name) == "" {
+		name = query
+		if name == "" {
+			name = strings.Join(tags, ", ")
+		}
+	}
+
+	count, err := uc.savedSearchRepo.CountSavedSearchesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count saved searches: %w", err)
+	}
+	if count >= maxSavedSearchesPerUser {
+		return nil, fmt.Errorf("saved search limit of %d reached", maxSavedSearchesPerUser)
+	}
+
+	search := &entity.SavedSearch{
+		ID:        uc.uuidGenerator.NewUUID(),
+		UserID:    userID,
+		Name:      strings.TrimSpace(name),
+		Query:     query,
+		Tags:      tags,
+		CreatedAt: time.Now(),
+	}
+	if err := uc.savedSearchRepo.CreateSavedSearch(ctx, search); err != nil {
+		return nil, err
+	}
+	return search, nil
+}
+
+// ListSavedSearches returns the user's saved searches, newest first.
+func (uc *SavedSearchUseCaseImpl) ListSavedSearches(ctx context.Context, userID string) ([]*entity.SavedSearch, error) {
+	return uc.savedSearchRepo.ListSavedSearchesByUserID(ctx, userID)
+}
+
+// DeleteSavedSearch removes the user's saved search.
+func (uc *SavedSearchUseCaseImpl) DeleteSavedSearch(ctx context.Context, userID, id string) error {
+	return uc.savedSearchRepo.DeleteSavedSearch(ctx, userID, id)
+}
+
+// NotifyPublished evaluates every saved search against the freshly published
+// blog and emails the owners of matching ones. Called in the background on
+// publish; failures are logged only.
+func (uc *SavedSearchUseCaseImpl) NotifyPublished(ctx context.Context, blog *entity.Blog) {
+	if blog == nil {
+		return
+	}
+
+	notified := make(map[string]struct{})
+	for offset := 0; ; offset += savedSearchBatchSize {
+		searches, err := uc.savedSearchRepo.ListSavedSearches(ctx, offset, savedSearchBatchSize)
+		if err != nil {
+			uc.logger.Errorf("failed to list saved searches for blog %s: %v", blog.ID, err)
+			return
+		}
+		if len(searches) == 0 {
+			return
+		}
+		for _, search := range searches {
+			// One email per user per post, even when several of their
+			// searches match; authors are not alerted about their own posts.
+			if _, done := notified[search.UserID]; done || search.UserID == blog.AuthorID {
+				continue
+			}
+			if !search.Matches(blog) {
+				continue
+			}
+			notified[search.UserID] = struct{}{}
+			uc.notifyMatch(ctx, search, blog)
+		}
+	}
+}
+
+// notifyMatch emails the saved search's owner about the matching post.
+func (uc *SavedSearchUseCaseImpl) notifyMatch(ctx context.Context, search *entity.SavedSearch, blog *entity.Blog) {
+	user, err := uc.userRepo.GetUserByID(ctx, search.UserID)
+	if err != nil || user == nil || user.Email == "" {
+		return
+	}
+
+	subject := fmt.Sprintf("New post matches your saved search %q", search.Name)
+	body := fmt.Sprintf("Hi %s,\n\nA new post matches your saved search %q:\n\n%s\n%s/blogs/slug/%s\n\nThanks,\nThe Team", user.Username, search.Name, blog.Title, uc.baseURL, blog.Slug)
+	if err := uc.mailService.SendEmail(ctx, user.Email, subject, body); err != nil {
+		uc.logger.Errorf("failed to send saved search alert to %s: %v", user.Email, err)
+	}
+}
@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// trendingTagBlogsLimit is the number of trending posts shown on a tag page.
+const trendingTagBlogsLimit = 5
+
+// suggestTagsLimit is the number of tags returned by the auto-complete endpoint.
+const suggestTagsLimit = 10
+
+// Ensure TagUseCaseImpl implements ITagUseCase
+var _ usecasecontract.ITagUseCase = (*TagUseCaseImpl)(nil)
+
+type TagUseCaseImpl struct {
+	tagRepo        contract.ITagRepository
+	blogRepo       contract.IBlogRepository
+	uuidGen        contract.IUUIDGenerator
+	tagCache       contract.ITagCache       // optional suggestion cache
+	feedProjection contract.IFeedProjection // optional precomputed feed lists
+	accessGater    BlogAccessGater          // optional membership paywall on feed reads
+}
+
+func NewTagUseCase(
+	tagRepo contract.ITagRepository,
+	blogRepo contract.IBlogRepository,
+	uuidGen contract.IUUIDGenerator,
+) *TagUseCaseImpl {
+	return &TagUseCaseImpl{
+		tagRepo:  tagRepo,
+		blogRepo: blogRepo,
+		uuidGen:  uuidGen,
+	}
+}
+
+// SetTagCache wires an optional cache for tag suggestions (no-op when unset).
+func (uc *TagUseCaseImpl) SetTagCache(cache contract.ITagCache) {
+	uc.tagCache = cache
+}
+
+// SetFeedProjection wires the optional feed projection; without it the home
+// feed falls back to querying Mongo per request.
+func (uc *TagUseCaseImpl) SetFeedProjection(projection contract.IFeedProjection) {
+	uc.feedProjection = projection
+}
+
+// SetAccessGater wires the membership paywall into feed reads; without it
+// gated posts would leave the feed in full.
+func (uc *TagUseCaseImpl) SetAccessGater(gater BlogAccessGater) {
+	uc.accessGater = gater
+}
+
+// gateFeedAccess truncates gated posts to a teaser for viewers without the
+// required membership before they leave a feed read.
+func (uc *TagUseCaseImpl) gateFeedAccess(ctx context.Context, userID string, blogs []*entity.Blog) {
+	if uc.accessGater == nil {
+		return
+	}
+	var viewerID *string
+	if userID != "" {
+		viewerID = &userID
+	}
+	for _, blog := range blogs {
+		uc.accessGater.GateBlogAccess(ctx, viewerID, blog)
+	}
+}
+
+// SuggestTags returns prefix-matched tags for the editor's tag input, ordered
+// by usage count. Results are served from the cache when one is configured.
+func (uc *TagUseCaseImpl) SuggestTags(ctx context.Context, query string) ([]*entity.TagSuggestion, error) {
+	prefix := strings.ToLower(strings.TrimSpace(query))
+	if prefix == "" {
+		return []*entity.TagSuggestion{}, nil
+	}
+
+	cacheKey := "tags:suggest:" + prefix
+	if uc.tagCache != nil {
+		if cached, ok, err := uc.tagCache.GetTagSuggestions(ctx, cacheKey); err == nil && ok {
+			return cached, nil
+		}
+	}
+
+	suggestions, err := uc.tagRepo.SuggestTags(ctx, prefix, suggestTagsLimit)
+	if err != nil {
+		return nil, err
+	}
+	if suggestions == nil {
+		suggestions = []*entity.TagSuggestion{}
+	}
+
+	if uc.tagCache != nil {
+		_ = uc.tagCache.SetTagSuggestions(ctx, cacheKey, suggestions)
+	}
+
+	return suggestions, nil
+}
+
+// GetTagDetail returns a tag's metadata together with its blog count,
+// follower count and trending posts, plus the caller's follow state when
+// userID is set.
+func (uc *TagUseCaseImpl) GetTagDetail(ctx context.Context, slug string, userID *string) (*entity.TagDetail, error) {
+	tag, err := uc.tagRepo.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	// The trending query doubles as the blog count: sorting by popularity and
+	// taking the first page gives us both in one aggregation.
+	trending, blogCount, err := uc.blogRepo.GetBlogsByTagID(ctx, tag.ID, &contract.BlogFilterOptions{
+		Page:      1,
+		PageSize:  trendingTagBlogsLimit,
+		SortBy:    "popularity",
+		SortOrder: "desc",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load trending blogs for tag: %w", err)
+	}
+
+	followerCount, err := uc.tagRepo.CountTagFollowers(ctx, tag.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &entity.TagDetail{
+		Tag:           *tag,
+		BlogCount:     blogCount,
+		FollowerCount: followerCount,
+		TrendingBlogs: trending,
+	}
+
+	if userID != nil && *userID != "" {
+		isFollowing, err := uc.tagRepo.IsFollowingTag(ctx, *userID, tag.ID)
+		if err != nil {
+			return nil, err
+		}
+		detail.IsFollowing = isFollowing
+	}
+
+	return detail, nil
+}
+
+// FollowTag subscribes the user to a tag; following an already-followed tag
+// is a no-op.
+func (uc *TagUseCaseImpl) FollowTag(ctx context.Context, slug, userID string) error {
+	tag, err := uc.tagRepo.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	return uc.tagRepo.FollowTag(ctx, &entity.TagFollow{
+		ID:     uc.uuidGen.NewUUID(),
+		UserID: userID,
+		TagID:  tag.ID,
+	})
+}
+
+// UnfollowTag removes the user's follow for a tag.
+func (uc *TagUseCaseImpl) UnfollowTag(ctx context.Context, slug, userID string) error {
+	tag, err := uc.tagRepo.GetTagBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	return uc.tagRepo.UnfollowTag(ctx, userID, tag.ID)
+}
+
+// MergeTags folds the source tag into the target. Blogs carrying the source
+// tag are retagged, followers are moved over (dropping duplicates), the
+// source slug and its aliases are recorded on the target so old links keep
+// resolving, and finally the source tag is deleted. The steps are ordered so
+// a mid-merge failure leaves the source tag intact and the merge retryable.
+func (uc *TagUseCaseImpl) MergeTags(ctx context.Context, sourceTagID, targetTagID string) (*entity.Tag, error) {
+	if sourceTagID == targetTagID {
+		return nil, errors.New("cannot merge a tag into itself")
+	}
+
+	source, err := uc.tagRepo.GetTagByID(ctx, sourceTagID)
+	if err != nil {
+		return nil, fmt.Errorf("source tag: %w", err)
+	}
+	target, err := uc.tagRepo.GetTagByID(ctx, targetTagID)
+	if err != nil {
+		return nil, fmt.Errorf("target tag: %w", err)
+	}
+
+	if _, err := uc.blogRepo.ReassignTag(ctx, source.ID, target.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.tagRepo.ReassignTagFollowers(ctx, source.ID, target.ID); err != nil {
+		return nil, err
+	}
+
+	aliases := append([]string{source.Slug}, source.Aliases...)
+	if err := uc.tagRepo.AddTagAliases(ctx, target.ID, aliases); err != nil {
+		return nil, err
+	}
+
+	if err := uc.tagRepo.DeleteTag(ctx, source.ID); err != nil {
+		return nil, err
+	}
+
+	return uc.tagRepo.GetTagByID(ctx, target.ID)
+}
+
+// GetFollowedFeed returns recent blogs under the tags the user follows. A
+// user with no followed tags gets an empty feed rather than an error.
+func (uc *TagUseCaseImpl) GetFollowedFeed(ctx context.Context, userID string, page, pageSize int) ([]*entity.Blog, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	tagIDs, err := uc.tagRepo.GetFollowedTagIDs(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	blogs, total, err := uc.blogRepo.GetBlogsByTagIDs(ctx, tagIDs, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	uc.gateFeedAccess(ctx, userID, blogs)
+	return blogs, total, nil
+}
+
+func (uc *TagUseCaseImpl) GetProjectedFeed(ctx context.Context, userID string, page, pageSize int) ([]*entity.Blog, int64, error) {
+	if uc.feedProjection == nil {
+		return uc.GetFollowedFeed(ctx, userID, page, pageSize)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	tagIDs, err := uc.tagRepo.GetFollowedTagIDs(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var blogIDs []string
+	var total int64
+	if len(tagIDs) > 0 {
+		blogIDs, total, err = uc.feedProjection.BlogIDsForTags(ctx, tagIDs, offset, pageSize)
+	} else {
+		// Users who follow nothing get the site-wide latest segment.
+		blogIDs, total, err = uc.feedProjection.LatestBlogIDs(ctx, offset, pageSize)
+	}
+	if err != nil {
+		// The projection is a read optimization; fall back to the source of
+		// truth rather than failing the feed.
+		return uc.GetFollowedFeed(ctx, userID, page, pageSize)
+	}
+
+	blogs, err := uc.blogRepo.GetBlogsByIDs(ctx, blogIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	uc.gateFeedAccess(ctx, userID, blogs)
+	return blogs, total, nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// defaultTakedownReason is shown on the tombstone page when the reviewing
+// admin did not provide a public reason.
+const defaultTakedownReason = "This post was removed in response to a takedown request."
+
+// ITakedownUseCase implements the DMCA/takedown workflow: anyone can file a
+// request, admins review the queue, and accepted requests unpublish the post
+// behind a tombstone page.
+type ITakedownUseCase interface {
+	SubmitRequest(ctx context.Context, blogID, reporterName, reporterEmail, reason string) (*entity.TakedownRequest, error)
+	ListRequests(ctx context.Context, status *entity.TakedownStatus) ([]*entity.TakedownRequest, error)
+	ReviewRequest(ctx context.Context, requestID, reviewerID string, accept bool, note string) (*entity.TakedownRequest, error)
+}
+
+// TakedownUseCaseImpl implements ITakedownUseCase.
+type TakedownUseCaseImpl struct {
+	takedownRepo contract.ITakedownRepository
+	blogRepo     contract.IBlogRepository
+	userRepo     contract.IUserRepository
+	uuidgen      contract.IUUIDGenerator
+	logger       usecasecontract.IAppLogger
+	mailService  contract.IEmailService // optional, notifies the author of verdicts
+	blogCache    contract.IBlogCache    // optional, evicts the post after a takedown
+}
+
+// NewTakedownUseCase creates a new instance of TakedownUseCase.
+func NewTakedownUseCase(takedownRepo contract.ITakedownRepository, blogRepo contract.IBlogRepository, userRepo contract.IUserRepository, uuidgen contract.IUUIDGenerator, logger usecasecontract.IAppLogger) *TakedownUseCaseImpl {
+	return &TakedownUseCaseImpl{
+		takedownRepo: takedownRepo,
+		blogRepo:     blogRepo,
+		userRepo:     userRepo,
+		uuidgen:      uuidgen,
+		logger:       logger,
+	}
+}
+
+var _ ITakedownUseCase = (*TakedownUseCaseImpl)(nil)
+
+// SetEmailService enables notification emails to authors whose posts are
+// taken down.
+func (uc *TakedownUseCaseImpl) SetEmailService(mailService contract.IEmailService) {
+	uc.mailService = mailService
+}
+
+// SetBlogCache evicts taken-down posts from the cache so the tombstone
+// appears immediately.
+func (uc *TakedownUseCaseImpl) SetBlogCache(cache contract.IBlogCache) {
+	uc.blogCache = cache
+}
+
+// SubmitRequest files a takedown request against a post. The endpoint is
+// public: complainants are identified by name and email, not an account.
+func (uc *TakedownUseCaseImpl) SubmitRequest(ctx context.Context, blogID, reporterName, reporterEmail, reason string) (*entity.TakedownRequest, error) {
+	if blogID == "" {
+		return nil, errors.New("blog ID is required")
+	}
+	reporterName = strings.TrimSpace(reporterName)
+	reason = strings.TrimSpace(reason)
+	if reporterName == "" {
+		return nil, errors.New("reporter name is required")
+	}
+	if reason == "" {
+		return nil, errors.New("a reason is required")
+	}
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog == nil || blog.IsDeleted {
+		return nil, errors.New("blog not found")
+	}
+
+	request := &entity.TakedownRequest{
+		ID:            uc.uuidgen.NewUUID(),
+		BlogID:        blogID,
+		ReporterName:  reporterName,
+		ReporterEmail: strings.TrimSpace(reporterEmail),
+		Reason:        reason,
+		Status:        entity.TakedownStatusPending,
+	}
+	if err := uc.takedownRepo.CreateRequest(ctx, request); err != nil {
+		uc.logger.Errorf("failed to create takedown request for blog %s: %v", blogID, err)
+		return nil, fmt.Errorf("failed to submit takedown request: %w", err)
+	}
+	return request, nil
+}
+
+// ListRequests returns the admin review queue, optionally filtered by
+// status.
+func (uc *TakedownUseCaseImpl) ListRequests(ctx context.Context, status *entity.TakedownStatus) ([]*entity.TakedownRequest, error) {
+	return uc.takedownRepo.ListRequests(ctx, status)
+}
+
+// ReviewRequest records an admin verdict. Accepting unpublishes the post
+// behind a tombstone page and notifies the author; rejecting only closes the
+// request.
+func (uc *TakedownUseCaseImpl) ReviewRequest(ctx context.Context, requestID, reviewerID string, accept bool, note string) (*entity.TakedownRequest, error) {
+	if requestID == "" {
+		return nil, errors.New("request ID is required")
+	}
+	request, err := uc.takedownRepo.GetRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != entity.TakedownStatusPending {
+		return nil, errors.New("takedown request has already been reviewed")
+	}
+
+	request.Status = entity.TakedownStatusRejected
+	if accept {
+		request.Status = entity.TakedownStatusAccepted
+	}
+	request.ReviewedBy = reviewerID
+	request.ReviewNote = strings.TrimSpace(note)
+	now := time.Now()
+	request.ReviewedAt = &now
+	if err := uc.takedownRepo.UpdateRequest(ctx, request); err != nil {
+		uc.logger.Errorf("failed to update takedown request %s: %v", requestID, err)
+		return nil, fmt.Errorf("failed to update takedown request: %w", err)
+	}
+
+	if accept {
+		uc.takeDownBlog(ctx, request)
+	}
+	return request, nil
+}
+
+// takeDownBlog unpublishes the post behind a tombstone page, evicts it from
+// the cache and notifies the author. Failures after the status update are
+// logged but do not undo the verdict.
+func (uc *TakedownUseCaseImpl) takeDownBlog(ctx context.Context, request *entity.TakedownRequest) {
+	reason := request.ReviewNote
+	if reason == "" {
+		reason = defaultTakedownReason
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, request.BlogID)
+	if err != nil || blog == nil {
+		uc.logger.Errorf("failed to load blog %s for takedown: %v", request.BlogID, err)
+		return
+	}
+
+	updates := map[string]interface{}{
+		"status":          entity.BlogStatusTakenDown,
+		"takedown_reason": reason,
+		"updated_at":      time.Now(),
+	}
+	if err := uc.blogRepo.UpdateBlog(ctx, request.BlogID, updates); err != nil {
+		uc.logger.Errorf("failed to take down blog %s: %v", request.BlogID, err)
+		return
+	}
+
+	if uc.blogCache != nil {
+		_ = uc.blogCache.InvalidateBlogBySlug(ctx, blog.Slug)
+		_ = uc.blogCache.InvalidateBlogLists(ctx)
+	}
+
+	uc.notifyAuthor(ctx, blog, reason)
+}
+
+// notifyAuthor emails the post's author about the takedown.
+func (uc *TakedownUseCaseImpl) notifyAuthor(ctx context.Context, blog *entity.Blog, reason string) {
+	if uc.mailService == nil {
+		return
+	}
+	author, err := uc.userRepo.GetUserByID(ctx, blog.AuthorID)
+	if err != nil || author.Email == "" {
+		return
+	}
+	subject := fmt.Sprintf("Your post %q has been removed", blog.Title)
+	body := fmt.Sprintf(
+		"Hello %s,\n\nYour post %q was unpublished following a takedown request.\n\nReason: %s\n\nIf you believe this was a mistake, please contact support.\n",
+		author.Username, blog.Title, reason,
+	)
+	if err := uc.mailService.SendEmail(ctx, author.Email, subject, body); err != nil {
+		uc.logger.Warningf("failed to send takedown notification for blog %s: %v", blog.ID, err)
+	}
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+// minTipAmountCents is the smallest tip the checkout accepts; payment
+// providers reject charges below their processing floor.
+const minTipAmountCents = 100
+
+// recentTipsLimit caps how many tips the earnings dashboard lists.
+const recentTipsLimit = 20
+
+// TipUseCaseImpl lets readers tip authors on a post through a pluggable
+// payment provider. Tips are recorded pending and confirmed by the
+// provider's webhook.
+type TipUseCaseImpl struct {
+	tipRepo       contract.ITipRepository
+	blogRepo      contract.IBlogRepository
+	provider      contract.IPaymentProvider
+	uuidGenerator contract.IUUIDGenerator
+	logger        usecasecontract.IAppLogger
+	baseURL       string
+}
+
+var _ usecasecontract.ITipUseCase = (*TipUseCaseImpl)(nil)
+
+func NewTipUseCase(tipRepo contract.ITipRepository, blogRepo contract.IBlogRepository, provider contract.IPaymentProvider, uuidGenerator contract.IUUIDGenerator, logger usecasecontract.IAppLogger, baseURL string) *TipUseCaseImpl {
+	return &TipUseCaseImpl{
+		tipRepo:       tipRepo,
+		blogRepo:      blogRepo,
+		provider:      provider,
+		uuidGenerator: uuidGenerator,
+		logger:        logger,
+		baseURL:       baseURL,
+	}
+}
+
+// CreateTip opens a checkout session for a tip on the blog and returns the
+// URL the reader is redirected to.
+func (uc *TipUseCaseImpl) CreateTip(ctx context.Context, blogID, supporterID string, amountCents int64, currency string) (string, error) {
+	if amountCents < minTipAmountCents {
+		return "", fmt.Errorf("tip amount must be at least %d cents", minTipAmountCents)
+	}
+	currency = strings.ToLower(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = "usd"
+	}
+
+	blog, err := uc.blogRepo.GetBlogByID(ctx, blogID)
+	if err != nil || blog == nil {
+		return "", fmt.Errorf("blog not found")
+	}
+	if blog.AuthorID == supporterID {
+		return "", fmt.Errorf("cannot tip your own blog")
+	}
+
+	tip := &entity.Tip{
+		ID:          uc.uuidGenerator.NewUUID(),
+		BlogID:      blog.ID,
+		AuthorID:    blog.AuthorID,
+		SupporterID: supporterID,
+		AmountCents: amountCents,
+		Currency:    currency,
+		Status:      entity.TipStatusPending,
+		CreatedAt:   time.Now(),
+	}
+
+	blogURL := fmt.Sprintf("%s/blogs/slug/%s", uc.baseURL, blog.Slug)
+	session, err := uc.provider.CreateCheckoutSession(ctx, tip.ID, amountCents, currency, blogURL+"?tip=success", blogURL+"?tip=cancelled")
+	if err != nil {
+		uc.logger.Errorf("failed to create checkout session for blog %s: %v", blogID, err)
+		return "", fmt.Errorf("failed to create checkout session")
+	}
+	tip.SessionID = session.ID
+
+	if err := uc.tipRepo.CreateTip(ctx, tip); err != nil {
+		uc.logger.Errorf("failed to record tip %s: %v", tip.ID, err)
+		return "", fmt.Errorf("failed to create tip")
+	}
+
+	return session.URL, nil
+}
+
+// HandleWebhook processes a provider webhook delivery and marks the tip
+// completed when the checkout succeeded. Events the provider does not
+// recognize as completed checkouts are acknowledged without effect.
+func (uc *TipUseCaseImpl) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	tipID, ok, err := uc.provider.ParseCompletedTip(payload, signature)
+	if err != nil {
+		return fmt.Errorf("invalid webhook: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	if err := uc.tipRepo.MarkTipCompleted(ctx, tipID); err != nil {
+		uc.logger.Errorf("failed to mark tip %s completed: %v", tipID, err)
+		return err
+	}
+	uc.logger.Infof("tip %s completed", tipID)
+	return nil
+}
+
+// GetEarnings returns the author's completed-tip totals and most recent tips.
+func (uc *TipUseCaseImpl) GetEarnings(ctx context.Context, authorID string) (*entity.AuthorEarnings, []*entity.Tip, error) {
+	earnings, err := uc.tipRepo.GetEarningsByAuthorID(ctx, authorID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get earnings: %w", err)
+	}
+	tips, err := uc.tipRepo.ListTipsByAuthorID(ctx, authorID, recentTipsLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list tips: %w", err)
+	}
+	return earnings, tips, nil
+}
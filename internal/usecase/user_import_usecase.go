@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
+	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
+	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
+)
+
+const (
+	// importBatchSize caps how many users are inserted per repository call.
+	importBatchSize = 100
+	// maxImportRows caps a single import request; larger migrations should
+	// be split into several files.
+	maxImportRows = 5000
+)
+
+// ImportUserInput is one row of a bulk user import. PasswordHash is the
+// pre-hashed password from the source platform; when empty the account gets
+// an unguessable random password and the user signs in via password reset.
+type ImportUserInput struct {
+	Email        string
+	Username     string
+	Role         string
+	PasswordHash string
+}
+
+// ImportRowError reports why one row of an import was skipped.
+type ImportRowError struct {
+	Row    int    `json:"row"`
+	Email  string `json:"email,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// ImportReport summarizes a bulk user import.
+type ImportReport struct {
+	Total    int              `json:"total"`
+	Imported int              `json:"imported"`
+	Skipped  int              `json:"skipped"`
+	Errors   []ImportRowError `json:"errors,omitempty"`
+}
+
+// IUserImportUseCase imports user accounts in bulk when migrating from
+// another platform.
+type IUserImportUseCase interface {
+	ImportUsers(ctx context.Context, rows []ImportUserInput) (*ImportReport, error)
+}
+
+// UserImportUseCaseImpl implements IUserImportUseCase.
+type UserImportUseCaseImpl struct {
+	userRepo        contract.IUserRepository
+	hasher          contract.IHasher
+	validator       usecasecontract.IValidator
+	uuidGenerator   contract.IUUIDGenerator
+	randomGenerator contract.IRandomGenerator
+	logger          usecasecontract.IAppLogger
+}
+
+var _ IUserImportUseCase = (*UserImportUseCaseImpl)(nil)
+
+// NewUserImportUseCase creates a new UserImportUseCaseImpl.
+func NewUserImportUseCase(
+	userRepo contract.IUserRepository,
+	hasher contract.IHasher,
+	validator usecasecontract.IValidator,
+	uuidGenerator contract.IUUIDGenerator,
+	randomGenerator contract.IRandomGenerator,
+	logger usecasecontract.IAppLogger,
+) *UserImportUseCaseImpl {
+	return &UserImportUseCaseImpl{
+		userRepo:        userRepo,
+		hasher:          hasher,
+		validator:       validator,
+		uuidGenerator:   uuidGenerator,
+		randomGenerator: randomGenerator,
+		logger:          logger,
+	}
+}
+
+// ImportUsers validates each row, skips duplicates (within the file and
+// against existing accounts) and inserts the rest in batches. It always
+// returns a report; rows that could not be imported are listed with the
+// reason instead of failing the whole import.
+func (uc *UserImportUseCaseImpl) ImportUsers(ctx context.Context, rows []ImportUserInput) (*ImportReport, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no users to import")
+	}
+	if len(rows) > maxImportRows {
+		return nil, fmt.Errorf("too many rows: at most %d users can be imported per request", maxImportRows)
+	}
+
+	report := &ImportReport{Total: len(rows)}
+	seenEmails := make(map[string]struct{}, len(rows))
+	seenUsernames := make(map[string]struct{}, len(rows))
+
+	var batch []*entity.User
+	var batchRows []int
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := uc.userRepo.CreateUsers(ctx, batch); err != nil {
+			uc.logger.Errorf("failed to insert imported users: %v", err)
+			for i, user := range batch {
+				report.Skipped++
+				report.Errors = append(report.Errors, ImportRowError{
+					Row: batchRows[i], Email: user.Email, Reason: "database insert failed",
+				})
+			}
+		} else {
+			report.Imported += len(batch)
+		}
+		batch = nil
+		batchRows = nil
+	}
+
+	for i, row := range rows {
+		rowNum := i + 1
+		email := strings.ToLower(strings.TrimSpace(row.Email))
+		skip := func(reason string) {
+			report.Skipped++
+			report.Errors = append(report.Errors, ImportRowError{Row: rowNum, Email: email, Reason: reason})
+		}
+
+		if err := uc.validator.ValidateEmail(email); err != nil {
+			skip("invalid email")
+			continue
+		}
+
+		// Default the username to the email's local part so exports without
+		// usernames still import.
+		username := strings.TrimSpace(row.Username)
+		if username == "" {
+			username = email[:strings.Index(email, "@")]
+		}
+
+		role := entity.UserRole(strings.ToLower(strings.TrimSpace(row.Role)))
+		if role == "" {
+			role = entity.DefaultRole()
+		}
+		if role != entity.UserRoleUser && role != entity.UserRoleAdmin {
+			skip(fmt.Sprintf("unknown role %q", row.Role))
+			continue
+		}
+
+		// Duplicates within the file.
+		if _, ok := seenEmails[email]; ok {
+			skip("duplicate email in import")
+			continue
+		}
+		if _, ok := seenUsernames[username]; ok {
+			skip("duplicate username in import")
+			continue
+		}
+
+		// Duplicates against existing accounts.
+		if existing, _ := uc.userRepo.GetUserByEmail(ctx, email); existing != nil {
+			skip("email already registered")
+			continue
+		}
+		if existing, _ := uc.userRepo.GetUserByUsername(ctx, username); existing != nil {
+			skip("username already taken")
+			continue
+		}
+
+		passwordHash := strings.TrimSpace(row.PasswordHash)
+		if passwordHash == "" {
+			// No hash from the source platform: give the account a random
+			// password so it can only be claimed via password reset.
+			random, err := uc.randomGenerator.GenerateRandomToken(32)
+			if err != nil {
+				skip("failed to generate placeholder password")
+				continue
+			}
+			passwordHash, err = uc.hasher.HashPassword(random)
+			if err != nil {
+				skip("failed to generate placeholder password")
+				continue
+			}
+		}
+
+		seenEmails[email] = struct{}{}
+		seenUsernames[username] = struct{}{}
+		now := time.Now()
+		batch = append(batch, &entity.User{
+			ID:           uc.uuidGenerator.NewUUID(),
+			Username:     username,
+			Email:        email,
+			PasswordHash: passwordHash,
+			Role:         role,
+			IsActive:     true,
+			IsVerified:   true,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
+		batchRows = append(batchRows, rowNum)
+		if len(batch) >= importBatchSize {
+			flush()
+		}
+	}
+	flush()
+
+	return report, nil
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/mikiasgoitom/Articulate/internal/domain/contract"
 	"github.com/mikiasgoitom/Articulate/internal/domain/entity"
 	usecasecontract "github.com/mikiasgoitom/Articulate/internal/usecase/contract"
-	"golang.org/x/crypto/bcrypt"
 )
 
 // Constants for common error messages
@@ -17,6 +18,13 @@ const (
 	errUserNotFound   = "user not found"
 	errTokenNotFound  = "token not found"
 	errInternalServer = "internal server error"
+	// errDuplicateUser is returned by the repository when an insert loses the
+	// race against a concurrent registration with the same email or username.
+	errDuplicateUser = "user with that email or username already exists"
+
+	// deletedUserPlaceholder replaces the author name on a deleted user's
+	// comments.
+	deletedUserPlaceholder = "[deleted]"
 )
 
 // UserUsecase implements the UserUseCase interface.
@@ -32,6 +40,25 @@ type UserUsecase struct {
 	validator       usecasecontract.IValidator
 	uuidGenerator   contract.IUUIDGenerator
 	randomGenerator contract.IRandomGenerator
+
+	// securityEventRepo and geoResolver are optional; when unset no security
+	// events are recorded. Injected via SetSecurityEventTracking.
+	securityEventRepo contract.ISecurityEventRepository
+	geoResolver       contract.IGeoIPResolver
+
+	// emailBlocklist is optional; when set, registrations from disposable
+	// email domains are rejected. Injected via SetEmailBlocklist.
+	emailBlocklist contract.IEmailBlocklist
+
+	// outboxRepo is optional; when set, referral signups enqueue an outbox
+	// event for reward fulfillment. Injected via SetOutbox.
+	outboxRepo contract.IOutboxRepository
+
+	// blogRepo and commentRepo are optional; when set, deactivating or
+	// deleting a user cascades to their content's visibility. Injected via
+	// SetContentCascade.
+	blogRepo    contract.IBlogRepository
+	commentRepo contract.ICommentRepository
 }
 
 // NewUserUsecase creates a new UserUsecase instance.
@@ -66,12 +93,159 @@ func NewUserUsecase(
 // check if UserUseCase implements the IUserUseCase
 var _ usecasecontract.IUserUseCase = (*UserUsecase)(nil)
 
+// SetSecurityEventTracking enables IP/user-agent fingerprint recording on
+// login and registration. The geoResolver may be nil, in which case events
+// are stored without geographic information and no new-country alerts fire.
+func (uc *UserUsecase) SetSecurityEventTracking(eventRepo contract.ISecurityEventRepository, geoResolver contract.IGeoIPResolver) {
+	uc.securityEventRepo = eventRepo
+	uc.geoResolver = geoResolver
+}
+
+// SetEmailBlocklist enables disposable email domain blocking on registration.
+func (uc *UserUsecase) SetEmailBlocklist(blocklist contract.IEmailBlocklist) {
+	uc.emailBlocklist = blocklist
+}
+
+// SetOutbox enables referral reward events: attributed signups enqueue an
+// outbox event the relay delivers to the fulfillment consumer.
+func (uc *UserUsecase) SetOutbox(outbox contract.IOutboxRepository) {
+	uc.outboxRepo = outbox
+}
+
+// SetContentCascade enables the user visibility cascade: deactivating a user
+// hides their blogs and comments from listings, and deleting a user
+// anonymizes their comments.
+func (uc *UserUsecase) SetContentCascade(blogRepo contract.IBlogRepository, commentRepo contract.ICommentRepository) {
+	uc.blogRepo = blogRepo
+	uc.commentRepo = commentRepo
+}
+
+// recordSecurityEvent stores a security event for the user and, for sign-ins
+// from a country the user has never signed in from before, emails an alert.
+// Failures are logged but never surfaced to the caller; auditing must not
+// block authentication.
+func (uc *UserUsecase) recordSecurityEvent(ctx context.Context, user *entity.User, eventType entity.SecurityEventType, ipAddress, userAgent string) {
+	if uc.securityEventRepo == nil {
+		return
+	}
+
+	var country, region string
+	if uc.geoResolver != nil && ipAddress != "" {
+		loc, err := uc.geoResolver.ResolveIP(ctx, ipAddress)
+		if err != nil {
+			uc.logger.Warnf("failed to resolve GeoIP for %s: %v", ipAddress, err)
+		} else if loc != nil {
+			country = loc.Country
+			region = loc.Region
+		}
+	}
+
+	// Alert on sign-ins from a country not seen before for this account.
+	if eventType != entity.SecurityEventRegister && country != "" {
+		known, err := uc.securityEventRepo.GetKnownCountriesForUser(ctx, user.ID)
+		if err != nil {
+			uc.logger.Warnf("failed to retrieve known countries for user %s: %v", user.ID, err)
+		} else if len(known) > 0 && !containsCountry(known, country) {
+			uc.notifySecurityEvent(ctx, user, "New sign-in to your account",
+				fmt.Sprintf("We noticed a sign-in to your account from a new location: %s, %s (IP: %s).\n\nIf this was you, you can safely ignore this email.", region, country, ipAddress))
+		}
+	}
+
+	event := &entity.SecurityEvent{
+		ID:        uc.uuidGenerator.NewUUID(),
+		UserID:    user.ID,
+		EventType: eventType,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Country:   country,
+		Region:    region,
+		CreatedAt: time.Now(),
+	}
+	if err := uc.securityEventRepo.CreateEvent(ctx, event); err != nil {
+		uc.logger.Errorf("failed to record security event for user %s: %v", user.ID, err)
+	}
+}
+
+// securityEmailTemplate is the shared wrapper for security notification emails.
+const securityEmailTemplate = "Hi %s,\n\n%s\n\nIf this wasn't you, please reset your password immediately.\n\nThanks,\nThe Team"
+
+// notifySecurityEvent emails the user about a security-relevant account change
+// unless they opted out of security notifications. Failures are logged only;
+// notifications must never block the triggering operation.
+func (uc *UserUsecase) notifySecurityEvent(ctx context.Context, user *entity.User, subject, detail string) {
+	if user.SecurityEmailsOptOut {
+		return
+	}
+	body := fmt.Sprintf(securityEmailTemplate, user.Username, detail)
+	if err := uc.mailService.SendEmail(ctx, user.Email, subject, body); err != nil {
+		uc.logger.Errorf("failed to send security notification to %s: %v", user.Email, err)
+	}
+}
+
+// referralCodeBytes is the entropy of a referral code; base64url encoding
+// yields an 8-character link-safe code.
+const referralCodeBytes = 6
+
+// newReferralCode generates a shareable referral code. Failures are logged
+// only; the code is backfilled on the next referrals lookup.
+func (uc *UserUsecase) newReferralCode() string {
+	code, err := uc.randomGenerator.GenerateRandomToken(referralCodeBytes)
+	if err != nil {
+		uc.logger.Errorf("failed to generate referral code: %v", err)
+		return ""
+	}
+	return code
+}
+
+// emitReferralEvent enqueues a referral outbox event for reward fulfillment.
+// Failures are logged only; rewards must never block registration.
+func (uc *UserUsecase) emitReferralEvent(ctx context.Context, eventType string, user *entity.User) {
+	if uc.outboxRepo == nil {
+		return
+	}
+	now := time.Now()
+	event := &entity.OutboxEvent{
+		ID:        uc.uuidGenerator.NewUUID(),
+		EventType: eventType,
+		Payload: map[string]string{
+			"referrer_id":      user.ReferredBy,
+			"referred_user_id": user.ID,
+		},
+		Status:        entity.OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+	if err := uc.outboxRepo.EnqueueOutboxEvent(ctx, event); err != nil {
+		uc.logger.Errorf("failed to enqueue %s event: %v", eventType, err)
+	}
+}
+
+func containsCountry(countries []string, country string) bool {
+	for _, c := range countries {
+		if c == country {
+			return true
+		}
+	}
+	return false
+}
+
 // Register handles user registration.
-func (uc *UserUsecase) Register(ctx context.Context, username, email, password, firstName, lastName string) (*entity.User, error) {
+func (uc *UserUsecase) Register(ctx context.Context, username, email, password, firstName, lastName, referralCode, ipAddress, userAgent string) (*entity.User, error) {
+	// Normalize so existence checks and the unique indexes are effectively
+	// case-insensitive: Foo@Bar.com and foo@bar.com are the same account.
+	email = strings.ToLower(strings.TrimSpace(email))
+	username = strings.ToLower(strings.TrimSpace(username))
+
 	// Validate input fields using the injected validator
 	if err := uc.validator.ValidateEmail(email); err != nil {
 		return nil, fmt.Errorf("invalid email format: %w", err)
 	}
+	if err := uc.validator.ValidateUsername(username); err != nil {
+		return nil, fmt.Errorf("invalid username: %w", err)
+	}
+	if uc.emailBlocklist != nil && uc.emailBlocklist.IsBlocked(ctx, email) {
+		return nil, errors.New("disposable email addresses are not allowed")
+	}
 	if err := uc.validator.ValidatePasswordStrength(password); err != nil {
 		return nil, fmt.Errorf("weak password: %w", err)
 	}
@@ -125,14 +299,34 @@ func (uc *UserUsecase) Register(ctx context.Context, username, email, password,
 		UpdatedAt:    time.Now(),
 		FirstName:    pFirstName,
 		LastName:     pLastName,
+		ReferralCode: uc.newReferralCode(),
+	}
+
+	// Attribute the signup to the referrer whose code it arrived with. An
+	// unknown or stale code must not block registration, so it is ignored.
+	if referralCode != "" {
+		if referrer, err := uc.userRepo.GetUserByReferralCode(ctx, referralCode); err == nil {
+			user.ReferredBy = referrer.ID
+		}
 	}
 
-	// Save user to database
+	// Save user to database. The existence checks above are advisory; the
+	// unique indexes are what actually decide a race between two concurrent
+	// registrations, so a duplicate here is a conflict, not a server error.
 	if err := uc.userRepo.CreateUser(ctx, user); err != nil {
+		if err.Error() == errDuplicateUser {
+			return nil, errors.New(errDuplicateUser)
+		}
 		uc.logger.Errorf("failed to create user: %v", err)
 		return nil, fmt.Errorf("failed to register user")
 	}
 
+	uc.recordSecurityEvent(ctx, user, entity.SecurityEventRegister, ipAddress, userAgent)
+
+	if user.ReferredBy != "" {
+		uc.emitReferralEvent(ctx, entity.OutboxEventReferralSignup, user)
+	}
+
 	// Send activation email if required, using config from injected ConfigProvider
 	if uc.config.GetSendActivationEmail() {
 		// Generate email verification token
@@ -144,8 +338,40 @@ func (uc *UserUsecase) Register(ctx context.Context, username, email, password,
 	return user, nil
 }
 
+// GetMyReferrals returns the user's referral code and the accounts that
+// signed up with it. Users created before the referral program get their
+// code backfilled on first lookup.
+func (uc *UserUsecase) GetMyReferrals(ctx context.Context, userID string) (string, []*entity.User, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", nil, errors.New(errUserNotFound)
+	}
+
+	if user.ReferralCode == "" {
+		user.ReferralCode = uc.newReferralCode()
+		if user.ReferralCode == "" {
+			return "", nil, errors.New(errInternalServer)
+		}
+		if _, err := uc.userRepo.UpdateUser(ctx, user); err != nil {
+			uc.logger.Errorf("failed to backfill referral code: %v", err)
+			return "", nil, errors.New(errInternalServer)
+		}
+	}
+
+	referred, err := uc.userRepo.ListUsersByReferrer(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf("failed to list referred users: %v", err)
+		return "", nil, errors.New(errInternalServer)
+	}
+	return user.ReferralCode, referred, nil
+}
+
 // Login handles user login and token generation.
-func (uc *UserUsecase) Login(ctx context.Context, email, password string) (*entity.User, string, string, error) {
+func (uc *UserUsecase) Login(ctx context.Context, email, password, ipAddress, userAgent string, clientType entity.ClientType, rememberMe bool) (*entity.User, string, string, error) {
+	// Identifiers are stored lowercase (registration normalizes them), so
+	// normalize here too and login is case-insensitive for both fields.
+	email = strings.ToLower(strings.TrimSpace(email))
+
 	// Retrieve user by username or email
 	var user *entity.User
 	var err error
@@ -174,40 +400,58 @@ func (uc *UserUsecase) Login(ctx context.Context, email, password string) (*enti
 		return nil, "", "", errors.New("invalid credentials")
 	}
 
-	// Generate access and refresh tokens
-	accessToken, err := uc.jwtService.GenerateAccessToken(user.ID, user.Role)
+	// Transparently upgrade the stored hash when the configured algorithm or
+	// cost has changed; a failure here must not block the login.
+	if uc.hasher.NeedsRehash(user.PasswordHash) {
+		if newHash, err := uc.hasher.HashPassword(password); err != nil {
+			uc.logger.Warnf("failed to rehash password for user %s: %v", user.ID, err)
+		} else if err := uc.userRepo.UpdateUserPassword(ctx, user.ID, newHash); err != nil {
+			uc.logger.Warnf("failed to store rehashed password for user %s: %v", user.ID, err)
+		}
+	}
+
+	// Generate access and refresh tokens with the lifetimes configured for
+	// the client type; remember-me logins get the extended refresh lifetime
+	accessToken, err := uc.jwtService.GenerateAccessToken(user.ID, user.Role, clientType)
 	if err != nil {
 		uc.logger.Errorf("failed to generate access token: %v", err)
 		return nil, "", "", errors.New("failed to generate token")
 	}
 
-	refreshToken, err := uc.jwtService.GenerateRefreshToken(user.ID, user.Role)
+	refreshToken, err := uc.jwtService.GenerateRefreshToken(user.ID, user.Role, clientType, rememberMe)
 	if err != nil {
 		uc.logger.Errorf("failed to generate refresh token: %v", err)
 		return nil, "", "", errors.New("failed to generate token")
 	}
 
-	refreshTokenExpiry := uc.config.GetRefreshTokenExpiry()
+	refreshTokenExpiry := uc.config.GetRefreshTokenExpiryFor(clientType, rememberMe)
 	if refreshTokenExpiry <= 0 {
 		uc.logger.Errorf("invalid refresh token expiry configuration: %v", refreshTokenExpiry)
 		return nil, "", "", errors.New("invalid refresh token expiry configuration")
 	}
 
-	// Create token entity with all fields from the schema
+	// Create token entity with all fields from the schema, including session
+	// metadata so the account's active sessions can be listed per device
 	tokenEntity := &entity.Token{
-		ID:        uc.uuidGenerator.NewUUID(),
-		UserID:    user.ID,
-		TokenType: entity.TokenTypeRefresh,
-		TokenHash: uc.hasher.HashString(refreshToken),
-		ExpiresAt: time.Now().Add(refreshTokenExpiry),
-		CreatedAt: time.Now(),
-		Revoke:    false,
+		ID:         uc.uuidGenerator.NewUUID(),
+		UserID:     user.ID,
+		TokenType:  entity.TokenTypeRefresh,
+		TokenHash:  uc.hasher.HashString(refreshToken),
+		ExpiresAt:  time.Now().Add(refreshTokenExpiry),
+		CreatedAt:  time.Now(),
+		Revoke:     false,
+		DeviceName: deviceNameFromUserAgent(userAgent),
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		LastUsedAt: time.Now(),
 	}
 	if err := uc.tokenRepo.CreateToken(ctx, tokenEntity); err != nil {
 		uc.logger.Errorf("failed to store refresh token for user %s: %v", user.ID, err)
 		return nil, "", "", errors.New("failed to store token")
 	}
 
+	uc.recordSecurityEvent(ctx, user, entity.SecurityEventLogin, ipAddress, userAgent)
+
 	return user, accessToken, refreshToken, nil
 }
 
@@ -245,9 +489,10 @@ func (uc *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (s
 	// The UserID from claims is already a string, so we can use it directly.
 	userID := claims.UserID
 
-	// Retrieve the stored token using the parsed UUID.
+	// Retrieve the stored refresh token; filtering by type keeps verification
+	// and reset tokens the user also holds out of the lookup.
 	uc.logger.Infof("Debug: Looking up stored token for user: %s", userID)
-	storedToken, err := uc.tokenRepo.GetTokenByUserID(ctx, userID)
+	storedToken, err := uc.tokenRepo.GetActiveTokenByUserIDAndType(ctx, userID, entity.TokenTypeRefresh)
 	if err != nil {
 		uc.logger.Errorf("Debug: Failed to retrieve stored token: %v", err)
 		if err.Error() == "token not found" {
@@ -279,15 +524,15 @@ func (uc *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (s
 		return "", "", errors.New("refresh token expired, please log in again")
 	}
 
-	// Generate new access token
-	newAccessToken, err := uc.jwtService.GenerateAccessToken(claims.UserID, claims.Role)
+	// Generate new access token, preserving the session's client type
+	newAccessToken, err := uc.jwtService.GenerateAccessToken(claims.UserID, claims.Role, claims.ClientType)
 	if err != nil {
 		uc.logger.Errorf("failed to generate new access token during refresh: %v", err)
 		return "", "", errors.New("failed to generate new access token")
 	}
 
-	// Generate a new refresh token
-	newRefreshToken, err := uc.jwtService.GenerateRefreshToken(claims.UserID, claims.Role)
+	// Generate a new refresh token, carrying the remember-me flag forward
+	newRefreshToken, err := uc.jwtService.GenerateRefreshToken(claims.UserID, claims.Role, claims.ClientType, claims.RememberMe)
 	if err != nil {
 		uc.logger.Errorf("failed to generate new refresh token during refresh: %v", err)
 		return "", "", errors.New("failed to generate new refresh token")
@@ -297,7 +542,7 @@ func (uc *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (s
 	newHashedRefreshToken := uc.hasher.HashString(newRefreshToken)
 
 	// Update the stored refresh token with the new hash and expiry.
-	err = uc.tokenRepo.UpdateToken(ctx, storedToken.ID, newHashedRefreshToken, time.Now().Add(uc.config.GetRefreshTokenExpiry()))
+	err = uc.tokenRepo.UpdateToken(ctx, storedToken.ID, newHashedRefreshToken, time.Now().Add(uc.config.GetRefreshTokenExpiryFor(claims.ClientType, claims.RememberMe)))
 	if err != nil {
 		uc.logger.Errorf("failed to update refresh token in db: %v", err)
 		return "", "", errors.New("failed to update token")
@@ -309,6 +554,7 @@ func (uc *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (s
 
 // ForgotPassword handles the forgot password flow.
 func (uc *UserUsecase) ForgotPassword(ctx context.Context, email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
 	user, err := uc.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
 		return fmt.Errorf("email not found: %w", err)
@@ -321,7 +567,7 @@ func (uc *UserUsecase) ForgotPassword(ctx context.Context, email string) error {
 	}
 
 	// Hash the token before storing it to match the schema
-	hashedResetToken, err := bcrypt.GenerateFromPassword([]byte(resetToken), 7)
+	hashedResetToken, err := uc.hasher.HashPassword(resetToken)
 	if err != nil {
 		return fmt.Errorf("failed to hash reset token: %w", err)
 	}
@@ -331,12 +577,19 @@ func (uc *UserUsecase) ForgotPassword(ctx context.Context, email string) error {
 		return fmt.Errorf("failed to generate verifier: %w", err)
 	}
 
-	// Store the token
+	// Revoke any outstanding reset tokens so only the newest link works
+	if err := uc.tokenRepo.RevokeAllTokensForUser(ctx, user.ID, entity.TokenTypePasswordReset); err != nil {
+		uc.logger.Errorf("failed to revoke previous reset tokens for user %s: %v", user.ID, err)
+		return errors.New("failed to initiate password reset")
+	}
+
+	// Store the token; the verifier is what ResetPassword looks the token up by
 	tokenEntity := &entity.Token{
 		ID:        uc.uuidGenerator.NewUUID(),
 		UserID:    user.ID,
 		TokenType: entity.TokenTypePasswordReset,
 		TokenHash: string(hashedResetToken),
+		Verifier:  verifier,
 		ExpiresAt: time.Now().Add(uc.config.GetPasswordResetTokenExpiry()),
 		CreatedAt: time.Now(),
 		Revoke:    false,
@@ -377,11 +630,14 @@ func (uc *UserUsecase) ResetPassword(ctx context.Context, verifier, resetToken,
 	}
 
 	// check if the token hash and plain rest token matchs
-	if err = bcrypt.CompareHashAndPassword([]byte(token.TokenHash), []byte(resetToken)); err != nil {
-		if err == bcrypt.ErrMismatchedHashAndPassword {
-			return fmt.Errorf("token doesnt match: %w", err)
-		}
-		return fmt.Errorf("failded to match the the hashed and plain token: %w", err)
+	if err = uc.hasher.ComparePasswordHash(resetToken, token.TokenHash); err != nil {
+		return fmt.Errorf("token doesnt match: %w", err)
+	}
+
+	// Consume the token before touching the password so concurrent resets with
+	// the same link cannot both succeed (single use, enforced atomically).
+	if err = uc.tokenRepo.ConsumeToken(ctx, token.ID); err != nil {
+		return fmt.Errorf("invalid token. It is revoked")
 	}
 
 	// Hash the new password before updating the user.
@@ -395,9 +651,10 @@ func (uc *UserUsecase) ResetPassword(ctx context.Context, verifier, resetToken,
 		return fmt.Errorf("failed to update password for user %s: %v", token.UserID, err)
 	}
 
-	// revoke the password reset token
-	if err = uc.tokenRepo.RevokeToken(ctx, token.ID); err != nil {
-		return fmt.Errorf("failed to revoke reset password")
+	// Confirm the change to the account owner.
+	if user, err := uc.userRepo.GetUserByID(ctx, token.UserID); err == nil {
+		uc.notifySecurityEvent(ctx, user, "Your password was changed",
+			"The password for your account was just changed.")
 	}
 
 	// Return success, confirming the change.
@@ -413,8 +670,8 @@ func (uc *UserUsecase) Logout(ctx context.Context, refreshToken string) error {
 		return nil
 	}
 
-	// Retrieve the stored token by UserID to get its database ID.
-	storedToken, err := uc.tokenRepo.GetTokenByUserID(ctx, claims.UserID)
+	// Retrieve the stored refresh token by UserID to get its database ID.
+	storedToken, err := uc.tokenRepo.GetActiveTokenByUserIDAndType(ctx, claims.UserID, entity.TokenTypeRefresh)
 	if err != nil {
 		if err.Error() == errTokenNotFound {
 			uc.logger.Warnf("refresh token for user %s not found during logout, assuming it's already deleted", claims.UserID)
@@ -433,6 +690,57 @@ func (uc *UserUsecase) Logout(ctx context.Context, refreshToken string) error {
 	return nil
 }
 
+// GetActiveSessions lists the user's active refresh-token sessions with their
+// device metadata, newest first.
+func (uc *UserUsecase) GetActiveSessions(ctx context.Context, userID string) ([]*entity.Token, error) {
+	sessions, err := uc.tokenRepo.ListActiveTokensByUserIDAndType(ctx, userID, entity.TokenTypeRefresh)
+	if err != nil {
+		uc.logger.Errorf("failed to list active sessions for user %s: %v", userID, err)
+		return nil, errors.New(errInternalServer)
+	}
+	return sessions, nil
+}
+
+// deviceNameFromUserAgent derives a human-readable device label such as
+// "Chrome on Windows" from a raw User-Agent header.
+func deviceNameFromUserAgent(userAgent string) string {
+	if userAgent == "" {
+		return "Unknown device"
+	}
+
+	ua := strings.ToLower(userAgent)
+
+	browser := "Unknown browser"
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "opr/") || strings.Contains(ua, "opera"):
+		browser = "Opera"
+	case strings.Contains(ua, "chrome"):
+		browser = "Chrome"
+	case strings.Contains(ua, "firefox"):
+		browser = "Firefox"
+	case strings.Contains(ua, "safari"):
+		browser = "Safari"
+	}
+
+	platform := "unknown device"
+	switch {
+	case strings.Contains(ua, "windows"):
+		platform = "Windows"
+	case strings.Contains(ua, "android"):
+		platform = "Android"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		platform = "iOS"
+	case strings.Contains(ua, "mac os"), strings.Contains(ua, "macintosh"):
+		platform = "macOS"
+	case strings.Contains(ua, "linux"):
+		platform = "Linux"
+	}
+
+	return browser + " on " + platform
+}
+
 // PromoteUser promotes a user to an Admin role.
 func (uc *UserUsecase) PromoteUser(ctx context.Context, userID string) (*entity.User, error) {
 	user, err := uc.userRepo.GetUserByID(ctx, userID)
@@ -485,6 +793,238 @@ func (uc *UserUsecase) DemoteUser(ctx context.Context, userID string) (*entity.U
 	return user, nil
 }
 
+// UpdateUserRole sets a user's role (admin only). The acting admin cannot
+// demote themselves, and the target's refresh tokens are revoked so the old
+// role cannot be used to mint new access tokens.
+func (uc *UserUsecase) UpdateUserRole(ctx context.Context, userID, actorID string, role entity.UserRole) (*entity.User, error) {
+	if role != entity.UserRoleAdmin && role != entity.UserRoleUser {
+		return nil, errors.New("invalid role")
+	}
+	if userID == actorID && role != entity.UserRoleAdmin {
+		return nil, errors.New("admins cannot demote themselves")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err.Error() == errUserNotFound {
+			return nil, errors.New("user not found")
+		}
+		uc.logger.Errorf("failed to retrieve user for role update: %v", err)
+		return nil, errors.New(errInternalServer)
+	}
+
+	if user.Role == role {
+		return user, nil
+	}
+
+	previousRole := user.Role
+	user.Role = role
+
+	_, err = uc.userRepo.UpdateUser(ctx, user)
+	if err != nil {
+		uc.logger.Errorf("failed to update role for user %s: %v", userID, err)
+		return nil, errors.New("failed to update user role")
+	}
+
+	// Revoke outstanding refresh tokens so the role change takes effect on the
+	// target's next token refresh.
+	if err := uc.tokenRepo.RevokeAllTokensForUser(ctx, userID, entity.TokenTypeRefresh); err != nil {
+		uc.logger.Errorf("failed to revoke tokens for user %s after role change: %v", userID, err)
+	}
+
+	uc.logger.Infof("user %s role changed from %s to %s by admin %s", userID, previousRole, role, actorID)
+
+	uc.notifySecurityEvent(ctx, user, "Your account role was changed",
+		fmt.Sprintf("Your account role was changed from %s to %s. You have been signed out everywhere and will need to log in again.", previousRole, role))
+
+	if uc.securityEventRepo != nil {
+		event := &entity.SecurityEvent{
+			ID:        uc.uuidGenerator.NewUUID(),
+			UserID:    user.ID,
+			EventType: entity.SecurityEventRoleChange,
+			CreatedAt: time.Now(),
+		}
+		if err := uc.securityEventRepo.CreateEvent(ctx, event); err != nil {
+			uc.logger.Errorf("failed to record role change event for user %s: %v", user.ID, err)
+		}
+	}
+
+	return user, nil
+}
+
+// SetShadowBan sets or clears the shadow-ban flag on a user.
+func (uc *UserUsecase) SetShadowBan(ctx context.Context, userID string, banned bool) (*entity.User, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err.Error() == errUserNotFound {
+			return nil, errors.New("user not found")
+		}
+		uc.logger.Errorf("failed to retrieve user for shadow ban update: %v", err)
+		return nil, errors.New(errInternalServer)
+	}
+
+	if user.Role == entity.UserRoleAdmin {
+		return nil, errors.New("cannot shadow ban an admin")
+	}
+
+	if user.IsShadowBanned == banned {
+		return user, nil
+	}
+
+	user.IsShadowBanned = banned
+
+	_, err = uc.userRepo.UpdateUser(ctx, user)
+	if err != nil {
+		uc.logger.Errorf("failed to update shadow ban for user %s: %v", userID, err)
+		return nil, errors.New("failed to update shadow ban")
+	}
+
+	return user, nil
+}
+
+// SetActive activates or deactivates a user. Deactivated users cannot log in
+// and their blogs and comments are hidden from listings until reactivation.
+func (uc *UserUsecase) SetActive(ctx context.Context, userID string, active bool) (*entity.User, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err.Error() == errUserNotFound {
+			return nil, errors.New("user not found")
+		}
+		uc.logger.Errorf("failed to retrieve user for activation update: %v", err)
+		return nil, errors.New(errInternalServer)
+	}
+
+	if user.IsDeleted {
+		return nil, errors.New("user not found")
+	}
+	if user.Role == entity.UserRoleAdmin {
+		return nil, errors.New("cannot deactivate an admin")
+	}
+
+	if user.IsActive == active {
+		return user, nil
+	}
+
+	user.IsActive = active
+
+	_, err = uc.userRepo.UpdateUser(ctx, user)
+	if err != nil {
+		uc.logger.Errorf("failed to update activation for user %s: %v", userID, err)
+		return nil, errors.New("failed to update user activation")
+	}
+
+	// Sign the user out everywhere so the deactivation takes effect
+	// immediately, not on their next token refresh.
+	if !active {
+		if err := uc.tokenRepo.RevokeAllTokensForUser(ctx, userID, entity.TokenTypeRefresh); err != nil {
+			uc.logger.Errorf("failed to revoke tokens for deactivated user %s: %v", userID, err)
+		}
+	}
+
+	uc.cascadeAuthorHidden(ctx, userID, !active)
+
+	return user, nil
+}
+
+// DeleteUser soft-deletes a user: the record is kept with its PII scrubbed,
+// their comments are anonymized to "[deleted]", and their refresh tokens
+// revoked. Their published content stays visible.
+func (uc *UserUsecase) DeleteUser(ctx context.Context, userID string) error {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err.Error() == errUserNotFound {
+			return errors.New("user not found")
+		}
+		uc.logger.Errorf("failed to retrieve user for deletion: %v", err)
+		return errors.New(errInternalServer)
+	}
+
+	if user.IsDeleted {
+		return errors.New("user not found")
+	}
+	if user.Role == entity.UserRoleAdmin {
+		return errors.New("cannot delete an admin")
+	}
+
+	if err := uc.userRepo.DeleteUser(ctx, userID); err != nil {
+		uc.logger.Errorf("failed to delete user %s: %v", userID, err)
+		return errors.New("failed to delete user")
+	}
+
+	if err := uc.tokenRepo.RevokeAllTokensForUser(ctx, userID, entity.TokenTypeRefresh); err != nil {
+		uc.logger.Errorf("failed to revoke tokens for deleted user %s: %v", userID, err)
+	}
+
+	if uc.commentRepo != nil {
+		if err := uc.commentRepo.AnonymizeByAuthor(ctx, userID, deletedUserPlaceholder); err != nil {
+			uc.logger.Errorf("failed to anonymize comments for deleted user %s: %v", userID, err)
+		}
+	}
+
+	// The account may have been deactivated before deletion; deleted users'
+	// content stays visible (anonymized), so lift any hidden flags.
+	uc.cascadeAuthorHidden(ctx, userID, false)
+
+	return nil
+}
+
+// cascadeAuthorHidden propagates a user's visibility to their blogs and
+// comments. Failures are logged but never surfaced; the account update
+// already happened and the cascade can be retried by toggling again.
+func (uc *UserUsecase) cascadeAuthorHidden(ctx context.Context, userID string, hidden bool) {
+	if uc.blogRepo != nil {
+		if err := uc.blogRepo.SetAuthorHidden(ctx, userID, hidden); err != nil {
+			uc.logger.Errorf("failed to cascade blog visibility for user %s: %v", userID, err)
+		}
+	}
+	if uc.commentRepo != nil {
+		if err := uc.commentRepo.SetAuthorHidden(ctx, userID, hidden); err != nil {
+			uc.logger.Errorf("failed to cascade comment visibility for user %s: %v", userID, err)
+		}
+	}
+}
+
+// ListShadowBannedUsers returns all accounts that are currently shadow banned.
+func (uc *UserUsecase) ListShadowBannedUsers(ctx context.Context) ([]*entity.User, error) {
+	users, err := uc.userRepo.GetShadowBannedUsers(ctx)
+	if err != nil {
+		uc.logger.Errorf("failed to list shadow banned users: %v", err)
+		return nil, errors.New(errInternalServer)
+	}
+	return users, nil
+}
+
+// GetSecurityEvents returns a page of recorded security events for a user,
+// newest first, along with the total event count.
+func (uc *UserUsecase) GetSecurityEvents(ctx context.Context, userID string, page, pageSize int) ([]*entity.SecurityEvent, int64, error) {
+	if uc.securityEventRepo == nil {
+		return nil, 0, errors.New("security event tracking is not enabled")
+	}
+
+	if _, err := uc.userRepo.GetUserByID(ctx, userID); err != nil {
+		if err.Error() == errUserNotFound {
+			return nil, 0, errors.New("user not found")
+		}
+		uc.logger.Errorf("failed to retrieve user for security events: %v", err)
+		return nil, 0, errors.New(errInternalServer)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	events, total, err := uc.securityEventRepo.GetEventsByUserID(ctx, userID, contract.Pagination{Page: page, PageSize: pageSize})
+	if err != nil {
+		uc.logger.Errorf("failed to retrieve security events for user %s: %v", userID, err)
+		return nil, 0, errors.New(errInternalServer)
+	}
+
+	return events, total, nil
+}
+
 // UpdateProfile allows a registered user to update their profile details.
 func (uc *UserUsecase) UpdateProfile(ctx context.Context, userID string, updates map[string]interface{}) (*entity.User, error) {
 	uc.logger.Infof("UpdateProfile called for user %s with updates: %+v", userID, updates)
@@ -500,6 +1040,16 @@ func (uc *UserUsecase) UpdateProfile(ctx context.Context, userID string, updates
 
 	uc.logger.Infof("Current user before update: %+v", user)
 
+	// Only profile fields may change here; identity and account-state fields
+	// (email, role, is_active) are managed by their own flows
+	for k := range updates {
+		switch k {
+		case "username", "first_name", "last_name", "avatar_url", "security_emails_opt_out", "leaderboard_opt_out":
+		default:
+			return nil, fmt.Errorf("field %s cannot be updated", k)
+		}
+	}
+
 	// Check for username uniqueness if username is being updated
 	if val, ok := updates["username"]; ok {
 		if username, isString := val.(string); isString {
@@ -525,19 +1075,61 @@ func (uc *UserUsecase) UpdateProfile(ctx context.Context, userID string, updates
 			}
 		case "first_name":
 			if firstName, ok := v.(string); ok {
+				if len(firstName) > 50 {
+					return nil, errors.New("first name must be at most 50 characters")
+				}
 				user.FirstName = &firstName
 			}
 		case "last_name":
 			if lastName, ok := v.(string); ok {
+				if len(lastName) > 50 {
+					return nil, errors.New("last name must be at most 50 characters")
+				}
 				user.LastName = &lastName
 			}
 		case "avatar_url":
 			if avatarURL, ok := v.(string); ok {
+				parsed, err := url.ParseRequestURI(avatarURL)
+				if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+					return nil, errors.New("invalid avatar URL")
+				}
 				user.AvatarURL = &avatarURL
 			}
-		case "is_active":
-			if isActive, ok := v.(bool); ok {
-				user.IsActive = isActive
+		case "security_emails_opt_out":
+			if optOut, ok := v.(bool); ok {
+				user.SecurityEmailsOptOut = optOut
+			}
+		case "leaderboard_opt_out":
+			if optOut, ok := v.(bool); ok {
+				user.LeaderboardOptOut = optOut
+			}
+		case "push_notifications_opt_out":
+			if optOut, ok := v.(bool); ok {
+				user.PushNotificationsOptOut = optOut
+			}
+		case "comment_digest":
+			if digest, ok := v.(string); ok {
+				if digest == "none" {
+					digest = ""
+				}
+				if digest != "" && digest != "hourly" && digest != "daily" {
+					return nil, errors.New("comment digest must be none, hourly or daily")
+				}
+				user.CommentDigest = digest
+			}
+		case "mature_content_opt_in":
+			if optIn, ok := v.(bool); ok {
+				user.MatureContentOptIn = optIn
+			}
+		case "performance_report":
+			if report, ok := v.(string); ok {
+				if report == "none" {
+					report = ""
+				}
+				if report != "" && report != "weekly" && report != "monthly" {
+					return nil, errors.New("performance report must be none, weekly or monthly")
+				}
+				user.PerformanceReport = report
 			}
 		}
 	}
@@ -562,6 +1154,8 @@ func (uc *UserUsecase) UpdateProfile(ctx context.Context, userID string, updates
 
 // login with OAuth2
 func (uc *UserUsecase) LoginWithOAuth(ctx context.Context, firstName, lastName, email string) (string, string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
 	// Check if user with the given email already exists
 	user, err := uc.userRepo.GetUserByEmail(ctx, email)
 	if err != nil && err.Error() != errUserNotFound {
@@ -606,13 +1200,13 @@ func (uc *UserUsecase) LoginWithOAuth(ctx context.Context, firstName, lastName,
 
 	// At this point, we have a user (either existing or newly created)
 	// Generate access and refresh tokens
-	accessToken, err := uc.jwtService.GenerateAccessToken(user.ID, user.Role)
+	accessToken, err := uc.jwtService.GenerateAccessToken(user.ID, user.Role, entity.ClientTypeWeb)
 	if err != nil {
 		uc.logger.Errorf("failed to generate access token for OAuth user: %v", err)
 		return "", "", errors.New("failed to generate token")
 	}
 
-	refreshToken, err := uc.jwtService.GenerateRefreshToken(user.ID, user.Role)
+	refreshToken, err := uc.jwtService.GenerateRefreshToken(user.ID, user.Role, entity.ClientTypeWeb, false)
 	if err != nil {
 		uc.logger.Errorf("failed to generate refresh token for OAuth user: %v", err)
 		return "", "", errors.New("failed to generate token")
@@ -653,5 +1247,10 @@ func (uc *UserUsecase) GetUserByID(ctx context.Context, userID string) (*entity.
 		return nil, errors.New(errInternalServer)
 	}
 
+	// Soft-deleted accounts are gone as far as callers are concerned.
+	if user.IsDeleted {
+		return nil, errors.New("user not found")
+	}
+
 	return user, nil
 }
@@ -0,0 +1,234 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContextLines is how many unchanged lines surround each hunk in a
+// unified diff.
+const diffContextLines = 3
+
+// maxDiffCells caps the size of the LCS table; beyond it the differing
+// middle section is reported as one whole-block replacement instead of a
+// line-by-line diff.
+const maxDiffCells = 4_000_000
+
+// diff op kinds.
+const (
+	diffEqual  = ' '
+	diffDelete = '-'
+	diffInsert = '+'
+)
+
+type diffOp struct {
+	kind rune
+	line string
+}
+
+// UnifiedDiff renders a unified text diff (as produced by diff -u) between
+// two texts, comparing line by line.
+func UnifiedDiff(aName, bName, a, b string) string {
+	aLines := splitDiffLines(a)
+	bLines := splitDiffLines(b)
+	ops := diffLines(aLines, bLines)
+
+	hunks := buildDiffHunks(ops)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n", aName)
+	fmt.Fprintf(&sb, "+++ %s\n", bName)
+	sb.WriteString(strings.Join(hunks, ""))
+	return sb.String()
+}
+
+// splitDiffLines splits text into lines without trailing newlines. Empty
+// text yields no lines, so an empty side diffs cleanly.
+func splitDiffLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines computes the line-level edit script between a and b. Common
+// prefix and suffix lines are trimmed first so the quadratic LCS table only
+// covers the changed middle.
+func diffLines(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{diffEqual, line})
+	}
+	ops = append(ops, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{diffEqual, line})
+	}
+	return ops
+}
+
+// diffMiddle diffs the changed middle section with a longest-common-
+// subsequence walk, falling back to a whole-block replacement when the
+// section is too large to table.
+func diffMiddle(a, b []string) []diffOp {
+	if len(a) == 0 && len(b) == 0 {
+		return nil
+	}
+	if len(a)*len(b) > maxDiffCells {
+		return replaceBlock(a, b)
+	}
+
+	// lcs[i][j] holds the LCS length of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{diffEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{diffDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{diffInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{diffDelete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{diffInsert, b[j]})
+	}
+	return ops
+}
+
+// replaceBlock reports every line of a as deleted and every line of b as
+// inserted.
+func replaceBlock(a, b []string) []diffOp {
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, line := range a {
+		ops = append(ops, diffOp{diffDelete, line})
+	}
+	for _, line := range b {
+		ops = append(ops, diffOp{diffInsert, line})
+	}
+	return ops
+}
+
+// buildDiffHunks groups the edit script into unified hunks with context.
+func buildDiffHunks(ops []diffOp) []string {
+	var hunks []string
+	aLine, bLine := 1, 1
+	i := 0
+	for i < len(ops) {
+		if ops[i].kind == diffEqual {
+			aLine++
+			bLine++
+			i++
+			continue
+		}
+
+		// Found a change: open a hunk with leading context.
+		start := i - diffContextLines
+		if start < 0 {
+			start = 0
+		}
+		// Count equal lines skipped before the context window.
+		for k := i - 1; k >= start; k-- {
+			aLine--
+			bLine--
+		}
+
+		aStart, bStart := aLine, bLine
+		var aCount, bCount int
+		var body strings.Builder
+		equalRun := 0
+		j := start
+		for j < len(ops) {
+			op := ops[j]
+			if op.kind == diffEqual {
+				equalRun++
+				// Close the hunk once the gap to the next change exceeds
+				// twice the context width. This op was not emitted, so it
+				// does not count towards the trailing run.
+				if equalRun > 2*diffContextLines {
+					equalRun--
+					break
+				}
+			} else {
+				equalRun = 0
+			}
+			fmt.Fprintf(&body, "%c%s\n", op.kind, op.line)
+			switch op.kind {
+			case diffEqual:
+				aCount++
+				bCount++
+				aLine++
+				bLine++
+			case diffDelete:
+				aCount++
+				aLine++
+			case diffInsert:
+				bCount++
+				bLine++
+			}
+			j++
+		}
+
+		// Trim trailing context beyond the window; the outer loop recounts
+		// the trimmed equal lines.
+		text := body.String()
+		if equalRun > diffContextLines {
+			trim := equalRun - diffContextLines
+			lines := strings.SplitAfter(text, "\n")
+			lines = lines[:len(lines)-1-trim]
+			text = strings.Join(lines, "")
+			aCount -= trim
+			bCount -= trim
+			aLine -= trim
+			bLine -= trim
+			j -= trim
+		}
+
+		// An empty side is conventionally anchored one line earlier.
+		if aCount == 0 {
+			aStart--
+		}
+		if bCount == 0 {
+			bStart--
+		}
+		hunks = append(hunks, fmt.Sprintf("@@ -%d,%d +%d,%d @@\n%s", aStart, aCount, bStart, bCount, text))
+		i = j
+	}
+	return hunks
+}
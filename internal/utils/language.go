@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// languageStopwords maps a language tag to common short words that rarely
+// appear in other Latin-script languages. Detection for Latin scripts picks
+// the language whose stopwords occur most often in the text.
+var languageStopwords = map[string][]string{
+	"en": {"the", "and", "is", "of", "to", "in", "that", "it", "was", "with"},
+	"es": {"el", "la", "los", "las", "que", "es", "de", "un", "una", "para"},
+	"fr": {"le", "la", "les", "des", "est", "une", "dans", "que", "pour", "avec"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ein", "eine", "mit", "für"},
+	"pt": {"o", "os", "as", "que", "uma", "não", "para", "com", "em", "é"},
+	"it": {"il", "lo", "gli", "che", "di", "un", "una", "per", "con", "sono"},
+}
+
+// DetectLanguage guesses the BCP 47 language tag of the text. Non-Latin
+// scripts are recognized by their Unicode range; Latin-script text is scored
+// against per-language stopword lists. It returns "" when the text gives no
+// usable signal, so callers can leave the language unset rather than store a
+// bad guess.
+func DetectLanguage(text string) string {
+	if tag := detectByScript(text); tag != "" {
+		return tag
+	}
+	return detectByStopwords(text)
+}
+
+// detectByScript returns a language tag when the majority script of the text
+// identifies the language on its own.
+func detectByScript(text string) string {
+	counts := map[string]int{}
+	letters := 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		switch {
+		case unicode.Is(unicode.Ethiopic, r):
+			counts["am"]++
+		case unicode.Is(unicode.Arabic, r):
+			counts["ar"]++
+		case unicode.Is(unicode.Cyrillic, r):
+			counts["ru"]++
+		case unicode.Is(unicode.Han, r):
+			counts["zh"]++
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			counts["ja"]++
+		case unicode.Is(unicode.Hangul, r):
+			counts["ko"]++
+		case unicode.Is(unicode.Devanagari, r):
+			counts["hi"]++
+		case unicode.Is(unicode.Greek, r):
+			counts["el"]++
+		case unicode.Is(unicode.Hebrew, r):
+			counts["he"]++
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+	// Japanese text mixes Han with kana; any kana at all means Japanese.
+	if counts["ja"] > 0 {
+		counts["ja"] += counts["zh"]
+		counts["zh"] = 0
+	}
+	for tag, count := range counts {
+		// A script must dominate the text, not just appear in a quote.
+		if count*2 > letters {
+			return tag
+		}
+	}
+	return ""
+}
+
+// detectByStopwords scores Latin-script text against the per-language
+// stopword lists and returns the best match.
+func detectByStopwords(text string) string {
+	counts := map[string]int{}
+	for _, w := range strings.Fields(strings.ToLower(text)) {
+		w = strings.TrimFunc(w, func(r rune) bool { return !unicode.IsLetter(r) })
+		if w != "" {
+			counts[w]++
+		}
+	}
+	if len(counts) == 0 {
+		return ""
+	}
+
+	// Stopwords are shared between languages ("que", "la"), so every language
+	// is scored independently and ties break towards English.
+	best, bestScore := "", 0
+	for _, tag := range []string{"en", "es", "fr", "de", "pt", "it"} {
+		score := 0
+		for _, w := range languageStopwords[tag] {
+			score += counts[w]
+		}
+		if score > bestScore {
+			best, bestScore = tag, score
+		}
+	}
+	// Require more than one hit so a single ambiguous word does not decide.
+	if bestScore < 2 {
+		return ""
+	}
+	return best
+}
@@ -1,12 +1,42 @@
 package utils
 
-// CalculatePopularity computes the popularity score for a blog.
+import (
+	"math"
+	"time"
+)
+
+// PopularityWeights holds the coefficients of the popularity formula. A
+// RecencyHalfLifeHours of zero disables the recency decay.
+type PopularityWeights struct {
+	View                 float64 `json:"view"`
+	Like                 float64 `json:"like"`
+	Dislike              float64 `json:"dislike"`
+	Comment              float64 `json:"comment"`
+	RecencyHalfLifeHours float64 `json:"recency_half_life_hours"`
+}
+
+// DefaultPopularityWeights returns the weights the formula shipped with.
+func DefaultPopularityWeights() PopularityWeights {
+	return PopularityWeights{
+		View:    1.0,
+		Like:    3.0,
+		Dislike: -2.0,
+		Comment: 2.0,
+	}
+}
+
+// Score computes the popularity score for a blog of the given age. With a
+// positive half-life the raw score halves every RecencyHalfLifeHours.
+func (w PopularityWeights) Score(views, likes, dislikes, comments int, age time.Duration) float64 {
+	score := float64(views)*w.View + float64(likes)*w.Like + float64(dislikes)*w.Dislike + float64(comments)*w.Comment
+	if w.RecencyHalfLifeHours > 0 && age > 0 {
+		score *= math.Pow(0.5, age.Hours()/w.RecencyHalfLifeHours)
+	}
+	return score
+}
+
+// CalculatePopularity computes the popularity score for a blog using the
+// default weights, without recency decay.
 func CalculatePopularity(views, likes, dislikes, comments int) float64 {
-	const (
-		viewWeight    = 1.0
-		likeWeight    = 3.0
-		dislikeWeight = -2.0
-		commentWeight = 2.0
-	)
-	return float64(views)*viewWeight + float64(likes)*likeWeight + float64(dislikes)*dislikeWeight + float64(comments)*commentWeight
+	return DefaultPopularityWeights().Score(views, likes, dislikes, comments, 0)
 }
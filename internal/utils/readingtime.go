@@ -0,0 +1,21 @@
+package utils
+
+import "strings"
+
+// readingWordsPerMinute is the assumed reading speed used to estimate how
+// long a post takes to read.
+const readingWordsPerMinute = 200
+
+// EstimateReadingTime returns the estimated reading time of the content in
+// whole minutes. Non-empty content always takes at least one minute.
+func EstimateReadingTime(content string) int {
+	words := len(strings.Fields(content))
+	if words == 0 {
+		return 0
+	}
+	minutes := (words + readingWordsPerMinute - 1) / readingWordsPerMinute
+	if minutes < 1 {
+		minutes = 1
+	}
+	return minutes
+}